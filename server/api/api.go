@@ -78,6 +78,7 @@ type API struct {
 	RedMountainChat  *Chatroom
 	BostonChat       *Chatroom
 	ZaibatsuChat     *Chatroom
+	SyndicateChats   *SyndicateChatrooms
 	ProfanityManager *profanities.ProfanityManager
 
 	// captcha
@@ -93,6 +94,9 @@ type API struct {
 
 	ViewerUpdateChan chan bool
 
+	// players who were online on the last presence check
+	friendPresence map[string]bool
+
 	ChallengeFund decimal.Decimal
 }
 
@@ -145,7 +149,7 @@ func NewAPI(
 		FactionActivePlayers: make(map[string]*ActivePlayers),
 
 		// marketplace
-		MarketplaceController: marketplace.NewMarketplaceController(pp),
+		MarketplaceController: marketplace.NewMarketplaceController(pp, telegram, questManager),
 
 		// fiat
 		FiatController: fiat.NewFiatController(pp, stripeClient),
@@ -155,6 +159,7 @@ func NewAPI(
 		RedMountainChat:  NewChatroom(server.RedMountainFactionID),
 		BostonChat:       NewChatroom(server.BostonCyberneticsFactionID),
 		ZaibatsuChat:     NewChatroom(server.ZaibatsuFactionID),
+		SyndicateChats:   NewSyndicateChatrooms(ss),
 		ProfanityManager: pm,
 		SyndicateSystem:  ss,
 		SyncConfig:       syncConfig,
@@ -188,7 +193,10 @@ func NewAPI(
 	sc := NewStreamController(api)
 	pc := NewPlayerController(api)
 	cc := NewChatController(api)
+	dmc := NewDirectMessageController(api)
+	prc := NewPlayerRelationshipController(api)
 	ssc := NewStoreController(api)
+	_ = NewAmmoController(api)
 	_ = NewBattleController(api)
 	mc := NewMarketplaceController(api)
 	pac := NewAbilitiesController(api)
@@ -204,6 +212,7 @@ func NewAPI(
 	_ = NewReplayController(api)
 	NewVoiceStreamController(api)
 	BattleQueueController(api)
+	NewTournamentController(api)
 	NewMarketplaceController(api)
 	NewModToolsController(api)
 	NewAdminController(api)
@@ -231,6 +240,7 @@ func NewAPI(
 		r.Post("/stripe-webhook", WithError(fc.StripeWebhook))
 		r.Mount("/check", CheckRouter(arenaManager, telegram, arenaManager.IsClientConnected))
 		r.Mount("/stat", AssetStatsRouter(api))
+		r.Mount("/marketplace", MarketplaceRouter(api))
 		r.Mount(fmt.Sprintf("/%s/Supremacy_game", server.SupremacyGameUserID), PassportWebhookRouter(config.PassportWebhookSecret, api))
 
 		r.Group(func(r chi.Router) {
@@ -245,6 +255,7 @@ func NewAPI(
 			r.Mount("/replay", BattleReplayRouter(api))
 			r.Mount("/sale_abilities", SaleAbilitiesRouter(api))
 			r.Mount("/system_messages", SystemMessagesRouter(api))
+			r.Mount("/crate", MysteryCrateRouter(api))
 
 			r.Mount("/battle", BattleRouter(api))
 			r.Get("/telegram/shortcode_registered", WithToken(config.ServerStreamKey, WithError(api.PlayerGetTelegramShortcodeRegistered)))
@@ -271,6 +282,9 @@ func NewAPI(
 				s.WS("/challenge_fund", server.HubKeyChallengeFundSubscribe, api.ChallengeFundSubscribeHandler)
 
 				s.WS("/arena_list", server.HubKeyBattleArenaListSubscribe, api.ArenaListSubscribeHandler)
+
+				s.WS("/tournament_list", server.HubKeyTournamentListUpdate, api.TournamentListSubscribeHandler)
+				s.WS("/tournament/{tournament_id}", server.HubKeyTournamentUpdate, api.TournamentSubscribeHandler)
 				s.WS("/arena/{arena_id}/closed", server.HubKeyBattleArenaClosedSubscribe, api.ArenaClosedSubscribeHandler)
 
 				// come from battle
@@ -313,6 +327,9 @@ func NewAPI(
 				s.WS("/user/{user_id}/player_abilities", server.HubKeyPlayerAbilitiesList, server.MustSecure(pac.PlayerAbilitiesListHandler), MustMatchUserID)
 				s.WS("/user/{user_id}/punishment_list", HubKeyPlayerPunishmentList, server.MustSecure(pc.PlayerPunishmentList), MustMatchUserID)
 				s.WS("/user/{user_id}/system_messages", server.HubKeySystemMessageListUpdatedSubscribe, nil, MustMatchUserID)
+				s.WS("/user/{user_id}/marketplace_alerts", server.HubKeyMarketplaceAlert, nil, MustMatchUserID)
+				s.WS("/user/{user_id}/direct_messages", server.HubKeyDirectMessageSubscribe, server.MustSecure(dmc.DirectMessageSubscribeHandler), MustMatchUserID)
+				s.WS("/user/{user_id}/friends", server.HubKeyPlayerFriendsSubscribe, server.MustSecure(prc.FriendsSubscribeHandler), MustMatchUserID)
 				s.WS("/user/{user_id}/telegram_shortcode_register", server.HubKeyTelegramShortcodeRegistered, nil, MustMatchUserID)
 				s.WS("/user/{user_id}/quest_stat", server.HubKeyPlayerQuestStats, server.MustSecure(pc.PlayerQuestStat), MustMatchUserID)
 				s.WS("/user/{user_id}/quest_progression", server.HubKeyPlayerQuestProgressions, server.MustSecure(pc.PlayerQuestProgressions), MustMatchUserID)
@@ -378,6 +395,7 @@ func NewAPI(
 				s.WS("/syndicate/{syndicate_id}/committees", server.HubKeySyndicateCommitteesSubscribe, server.MustSecureFaction(api.SyndicateCommitteesSubscribeHandler), MustMatchSyndicate)
 				s.WS("/syndicate/{syndicate_id}/ongoing_motions", server.HubKeySyndicateOngoingMotionSubscribe, server.MustSecureFaction(api.SyndicateOngoingMotionSubscribeHandler), MustMatchSyndicate)
 				s.WS("/syndicate/{syndicate_id}/ongoing_election", server.HubKeySyndicateOngoingElectionSubscribe, server.MustSecureFaction(api.SyndicateOngoingElectionSubscribeHandler), MustMatchSyndicate)
+				s.WS("/syndicate/{syndicate_id}/chat", server.HubKeySyndicateChatSubscribe, server.MustSecureFaction(cc.SyndicateChatSubscribeHandler), MustMatchSyndicate)
 
 				// faction pass
 				s.WS("/faction_pass/{faction_pass_id}/stripe_payment_intent", HubKeyFactionPassStripePaymentIntent, server.MustSecureFaction(api.FactionPassStripePaymentIntent))
@@ -479,6 +497,9 @@ func (api *API) initialWSBroadcast() error {
 	// start repair offer cleaner
 	go api.ArenaManager.RepairOfferCleaner()
 
+	// start tournaments when their registration closes
+	go api.ArenaManager.TournamentRegistrationChecker()
+
 	// start debounce lobby update sender
 	go api.ArenaManager.DebounceSendBattleLobbiesUpdate()
 
@@ -739,6 +739,10 @@ func (pvt *PunishVoteTracker) BroadcastPunishVoteResult(isPassed bool) {
 	}
 
 	// store message to the chat
+	err = persistChatMessage(chatMessage, boiler.ChatMSGTypeEnumPUNISH_VOTE, null.StringFrom(pvt.FactionID), punishVote.IssuedByID)
+	if err != nil {
+		gamelog.L.Error().Str("punish vote id", punishVote.ID).Err(err).Msg("Failed to store punish vote message in chat history")
+	}
 	pvt.api.AddFactionChatMessage(pvt.FactionID, chatMessage)
 
 	// broadcast
@@ -2,8 +2,10 @@ package api
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"server/db"
+	"server/helpers"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/ninja-syndicate/ws"
@@ -29,6 +31,9 @@ func AdminRoutes(api *API, key string) chi.Router {
 
 	r.Post("/livestream", WithToken(key, WithError(api.LivestreamUpdate)))
 
+	r.Post("/battle_arena/{arena_id}/client_key", WithToken(key, WithError(api.BattleArenaClientKeyCreate)))
+	r.Delete("/battle_arena/{arena_id}/client_key/{key_id}", WithToken(key, WithError(api.BattleArenaClientKeyRevoke)))
+
 	return r
 }
 
@@ -49,3 +54,57 @@ func (api *API) LivestreamUpdate(w http.ResponseWriter, r *http.Request) (int, e
 
 	return http.StatusOK, nil
 }
+
+type BattleArenaClientKeyCreateReq struct {
+	Label string `json:"label"`
+}
+
+type BattleArenaClientKeyResp struct {
+	ID      string `json:"id"`
+	ArenaID string `json:"arena_id"`
+	Label   string `json:"label"`
+	Secret  string `json:"secret"`
+}
+
+// BattleArenaClientKeyCreate generates a new credential for the game client of an arena.
+// The secret is only shown once, so it needs to be configured on the game client straight away.
+func (api *API) BattleArenaClientKeyCreate(w http.ResponseWriter, r *http.Request) (int, error) {
+	arenaID := chi.URLParam(r, "arena_id")
+	if arenaID == "" {
+		return http.StatusBadRequest, fmt.Errorf("missing arena id")
+	}
+
+	req := &BattleArenaClientKeyCreateReq{}
+	err := json.NewDecoder(r.Body).Decode(req)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	key, err := db.BattleArenaClientKeyCreate(arenaID, req.Label)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	return helpers.EncodeJSON(w, &BattleArenaClientKeyResp{
+		ID:      key.ID,
+		ArenaID: key.BattleArenaID,
+		Label:   key.Label,
+		Secret:  key.Secret,
+	})
+}
+
+// BattleArenaClientKeyRevoke revokes a game client credential of an arena
+func (api *API) BattleArenaClientKeyRevoke(w http.ResponseWriter, r *http.Request) (int, error) {
+	arenaID := chi.URLParam(r, "arena_id")
+	keyID := chi.URLParam(r, "key_id")
+	if arenaID == "" || keyID == "" {
+		return http.StatusBadRequest, fmt.Errorf("missing arena id or key id")
+	}
+
+	err := db.BattleArenaClientKeyRevoke(arenaID, keyID)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	return helpers.EncodeJSON(w, true)
+}
@@ -10,7 +10,6 @@ import (
 	"server"
 	"server/db"
 	"server/db/boiler"
-	"server/fiat"
 	"server/gamedb"
 	"server/gamelog"
 	"time"
@@ -90,12 +89,95 @@ func (f *FiatController) StripeWebhook(w http.ResponseWriter, r *http.Request) (
 				return http.StatusInternalServerError, terror.Error(err, "Failed to get faction pass purchase log.")
 			}
 
+			fromStatus := fpp.PaymentStatus
 			fpp.PaymentStatus = PaymentStatusSuccess
 			_, err = fpp.Update(gamedb.StdConn, boil.Whitelist(boiler.FactionPassPurchaseLogColumns.PaymentStatus))
 			if err != nil {
 				l.Warn().Err(err).Msg("Failed to update payment status")
 				return http.StatusInternalServerError, terror.Error(err, "Failed to update payment status")
 			}
+			err = recordOrderState(gamedb.StdConn, &boiler.OrderStateHistory{
+				FactionPassPurchaseLogID: null.StringFrom(fpp.ID),
+				Action:                   db.OrderStateActionStatusChanged,
+				FromStatus:               null.StringFrom(fromStatus),
+				ToStatus:                 null.StringFrom(fpp.PaymentStatus),
+				StripeEventID:            null.StringFrom(event.ID),
+				Note:                     "charge succeeded",
+			})
+			if err != nil {
+				l.Warn().Err(err).Msg("Failed to record payment status")
+			}
+
+			// gifts go to the recipient, or are handed out as a gift code
+			if charge.Metadata["gift_code"] == "true" {
+				existing, err := db.FiatGiftByFactionPassPurchase(gamedb.StdConn, fpp.ID)
+				if err != nil {
+					l.Warn().Err(err).Msg("Failed to load faction pass gift")
+					return http.StatusInternalServerError, terror.Error(err, "Failed to load faction pass gift.")
+				}
+				if existing != nil {
+					return http.StatusOK, nil
+				}
+
+				fp, err := boiler.FindFactionPass(gamedb.StdConn, factionPassID)
+				if err != nil {
+					l.Warn().Err(err).Msg("Failed to load faction pass")
+					return http.StatusInternalServerError, terror.Error(err, "Failed to load faction pass.")
+				}
+
+				tx, err := gamedb.StdConn.Begin()
+				if err != nil {
+					l.Warn().Err(err).Msg("Failed to start transaction")
+					return http.StatusInternalServerError, terror.Error(err, "Failed to create gift code.")
+				}
+				defer tx.Rollback()
+
+				codes, err := createFiatGiftCodes(tx, &boiler.FiatGift{
+					PurchasedByID:            playerID,
+					FactionPassPurchaseLogID: null.StringFrom(fpp.ID),
+				}, boiler.CouponItemTypeFACTION_PASS, factionPassID, 1)
+				if err != nil {
+					l.Warn().Err(err).Msg("Failed to create gift code")
+					return http.StatusInternalServerError, err
+				}
+
+				err = tx.Commit()
+				if err != nil {
+					l.Warn().Err(err).Msg("Failed to commit transaction")
+					return http.StatusInternalServerError, terror.Error(err, "Failed to create gift code.")
+				}
+
+				go sendFiatGiftMessages(playerID, nil, []*FiatGiftMessage{{
+					FromPlayerID: playerID,
+					ItemLabel:    fmt.Sprintf("%s faction pass", fp.Label),
+					Quantity:     1,
+					Codes:        codes,
+				}})
+				return http.StatusOK, nil
+			}
+
+			buyerID := playerID
+			giftRecipientID, isGift := charge.Metadata["gift_recipient_id"]
+			if isGift {
+				existing, err := db.FiatGiftByFactionPassPurchase(gamedb.StdConn, fpp.ID)
+				if err != nil {
+					l.Warn().Err(err).Msg("Failed to load faction pass gift")
+					return http.StatusInternalServerError, terror.Error(err, "Failed to load faction pass gift.")
+				}
+				if existing == nil {
+					gift := &boiler.FiatGift{
+						PurchasedByID:            playerID,
+						RecipientID:              null.StringFrom(giftRecipientID),
+						FactionPassPurchaseLogID: null.StringFrom(fpp.ID),
+					}
+					err = gift.Insert(gamedb.StdConn, boil.Infer())
+					if err != nil {
+						l.Warn().Err(err).Msg("Failed to record faction pass gift")
+						return http.StatusInternalServerError, terror.Error(err, "Failed to record faction pass gift.")
+					}
+				}
+				playerID = giftRecipientID
+			}
 
 			player, err := boiler.FindPlayer(gamedb.StdConn, playerID)
 			if err != nil {
@@ -114,6 +196,16 @@ func (f *FiatController) StripeWebhook(w http.ResponseWriter, r *http.Request) (
 			}
 
 			ws.PublishMessage(fmt.Sprintf("/secure/user/%s/faction_pass_expiry_date", player.ID), HubKeyPlayerFactionPassExpiryDate, player.FactionPassExpiresAt)
+
+			if isGift {
+				go sendFiatGiftMessages(buyerID, map[string][]*FiatGiftMessage{
+					player.ID: {{
+						FromPlayerID: buyerID,
+						ItemLabel:    fmt.Sprintf("%d day faction pass", fpp.ExpendFactionPassDays),
+						Quantity:     1,
+					}},
+				}, nil)
+			}
 		}
 
 	case "invoice.paid":
@@ -150,13 +242,24 @@ func (f *FiatController) StripeWebhook(w http.ResponseWriter, r *http.Request) (
 			TXNReference:  invoice.ID,
 			Currency:      server.FiatCurrencyCodeUSD,
 		}
+		if invoice.PaymentIntent != nil {
+			order.StripePaymentIntentID = null.StringFrom(invoice.PaymentIntent.ID)
+		}
 		err = order.Insert(gamedb.StdConn, boil.Infer())
 		if err != nil {
 			l.Error().Err(err).Msg("failed to record order")
 			return http.StatusInternalServerError, terror.Error(err, "failed to record order")
 		}
 
+		orderItemIDs := make([]string, len(invoice.Lines.Data))
+		playerDiscountID := ""
 		for i, item := range invoice.Lines.Data {
+			// discount lines are not order items
+			if discountID, ok := item.Metadata["player_discount_id"]; ok {
+				playerDiscountID = discountID
+				continue
+			}
+
 			fiatProductID, ok := item.Metadata["fiat_product_id"]
 			if !ok {
 				l.Error().Err(err).Msg("failed to get product info")
@@ -189,6 +292,7 @@ func (f *FiatController) StripeWebhook(w http.ResponseWriter, r *http.Request) (
 				l.Error().Err(err).Msg(fmt.Sprintf("failed to record order item %d", i))
 				return http.StatusInternalServerError, terror.Error(err, "failed to record order item")
 			}
+			orderItemIDs[i] = orderItem.ID
 		}
 
 		// Start transaction
@@ -199,8 +303,14 @@ func (f *FiatController) StripeWebhook(w http.ResponseWriter, r *http.Request) (
 		}
 		defer tx.Rollback()
 
-		// Handle giving out items to user
-		for _, item := range invoice.Lines.Data {
+		// Handle giving out items to user, or their gift recipients
+		gifts := map[string][]*FiatGiftMessage{}
+		giftCodes := []*FiatGiftMessage{}
+		for i, item := range invoice.Lines.Data {
+			if _, ok := item.Metadata["player_discount_id"]; ok {
+				continue
+			}
+
 			fiatProductID, ok := item.Metadata["fiat_product_id"]
 			if !ok {
 				l.Error().Err(err).Msg("failed to get product info")
@@ -220,25 +330,61 @@ func (f *FiatController) StripeWebhook(w http.ResponseWriter, r *http.Request) (
 				return http.StatusInternalServerError, terror.Error(err, "Failed to give out item to user.")
 			}
 
-			if product.ProductType == boiler.FiatProductTypesStarterPackage {
-				for i := 0; i < int(item.Quantity); i++ {
-					if product.ProductType == server.FiatProductTypeGeneric {
-						err = fiat.SendStarterPackageContentsToUser(tx, f.API.Passport, userID, product.ID)
-						if err != nil {
-							l.Error().Err(err).Msg("failed to send out package contents to user.")
-							return http.StatusInternalServerError, terror.Error(err, "failed to give user the package contents")
-						}
-					}
+			if item.Metadata["gift_code"] == "true" {
+				codes, err := createFiatGiftCodes(tx, &boiler.FiatGift{
+					PurchasedByID: userID,
+					OrderItemID:   null.StringFrom(orderItemIDs[i]),
+				}, boiler.CouponItemTypeFIAT_PRODUCT, product.ID, int(item.Quantity))
+				if err != nil {
+					l.Error().Err(err).Msg("failed to create gift codes.")
+					return http.StatusInternalServerError, terror.Error(err, "failed to create gift codes")
+				}
+				giftCodes = append(giftCodes, &FiatGiftMessage{
+					FromPlayerID: userID,
+					ItemLabel:    product.Name,
+					Quantity:     int(item.Quantity),
+					Codes:        codes,
+				})
+				continue
+			}
+
+			recipientID := userID
+			if giftRecipientID, ok := item.Metadata["gift_recipient_id"]; ok {
+				gift := &boiler.FiatGift{
+					PurchasedByID: userID,
+					RecipientID:   null.StringFrom(giftRecipientID),
+					OrderItemID:   null.StringFrom(orderItemIDs[i]),
 				}
-			} else if product.ProductType == boiler.FiatProductTypesMysteryCrate {
-				err = fiat.SendMysteryCrateToUser(tx, f.API.Passport, userID, product.ID, int(item.Quantity))
+				err = gift.Insert(tx, boil.Infer())
 				if err != nil {
-					l.Error().Err(err).Msg("failed to send out mystery crate contents to user.")
-					return http.StatusInternalServerError, terror.Error(err, "failed to give user the package contents")
+					l.Error().Err(err).Msg("failed to record gift.")
+					return http.StatusInternalServerError, terror.Error(err, "failed to record gift")
 				}
+				recipientID = giftRecipientID
+				gifts[recipientID] = append(gifts[recipientID], &FiatGiftMessage{
+					FromPlayerID: userID,
+					ItemLabel:    product.Name,
+					Quantity:     int(item.Quantity),
+				})
 			}
 
-			// TODO: Handle other product types :/
+			err = deliverFiatProduct(tx, f.API.Passport, product, recipientID, orderItemIDs[i], int(item.Quantity))
+			if err != nil {
+				l.Error().Err(err).Str("recipient_id", recipientID).Msg("failed to send out package contents to user.")
+				return http.StatusInternalServerError, terror.Error(err, "failed to give user the package contents")
+			}
+		}
+
+		if playerDiscountID != "" {
+			used, err := db.PlayerDiscountUseReserved(tx, playerDiscountID, invoice.ID)
+			if err != nil {
+				l.Error().Err(err).Str("player_discount_id", playerDiscountID).Msg("failed to mark discount as used")
+				return http.StatusInternalServerError, terror.Error(err, "Failed to mark discount as used.")
+			}
+			if !used {
+				// the invoice is paid either way, so it is only flagged for review
+				l.Warn().Str("player_discount_id", playerDiscountID).Msg("discount was used on more than one invoice")
+			}
 		}
 
 		// Clear user's cart
@@ -263,8 +409,70 @@ func (f *FiatController) StripeWebhook(w http.ResponseWriter, r *http.Request) (
 			l.Error().Err(err).Msg("failed to mark order as completed")
 			return http.StatusInternalServerError, terror.Error(err, "Failed to order as completed.")
 		}
+		err = recordOrderState(gamedb.StdConn, &boiler.OrderStateHistory{
+			OrderID:       null.StringFrom(order.ID),
+			Action:        db.OrderStateActionStatusChanged,
+			FromStatus:    null.StringFrom(boiler.OrderStatusesPending),
+			ToStatus:      null.StringFrom(boiler.OrderStatusesCompleted),
+			StripeEventID: null.StringFrom(event.ID),
+			Note:          "invoice paid, items delivered",
+		})
+		if err != nil {
+			l.Error().Err(err).Msg("failed to record completed order state")
+		}
 
 		f.publishUpdatedCart(userID, nil)
+
+		if len(gifts) > 0 || len(giftCodes) > 0 {
+			go sendFiatGiftMessages(userID, gifts, giftCodes)
+		}
+
+	case "invoice.voided", "invoice.deleted":
+		var invoice stripe.Invoice
+		err := json.Unmarshal(event.Data.Raw, &invoice)
+		if err != nil {
+			l.Error().Err(err).Msg("error parsing webhook JSON")
+			return http.StatusBadRequest, terror.Error(err)
+		}
+
+		// Discounts reserved by an invoice which will never be paid can be used again
+		err = db.PlayerDiscountRelease(gamedb.StdConn, invoice.ID)
+		if err != nil {
+			l.Error().Err(err).Str("invoice_id", invoice.ID).Msg("failed to release discount")
+			return http.StatusInternalServerError, err
+		}
+
+	case "charge.refunded":
+		var charge stripe.Charge
+		err := json.Unmarshal(event.Data.Raw, &charge)
+		if err != nil {
+			l.Error().Err(err).Msg("error parsing webhook JSON")
+			return http.StatusBadRequest, terror.Error(err)
+		}
+
+		err = f.stripeChargeRefunded(event.ID, &charge)
+		if err != nil {
+			l.Error().Err(err).Msg("failed to process refunded charge")
+			return http.StatusInternalServerError, err
+		}
+
+	case "charge.dispute.created", "charge.dispute.closed":
+		var dispute stripe.Dispute
+		err := json.Unmarshal(event.Data.Raw, &dispute)
+		if err != nil {
+			l.Error().Err(err).Msg("error parsing webhook JSON")
+			return http.StatusBadRequest, terror.Error(err)
+		}
+
+		if event.Type == "charge.dispute.created" {
+			err = f.stripeDisputeCreated(event.ID, &dispute)
+		} else {
+			err = f.stripeDisputeClosed(event.ID, &dispute)
+		}
+		if err != nil {
+			l.Error().Err(err).Str("dispute_id", dispute.ID).Msg("failed to process dispute")
+			return http.StatusInternalServerError, err
+		}
 	}
 
 	return http.StatusOK, nil
@@ -0,0 +1,454 @@
+package api
+
+import (
+	"database/sql"
+	"fmt"
+	"server/db"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+	"server/slack"
+	"strings"
+	"time"
+
+	"github.com/ninja-software/terror/v2"
+	"github.com/ninja-syndicate/ws"
+	"github.com/shopspring/decimal"
+	"github.com/stripe/stripe-go/v72"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// paymentReversal describes how an order and faction pass purchase move when stripe reverses their payment.
+// Orders and purchases which are not in one of the from statuses are left as they are.
+type paymentReversal struct {
+	label string
+
+	orderFrom []string
+	orderTo   string
+
+	assetsFrom   []string
+	assetsTo     string
+	assetsAction string
+	assetHidden  null.String
+
+	factionPassFrom []string
+	factionPassTo   string
+
+	// revokeGiftCodes expires the gift codes bought with the payment which have not been redeemed yet
+	revokeGiftCodes bool
+
+	// unchangedAction is recorded when nothing is moved, defaults to ignored
+	unchangedAction string
+}
+
+var (
+	paymentReversalRefunded = &paymentReversal{
+		label:           "refunded",
+		orderFrom:       []string{boiler.OrderStatusesCompleted, boiler.OrderStatusesDisputed},
+		orderTo:         boiler.OrderStatusesRefunded,
+		assetsFrom:      []string{db.OrderItemAssetStatusDelivered, db.OrderItemAssetStatusLocked},
+		assetsTo:        db.OrderItemAssetStatusRevoked,
+		assetsAction:    db.OrderStateActionAssetsRevoked,
+		assetHidden:     null.StringFrom(db.AssetHiddenPaymentReversed),
+		factionPassFrom: []string{PaymentStatusSuccess, PaymentStatusDisputed},
+		factionPassTo:   PaymentStatusRefunded,
+		revokeGiftCodes: true,
+	}
+	paymentReversalPartiallyRefunded = &paymentReversal{
+		label:           "partially refunded",
+		unchangedAction: db.OrderStateActionPartialRefund,
+	}
+	paymentReversalDisputed = &paymentReversal{
+		label:           "disputed",
+		orderFrom:       []string{boiler.OrderStatusesCompleted},
+		orderTo:         boiler.OrderStatusesDisputed,
+		assetsFrom:      []string{db.OrderItemAssetStatusDelivered},
+		assetsTo:        db.OrderItemAssetStatusLocked,
+		assetsAction:    db.OrderStateActionAssetsLocked,
+		assetHidden:     null.StringFrom(db.AssetHiddenPaymentDisputed),
+		factionPassFrom: []string{PaymentStatusSuccess},
+		factionPassTo:   PaymentStatusDisputed,
+	}
+	paymentReversalDisputeWon = &paymentReversal{
+		label:           "dispute won",
+		orderFrom:       []string{boiler.OrderStatusesDisputed},
+		orderTo:         boiler.OrderStatusesCompleted,
+		assetsFrom:      []string{db.OrderItemAssetStatusLocked},
+		assetsTo:        db.OrderItemAssetStatusDelivered,
+		assetsAction:    db.OrderStateActionAssetsUnlocked,
+		factionPassFrom: []string{PaymentStatusDisputed},
+		factionPassTo:   PaymentStatusSuccess,
+	}
+	paymentReversalDisputeLost = &paymentReversal{
+		label:           "dispute lost",
+		orderFrom:       []string{boiler.OrderStatusesCompleted, boiler.OrderStatusesDisputed},
+		orderTo:         boiler.OrderStatusesChargeback,
+		assetsFrom:      []string{db.OrderItemAssetStatusDelivered, db.OrderItemAssetStatusLocked},
+		assetsTo:        db.OrderItemAssetStatusRevoked,
+		assetsAction:    db.OrderStateActionAssetsRevoked,
+		assetHidden:     null.StringFrom(db.AssetHiddenPaymentReversed),
+		factionPassFrom: []string{PaymentStatusSuccess, PaymentStatusDisputed},
+		factionPassTo:   PaymentStatusChargeback,
+		revokeGiftCodes: true,
+	}
+	paymentReversalDisputeClosed = &paymentReversal{
+		label: "dispute closed",
+	}
+)
+
+func containsStatus(statuses []string, status string) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *paymentReversal) noChange() string {
+	if r.unchangedAction != "" {
+		return r.unchangedAction
+	}
+	return db.OrderStateActionIgnored
+}
+
+// recordOrderState adds a step to the order state history.
+func recordOrderState(conn boil.Executor, history *boiler.OrderStateHistory) error {
+	err := history.Insert(conn, boil.Infer())
+	if err != nil {
+		return terror.Error(err, "Failed to record order state.")
+	}
+	return nil
+}
+
+// stripeChargeRefunded takes back what was delivered for a fully refunded charge.
+// Partial refunds are only recorded and left to the moderators.
+func (f *FiatController) stripeChargeRefunded(eventID string, charge *stripe.Charge) error {
+	paymentIntentID := ""
+	if charge.PaymentIntent != nil {
+		paymentIntentID = charge.PaymentIntent.ID
+	}
+	invoiceID := ""
+	if charge.Invoice != nil {
+		invoiceID = charge.Invoice.ID
+	}
+
+	note := fmt.Sprintf(
+		"charge %s refunded %s of %s %s",
+		charge.ID,
+		decimal.New(charge.AmountRefunded, -2).StringFixed(2),
+		decimal.New(charge.Amount, -2).StringFixed(2),
+		strings.ToUpper(string(charge.Currency)),
+	)
+	if !charge.Refunded {
+		return f.reversePayment(eventID, paymentIntentID, invoiceID, paymentReversalPartiallyRefunded, note)
+	}
+	return f.reversePayment(eventID, paymentIntentID, invoiceID, paymentReversalRefunded, note)
+}
+
+// stripeDisputeCreated locks what was delivered for a disputed charge until the dispute is closed.
+func (f *FiatController) stripeDisputeCreated(eventID string, dispute *stripe.Dispute) error {
+	paymentIntentID := ""
+	if dispute.PaymentIntent != nil {
+		paymentIntentID = dispute.PaymentIntent.ID
+	}
+
+	note := fmt.Sprintf("dispute %s opened, reason: %s", dispute.ID, dispute.Reason)
+	return f.reversePayment(eventID, paymentIntentID, "", paymentReversalDisputed, note)
+}
+
+// stripeDisputeClosed unlocks what was delivered when a dispute is won, and revokes it when the dispute is lost.
+func (f *FiatController) stripeDisputeClosed(eventID string, dispute *stripe.Dispute) error {
+	paymentIntentID := ""
+	if dispute.PaymentIntent != nil {
+		paymentIntentID = dispute.PaymentIntent.ID
+	}
+
+	reversal := paymentReversalDisputeClosed
+	switch dispute.Status {
+	case stripe.DisputeStatusWon, stripe.DisputeStatusWarningClosed:
+		reversal = paymentReversalDisputeWon
+	case stripe.DisputeStatusLost:
+		reversal = paymentReversalDisputeLost
+	}
+
+	note := fmt.Sprintf("dispute %s closed, status: %s", dispute.ID, dispute.Status)
+	return f.reversePayment(eventID, paymentIntentID, "", reversal, note)
+}
+
+// reversePayment moves the order and faction pass purchase paid by a stripe payment, then alerts the moderators.
+// Events which have already been recorded are skipped, so stripe retries are safe.
+func (f *FiatController) reversePayment(eventID string, paymentIntentID string, invoiceID string, reversal *paymentReversal, note string) error {
+	l := gamelog.L.With().
+		Str("func", "reversePayment").
+		Str("stripe_event_id", eventID).
+		Str("payment_intent_id", paymentIntentID).
+		Str("invoice_id", invoiceID).
+		Str("reversal", reversal.label).
+		Logger()
+
+	tx, err := gamedb.StdConn.Begin()
+	if err != nil {
+		l.Error().Err(err).Msg("failed to start transaction")
+		return terror.Error(err, "Failed to process payment reversal.")
+	}
+	defer tx.Rollback()
+
+	processed, err := db.StripeEventProcessed(tx, eventID)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to check stripe event")
+		return terror.Error(err, "Failed to process payment reversal.")
+	}
+	if processed {
+		l.Debug().Msg("stripe event already processed")
+		return nil
+	}
+
+	order, err := db.FiatOrderByStripePayment(tx, paymentIntentID, invoiceID)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to get order")
+		return terror.Error(err, "Failed to process payment reversal.")
+	}
+	fpp, err := db.FactionPassPurchaseByStripePayment(tx, paymentIntentID)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to get faction pass purchase")
+		return terror.Error(err, "Failed to process payment reversal.")
+	}
+	if order == nil && fpp == nil {
+		l.Warn().Msg("no order or faction pass purchase paid by the reversed payment")
+		return nil
+	}
+
+	summary := []string{note}
+	if order != nil {
+		orderSummary, err := reverseOrder(tx, eventID, order, reversal, note)
+		if err != nil {
+			l.Error().Err(err).Str("order_id", order.ID).Msg("failed to reverse order")
+			return err
+		}
+		summary = append(summary, orderSummary...)
+	}
+	var player *boiler.Player
+	if fpp != nil {
+		fppSummary, updatedPlayer, err := reverseFactionPassPurchase(tx, eventID, fpp, reversal, note)
+		if err != nil {
+			l.Error().Err(err).Str("faction_pass_purchase_log_id", fpp.ID).Msg("failed to reverse faction pass purchase")
+			return err
+		}
+		summary = append(summary, fppSummary...)
+		player = updatedPlayer
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		l.Error().Err(err).Msg("failed to commit transaction")
+		return terror.Error(err, "Failed to process payment reversal.")
+	}
+
+	if player != nil {
+		ws.PublishMessage(fmt.Sprintf("/secure/user/%s/faction_pass_expiry_date", player.ID), HubKeyPlayerFactionPassExpiryDate, player.FactionPassExpiresAt)
+	}
+
+	// alert the moderators, the reversal stands even when slack is down
+	slackMessage := fmt.Sprintf("<!subteam^S03GCC87CD7>\n\n:rotating_light: Stripe payment %s :rotating_light: \n\n```%s```", reversal.label, strings.Join(summary, "\n"))
+	err = slack.SendSlackNotification(slackMessage, db.GetStrWithDefault(db.KeySlackModChannelID, "C03GDHLV9FE"), slack.ModToolsAppToken)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to send slack notification for payment reversal")
+		return nil
+	}
+
+	alerted := &boiler.OrderStateHistory{
+		Action:        db.OrderStateActionModeratorsAlerted,
+		StripeEventID: null.StringFrom(eventID),
+		Note:          "slack notification sent",
+	}
+	if order != nil {
+		alerted.OrderID = null.StringFrom(order.ID)
+	}
+	if fpp != nil {
+		alerted.FactionPassPurchaseLogID = null.StringFrom(fpp.ID)
+	}
+	err = recordOrderState(gamedb.StdConn, alerted)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to record moderator alert")
+	}
+
+	return nil
+}
+
+// reverseOrder moves the order and the assets it delivered, returning a summary for the moderators.
+func reverseOrder(tx *sql.Tx, eventID string, order *boiler.Order, reversal *paymentReversal, note string) ([]string, error) {
+	summary := []string{fmt.Sprintf("Order #%d (%s), buyer: %s", order.OrderNumber, order.ID, order.UserID)}
+
+	history := &boiler.OrderStateHistory{
+		OrderID:       null.StringFrom(order.ID),
+		StripeEventID: null.StringFrom(eventID),
+		FromStatus:    null.StringFrom(order.OrderStatus),
+		Note:          note,
+	}
+	if !containsStatus(reversal.orderFrom, order.OrderStatus) {
+		history.Action = reversal.noChange()
+		err := recordOrderState(tx, history)
+		if err != nil {
+			return nil, err
+		}
+		return append(summary, fmt.Sprintf("Order left as %s", order.OrderStatus)), nil
+	}
+
+	order.OrderStatus = reversal.orderTo
+	order.UpdatedAt = time.Now()
+	_, err := order.Update(tx, boil.Whitelist(boiler.OrderColumns.OrderStatus, boiler.OrderColumns.UpdatedAt))
+	if err != nil {
+		return nil, terror.Error(err, "Failed to update order status.")
+	}
+
+	history.Action = db.OrderStateActionStatusChanged
+	history.ToStatus = null.StringFrom(order.OrderStatus)
+	err = recordOrderState(tx, history)
+	if err != nil {
+		return nil, err
+	}
+	summary = append(summary, fmt.Sprintf("Order %s -> %s", history.FromStatus.String, history.ToStatus.String))
+
+	if reversal.revokeGiftCodes {
+		revoked, err := db.FiatGiftCodesRevoke(tx, null.StringFrom(order.ID), null.String{}, note)
+		if err != nil {
+			return nil, terror.Error(err, "Failed to revoke gift codes.")
+		}
+		if revoked > 0 {
+			summary = append(summary, fmt.Sprintf("%d unredeemed gift codes revoked", revoked))
+		}
+	}
+
+	ownerIDs, err := db.FiatOrderAssetsSetStatus(tx, order.ID, reversal.assetsFrom, reversal.assetsTo, reversal.assetHidden)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to update order assets.")
+	}
+	if len(ownerIDs) == 0 {
+		return summary, nil
+	}
+
+	notOwned := 0
+	for _, ownerID := range ownerIDs {
+		if ownerID != order.UserID {
+			notOwned++
+		}
+	}
+	assetsNote := fmt.Sprintf("%d assets %s, %d no longer owned by the buyer", len(ownerIDs), reversal.assetsTo, notOwned)
+	err = recordOrderState(tx, &boiler.OrderStateHistory{
+		OrderID:       null.StringFrom(order.ID),
+		Action:        reversal.assetsAction,
+		StripeEventID: null.StringFrom(eventID),
+		Note:          assetsNote,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return append(summary, assetsNote), nil
+}
+
+// reverseFactionPassPurchase moves the faction pass purchase, taking back the pass days when the payment stops counting
+// and giving them back when a dispute is won. It returns a summary for the moderators and the player when their pass changed.
+func reverseFactionPassPurchase(tx *sql.Tx, eventID string, fpp *boiler.FactionPassPurchaseLog, reversal *paymentReversal, note string) ([]string, *boiler.Player, error) {
+	summary := []string{fmt.Sprintf("Faction pass purchase %s, buyer: %s", fpp.ID, fpp.PurchasedByID)}
+
+	history := &boiler.OrderStateHistory{
+		FactionPassPurchaseLogID: null.StringFrom(fpp.ID),
+		StripeEventID:            null.StringFrom(eventID),
+		FromStatus:               null.StringFrom(fpp.PaymentStatus),
+		Note:                     note,
+	}
+	if !containsStatus(reversal.factionPassFrom, fpp.PaymentStatus) {
+		history.Action = reversal.noChange()
+		err := recordOrderState(tx, history)
+		if err != nil {
+			return nil, nil, err
+		}
+		return append(summary, fmt.Sprintf("Faction pass purchase left as %s", fpp.PaymentStatus)), nil, nil
+	}
+
+	fromStatus := fpp.PaymentStatus
+	fpp.PaymentStatus = reversal.factionPassTo
+	_, err := fpp.Update(tx, boil.Whitelist(boiler.FactionPassPurchaseLogColumns.PaymentStatus))
+	if err != nil {
+		return nil, nil, terror.Error(err, "Failed to update payment status.")
+	}
+
+	history.Action = db.OrderStateActionStatusChanged
+	history.ToStatus = null.StringFrom(fpp.PaymentStatus)
+	err = recordOrderState(tx, history)
+	if err != nil {
+		return nil, nil, err
+	}
+	summary = append(summary, fmt.Sprintf("Faction pass purchase %s -> %s", fromStatus, fpp.PaymentStatus))
+
+	if reversal.revokeGiftCodes {
+		revoked, err := db.FiatGiftCodesRevoke(tx, null.String{}, null.StringFrom(fpp.ID), note)
+		if err != nil {
+			return nil, nil, terror.Error(err, "Failed to revoke gift codes.")
+		}
+		if revoked > 0 {
+			summary = append(summary, fmt.Sprintf("%d unredeemed gift codes revoked", revoked))
+		}
+	}
+
+	// gifted passes are held by the recipient, gift codes nobody has redeemed yet are not held by anyone
+	holderID := fpp.PurchasedByID
+	gift, err := db.FiatGiftByFactionPassPurchase(tx, fpp.ID)
+	if err != nil {
+		return nil, nil, terror.Error(err, "Failed to load faction pass gift.")
+	}
+	if gift != nil {
+		if !gift.RecipientID.Valid {
+			return summary, nil, nil
+		}
+		holderID = gift.RecipientID.String
+		summary = append(summary, fmt.Sprintf("Gifted to: %s", holderID))
+	}
+
+	player, err := boiler.FindPlayer(tx, holderID)
+	if err != nil {
+		return nil, nil, terror.Error(err, "Failed to load player.")
+	}
+
+	days := time.Duration(fpp.ExpendFactionPassDays) * 24 * time.Hour
+	previousExpiry := player.FactionPassExpiresAt
+	action := ""
+	switch {
+	case fromStatus == PaymentStatusSuccess && fpp.PaymentStatus != PaymentStatusSuccess:
+		if !player.FactionPassExpiresAt.Valid {
+			return summary, nil, nil
+		}
+		player.FactionPassExpiresAt = null.TimeFrom(player.FactionPassExpiresAt.Time.Add(-days))
+		action = db.OrderStateActionFactionPassRolledBack
+	case fromStatus != PaymentStatusSuccess && fpp.PaymentStatus == PaymentStatusSuccess:
+		startFrom := time.Now()
+		if player.FactionPassExpiresAt.Valid && player.FactionPassExpiresAt.Time.After(startFrom) {
+			startFrom = player.FactionPassExpiresAt.Time
+		}
+		player.FactionPassExpiresAt = null.TimeFrom(startFrom.Add(days))
+		action = db.OrderStateActionFactionPassRestored
+	default:
+		return summary, nil, nil
+	}
+
+	_, err = player.Update(tx, boil.Whitelist(boiler.PlayerColumns.FactionPassExpiresAt))
+	if err != nil {
+		return nil, nil, terror.Error(err, "Failed to update player faction pass expiry date.")
+	}
+
+	expiryNote := fmt.Sprintf("faction pass expiry moved from %s to %s", previousExpiry.Time.Format(time.RFC3339), player.FactionPassExpiresAt.Time.Format(time.RFC3339))
+	err = recordOrderState(tx, &boiler.OrderStateHistory{
+		FactionPassPurchaseLogID: null.StringFrom(fpp.ID),
+		Action:                   action,
+		StripeEventID:            null.StringFrom(eventID),
+		Note:                     expiryNote,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return append(summary, expiryNote), player, nil
+}
@@ -0,0 +1,149 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"server"
+	"server/db"
+	"server/db/boiler"
+	"server/fiat"
+	"server/gamedb"
+	"server/gamelog"
+	"server/system_messages"
+	"server/xsyn_rpcclient"
+	"time"
+
+	"github.com/ninja-software/terror/v2"
+	"github.com/ninja-syndicate/ws"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// FiatGiftCode is a gift code bought from the fiat store.
+type FiatGiftCode struct {
+	Code      string    `json:"code"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// FiatGiftMessage is the data of the system message sent for a gift bought from the fiat store.
+type FiatGiftMessage struct {
+	FromPlayerID string          `json:"from_player_id"`
+	ItemLabel    string          `json:"item_label"`
+	Quantity     int             `json:"quantity"`
+	Codes        []*FiatGiftCode `json:"codes,omitempty"`
+}
+
+// fiatGiftCodeExpiry returns when a gift code bought now expires.
+func fiatGiftCodeExpiry() time.Time {
+	return time.Now().AddDate(0, 0, db.GetIntWithDefault(db.KeyFiatGiftCodeExpiryDays, 365))
+}
+
+// fiatProductDeliverable returns whether the contents of a store product type can be given to players.
+func fiatProductDeliverable(productType string) bool {
+	switch productType {
+	case boiler.FiatProductTypesStarterPackage, boiler.FiatProductTypesMysteryCrate:
+		return true
+	}
+	return false
+}
+
+// deliverFiatProduct gives the contents of a store product to a player, recording them against the order item.
+func deliverFiatProduct(tx *sql.Tx, pp *xsyn_rpcclient.XsynXrpcClient, product *server.FiatProduct, playerID string, orderItemID string, quantity int) error {
+	switch product.ProductType {
+	case boiler.FiatProductTypesStarterPackage:
+		for i := 0; i < quantity; i++ {
+			err := fiat.SendStarterPackageContentsToUser(tx, pp, playerID, product.ID, orderItemID)
+			if err != nil {
+				return err
+			}
+		}
+	case boiler.FiatProductTypesMysteryCrate:
+		err := fiat.SendMysteryCrateToUser(tx, pp, playerID, product.ID, orderItemID, quantity)
+		if err != nil {
+			return err
+		}
+	default:
+		return terror.Error(fmt.Errorf("unsupported product type: %s", product.ProductType), "This product cannot be delivered, please contact support.")
+	}
+
+	return nil
+}
+
+// createFiatGiftCodes creates a gift code for each unit of a gift.
+func createFiatGiftCodes(tx *sql.Tx, gift *boiler.FiatGift, itemType string, itemID string, quantity int) ([]*FiatGiftCode, error) {
+	codes := []*FiatGiftCode{}
+	expiresAt := fiatGiftCodeExpiry()
+	for i := 0; i < quantity; i++ {
+		g := &boiler.FiatGift{
+			PurchasedByID:            gift.PurchasedByID,
+			OrderItemID:              gift.OrderItemID,
+			FactionPassPurchaseLogID: gift.FactionPassPurchaseLogID,
+		}
+		coupon, err := db.FiatGiftCodeCreate(tx, g, itemType, itemID, expiresAt)
+		if err != nil {
+			return nil, terror.Error(err, "Failed to create gift code.")
+		}
+		codes = append(codes, &FiatGiftCode{
+			Code:      coupon.Code,
+			ExpiresAt: coupon.ExpiryDate,
+		})
+	}
+	return codes, nil
+}
+
+// sendFiatGiftMessage sends a system message about a gift to a player.
+func sendFiatGiftMessage(playerID string, title string, message string, gift *FiatGiftMessage) {
+	l := gamelog.L.With().Str("func", "sendFiatGiftMessage").Str("player_id", playerID).Interface("gift", gift).Logger()
+
+	data, err := json.Marshal(gift)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to marshal gift")
+		return
+	}
+
+	msg := &boiler.SystemMessage{
+		PlayerID: playerID,
+		SenderID: server.SupremacySystemAdminUserID,
+		DataType: null.StringFrom(string(system_messages.SystemMessageDataTypeFiatGift)),
+		Title:    title,
+		Message:  message,
+		Data:     null.JSONFrom(data),
+	}
+	err = msg.Insert(gamedb.StdConn, boil.Infer())
+	if err != nil {
+		l.Error().Err(err).Msg("failed to insert gift system message")
+		return
+	}
+
+	ws.PublishMessage(fmt.Sprintf("/secure/user/%s/system_messages", playerID), server.HubKeySystemMessageListUpdatedSubscribe, true)
+}
+
+// sendFiatGiftMessages lets the recipients know about their gifts, and sends the buyer the gift codes they bought.
+func sendFiatGiftMessages(buyerID string, gifts map[string][]*FiatGiftMessage, codes []*FiatGiftMessage) {
+	buyer, err := boiler.FindPlayer(gamedb.StdConn, buyerID)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("player_id", buyerID).Msg("failed to load gift buyer")
+		return
+	}
+
+	for recipientID, recipientGifts := range gifts {
+		for _, gift := range recipientGifts {
+			sendFiatGiftMessage(
+				recipientID,
+				"You received a gift",
+				fmt.Sprintf("%s #%d sent you %d x %s.", buyer.Username.String, buyer.Gid, gift.Quantity, gift.ItemLabel),
+				gift,
+			)
+		}
+	}
+
+	for _, gift := range codes {
+		sendFiatGiftMessage(
+			buyerID,
+			"Your gift codes",
+			fmt.Sprintf("Your %d x %s gift codes are ready to hand out. Each code can be redeemed once before it expires.", gift.Quantity, gift.ItemLabel),
+			gift,
+		)
+	}
+}
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"net/http"
+	"os"
+	"server/db"
+	"server/gamelog"
+	"server/helpers"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gofrs/uuid"
+	"github.com/ninja-software/terror/v2"
+	cache "github.com/victorspringer/http-cache"
+	"github.com/victorspringer/http-cache/adapter/memory"
+	"github.com/volatiletech/null/v8"
+)
+
+type MarketplaceRestController struct {
+	API *API
+}
+
+func MarketplaceRouter(api *API) chi.Router {
+	adapter, err := memory.NewAdapter(
+		memory.AdapterWithAlgorithm(memory.LRU),
+		memory.AdapterWithCapacity(10000),
+	)
+	if err != nil {
+		gamelog.L.Error().Err(err).Msg("price history adaptor: failed to create")
+		os.Exit(1)
+	}
+	priceHistoryCache, err := cache.NewClient(
+		cache.ClientWithAdapter(adapter),
+		cache.ClientWithTTL(5*time.Minute),
+		cache.ClientWithRefreshKey("opn"),
+	)
+	if err != nil {
+		gamelog.L.Error().Err(err).Msg("price history cache: failed to initialise")
+		os.Exit(1)
+	}
+
+	c := &MarketplaceRestController{
+		API: api,
+	}
+	r := chi.NewRouter()
+	r.Get("/price_history", priceHistoryCache.Middleware(http.HandlerFunc(WithError(c.PriceHistory))).ServeHTTP)
+
+	return r
+}
+
+// GET /api/marketplace/price_history?item_type=mech&blueprint_id=...&skin_blueprint_id=...&tier=...&bucket=day&days=30
+func (mc *MarketplaceRestController) PriceHistory(w http.ResponseWriter, r *http.Request) (int, error) {
+	filter := &db.MarketplacePriceFilter{
+		ItemType:    r.URL.Query().Get("item_type"),
+		BlueprintID: r.URL.Query().Get("blueprint_id"),
+	}
+	if _, err := uuid.FromString(filter.BlueprintID); err != nil {
+		return http.StatusBadRequest, terror.Error(err, "Invalid blueprint received.")
+	}
+	if skinBlueprintID := r.URL.Query().Get("skin_blueprint_id"); skinBlueprintID != "" {
+		if _, err := uuid.FromString(skinBlueprintID); err != nil {
+			return http.StatusBadRequest, terror.Error(err, "Invalid skin blueprint received.")
+		}
+		filter.SkinBlueprintID = null.StringFrom(skinBlueprintID)
+	}
+	if tier := r.URL.Query().Get("tier"); tier != "" {
+		filter.Tier = null.StringFrom(tier)
+	}
+
+	bucket := r.URL.Query().Get("bucket")
+	if bucket == "" {
+		bucket = db.PriceHistoryBucketDay
+	}
+	if !db.IsValidPriceHistoryBucket(bucket) {
+		return http.StatusBadRequest, terror.Error(terror.ErrInvalidInput, "Invalid price history interval.")
+	}
+
+	days := 0
+	if daysStr := r.URL.Query().Get("days"); daysStr != "" {
+		var err error
+		days, err = strconv.Atoi(daysStr)
+		if err != nil {
+			return http.StatusBadRequest, terror.Error(err, "Invalid days received.")
+		}
+	}
+	from, err := priceHistoryFrom(days)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	resp, err := db.MarketplacePriceHistory(filter, bucket, from)
+	if err != nil {
+		gamelog.L.Error().Interface("filter", filter).Str("bucket", bucket).Err(err).Msg("unable to get price history")
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get price history.")
+	}
+
+	return helpers.EncodeJSON(w, resp)
+}
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"net/http"
+	"server/db"
+	"server/helpers"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/ninja-software/terror/v2"
+)
+
+type MysteryCrateController struct {
+	API *API
+}
+
+func MysteryCrateRouter(api *API) chi.Router {
+	c := &MysteryCrateController{
+		api,
+	}
+	r := chi.NewRouter()
+	r.Get("/{crate_id}/verify", WithError(c.MysteryCrateVerify))
+	r.Get("/drop_table/{storefront_mystery_crate_id}", WithError(c.MysteryCrateDropTable))
+
+	return r
+}
+
+// MysteryCrateVerify returns the data required to verify the contents of an opened crate
+func (c *MysteryCrateController) MysteryCrateVerify(w http.ResponseWriter, r *http.Request) (int, error) {
+	crateID := chi.URLParam(r, "crate_id")
+	if crateID == "" {
+		return http.StatusBadRequest, terror.Error(terror.ErrInvalidInput, "Missing crate id.")
+	}
+
+	resp, err := db.MysteryCrateVerify(crateID)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	return helpers.EncodeJSON(w, resp)
+}
+
+// MysteryCrateDropTable returns the published drop table of the storefront crate
+func (c *MysteryCrateController) MysteryCrateDropTable(w http.ResponseWriter, r *http.Request) (int, error) {
+	storefrontCrateID := chi.URLParam(r, "storefront_mystery_crate_id")
+	if storefrontCrateID == "" {
+		return http.StatusBadRequest, terror.Error(terror.ErrInvalidInput, "Missing storefront crate id.")
+	}
+
+	resp, err := db.MysteryCrateDropTable(storefrontCrateID)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	return helpers.EncodeJSON(w, resp)
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"server/gamedb"
 	"server/helpers"
@@ -12,6 +13,16 @@ import (
 	"github.com/ninja-software/terror/v2"
 )
 
+// staticDataRemoteNames are the names the static data repo stores some of the files under, by the name of the static file.
+var staticDataRemoteNames = map[string]string{
+	"battle_arena.csv":     "sbattle_arena.csv",
+	"faction_palettes.csv": "sfaction_palettes.csv",
+	"faction_passes.csv":   "sfaction_passes.csv",
+}
+
+// SyncStaticData downloads the static data of a branch and syncs it in a single transaction. Without the confirm param
+// nothing is written and the report of what would change is returned. Passing the checksum of that report as the confirm
+// param applies the sync, unless the files have changed since, in which case the report of the new files is returned with a conflict.
 func (api *API) SyncStaticData(w http.ResponseWriter, r *http.Request) (int, error) {
 	branch := chi.URLParam(r, "branch")
 	if branch == "" {
@@ -20,175 +31,33 @@ func (api *API) SyncStaticData(w http.ResponseWriter, r *http.Request) (int, err
 
 	timeout := time.Minute
 
-	url := fmt.Sprintf("%s/%s/sbattle_arena.csv", api.SyncConfig.FilePath, branch)
-	f, err := synctool.DownloadFile(api.ctx, url, timeout)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync battle arena data")
-	}
-	err = synctool.SyncBattleArenas(f, gamedb.StdConn)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync battle arena with db")
-	}
-
-	url = fmt.Sprintf("%s/%s/factions.csv", api.SyncConfig.FilePath, branch)
-	f, err = synctool.DownloadFile(api.ctx, url, timeout)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction data")
-	}
-	err = synctool.SyncFactions(f, gamedb.StdConn)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction with db")
-	}
-
-	url = fmt.Sprintf("%s/%s/sfaction_palettes.csv", api.SyncConfig.FilePath, branch)
-	f, err = synctool.DownloadFile(api.ctx, url, timeout)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction palette data")
-	}
-	err = synctool.SyncFactionPalettes(f, gamedb.StdConn)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction palettes with db")
-	}
-
-	url = fmt.Sprintf("%s/%s/sfaction_passes.csv", api.SyncConfig.FilePath, branch)
-	f, err = synctool.DownloadFile(api.ctx, url, timeout)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction pass data")
-	}
-	err = synctool.SyncFactionPasses(f, gamedb.StdConn)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction pass with db")
-	}
-
-	url = fmt.Sprintf("%s/%s/brands.csv", api.SyncConfig.FilePath, branch)
-	f, err = synctool.DownloadFile(api.ctx, url, timeout)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction data")
-	}
-	err = synctool.SyncBrands(f, gamedb.StdConn)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction with db")
-	}
-
-	url = fmt.Sprintf("%s/%s/shield_types.csv", api.SyncConfig.FilePath, branch)
-	f, err = synctool.DownloadFile(api.ctx, url, timeout)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction data")
-	}
-	err = synctool.SyncShieldTypes(f, gamedb.StdConn)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction with db")
-	}
-
-	url = fmt.Sprintf("%s/%s/weapon_skins.csv", api.SyncConfig.FilePath, branch)
-	f, err = synctool.DownloadFile(api.ctx, url, timeout)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction data")
-	}
-	err = synctool.SyncWeaponSkins(f, gamedb.StdConn)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction with db")
-	}
-
-	url = fmt.Sprintf("%s/%s/mech_skins.csv", api.SyncConfig.FilePath, branch)
-	f, err = synctool.DownloadFile(api.ctx, url, timeout)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction data")
-	}
-	err = synctool.SyncMechSkins(f, gamedb.StdConn)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction with db")
-	}
-
-	url = fmt.Sprintf("%s/%s/mechs.csv", api.SyncConfig.FilePath, branch)
-	f, err = synctool.DownloadFile(api.ctx, url, timeout)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction data")
-	}
-	err = synctool.SyncMechModels(f, gamedb.StdConn)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction with db")
-	}
-
-	url = fmt.Sprintf("%s/%s/mech_model_skin_compatibilities.csv", api.SyncConfig.FilePath, branch)
-	f, err = synctool.DownloadFile(api.ctx, url, timeout)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction data")
-	}
-	err = synctool.SyncMechModelSkinCompatibilities(f, gamedb.StdConn)
+	sd, err := synctool.LoadStaticFiles(func(name string) ([]byte, error) {
+		if remoteName, ok := staticDataRemoteNames[name]; ok {
+			name = remoteName
+		}
+		url := fmt.Sprintf("%s/%s/%s", api.SyncConfig.FilePath, branch, name)
+		f, err := synctool.DownloadFile(api.ctx, url, timeout)
+		if err != nil {
+			return nil, err
+		}
+		return io.ReadAll(f)
+	})
 	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction with db")
+		return http.StatusBadRequest, terror.Error(err, "Failed to load static data files")
 	}
 
-	url = fmt.Sprintf("%s/%s/weapons.csv", api.SyncConfig.FilePath, branch)
-	f, err = synctool.DownloadFile(api.ctx, url, timeout)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction data")
-	}
-	err = synctool.SyncWeaponModel(f, gamedb.StdConn)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction with db")
-	}
+	confirm := r.URL.Query().Get("confirm")
+	apply := confirm != "" && confirm == sd.Checksum()
 
-	url = fmt.Sprintf("%s/%s/weapon_model_skin_compatibilities.csv", api.SyncConfig.FilePath, branch)
-	f, err = synctool.DownloadFile(api.ctx, url, timeout)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction data")
-	}
-	err = synctool.SyncWeaponModelSkinCompatibilities(f, gamedb.StdConn)
+	report, err := synctool.SyncStaticFiles(gamedb.StdConn, sd, !apply)
 	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction with db")
+		return http.StatusInternalServerError, terror.Error(err, "Failed to sync static data with db")
 	}
 
-	url = fmt.Sprintf("%s/%s/battle_abilities.csv", api.SyncConfig.FilePath, branch)
-	f, err = synctool.DownloadFile(api.ctx, url, timeout)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction data")
-	}
-	err = synctool.SyncBattleAbilities(f, gamedb.StdConn)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction with db")
-	}
-
-	url = fmt.Sprintf("%s/%s/game_abilities.csv", api.SyncConfig.FilePath, branch)
-	f, err = synctool.DownloadFile(api.ctx, url, timeout)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction data")
-	}
-	err = synctool.SyncGameAbilities(f, gamedb.StdConn)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction with db")
-	}
-
-	url = fmt.Sprintf("%s/%s/player_abilities.csv", api.SyncConfig.FilePath, branch)
-	f, err = synctool.DownloadFile(api.ctx, url, timeout)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction data")
-	}
-	err = synctool.SyncPlayerAbilities(f, gamedb.StdConn)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction with db")
-	}
-
-	url = fmt.Sprintf("%s/%s/power_cores.csv", api.SyncConfig.FilePath, branch)
-	f, err = synctool.DownloadFile(api.ctx, url, timeout)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction data")
-	}
-	err = synctool.SyncPowerCores(f, gamedb.StdConn)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction with db")
-	}
-
-	url = fmt.Sprintf("%s/%s/quests.csv", api.SyncConfig.FilePath, branch)
-	f, err = synctool.DownloadFile(api.ctx, url, timeout)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction data")
-	}
-	err = synctool.SyncStaticQuest(f, gamedb.StdConn)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to sync faction with db")
+	if confirm != "" && !apply {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
 	}
 
-	return helpers.EncodeJSON(w, "Done Syncing")
+	return helpers.EncodeJSON(w, report)
 }
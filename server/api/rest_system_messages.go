@@ -4,10 +4,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"server"
+	"server/db"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/helpers"
 	"server/system_messages"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
 type SystemMessagesAdminController struct {
@@ -20,6 +28,7 @@ func SystemMessagesRouter(api *API) chi.Router {
 	}
 	r := chi.NewRouter()
 	r.Post("/broadcast", WithToken(api.Config.ServerStreamKey, WithError(c.Broadcast)))
+	r.Delete("/broadcast/{broadcast_id}", WithToken(api.Config.ServerStreamKey, WithError(c.BroadcastDelete)))
 
 	return r
 }
@@ -30,8 +39,14 @@ type SystemMessagesAdminBroadcastRequest struct {
 	Message   string                                `json:"message"`
 	DataType  system_messages.SystemMessageDataType `json:"data_type"`
 	Data      *interface{}                          `json:"data,omitempty"`
+	// Audience is GLOBAL, FACTION, SYNDICATE, ROLE or FEATURE, and defaults to the faction when faction id is provided, or global
+	Audience   string    `json:"audience"`
+	AudienceID string    `json:"audience_id"`
+	SendAt     time.Time `json:"send_at"`
+	ExpiresAt  null.Time `json:"expires_at"`
 }
 
+// Broadcast stores a system message for an audience of players, which is sent straight away or at the time it is scheduled for.
 func (smac *SystemMessagesAdminController) Broadcast(w http.ResponseWriter, r *http.Request) (int, error) {
 	req := &SystemMessagesAdminBroadcastRequest{}
 	err := json.NewDecoder(r.Body).Decode(req)
@@ -43,16 +58,66 @@ func (smac *SystemMessagesAdminController) Broadcast(w http.ResponseWriter, r *h
 		return http.StatusBadRequest, terror.Error(fmt.Errorf("data_type must be provided when data is not null."))
 	}
 
-	if req.FactionID != "" {
-		// If faction id is provided then broadcast message to all faction players
-		err = system_messages.BroadcastFactionSystemMessage(req.FactionID, req.Title, req.Message, req.DataType, req.Data)
-	} else {
-		// Else broadcast to all online players (global)
-		err = system_messages.BroadcastGlobalSystemMessage(req.Title, req.Message, req.DataType, req.Data)
+	bsm := &system_messages.BroadcastSystemMessage{
+		SenderID:   server.SupremacySystemAdminUserID,
+		Audience:   req.Audience,
+		AudienceID: req.AudienceID,
+		Title:      req.Title,
+		Message:    req.Message,
+		DataType:   req.DataType,
+		Data:       req.Data,
+		SendAt:     req.SendAt,
+		ExpiresAt:  req.ExpiresAt,
 	}
 
+	if bsm.Audience == "" {
+		if req.FactionID != "" {
+			// If faction id is provided then broadcast message to all faction players
+			bsm.Audience = db.SystemMessageAudienceFaction
+			bsm.AudienceID = req.FactionID
+		} else {
+			// Else broadcast to all players (global)
+			bsm.Audience = db.SystemMessageAudienceGlobal
+		}
+	}
+
+	if valid, _ := db.SystemMessageAudienceIsValid(bsm.Audience); !valid {
+		return http.StatusBadRequest, terror.Error(fmt.Errorf("invalid audience: %s", bsm.Audience), "Invalid system message audience.")
+	}
+
+	// faction messages are sent from the faction user
+	if bsm.Audience == db.SystemMessageAudienceFaction {
+		sender, err := system_messages.FactionSender(bsm.AudienceID)
+		if err != nil {
+			return http.StatusBadRequest, err
+		}
+		bsm.SenderID = sender.ID
+	}
+
+	b, err := system_messages.Broadcast(bsm)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	return helpers.EncodeJSON(w, b)
+}
+
+// BroadcastDelete removes a broadcast system message from every player, or cancels it if it is still scheduled.
+func (smac *SystemMessagesAdminController) BroadcastDelete(w http.ResponseWriter, r *http.Request) (int, error) {
+	broadcastID := chi.URLParam(r, "broadcast_id")
+
+	b, err := boiler.BroadcastSystemMessages(
+		boiler.BroadcastSystemMessageWhere.ID.EQ(broadcastID),
+		boiler.BroadcastSystemMessageWhere.DeletedAt.IsNull(),
+	).One(gamedb.StdConn)
+	if err != nil {
+		return http.StatusNotFound, terror.Error(err, "Broadcast system message not found.")
+	}
+
+	b.DeletedAt = null.TimeFrom(time.Now())
+	_, err = b.Update(gamedb.StdConn, boil.Whitelist(boiler.BroadcastSystemMessageColumns.DeletedAt))
 	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err)
+		return http.StatusInternalServerError, terror.Error(err, "Failed to delete broadcast system message.")
 	}
 
 	return http.StatusOK, nil
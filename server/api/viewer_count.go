@@ -39,6 +39,9 @@ func (api *API) debounceSendingViewerCount() {
 		case <-timer.C:
 			// return total amount of tracked player
 			ws.PublishMessage("/public/live_viewer_count", HubKeyViewerLiveCountUpdated, len(ws.TrackedIdents()))
+
+			// notify friends of the players who come online or go offline
+			api.broadcastFriendPresence()
 		}
 	}
 }
@@ -28,15 +28,29 @@ func NewAdminController(api *API) *AdminController {
 		API: api,
 	}
 
-	api.SecureAdminCommand(HubKeyAdminFiatProductGet, adminHub.FiatProductGet)
-	api.SecureAdminCommand(HubKeyAdminFiatProductList, adminHub.FiatProductList)
-	api.SecureAdminCommand(HubKeyAdminFiatProductCreate, adminHub.FiatProductCreate)
-	api.SecureAdminCommand(HubKeyAdminFiatProductUpdate, adminHub.FiatProductUpdate)
-	api.SecureAdminCommand(HubKeyAdminFiatBlueprintMechList, adminHub.FiatBlueprintMechList)
-	api.SecureAdminCommand(HubKeyAdminFiatBlueprintMechSkinList, adminHub.FiatBlueprintMechSkinList)
-	api.SecureAdminCommand(HubKeyAdminFiatBlueprintMechAnimationList, adminHub.FiatBlueprintMechAnimationList)
-	api.SecureAdminCommand(HubKeyAdminFiatBlueprintWeaponList, adminHub.FiatBlueprintWeaponList)
-	api.SecureAdminCommand(HubKeyAdminFiatBlueprintWeaponSkinList, adminHub.FiatBlueprintWeaponSkinList)
+	api.SecurePermissionCommand(server.PermProductRead, HubKeyAdminFiatProductGet, adminHub.FiatProductGet)
+	api.SecurePermissionCommand(server.PermProductList, HubKeyAdminFiatProductList, adminHub.FiatProductList)
+	api.SecurePermissionCommand(server.PermProductCreate, HubKeyAdminFiatProductCreate, adminHub.FiatProductCreate)
+	api.SecurePermissionCommand(server.PermProductUpdate, HubKeyAdminFiatProductUpdate, adminHub.FiatProductUpdate)
+	api.SecurePermissionCommand(server.PermProductList, HubKeyAdminFiatBlueprintMechList, adminHub.FiatBlueprintMechList)
+	api.SecurePermissionCommand(server.PermProductList, HubKeyAdminFiatBlueprintMechSkinList, adminHub.FiatBlueprintMechSkinList)
+	api.SecurePermissionCommand(server.PermProductList, HubKeyAdminFiatBlueprintMechAnimationList, adminHub.FiatBlueprintMechAnimationList)
+	api.SecurePermissionCommand(server.PermProductList, HubKeyAdminFiatBlueprintWeaponList, adminHub.FiatBlueprintWeaponList)
+	api.SecurePermissionCommand(server.PermProductList, HubKeyAdminFiatBlueprintWeaponSkinList, adminHub.FiatBlueprintWeaponSkinList)
+
+	api.SecurePermissionCommand(server.PermRoleList, HubKeyAdminRoleList, adminHub.RoleList)
+	api.SecurePermissionCommand(server.PermRoleUpdate, HubKeyAdminRolePermissionsUpdate, adminHub.RolePermissionsUpdate)
+	api.SecurePermissionCommand(server.PermRoleAssign, HubKeyAdminPlayerRoleAssign, adminHub.PlayerRoleAssign)
+
+	api.SecurePermissionCommand(server.PermCrateDropRateRead, HubKeyAdminCrateDropRateStats, adminHub.CrateDropRateStats)
+	api.SecurePermissionCommand(server.PermCrateDropRateUpdate, HubKeyAdminCrateDropTableSet, adminHub.CrateDropTableSet)
+
+	api.SecurePermissionCommand(server.PermCouponList, HubKeyAdminCouponList, adminHub.CouponList)
+	api.SecurePermissionCommand(server.PermCouponCreate, HubKeyAdminCouponCreate, adminHub.CouponCreate)
+	api.SecurePermissionCommand(server.PermCouponUpdate, HubKeyAdminCouponDisable, adminHub.CouponDisable)
+
+	api.SecurePermissionCommand(server.PermTournamentCreate, HubKeyAdminTournamentCreate, adminHub.TournamentCreate)
+	api.SecurePermissionCommand(server.PermTournamentUpdate, HubKeyAdminTournamentCancel, adminHub.TournamentCancel)
 
 	return adminHub
 }
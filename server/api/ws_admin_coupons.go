@@ -0,0 +1,294 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"server/asset"
+	"server/db"
+	"server/db/boiler"
+	"server/gamedb"
+	"strings"
+	"time"
+
+	"github.com/ninja-software/terror/v2"
+	"github.com/ninja-syndicate/ws"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+)
+
+// AdminCoupon is a coupon code with the items it grants.
+type AdminCoupon struct {
+	*boiler.Coupon
+	Items boiler.CouponItemSlice `json:"items"`
+}
+
+func adminCouponFromBoiler(coupon *boiler.Coupon) *AdminCoupon {
+	ac := &AdminCoupon{
+		Coupon: coupon,
+		Items:  boiler.CouponItemSlice{},
+	}
+	if coupon.R != nil && coupon.R.CouponItems != nil {
+		ac.Items = coupon.R.CouponItems
+	}
+	return ac
+}
+
+type AdminCouponItem struct {
+	ItemType           string              `json:"item_type"`
+	ItemID             null.String         `json:"item_id"`
+	BlueprintType      null.String         `json:"blueprint_type"` // required for BLUEPRINT items
+	Amount             decimal.NullDecimal `json:"amount"`
+	DiscountTarget     null.String         `json:"discount_target"` // "fiat" or "sups", required for DISCOUNT items
+	DiscountPercentage decimal.NullDecimal `json:"discount_percentage"`
+}
+
+type AdminCouponCreateRequest struct {
+	Payload struct {
+		Code             string             `json:"code"` // a random code is generated when empty
+		Label            string             `json:"label"`
+		FactionID        null.String        `json:"faction_id"`
+		StartsAt         null.Time          `json:"starts_at"`
+		ExpiresAt        time.Time          `json:"expires_at"`
+		MaxUses          null.Int           `json:"max_uses"` // unlimited when null
+		MaxUsesPerPlayer int                `json:"max_uses_per_player"`
+		Items            []*AdminCouponItem `json:"items"`
+	} `json:"payload"`
+}
+
+const HubKeyAdminCouponCreate = "ADMIN:COUPON:CREATE"
+
+// CouponCreate creates a coupon code which grants the given items when redeemed.
+func (ac *AdminController) CouponCreate(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &AdminCouponCreateRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	code := strings.TrimSpace(req.Payload.Code)
+	if code != "" {
+		exists, err := boiler.Coupons(boiler.CouponWhere.Code.EQ(code)).Exists(gamedb.StdConn)
+		if err != nil {
+			return terror.Error(err, "Failed to check coupon code.")
+		}
+		if exists {
+			return terror.Error(fmt.Errorf("coupon code %s already exists", code), "This code already exists.")
+		}
+	}
+	if !req.Payload.ExpiresAt.After(time.Now()) {
+		return terror.Error(fmt.Errorf("expiry date is in the past"), "Expiry date must be in the future.")
+	}
+	if req.Payload.StartsAt.Valid && !req.Payload.StartsAt.Time.Before(req.Payload.ExpiresAt) {
+		return terror.Error(fmt.Errorf("start date is after the expiry date"), "Start date must be before the expiry date.")
+	}
+	if req.Payload.MaxUses.Valid && req.Payload.MaxUses.Int <= 0 {
+		return terror.Error(fmt.Errorf("invalid max uses: %d", req.Payload.MaxUses.Int), "Max uses must be at least one, or empty for unlimited uses.")
+	}
+	if req.Payload.MaxUsesPerPlayer == 0 {
+		req.Payload.MaxUsesPerPlayer = 1
+	}
+	if req.Payload.MaxUsesPerPlayer < 0 {
+		return terror.Error(fmt.Errorf("invalid max uses per player: %d", req.Payload.MaxUsesPerPlayer), "Max uses per player must be at least one.")
+	}
+	if req.Payload.FactionID.Valid {
+		exists, err := boiler.FactionExists(gamedb.StdConn, req.Payload.FactionID.String)
+		if err != nil {
+			return terror.Error(err, "Failed to check faction.")
+		}
+		if !exists {
+			return terror.Error(fmt.Errorf("faction %s not found", req.Payload.FactionID.String), "Faction not found.")
+		}
+	}
+	if len(req.Payload.Items) == 0 {
+		return terror.Error(fmt.Errorf("coupon has no items"), "A coupon needs at least one item.")
+	}
+
+	items := []*boiler.CouponItem{}
+	for _, item := range req.Payload.Items {
+		ci, err := adminCouponItem(item)
+		if err != nil {
+			return err
+		}
+		items = append(items, ci)
+	}
+
+	coupon := &boiler.Coupon{
+		Code:             code,
+		Label:            req.Payload.Label,
+		FactionID:        req.Payload.FactionID,
+		StartsAt:         req.Payload.StartsAt,
+		ExpiryDate:       req.Payload.ExpiresAt,
+		MaxUses:          req.Payload.MaxUses,
+		MaxUsesPerPlayer: req.Payload.MaxUsesPerPlayer,
+		CreatedByID:      null.StringFrom(user.ID),
+	}
+
+	tx, err := gamedb.StdConn.Begin()
+	if err != nil {
+		return terror.Error(err, "Failed to create coupon.")
+	}
+	defer tx.Rollback()
+
+	err = db.CouponCreate(tx, coupon, items)
+	if err != nil {
+		return terror.Error(err, "Failed to create coupon.")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return terror.Error(err, "Failed to create coupon.")
+	}
+
+	reply(adminCouponFromBoiler(coupon))
+
+	return nil
+}
+
+// adminCouponItem validates an item of a new coupon.
+func adminCouponItem(item *AdminCouponItem) (*boiler.CouponItem, error) {
+	ci := &boiler.CouponItem{
+		ItemType: item.ItemType,
+		ItemID:   item.ItemID,
+		Amount:   item.Amount,
+	}
+	if item.Amount.Valid && !item.Amount.Decimal.IsPositive() {
+		return nil, terror.Error(fmt.Errorf("invalid amount: %s", item.Amount.Decimal), "Item amounts must be positive.")
+	}
+
+	switch item.ItemType {
+	case boiler.CouponItemTypeSUPS:
+		if !item.Amount.Valid {
+			return nil, terror.Error(fmt.Errorf("sups amount is missing"), "SUPS items need an amount.")
+		}
+	case boiler.CouponItemTypeMECH_CRATE, boiler.CouponItemTypeWEAPON_CRATE:
+		ci.ItemID = null.String{}
+	case boiler.CouponItemTypeFIAT_PRODUCT:
+		if !item.ItemID.Valid {
+			return nil, terror.Error(fmt.Errorf("product id is missing"), "Store product items need a product.")
+		}
+		_, err := db.FiatProduct(gamedb.StdConn, item.ItemID.String)
+		if err != nil {
+			return nil, terror.Error(err, "Store product not found.")
+		}
+	case boiler.CouponItemTypeFACTION_PASS:
+		if item.ItemID.Valid {
+			exists, err := boiler.FactionPassExists(gamedb.StdConn, item.ItemID.String)
+			if err != nil {
+				return nil, terror.Error(err, "Failed to check faction pass.")
+			}
+			if !exists {
+				return nil, terror.Error(fmt.Errorf("faction pass %s not found", item.ItemID.String), "Faction pass not found.")
+			}
+		} else if !item.Amount.Valid {
+			return nil, terror.Error(fmt.Errorf("faction pass and days are missing"), "Faction pass items need a faction pass or an amount of days.")
+		}
+	case boiler.CouponItemTypeBLUEPRINT:
+		if !item.BlueprintType.Valid || !asset.IsValidBlueprintType(item.BlueprintType.String) {
+			return nil, terror.Error(fmt.Errorf("invalid blueprint type: %s", item.BlueprintType.String), "Invalid blueprint type.")
+		}
+		if !item.ItemID.Valid {
+			return nil, terror.Error(fmt.Errorf("blueprint id is missing"), "Blueprint items need a blueprint.")
+		}
+		_, err := asset.BlueprintLabel(gamedb.StdConn, item.BlueprintType.String, item.ItemID.String)
+		if err != nil {
+			return nil, err
+		}
+		if item.Amount.Valid && !item.Amount.Decimal.Equal(item.Amount.Decimal.Floor()) {
+			return nil, terror.Error(fmt.Errorf("invalid quantity: %s", item.Amount.Decimal), "Blueprint quantities must be whole numbers.")
+		}
+		ci.BlueprintType = item.BlueprintType
+	case boiler.CouponItemTypeDISCOUNT:
+		if item.DiscountTarget.String != db.DiscountTargetFiat && item.DiscountTarget.String != db.DiscountTargetSups {
+			return nil, terror.Error(fmt.Errorf("invalid discount target: %s", item.DiscountTarget.String), "Discounts must be for the fiat or SUPS store.")
+		}
+		if item.DiscountPercentage.Valid == item.Amount.Valid {
+			return nil, terror.Error(fmt.Errorf("discount needs exactly one of percentage and amount"), "Discounts need either a percentage or an amount.")
+		}
+		if item.DiscountPercentage.Valid && (!item.DiscountPercentage.Decimal.IsPositive() || item.DiscountPercentage.Decimal.GreaterThan(decimal.NewFromInt(100))) {
+			return nil, terror.Error(fmt.Errorf("invalid discount percentage: %s", item.DiscountPercentage.Decimal), "Discount percentages must be between 0 and 100.")
+		}
+		ci.ItemID = null.String{}
+		ci.DiscountTarget = item.DiscountTarget
+		ci.DiscountPercentage = item.DiscountPercentage
+	default:
+		return nil, terror.Error(fmt.Errorf("invalid coupon item type: %s", item.ItemType), "Invalid item type.")
+	}
+
+	return ci, nil
+}
+
+type AdminCouponListRequest struct {
+	Payload struct {
+		Search          string `json:"search"`
+		IncludeDisabled bool   `json:"include_disabled"`
+		PageSize        int    `json:"page_size"`
+		Page            int    `json:"page"`
+	} `json:"payload"`
+}
+
+type AdminCouponListResponse struct {
+	Total   int64          `json:"total"`
+	Records []*AdminCoupon `json:"records"`
+}
+
+const HubKeyAdminCouponList = "ADMIN:COUPON:LIST"
+
+// CouponList lists the coupon codes created by admins.
+func (ac *AdminController) CouponList(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &AdminCouponListRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	if req.Payload.PageSize <= 0 {
+		req.Payload.PageSize = 20
+	}
+	offset := 0
+	if req.Payload.Page > 0 {
+		offset = req.Payload.Page * req.Payload.PageSize
+	}
+
+	total, coupons, err := db.CouponList(gamedb.StdConn, req.Payload.Search, req.Payload.IncludeDisabled, offset, req.Payload.PageSize)
+	if err != nil {
+		return terror.Error(err, "Failed to get coupons, please try again.")
+	}
+
+	resp := &AdminCouponListResponse{
+		Total:   total,
+		Records: []*AdminCoupon{},
+	}
+	for _, coupon := range coupons {
+		resp.Records = append(resp.Records, adminCouponFromBoiler(coupon))
+	}
+	reply(resp)
+
+	return nil
+}
+
+type AdminCouponDisableRequest struct {
+	Payload struct {
+		ID string `json:"id"`
+	} `json:"payload"`
+}
+
+const HubKeyAdminCouponDisable = "ADMIN:COUPON:DISABLE"
+
+// CouponDisable stops a coupon code from being redeemed any more.
+func (ac *AdminController) CouponDisable(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &AdminCouponDisableRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	coupon, err := db.CouponDisable(gamedb.StdConn, req.Payload.ID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	reply(adminCouponFromBoiler(coupon))
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"server/db"
+	"server/db/boiler"
+	"server/gamelog"
+
+	"github.com/ninja-software/terror/v2"
+	"github.com/ninja-syndicate/ws"
+)
+
+const HubKeyAdminCrateDropRateStats = "ADMIN:CRATE:DROP:RATE:STATS"
+
+// CrateDropRateStats compares the advertised and realised drop rates of every storefront crate
+func (ac *AdminController) CrateDropRateStats(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	resp, err := db.MysteryCrateDropRateStatList()
+	if err != nil {
+		return err
+	}
+
+	reply(resp)
+
+	return nil
+}
+
+type AdminCrateDropTableSetRequest struct {
+	Payload struct {
+		StorefrontMysteryCrateID string                          `json:"storefront_mystery_crate_id"`
+		DropRates                []*db.MysteryCrateDropRateEntry `json:"drop_rates"`
+	} `json:"payload"`
+}
+
+const HubKeyAdminCrateDropTableSet = "ADMIN:CRATE:DROP:TABLE:SET"
+
+// CrateDropTableSet publishes a new drop table for a storefront crate, the crates purchased from then on are rolled from it
+func (ac *AdminController) CrateDropTableSet(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &AdminCrateDropTableSetRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	dropRates, err := db.MysteryCrateDropTableSet(req.Payload.StorefrontMysteryCrateID, req.Payload.DropRates)
+	if err != nil {
+		return err
+	}
+
+	gamelog.L.Info().Str("player id", user.ID).Str("storefront mystery crate id", req.Payload.StorefrontMysteryCrateID).Int("drop rates", len(dropRates)).Msg("crate drop table published")
+
+	reply(dropRates)
+
+	return nil
+}
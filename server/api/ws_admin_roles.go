@@ -0,0 +1,154 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"server"
+	"server/db"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+	"strings"
+
+	"github.com/ninja-software/terror/v2"
+	"github.com/ninja-syndicate/ws"
+)
+
+type AdminRoleListResponse struct {
+	Roles       []*db.RoleWithPermissions `json:"roles"`
+	Permissions []server.Perm             `json:"permissions"`
+}
+
+const HubKeyAdminRoleList = "ADMIN:ROLE:LIST"
+
+func (ac *AdminController) RoleList(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	roles, err := db.RoleList()
+	if err != nil {
+		return err
+	}
+
+	reply(&AdminRoleListResponse{
+		Roles:       roles,
+		Permissions: server.AllPerm,
+	})
+
+	return nil
+}
+
+type AdminRolePermissionsUpdateRequest struct {
+	Payload struct {
+		RoleID      string        `json:"role_id"`
+		Permissions []server.Perm `json:"permissions"`
+	} `json:"payload"`
+}
+
+const HubKeyAdminRolePermissionsUpdate = "ADMIN:ROLE:PERMISSIONS:UPDATE"
+
+func (ac *AdminController) RolePermissionsUpdate(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &AdminRolePermissionsUpdateRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	// stop admins from locking themselves out of the role management
+	if req.Payload.RoleID == user.RoleID {
+		return terror.Error(fmt.Errorf("player %s attempted to update their own role", user.ID), "You cannot update the permissions of your own role.")
+	}
+
+	// stop admins from granting the permissions they do not hold themselves
+	perms := []string{}
+	for _, perm := range req.Payload.Permissions {
+		perms = append(perms, perm.String())
+	}
+	notHeld, err := db.RolePermissionsNotHeld(user.RoleID, perms)
+	if err != nil {
+		return err
+	}
+	if len(notHeld) > 0 {
+		return terror.Error(fmt.Errorf("player %s attempted to grant permissions they do not hold: %s", user.ID, strings.Join(notHeld, ", ")), "You cannot grant permissions you do not hold.")
+	}
+
+	// nor revoke the permissions of a role above their own
+	err = roleBelowPlayerRole(user, req.Payload.RoleID)
+	if err != nil {
+		return err
+	}
+
+	err = db.RolePermissionsSet(req.Payload.RoleID, req.Payload.Permissions)
+	if err != nil {
+		return err
+	}
+
+	gamelog.L.Info().Str("player id", user.ID).Str("role id", req.Payload.RoleID).Interface("permissions", req.Payload.Permissions).Msg("role permissions updated")
+
+	reply(true)
+
+	return nil
+}
+
+type AdminPlayerRoleAssignRequest struct {
+	Payload struct {
+		PlayerID string `json:"player_id"`
+		RoleID   string `json:"role_id"`
+	} `json:"payload"`
+}
+
+const HubKeyAdminPlayerRoleAssign = "ADMIN:PLAYER:ROLE:ASSIGN"
+
+func (ac *AdminController) PlayerRoleAssign(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &AdminPlayerRoleAssignRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	if req.Payload.PlayerID == user.ID {
+		return terror.Error(fmt.Errorf("player %s attempted to change their own role", user.ID), "You cannot change your own role.")
+	}
+
+	// stop admins from assigning a role above their own, or changing the role of a player above them
+	err = roleBelowPlayerRole(user, req.Payload.RoleID)
+	if err != nil {
+		return err
+	}
+
+	target, err := boiler.FindPlayer(gamedb.StdConn, req.Payload.PlayerID, boiler.PlayerColumns.ID, boiler.PlayerColumns.RoleID)
+	if err != nil {
+		return terror.Error(err, "Failed to load player.")
+	}
+	err = roleBelowPlayerRole(user, target.RoleID)
+	if err != nil {
+		return err
+	}
+
+	player, err := db.PlayerRoleAssign(req.Payload.PlayerID, req.Payload.RoleID)
+	if err != nil {
+		return err
+	}
+
+	gamelog.L.Info().Str("player id", user.ID).Str("target player id", player.ID).Str("role id", player.RoleID).Msg("player role assigned")
+
+	reply(true)
+
+	return nil
+}
+
+// roleBelowPlayerRole returns an error if the role holds any permission the role of the player does not hold
+func roleBelowPlayerRole(user *boiler.Player, roleID string) error {
+	perms, err := db.RolePermissions(roleID)
+	if err != nil {
+		return err
+	}
+
+	notHeld, err := db.RolePermissionsNotHeld(user.RoleID, perms)
+	if err != nil {
+		return err
+	}
+	if len(notHeld) > 0 {
+		return terror.Error(fmt.Errorf("player %s attempted to manage role %s, which holds permissions they do not hold: %s", user.ID, roleID, strings.Join(notHeld, ", ")), "You cannot manage a role above your own.")
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"server"
+	"server/db/boiler"
+
+	"github.com/gofrs/uuid"
+	"github.com/ninja-software/terror/v2"
+	"github.com/ninja-syndicate/ws"
+)
+
+const HubKeyAdminTournamentCreate = "ADMIN:TOURNAMENT:CREATE"
+
+// TournamentCreate creates an admin hosted tournament, whose prize is paid from the treasury.
+func (ac *AdminController) TournamentCreate(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &TournamentCreateRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	t, err := tournamentCreate(ac.API, req, user.ID, uuid.UUID(server.XsynTreasuryUserID).String(), true)
+	if err != nil {
+		return err
+	}
+
+	reply(t)
+	return nil
+}
+
+const HubKeyAdminTournamentCancel = "ADMIN:TOURNAMENT:CANCEL"
+
+// TournamentCancel cancels any tournament whose matches have not started, refunding the entrants and the host prize.
+func (ac *AdminController) TournamentCancel(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &TournamentRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	err = ac.API.ArenaManager.TournamentCancel(req.Payload.TournamentID, false)
+	if err != nil {
+		return err
+	}
+
+	reply(true)
+	return nil
+}
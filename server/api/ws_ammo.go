@@ -0,0 +1,275 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"server"
+	"server/db"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+	"server/xsyn_rpcclient"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/gofrs/uuid"
+	"github.com/ninja-software/terror/v2"
+	"github.com/ninja-syndicate/ws"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const AmmoPurchaseQuantityLimit = 10000
+
+type AmmoController struct {
+	API *API
+}
+
+func NewAmmoController(api *API) *AmmoController {
+	ac := &AmmoController{
+		API: api,
+	}
+
+	api.SecureUserCommand(HubKeyStoreAmmoList, ac.StoreAmmoListHandler)
+	api.SecureUserCommand(HubKeyStoreAmmoPurchase, ac.StoreAmmoPurchaseHandler)
+	api.SecureUserCommand(HubKeyPlayerAssetAmmoList, ac.PlayerAssetAmmoListHandler)
+	api.SecureUserCommand(HubKeyPlayerAssetWeaponAmmoEquip, ac.PlayerAssetWeaponAmmoEquipHandler)
+	api.SecureUserCommand(HubKeyPlayerAssetWeaponAmmoUnequip, ac.PlayerAssetWeaponAmmoUnequipHandler)
+
+	return ac
+}
+
+const HubKeyStoreAmmoList = "STORE:AMMO:LIST"
+
+func (ac *AmmoController) StoreAmmoListHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	resp, err := db.AmmoStoreList()
+	if err != nil {
+		return err
+	}
+
+	reply(resp)
+
+	return nil
+}
+
+type StoreAmmoPurchaseRequest struct {
+	Payload struct {
+		BlueprintAmmoID string `json:"blueprint_ammo_id"`
+		Quantity        int    `json:"quantity"`
+	} `json:"payload"`
+}
+
+const HubKeyStoreAmmoPurchase = "STORE:AMMO:PURCHASE"
+
+func (ac *AmmoController) StoreAmmoPurchaseHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &StoreAmmoPurchaseRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	if req.Payload.Quantity <= 0 || req.Payload.Quantity > AmmoPurchaseQuantityLimit {
+		return terror.Error(terror.ErrInvalidInput, fmt.Sprintf("Ammo can be purchased in quantities from 1 to %d.", AmmoPurchaseQuantityLimit))
+	}
+
+	bpa, err := boiler.FindBlueprintAmmo(gamedb.StdConn, req.Payload.BlueprintAmmoID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return terror.Error(err, "Ammo not found.")
+	}
+	if err != nil {
+		return terror.Error(err, "Failed to load ammo.")
+	}
+
+	if !bpa.SupsCost.Valid || bpa.SupsCost.Decimal.LessThanOrEqual(decimal.Zero) {
+		return terror.Error(fmt.Errorf("ammo %s is not for sale", bpa.ID), "This ammo is not for sale.")
+	}
+
+	totalPrice := bpa.SupsCost.Decimal.Mul(decimal.NewFromInt(int64(req.Payload.Quantity)))
+
+	supTransactionID, err := ac.API.Passport.SpendSupMessage(xsyn_rpcclient.SpendSupsReq{
+		Amount:               totalPrice.String(),
+		FromUserID:           uuid.FromStringOrNil(user.ID),
+		ToUserID:             uuid.FromStringOrNil(server.SupremacyGameUserID),
+		TransactionReference: server.TransactionReference(fmt.Sprintf("player_ammo_purchase|%s|%d", bpa.ID, time.Now().UnixNano())),
+		Group:                string(server.TransactionGroupSupremacy),
+		SubGroup:             "Ammo",
+		Description:          fmt.Sprintf("Purchased %d %s", req.Payload.Quantity, bpa.Label),
+	})
+	if err != nil || supTransactionID == "TRANSACTION_FAILED" {
+		if err == nil {
+			err = fmt.Errorf("transaction failed")
+		}
+		gamelog.L.Error().Str("txID", supTransactionID).Str("blueprint_ammo_id", bpa.ID).Err(err).Msg("unable to charge user for ammo purchase")
+		return terror.Error(err, "Unable to process ammo purchase, check your balance and try again.")
+	}
+
+	refundFunc := func() {
+		refundSupTransactionID, err := ac.API.Passport.RefundSupsMessage(supTransactionID)
+		if err != nil {
+			gamelog.L.Error().Str("txID", refundSupTransactionID).Err(err).Msg("unable to refund user for ammo purchase cost")
+		}
+
+		txItem := &boiler.StorePurchaseHistory{
+			PlayerID:    user.ID,
+			Amount:      totalPrice,
+			ItemType:    "ammo",
+			ItemID:      bpa.ID,
+			Description: "refunding ammo due to failed transaction",
+			TXID:        supTransactionID,
+			RefundTXID:  null.StringFrom(refundSupTransactionID),
+			RefundedAt:  null.TimeFrom(time.Now()),
+		}
+
+		err = txItem.Insert(gamedb.StdConn, boil.Infer())
+		if err != nil {
+			gamelog.L.Error().Str("txID", refundSupTransactionID).Err(err).Msg("unable to insert ammo refund into purchase history table.")
+		}
+	}
+
+	tx, err := gamedb.StdConn.Begin()
+	if err != nil {
+		refundFunc()
+		gamelog.L.Error().Err(err).Msg("unable to begin tx")
+		return terror.Error(err, "Issue purchasing ammo, please try again or contact support.")
+	}
+	defer tx.Rollback()
+
+	err = db.AmmoAdd(tx, user.ID, bpa.ID, req.Payload.Quantity)
+	if err != nil {
+		refundFunc()
+		return terror.Error(err, "Failed to purchase ammo, please try again or contact support.")
+	}
+
+	txItem := &boiler.StorePurchaseHistory{
+		PlayerID:    user.ID,
+		Amount:      totalPrice,
+		ItemType:    "ammo",
+		ItemID:      bpa.ID,
+		Description: fmt.Sprintf("purchased %d ammo", req.Payload.Quantity),
+		TXID:        supTransactionID,
+	}
+	err = txItem.Insert(tx, boil.Infer())
+	if err != nil {
+		refundFunc()
+		gamelog.L.Error().Err(err).Str("blueprint_ammo_id", bpa.ID).Msg("failed to insert ammo into purchase history table")
+		return terror.Error(err, "Failed to purchase ammo, please try again or contact support.")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		refundFunc()
+		gamelog.L.Error().Err(err).Msg("failed to commit ammo transaction")
+		return terror.Error(err, "Issue purchasing ammo, please try again or contact support.")
+	}
+
+	reply(true)
+
+	return nil
+}
+
+const HubKeyPlayerAssetAmmoList = "PLAYER:ASSET:AMMO:LIST"
+
+func (ac *AmmoController) PlayerAssetAmmoListHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	resp, err := db.PlayerAmmoList(user.ID)
+	if err != nil {
+		return err
+	}
+
+	reply(resp)
+
+	return nil
+}
+
+type PlayerAssetWeaponAmmoRequest struct {
+	Payload struct {
+		WeaponID        string `json:"weapon_id"`
+		BlueprintAmmoID string `json:"blueprint_ammo_id"`
+		Count           int    `json:"count"`
+	} `json:"payload"`
+}
+
+// checkWeaponAmmoModifiable checks the player can change the ammo loaded into the weapon
+func checkWeaponAmmoModifiable(weaponID string, playerID string) error {
+	canModify, reason, err := db.CanAssetBeModifiedOrMoved(gamedb.StdConn, weaponID, boiler.ItemTypeWeapon, playerID)
+	if err != nil {
+		return terror.Error(err, "Failed to check weapon.")
+	}
+	if !canModify {
+		return terror.Error(terror.ErrForbidden, fmt.Sprintf("This weapon cannot be modified: %s", reason.String()))
+	}
+
+	return nil
+}
+
+const HubKeyPlayerAssetWeaponAmmoEquip = "PLAYER:ASSET:WEAPON:AMMO:EQUIP"
+
+func (ac *AmmoController) PlayerAssetWeaponAmmoEquipHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &PlayerAssetWeaponAmmoRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	err = checkWeaponAmmoModifiable(req.Payload.WeaponID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := gamedb.StdConn.Begin()
+	if err != nil {
+		return terror.Error(err, "Failed to start db transaction.")
+	}
+	defer tx.Rollback()
+
+	wa, err := db.WeaponAmmoEquip(tx, user.ID, req.Payload.WeaponID, req.Payload.BlueprintAmmoID, req.Payload.Count)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return terror.Error(err, "Failed to commit db transaction.")
+	}
+
+	reply(wa)
+
+	return nil
+}
+
+const HubKeyPlayerAssetWeaponAmmoUnequip = "PLAYER:ASSET:WEAPON:AMMO:UNEQUIP"
+
+func (ac *AmmoController) PlayerAssetWeaponAmmoUnequipHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &PlayerAssetWeaponAmmoRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	err = checkWeaponAmmoModifiable(req.Payload.WeaponID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := gamedb.StdConn.Begin()
+	if err != nil {
+		return terror.Error(err, "Failed to start db transaction.")
+	}
+	defer tx.Rollback()
+
+	err = db.WeaponAmmoUnequip(tx, user.ID, req.Payload.WeaponID)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return terror.Error(err, "Failed to commit db transaction.")
+	}
+
+	reply(true)
+
+	return nil
+}
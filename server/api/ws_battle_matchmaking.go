@@ -0,0 +1,208 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"server/db"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+
+	"github.com/ninja-software/terror/v2"
+	"github.com/ninja-syndicate/ws"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// MechRatingBandFilter filters out the mechs whose rating is too far from the rating of a public lobby.
+func MechRatingBandFilter(bl *boiler.BattleLobby, mechIDs []string) ([]string, error) {
+	if !db.BattleLobbyFilledByRating(bl) || len(mechIDs) == 0 {
+		return mechIDs, nil
+	}
+
+	lrs, err := db.BattleLobbyRatings(gamedb.StdConn, []string{bl.ID})
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("battle lobby id", bl.ID).Msg("Failed to load battle lobby rating.")
+		return nil, terror.Error(err, "Failed to load battle lobby rating.")
+	}
+
+	lr, ok := lrs[bl.ID]
+	if !ok {
+		return mechIDs, nil
+	}
+
+	mrs, err := db.MechRatingsGet(gamedb.StdConn, mechIDs)
+	if err != nil {
+		gamelog.L.Error().Err(err).Strs("mech ids", mechIDs).Msg("Failed to load mech ratings.")
+		return nil, terror.Error(err, "Failed to load mech ratings.")
+	}
+
+	inBandMechIDs := []string{}
+	for _, mechID := range mechIDs {
+		if db.MechRatingInBand(lr, mrs[mechID].Rating) {
+			inBandMechIDs = append(inBandMechIDs, mechID)
+		}
+	}
+
+	if len(inBandMechIDs) == 0 {
+		return nil, terror.Error(fmt.Errorf("mech ratings are outside the lobby rating band"), "Your mechs are outside the rating range of this lobby, use matchmaking to find a lobby closer to their rating.")
+	}
+
+	return inBandMechIDs, nil
+}
+
+type BattleLobbyMatchmakeRequest struct {
+	Payload struct {
+		MechIDs []string `json:"mech_ids"`
+	} `json:"payload"`
+}
+
+const HubKeyBattleLobbyMatchmake = "BATTLE:LOBBY:MATCHMAKE"
+
+// BattleLobbyMatchmake queues mechs in the public lobby closest to their rating.
+func (api *API) BattleLobbyMatchmake(ctx context.Context, user *boiler.Player, factionID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &BattleLobbyMatchmakeRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	availableMechIDs, err := MechAuthorisationFilter(user, factionID, req.Payload.MechIDs)
+	if err != nil {
+		return err
+	}
+
+	availableMechIDs, err = db.OverDamagedMechFilter(availableMechIDs)
+	if err != nil {
+		return err
+	}
+
+	availableMechIDs, err = db.NonQueuedMechFilter(availableMechIDs)
+	if err != nil {
+		return err
+	}
+
+	if len(availableMechIDs) == 0 {
+		return terror.Error(fmt.Errorf("no available mech"), "The provided mechs are not queueable.")
+	}
+
+	mrs, err := db.MechRatingsGet(gamedb.StdConn, availableMechIDs)
+	if err != nil {
+		return terror.Error(err, "Failed to load mech ratings.")
+	}
+
+	rating := decimal.Zero
+	for _, mr := range mrs {
+		rating = rating.Add(mr.Rating)
+	}
+	rating = rating.Div(decimal.NewFromInt(int64(len(mrs))))
+
+	bl, err := matchmakingBattleLobby(factionID, rating)
+	if err != nil {
+		return err
+	}
+
+	// every public lobby is outside the rating of the mechs, so make sure there is an empty one to start
+	if bl == nil {
+		err = api.ArenaManager.DefaultPublicLobbiesCheck()
+		if err != nil {
+			return err
+		}
+
+		bl, err = matchmakingBattleLobby(factionID, rating)
+		if err != nil {
+			return err
+		}
+	}
+
+	if bl == nil {
+		return terror.Error(fmt.Errorf("no public lobby available"), "There is no public lobby available for your mechs, please try again later.")
+	}
+
+	joinReq := &BattleLobbyJoinRequest{}
+	joinReq.Payload.BattleLobbyID = bl.ID
+	joinReq.Payload.MechIDs = availableMechIDs
+
+	joinPayload, err := json.Marshal(joinReq)
+	if err != nil {
+		return terror.Error(err, "Failed to queue your mechs.")
+	}
+
+	return api.BattleLobbyJoin(ctx, user, factionID, HubKeyBattleLobbyJoin, joinPayload, reply)
+}
+
+// matchmakingBattleLobby returns the public lobby with a free slot for the faction which is closest to a rating.
+// Lobbies which already have mechs queued are preferred over empty lobbies, so lobbies fill up before new ones start.
+func matchmakingBattleLobby(factionID string, rating decimal.Decimal) (*boiler.BattleLobby, error) {
+	bls, err := boiler.BattleLobbies(
+		boiler.BattleLobbyWhere.GeneratedBySystem.EQ(true),
+		boiler.BattleLobbyWhere.IsAiDrivenMatch.EQ(false),
+		boiler.BattleLobbyWhere.IsPrivate.EQ(false),
+		boiler.BattleLobbyWhere.AccessCode.IsNull(),
+		boiler.BattleLobbyWhere.ReadyAt.IsNull(),
+		boiler.BattleLobbyWhere.EndedAt.IsNull(),
+		qm.Load(
+			boiler.BattleLobbyRels.BattleLobbiesMechs,
+			boiler.BattleLobbiesMechWhere.RefundTXID.IsNull(),
+			boiler.BattleLobbiesMechWhere.DeletedAt.IsNull(),
+		),
+		qm.OrderBy(boiler.BattleLobbyColumns.CreatedAt),
+	).All(gamedb.StdConn)
+	if err != nil {
+		gamelog.L.Error().Err(err).Msg("Failed to load public battle lobbies.")
+		return nil, terror.Error(err, "Failed to load public battle lobbies.")
+	}
+
+	battleLobbyIDs := []string{}
+	for _, bl := range bls {
+		battleLobbyIDs = append(battleLobbyIDs, bl.ID)
+	}
+
+	lrs, err := db.BattleLobbyRatings(gamedb.StdConn, battleLobbyIDs)
+	if err != nil {
+		gamelog.L.Error().Err(err).Msg("Failed to load battle lobby ratings.")
+		return nil, terror.Error(err, "Failed to load battle lobby ratings.")
+	}
+
+	var match *boiler.BattleLobby
+	var matchDistance decimal.Decimal
+	var emptyLobby *boiler.BattleLobby
+	for _, bl := range bls {
+		factionMechCount := 0
+		if bl.R != nil {
+			for _, blm := range bl.R.BattleLobbiesMechs {
+				if blm.FactionID == factionID {
+					factionMechCount += 1
+				}
+			}
+		}
+		if factionMechCount >= bl.EachFactionMechAmount {
+			continue
+		}
+
+		lr, ok := lrs[bl.ID]
+		if !ok {
+			if emptyLobby == nil {
+				emptyLobby = bl
+			}
+			continue
+		}
+
+		if !db.MechRatingInBand(lr, rating) {
+			continue
+		}
+
+		distance := rating.Sub(lr.Rating).Abs()
+		if match == nil || distance.LessThan(matchDistance) {
+			match = bl
+			matchDistance = distance
+		}
+	}
+
+	if match != nil {
+		return match, nil
+	}
+
+	return emptyLobby, nil
+}
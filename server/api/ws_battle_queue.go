@@ -35,6 +35,7 @@ func BattleQueueController(api *API) {
 	api.SecureUserFactionCommand(HubKeyBattleLobbyClose, api.BattleLobbyClose)
 
 	api.SecureUserFactionCommand(HubKeyBattleLobbyJoin, api.BattleLobbyJoin)
+	api.SecureUserFactionCommand(HubKeyBattleLobbyMatchmake, api.BattleLobbyMatchmake)
 	api.SecureUserFactionCommand(HubKeyBattleLobbyLeave, api.BattleLobbyLeave)
 	api.SecureUserCommand(HubKeyBattleLobbyTopUpReward, api.BattleLobbyTopUpReward)
 
@@ -229,6 +230,7 @@ func (api *API) BattleLobbyCreate(ctx context.Context, user *boiler.Player, fact
 
 		if req.Payload.Accessibility == LobbyAccessibilityPrivate && req.Payload.AccessCode.Valid && req.Payload.AccessCode.String != "" {
 			bl.AccessCode = req.Payload.AccessCode
+			bl.IsPrivate = true
 			bl.ExpiresAt = null.TimeFromPtr(nil)
 		}
 
@@ -381,7 +383,18 @@ func (api *API) BattleLobbyCreate(ctx context.Context, user *boiler.Player, fact
 					return
 				}
 
+				// players who blocked the host do not receive the invitation
+				blockingIDs, err := db.PlayerIDsBlocking(host.ID, invitedPlayerIDs)
+				if err != nil {
+					gamelog.L.Error().Err(err).Str("host id", host.ID).Msg("failed to check players who blocked the host.")
+					return
+				}
+
 				for _, playerID := range invitedPlayerIDs {
+					if slices.Contains(blockingIDs, playerID) {
+						continue
+					}
+
 					// build system message
 					msg := &boiler.SystemMessage{
 						PlayerID: playerID,
@@ -564,6 +577,15 @@ func (api *API) BattleLobbyJoin(ctx context.Context, user *boiler.Player, factio
 			return terror.Error(fmt.Errorf("battle lobby is already full"), "The battle lobby is already full.")
 		}
 
+		// the access code is cleared once the lobby is ready, so record whether the lobby is private beforehand
+		isPrivateLobby := bl.AccessCode.Valid
+
+		// public lobbies are filled with mechs of a similar rating
+		availableMechIDs, err = MechRatingBandFilter(bl, availableMechIDs)
+		if err != nil {
+			return err
+		}
+
 		var battleLobbyMechs []*boiler.BattleLobbiesMech
 		deployedMechIDs := []string{}
 		availableSlotCount := bl.EachFactionMechAmount
@@ -774,6 +796,11 @@ func (api *API) BattleLobbyJoin(ctx context.Context, user *boiler.Player, factio
 			}
 
 			api.ArenaManager.FactionStakedMechDashboardKeyChan <- []string{battle.FactionStakedMechDashboardKeyQueue}
+
+			// let followers know, unless the lobby is private
+			if !isPrivateLobby {
+				go followedMechDeployedSystemMessage(user, bl, deployedMechIDs)
+			}
 		}
 
 		// kick
@@ -956,6 +983,21 @@ func (api *API) BattleLobbyLeave(ctx context.Context, user *boiler.Player, facti
 			return terror.Error(fmt.Errorf("no mech in queue"), "No mech is in queue.")
 		}
 
+		// mechs in a tournament match stay until the match is played
+		for _, blm := range blms {
+			var isTournamentMatch bool
+			isTournamentMatch, err = boiler.TournamentMatches(
+				boiler.TournamentMatchWhere.BattleLobbyID.EQ(null.StringFrom(blm.BattleLobbyID)),
+			).Exists(gamedb.StdConn)
+			if err != nil {
+				gamelog.L.Error().Err(err).Str("battle lobby id", blm.BattleLobbyID).Msg("Failed to check tournament match.")
+				return terror.Error(err, "Failed to leave battle lobby.")
+			}
+			if isTournamentMatch {
+				return terror.Error(fmt.Errorf("mech is in a tournament match"), "Mechs cannot leave a tournament match lobby.")
+			}
+		}
+
 		var tx *sql.Tx
 		tx, err = gamedb.StdConn.Begin()
 		if err != nil {
@@ -1282,7 +1324,7 @@ func (api *API) MechStake(ctx context.Context, user *boiler.Player, factionID st
 
 	stakedMechIDs := []string{}
 	for _, mqa := range mqas {
-		if mqa.OwnerID != user.ID || mqa.StakedOnFactionID.Valid || mqa.XsynLocked || mqa.LockedToMarketplace || !mqa.PowerCoreID.Valid || !mqa.HasWeapon {
+		if mqa.OwnerID != user.ID || mqa.StakedOnFactionID.Valid || mqa.XsynLocked || mqa.ClawbackLocked || mqa.LockedToMarketplace || !mqa.PowerCoreID.Valid || !mqa.HasWeapon {
 			continue
 		}
 
@@ -1770,6 +1812,11 @@ func MechAuthorisationFilter(player *boiler.Player, factionID string, mechIDs []
 			continue
 		}
 
+		if mqa.ClawbackLocked {
+			l.Debug().Err(err).Msg("mech is clawback locked")
+			continue
+		}
+
 		if !mqa.IsAvailable {
 			l.Debug().Err(err).Msg("mech is currently not available")
 			continue
@@ -1913,3 +1960,53 @@ func (api *API) PlayerBrowserAlert(ctx context.Context, user *boiler.Player, key
 
 	return nil
 }
+
+type FollowedMechDeployedData struct {
+	PlayerID        string              `json:"player_id"`
+	BattleLobbyID   string              `json:"battle_lobby_id"`
+	BattleLobbyName string              `json:"battle_lobby_name"`
+	Mechs           []*server.MechAlert `json:"mechs"`
+}
+
+// followedMechDeployedSystemMessage notifies the followers of the player about the mechs deployed into the lobby
+func followedMechDeployedSystemMessage(player *boiler.Player, bl *boiler.BattleLobby, mechIDs []string) {
+	l := gamelog.L.With().Str("func", "followedMechDeployedSystemMessage").Str("player id", player.ID).Str("battle lobby id", bl.ID).Logger()
+
+	mechs, err := boiler.Mechs(
+		boiler.MechWhere.ID.IN(mechIDs),
+		qm.Load(boiler.MechRels.Blueprint),
+	).All(gamedb.StdConn)
+	if err != nil {
+		l.Error().Err(err).Strs("mech ids", mechIDs).Msg("Failed to load deployed mechs.")
+		return
+	}
+
+	fmd := &FollowedMechDeployedData{
+		PlayerID:        player.ID,
+		BattleLobbyID:   bl.ID,
+		BattleLobbyName: bl.Name,
+		Mechs:           []*server.MechAlert{},
+	}
+	for _, mech := range mechs {
+		ma := &server.MechAlert{
+			ID:   mech.ID,
+			Name: mech.Name,
+		}
+		if ma.Name == "" && mech.R != nil && mech.R.Blueprint != nil {
+			ma.Name = mech.R.Blueprint.Label
+		}
+
+		fmd.Mechs = append(fmd.Mechs, ma)
+	}
+
+	message := fmt.Sprintf("%s #%d deployed a mech into lobby %s", player.Username.String, player.Gid, bl.Name)
+	if len(mechs) > 1 {
+		message = fmt.Sprintf("%s #%d deployed %d mechs into lobby %s", player.Username.String, player.Gid, len(mechs), bl.Name)
+	}
+
+	var data interface{} = fmd
+	err = system_messages.BroadcastFollowerSystemMessage(player.ID, "Mech Deployed", message, system_messages.SystemMessageDataTypeFollowedMechDeployed, &data)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to notify followers.")
+	}
+}
@@ -11,7 +11,6 @@ import (
 	"server/db/boiler"
 	"server/gamedb"
 	"server/gamelog"
-	"sort"
 	"time"
 
 	"github.com/sasha-s/go-deadlock"
@@ -37,8 +36,13 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
+// PersistChatMessageLimit is the number of recent messages each chatroom keeps in memory,
+// older messages are loaded from the chat history on request
 const PersistChatMessageLimit = 50
 
+// ChatHistoryPageSizeLimit is the maximum number of older messages returned in a single page
+const ChatHistoryPageSizeLimit = 100
+
 var bm = bluemonday.StrictPolicy()
 
 // ChatMessage contains chat message data to send.
@@ -57,6 +61,8 @@ const (
 	ChatMessageTypeSystemBan  ChatMessageType = "SYSTEM_BAN"
 	ChatMessageTypeModBan     ChatMessageType = "MOD_BAN"
 	ChatMessageTypeNewBattle  ChatMessageType = "NEW_BATTLE"
+
+	ChatMessageTypeSyndicateNotice ChatMessageType = "SYNDICATE_NOTICE"
 )
 
 type MessageText struct {
@@ -129,8 +135,8 @@ type Chatroom struct {
 func (c *Chatroom) AddMessage(message *ChatMessage) {
 	c.Lock()
 	c.messages = append(c.messages, message)
-	if len(c.messages) >= PersistChatMessageLimit {
-		c.messages = c.messages[1:]
+	if len(c.messages) > PersistChatMessageLimit {
+		c.messages = c.messages[len(c.messages)-PersistChatMessageLimit:]
 	}
 	c.Unlock()
 }
@@ -183,96 +189,110 @@ func isFingerPrintBanned(playerID string) bool {
 	return len(bannedFingerprints) > 0
 }
 
+// chatStream returns the chat history stream of the faction chat, or the global chat if the faction id is empty
+func chatStream(factionID string) string {
+	if factionID == "" {
+		return "global"
+	}
+	return factionID
+}
+
+// NewChatroom rehydrates the chatroom with the latest messages of its chat history
 func NewChatroom(factionID string) *Chatroom {
-	stream := "global"
-	if factionID != "" {
-		stream = factionID
+	return newChatroom(chatStream(factionID), factionID)
+}
+
+func newChatroom(stream string, factionID string) *Chatroom {
+	msgs, err := db.ChatHistoryList(stream, nil, PersistChatMessageLimit)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("chat stream", stream).Msg("Failed to load chat history for chatroom")
 	}
-	msgs, _ := boiler.ChatHistories(
-		boiler.ChatHistoryWhere.ChatStream.EQ(stream),
-		qm.OrderBy(fmt.Sprintf("%s %s", boiler.ChatHistoryColumns.CreatedAt, "DESC")),
-		qm.Limit(PersistChatMessageLimit),
-	).All(gamedb.StdConn)
 
+	factionUUID := server.FactionID(uuid.FromStringOrNil(factionID))
+	chatroom := &Chatroom{
+		factionID: &factionUUID,
+		messages:  chatMessagesFromHistory(msgs),
+	}
+	return chatroom
+}
+
+// chatMessagesFromHistory rebuilds the chat messages from the chat history (newest first), returns the oldest message first
+func chatMessagesFromHistory(msgs []*boiler.ChatHistory) []*ChatMessage {
 	players := map[string]*boiler.Player{}
 	stats := map[string]*server.UserStat{}
 
-	cms := make([]*ChatMessage, len(msgs))
-	cmstoSend := []*ChatMessage{}
-	for i, msg := range msgs {
-
-		player, ok := players[msg.PlayerID]
-		if !ok {
-			var err error
-			player, err = boiler.Players(
-				qm.Select(
-					boiler.PlayerColumns.ID,
-					boiler.PlayerColumns.Username,
-					boiler.PlayerColumns.Gid,
-					boiler.PlayerColumns.FactionID,
-					boiler.PlayerColumns.Rank,
-					boiler.PlayerColumns.SentMessageCount,
-				),
-				boiler.PlayerWhere.ID.EQ(msg.PlayerID),
-			).One(gamedb.StdConn)
-			if err != nil {
-				gamelog.L.Warn().Err(err).Interface("msg.PlayerID", msg.PlayerID).Msg("issue finding player")
-				continue
-			}
-			playerStat, err := db.UserStatsGet(player.ID)
-			if err != nil && !errors.Is(err, sql.ErrNoRows) {
-				gamelog.L.Warn().Err(err).Interface("player.ID", player.ID).Msg("issue UserStatsGet")
-				continue
-			}
+	playerIDs := []string{}
+	for _, msg := range msgs {
+		if msg.MSGType == boiler.ChatMSGTypeEnumTEXT && slices.Index(playerIDs, msg.PlayerID) == -1 {
+			playerIDs = append(playerIDs, msg.PlayerID)
+		}
+	}
 
-			if playerStat == nil {
-				continue
-			}
+	if len(playerIDs) > 0 {
+		ps, err := boiler.Players(
+			qm.Select(
+				boiler.PlayerColumns.ID,
+				boiler.PlayerColumns.Username,
+				boiler.PlayerColumns.Gid,
+				boiler.PlayerColumns.FactionID,
+				boiler.PlayerColumns.Rank,
+				boiler.PlayerColumns.SentMessageCount,
+			),
+			boiler.PlayerWhere.ID.IN(playerIDs),
+		).All(gamedb.StdConn)
+		if err != nil {
+			gamelog.L.Warn().Err(err).Strs("player ids", playerIDs).Msg("issue finding chat history players")
+		}
+		for _, p := range ps {
+			players[p.ID] = p
 
-			stats[player.ID] = playerStat
+			playerStat, err := db.UserStatsGet(p.ID)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				gamelog.L.Warn().Err(err).Str("player.ID", p.ID).Msg("issue UserStatsGet")
+			}
+			stats[p.ID] = playerStat
 		}
-		stat := stats[player.ID]
+	}
 
-		if msg.MSGType == boiler.ChatMSGTypeEnumNEW_BATTLE {
+	cms := []*ChatMessage{}
+	for i := len(msgs) - 1; i >= 0; i-- {
+		msg := msgs[i]
+		switch msg.MSGType {
+		case boiler.ChatMSGTypeEnumNEW_BATTLE, boiler.ChatMSGTypeEnumSYSTEM_BAN, boiler.ChatMSGTypeEnumPUNISH_VOTE, boiler.ChatMSGTypeEnumSYNDICATE_NOTICE:
+			// the whole chat message is stored in the metadata
 			cm := &ChatMessage{}
 			err := msg.Metadata.Unmarshal(cm)
 			if err != nil {
+				gamelog.L.Warn().Err(err).Str("chat history id", msg.ID).Msg("issue unmarshalling chat message")
 				continue
 			}
+			cms = append(cms, cm)
 
-			cms[i] = cm
-			cmstoSend = append(cmstoSend, cms[i])
-			continue
-		}
+		case boiler.ChatMSGTypeEnumTEXT:
+			player, ok := players[msg.PlayerID]
+			if !ok {
+				continue
+			}
 
-		cms[i] = &ChatMessage{
-			ID:     msg.ID,
-			Type:   ChatMessageType(msg.MSGType),
-			SentAt: msg.CreatedAt,
-			Data: &MessageText{
-				ID:           msg.ID,
-				Message:      msg.Text,
-				MessageColor: msg.MessageColor,
-				FromUser:     *player,
-				UserRank:     player.Rank,
-				FromUserStat: stat,
-				Metadata:     msg.Metadata,
-			},
+			cms = append(cms, &ChatMessage{
+				ID:     msg.ID,
+				Type:   ChatMessageType(msg.MSGType),
+				SentAt: msg.CreatedAt,
+				Data: &MessageText{
+					ID:           msg.ID,
+					Message:      msg.Text,
+					MessageColor: msg.MessageColor,
+					FromUser:     *player,
+					UserRank:     player.Rank,
+					FromUserStat: stats[player.ID],
+					Lang:         msg.Lang,
+					Metadata:     msg.Metadata,
+				},
+			})
 		}
-		cmstoSend = append(cmstoSend, cms[i])
 	}
 
-	// sort the messages to the correct order
-	sort.Slice(cmstoSend, func(i, j int) bool {
-		return cmstoSend[i].SentAt.Before(cmstoSend[j].SentAt)
-	})
-
-	factionUUID := server.FactionID(uuid.FromStringOrNil(factionID))
-	chatroom := &Chatroom{
-		factionID: &factionUUID,
-		messages:  cmstoSend,
-	}
-	return chatroom
+	return cms
 }
 
 // ChatController holds handlers for chat
@@ -293,6 +313,10 @@ func NewChatController(api *API) *ChatController {
 	api.SecureUserCommand(HubKeyChatBanPlayer, chatHub.ChatBanPlayerHandler)
 	api.SecureUserCommand(HubKeyReactToMessage, chatHub.ReactToMessageHandler)
 	api.SecureUserCommand(HubKeyChatReport, chatHub.ChatReportHandler)
+	api.SecureUserFactionCommand(HubKeyFactionChatHistoryList, chatHub.FactionChatHistoryListHandler)
+	api.Command(HubKeyGlobalChatHistoryList, chatHub.GlobalChatHistoryListHandler)
+	api.SecureUserFactionCommand(HubKeySyndicateChatMessage, chatHub.SyndicateChatMessageHandler)
+	api.SecureUserFactionCommand(HubKeySyndicateChatHistoryList, chatHub.SyndicateChatHistoryListHandler)
 
 	go api.MessageBroadcaster()
 
@@ -331,6 +355,11 @@ func (api *API) MessageBroadcaster() {
 				Data:   banMessage,
 			}
 
+			err := persistChatMessage(cm, boiler.ChatMSGTypeEnumSYSTEM_BAN, msg.FactionID, msg.SystemPlayer.ID)
+			if err != nil {
+				gamelog.L.Error().Err(err).Str("ban message id", cm.ID).Msg("Failed to store system ban message in chat history")
+			}
+
 			switch msg.FactionID.String {
 			case server.RedMountainFactionID:
 				api.RedMountainChat.AddMessage(cm)
@@ -348,6 +377,8 @@ func (api *API) MessageBroadcaster() {
 				api.GlobalChat.AddMessage(cm)
 				ws.PublishMessage("/public/global_chat", HubKeyGlobalChatSubscribe, []*ChatMessage{cm})
 			}
+		case event := <-api.SyndicateSystem.ChatEventChan:
+			api.SyndicateChats.handleEvent(event)
 		case newBattleInfo := <-api.ArenaManager.NewBattleChan:
 			err := api.BroadcastNewBattle(newBattleInfo.ID, newBattleInfo.BattleNumber)
 			if err != nil {
@@ -358,6 +389,36 @@ func (api *API) MessageBroadcaster() {
 	}
 }
 
+// persistChatMessage stores the whole chat message in the chat history, so it is restored with the chatroom.
+// Messages of the global chat are stored without a faction.
+func persistChatMessage(cm *ChatMessage, msgType string, factionID null.String, playerID string) error {
+	var jsonMeta null.JSON
+	err := jsonMeta.Marshal(cm)
+	if err != nil {
+		return err
+	}
+
+	stream := "global"
+	if factionID.Valid {
+		stream = factionID.String
+	}
+
+	ch := &boiler.ChatHistory{
+		ID:         cm.ID,
+		FactionID:  factionID,
+		PlayerID:   playerID,
+		MSGType:    msgType,
+		ChatStream: stream,
+		Metadata:   jsonMeta,
+	}
+	err = ch.Insert(gamedb.StdConn, boil.Infer())
+	if err != nil {
+		return terror.Error(err, fmt.Sprintf("Could not create %s message in chat history.", msgType))
+	}
+
+	return nil
+}
+
 func (api *API) updateMessageMetadata(chatHistory *boiler.ChatHistory, jsonTextMsgMeta null.JSON, logger zerolog.Logger) error {
 	logger = logger.With().Interface("updateMessageMetadata", chatHistory.ID).Logger()
 	fn := func(chatMessage *ChatMessage) bool {
@@ -423,6 +484,13 @@ func (api *API) updateMessageMetadata(chatHistory *boiler.ChatHistory, jsonTextM
 		api.ZaibatsuChat.WriteRange(fn)
 		ws.PublishMessage(fmt.Sprintf("/faction/%s/faction_chat", server.ZaibatsuFactionID), HubKeyFactionChatSubscribe, []*ChatMessage{chatMessage})
 	default:
+		// messages of the syndicate chats are streamed by the syndicate id
+		if room := api.SyndicateChats.get(chatHistory.ChatStream); room != nil {
+			room.WriteRange(fn)
+			ws.PublishMessage(syndicateChatRoute(chatHistory.FactionID.String, chatHistory.ChatStream), server.HubKeySyndicateChatSubscribe, []*ChatMessage{chatMessage})
+			break
+		}
+
 		api.GlobalChat.WriteRange(fn)
 		ws.PublishMessage("/public/global_chat", HubKeyGlobalChatSubscribe, []*ChatMessage{chatMessage})
 	}
@@ -430,63 +498,10 @@ func (api *API) updateMessageMetadata(chatHistory *boiler.ChatHistory, jsonTextM
 	return nil
 }
 
-// FactionChatRequest sends chat message to specific faction.
-type FactionChatRequest struct {
-	Payload struct {
-		Id              string           `json:"id"`
-		FactionID       server.FactionID `json:"faction_id"`
-		MessageColor    string           `json:"message_color"`
-		Message         string           `json:"message"`
-		TaggedUsersGids []int            `json:"tagged_users_gids"`
-	} `json:"payload"`
-}
-
-const HubKeyChatMessage = "CHAT:MESSAGE"
-
-func firstN(s string, n int) string {
-	i := 0
-	for j := range s {
-		if i == n {
-			return s[:j]
-		}
-		i++
-	}
-	return s
-}
-
-var bucket = leakybucket.NewCollector(4, 1, true) // 4 msg per second
-
-// ChatMessageHandler sends chat message from player
-func (fc *ChatController) ChatMessageHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
-	b1 := bucket.Add(user.ID, 1)
-
-	if b1 == 0 {
-		return terror.Warn(fmt.Errorf("too many messages"), "Too many messages.")
-	}
-
-	req := &FactionChatRequest{}
-	err := json.Unmarshal(payload, req)
-	if err != nil {
-		return terror.Error(err, "Invalid request received.")
-	}
-
-	if !user.FactionID.Valid {
-		return terror.Error(terror.ErrForbidden, "You must be enrolled in a faction to chat.")
-	}
-
-	// omit unused player detail
-	player := boiler.Player{
-		ID:               user.ID,
-		Username:         user.Username,
-		Gid:              user.Gid,
-		FactionID:        user.FactionID,
-		Rank:             user.Rank,
-		SentMessageCount: user.SentMessageCount,
-	}
-
-	// check user is banned on chat
+// checkChatBan returns an error if the player is banned from sending chat messages
+func checkChatBan(playerID string) error {
 	isBanned, err := boiler.PlayerBans(
-		boiler.PlayerBanWhere.BannedPlayerID.EQ(user.ID),
+		boiler.PlayerBanWhere.BannedPlayerID.EQ(playerID),
 		boiler.PlayerBanWhere.BanSendChat.EQ(true),
 		boiler.PlayerBanWhere.ManuallyUnbanByID.IsNull(),
 		boiler.PlayerBanWhere.EndAt.GT(time.Now()),
@@ -505,21 +520,50 @@ func (fc *ChatController) ChatMessageHandler(ctx context.Context, user *boiler.P
 		return err
 	}
 
+	return nil
+}
+
+// chatMessageDraft is a sanitised chat message from a player who is allowed to chat
+type chatMessageDraft struct {
+	player   boiler.Player
+	text     string
+	language string
+	stat     *server.UserStat
+	metadata null.JSON
+}
+
+// draftChatMessage runs the chat ban checks and sanitises the message, returns nil if the player is shadow banned
+func (fc *ChatController) draftChatMessage(user *boiler.Player, message string, taggedUsersGids []int) (*chatMessageDraft, error) {
+	// omit unused player detail
+	player := boiler.Player{
+		ID:               user.ID,
+		Username:         user.Username,
+		Gid:              user.Gid,
+		FactionID:        user.FactionID,
+		Rank:             user.Rank,
+		SentMessageCount: user.SentMessageCount,
+	}
+
+	// check user is banned on chat
+	err := checkChatBan(user.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	// user's fingerprint banned (shadow ban)
 	fingerprintBanned := isFingerPrintBanned(user.ID)
 	if fingerprintBanned {
-		reply(true)
-		return nil
+		return nil, nil
 	}
 
 	// update player sent message count
 	player.SentMessageCount += 1
 	_, err = player.Update(gamedb.StdConn, boil.Whitelist(boiler.PlayerColumns.SentMessageCount))
 	if err != nil {
-		return terror.Error(err, "Failed to update player sent message count")
+		return nil, terror.Error(err, "Failed to update player sent message count")
 	}
 
-	msg := html.UnescapeString(bm.Sanitize(req.Payload.Message))
+	msg := html.UnescapeString(bm.Sanitize(message))
 
 	linguaLanguage, exists := fc.API.LanguageDetector.DetectLanguageOf(msg)
 	language := linguaLanguage.String()
@@ -566,11 +610,20 @@ func (fc *ChatController) ChatMessageHandler(ctx context.Context, user *boiler.P
 	// get player current stat
 	playerStat, err := db.UserStatsGet(player.ID)
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return terror.Error(err, "Unable to get player stat from db")
+		return nil, terror.Error(err, "Unable to get player stat from db")
+	}
+
+	// players who blocked the sender cannot be tagged
+	blockingGids, err := db.PlayerGidsBlocking(player.ID, taggedUsersGids)
+	if err != nil {
+		return nil, err
 	}
 
 	taggedUsersGid := make(map[int]bool)
-	for _, gid := range req.Payload.TaggedUsersGids {
+	for _, gid := range taggedUsersGids {
+		if slices.Contains(blockingGids, gid) {
+			continue
+		}
 		taggedUsersGid[gid] = false
 	}
 
@@ -583,8 +636,79 @@ func (fc *ChatController) ChatMessageHandler(ctx context.Context, user *boiler.P
 	var jsonTextMsgMeta null.JSON
 	err = jsonTextMsgMeta.Marshal(textMsgMetadata)
 	if err != nil {
-		return terror.Error(err, "Could not marshal json")
+		return nil, terror.Error(err, "Could not marshal json")
+	}
+
+	return &chatMessageDraft{
+		player:   player,
+		text:     msg,
+		language: language,
+		stat:     playerStat,
+		metadata: jsonTextMsgMeta,
+	}, nil
+}
+
+// FactionChatRequest sends chat message to specific faction.
+type FactionChatRequest struct {
+	Payload struct {
+		Id              string           `json:"id"`
+		FactionID       server.FactionID `json:"faction_id"`
+		MessageColor    string           `json:"message_color"`
+		Message         string           `json:"message"`
+		TaggedUsersGids []int            `json:"tagged_users_gids"`
+	} `json:"payload"`
+}
+
+const HubKeyChatMessage = "CHAT:MESSAGE"
+
+func firstN(s string, n int) string {
+	i := 0
+	for j := range s {
+		if i == n {
+			return s[:j]
+		}
+		i++
+	}
+	return s
+}
+
+var bucket = leakybucket.NewCollector(4, 1, true) // 4 msg per second
+
+// ChatMessageHandler sends chat message from player
+func (fc *ChatController) ChatMessageHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	b1 := bucket.Add(user.ID, 1)
+
+	if b1 == 0 {
+		return terror.Warn(fmt.Errorf("too many messages"), "Too many messages.")
+	}
+
+	req := &FactionChatRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	if !user.FactionID.Valid {
+		return terror.Error(terror.ErrForbidden, "You must be enrolled in a faction to chat.")
 	}
+
+	draft, err := fc.draftChatMessage(user, req.Payload.Message, req.Payload.TaggedUsersGids)
+	if err != nil {
+		return err
+	}
+
+	// user's fingerprint banned (shadow ban)
+	if draft == nil {
+		reply(true)
+		return nil
+	}
+
+	player := draft.player
+	msg := draft.text
+	language := draft.language
+	playerStat := draft.stat
+	jsonTextMsgMeta := draft.metadata
+
 	// check if the faction id is provided
 	if !req.Payload.FactionID.IsNil() {
 		if !player.FactionID.Valid || player.FactionID.String == "" {
@@ -597,7 +721,7 @@ func (fc *ChatController) ChatMessageHandler(ctx context.Context, user *boiler.P
 
 		cm := boiler.ChatHistory{
 			ID:              req.Payload.Id,
-			FactionID:       player.FactionID.String,
+			FactionID:       player.FactionID,
 			PlayerID:        player.ID,
 			MessageColor:    req.Payload.MessageColor,
 			BattleID:        null.String{},
@@ -648,7 +772,7 @@ func (fc *ChatController) ChatMessageHandler(ctx context.Context, user *boiler.P
 	// global message
 	cm := boiler.ChatHistory{
 		ID:              req.Payload.Id,
-		FactionID:       player.FactionID.String,
+		FactionID:       player.FactionID,
 		PlayerID:        player.ID,
 		MessageColor:    req.Payload.MessageColor,
 		BattleID:        null.String{},
@@ -901,6 +1025,71 @@ func (fc *ChatController) GlobalChatUpdatedSubscribeHandler(ctx context.Context,
 	return nil
 }
 
+type ChatHistoryListRequest struct {
+	Payload struct {
+		Before   *db.ChatHistoryCursor `json:"before"`
+		PageSize int                   `json:"page_size"`
+	} `json:"payload"`
+}
+
+type ChatHistoryListResponse struct {
+	Messages []*ChatMessage `json:"messages"`
+	HasMore  bool           `json:"has_more"`
+}
+
+// chatHistoryList loads a page of older messages from the chat history, the chatrooms only keep the latest messages in memory
+func chatHistoryList(stream string, payload []byte) (*ChatHistoryListResponse, error) {
+	req := &ChatHistoryListRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return nil, terror.Error(err, "Invalid request received.")
+	}
+
+	pageSize := req.Payload.PageSize
+	if pageSize <= 0 || pageSize > ChatHistoryPageSizeLimit {
+		pageSize = PersistChatMessageLimit
+	}
+
+	// load an extra message to find out whether there are more messages to load
+	msgs, err := db.ChatHistoryList(stream, req.Payload.Before, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ChatHistoryListResponse{}
+	if len(msgs) > pageSize {
+		resp.HasMore = true
+		msgs = msgs[:pageSize]
+	}
+	resp.Messages = chatMessagesFromHistory(msgs)
+
+	return resp, nil
+}
+
+const HubKeyFactionChatHistoryList = "FACTION:CHAT:HISTORY:LIST"
+
+func (fc *ChatController) FactionChatHistoryListHandler(ctx context.Context, user *boiler.Player, factionID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	resp, err := chatHistoryList(chatStream(factionID), payload)
+	if err != nil {
+		return err
+	}
+
+	reply(resp)
+	return nil
+}
+
+const HubKeyGlobalChatHistoryList = "GLOBAL:CHAT:HISTORY:LIST"
+
+func (fc *ChatController) GlobalChatHistoryListHandler(ctx context.Context, key string, payload []byte, reply ws.ReplyFunc) error {
+	resp, err := chatHistoryList(chatStream(""), payload)
+	if err != nil {
+		return err
+	}
+
+	reply(resp)
+	return nil
+}
+
 func (api *API) BroadcastNewBattle(id string, battleNumber int) error {
 	factions, err := boiler.Factions().All(gamedb.StdConn)
 	if err != nil {
@@ -922,7 +1111,7 @@ func (api *API) BroadcastNewBattle(id string, battleNumber int) error {
 
 	for _, faction := range factions {
 		ch := &boiler.ChatHistory{
-			FactionID:       faction.ID,
+			FactionID:       null.StringFrom(faction.ID),
 			PlayerID:        server.SupremacyBattleUserID,
 			MessageColor:    "",
 			Text:            "",
@@ -942,7 +1131,7 @@ func (api *API) BroadcastNewBattle(id string, battleNumber int) error {
 	}
 
 	ch := &boiler.ChatHistory{
-		FactionID:       server.RedMountainFactionID,
+		FactionID:       null.StringFrom(server.RedMountainFactionID),
 		PlayerID:        server.SupremacyBattleUserID,
 		MessageColor:    "",
 		Text:            "",
@@ -6,10 +6,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"server"
+	"server/asset"
 	"server/benchmark"
+	"server/db"
 	"server/db/boiler"
 	"server/gamedb"
 	"server/gamelog"
+	"server/marketplace"
 	"server/xsyn_rpcclient"
 	"sync"
 	"time"
@@ -49,6 +52,7 @@ func NewCouponsController(api *API) *CouponController {
 	}
 
 	api.SecureUserFactionCommand(HubKeyCodeRedemption, couponHub.CodeRedemptionHandler)
+	api.SecureUserCommand(HubKeyPlayerDiscountList, couponHub.PlayerDiscountListHandler)
 
 	go couponHub.RunRedeemFailUserGC()
 
@@ -99,11 +103,18 @@ type CodeRedemptionRequest struct {
 }
 
 type Reward struct {
-	Crate       *server.MysteryCrate `json:"mystery_crate,omitempty"`
-	Label       string               `json:"label"`
-	ImageURL    null.String          `json:"image_url"`
-	LockedUntil null.Time            `json:"locked_until"`
-	Amount      decimal.NullDecimal  `json:"amount"`
+	Crate       *server.MysteryCrate   `json:"mystery_crate,omitempty"`
+	Discount    *boiler.PlayerDiscount `json:"discount,omitempty"`
+	Label       string                 `json:"label"`
+	ImageURL    null.String            `json:"image_url"`
+	LockedUntil null.Time              `json:"locked_until"`
+	Amount      decimal.NullDecimal    `json:"amount"`
+}
+
+// PlayerDiscount is a discount redeemed from a coupon code.
+type PlayerDiscount struct {
+	*boiler.PlayerDiscount
+	Label string `json:"label"`
 }
 
 type CodeRedemptionResponse struct {
@@ -141,56 +152,19 @@ func (cc *CouponController) CodeRedemptionHandler(ctx context.Context, user *boi
 
 	couponCode := req.Payload.Code
 
-	rollbackRedeem := func() {
-		_, err := boiler.NewQuery(
-			qm.SQL(
-				fmt.Sprintf(`
-					UPDATE %s SET %s = false,
-								  %s = null
-					WHERE %s = $1`,
-					boiler.TableNames.Coupons,
-					boiler.CouponColumns.Redeemed,
-					boiler.CouponColumns.RedeemedAt,
-					boiler.CouponColumns.Code,
-				),
-				couponCode,
-			),
-		).Exec(gamedb.StdConn)
-		if err != nil {
-			gamelog.L.Error().Err(err).Interface("coupon code: ", couponCode).Msg("handle")
-			return
-		}
+	tx, err := gamedb.StdConn.Begin()
+	if err != nil {
+		gamelog.L.Error().Err(err).Msg("unable to begin tx")
+		return terror.Error(err, "Issue redeeming code, please try again or contact support.")
 	}
+	defer tx.Rollback()
 
-	coupon := &boiler.Coupon{}
-	err = boiler.NewQuery(
-		qm.SQL(
-			fmt.Sprintf(`
-					UPDATE %s SET %s = true,
-								  %s = $2,
-								  %s = NOW()
-					WHERE %s = FALSE 
-					AND %s = $1
-					AND %s > NOW()
-					RETURNING  %s, %s, %s, %s`,
-				boiler.TableNames.Coupons,
-				boiler.CouponColumns.Redeemed,
-				boiler.CouponColumns.RedeemedByID,
-				boiler.CouponColumns.RedeemedAt,
-				boiler.CouponColumns.Redeemed,
-				boiler.CouponColumns.Code,
-				boiler.CouponColumns.ExpiryDate,
-				boiler.CouponColumns.ID,
-				boiler.CouponColumns.Code,
-				boiler.CouponColumns.Redeemed,
-				boiler.CouponColumns.ExpiryDate,
-			),
-			couponCode,
-			user.ID,
-		),
-	).QueryRow(gamedb.StdConn).Scan(&coupon.ID, &coupon.Code, &coupon.Redeemed, &coupon.ExpiryDate)
+	coupon, err := db.CouponRedeem(tx, couponCode, user.ID, factionID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
+			_ = tx.Rollback()
+			reason := cc.recordRejectedCode(couponCode, user.ID, factionID)
+
 			if !hasFailedBefore {
 				redeemFailUser = RedeemFailUser{
 					Count:         0,
@@ -211,6 +185,9 @@ func (cc *CouponController) CodeRedemptionHandler(ctx context.Context, user *boi
 				return terror.Error(fmt.Errorf("too many invalid code redemption requests"), "Too many failed code redemption requests, please try again.")
 			}
 
+			if reason != "" {
+				return terror.Error(fmt.Errorf("unable to redeem coupon"), reason)
+			}
 			return terror.Error(fmt.Errorf("unable to find unclaimed coupon"))
 		}
 
@@ -224,11 +201,51 @@ func (cc *CouponController) CodeRedemptionHandler(ctx context.Context, user *boi
 		cc.redeemedFailUsersMut.Unlock()
 	}
 
-	err = coupon.L.LoadCouponItems(gamedb.StdConn, true, coupon,
-		boiler.CouponItemWhere.Claimed.EQ(false),
-	)
+	// the coupon row is locked by the redemption, so concurrent redemptions by the same player are counted here in turn
+	redemptions, err := db.CouponPlayerRedemptionCount(tx, coupon.ID, user.ID)
+	if err != nil {
+		gamelog.L.Error().Err(err).Interface("coupon code: ", couponCode).Msg("failed to count player redemptions")
+		return terror.Error(err, "Issue finding coupon code, try again or contact support.")
+	}
+	if redemptions >= coupon.MaxUsesPerPlayer {
+		_ = tx.Rollback()
+		err = db.CouponEventInsert(gamedb.StdConn, coupon.ID, db.CouponEventRejected, null.StringFrom(user.ID), "player limit reached")
+		if err != nil {
+			gamelog.L.Error().Err(err).Str("coupon_id", coupon.ID).Msg("failed to record rejected coupon")
+		}
+		return terror.Error(fmt.Errorf("player has redeemed coupon %d times", redemptions), "You have already redeemed this code.")
+	}
+
+	// gift codes can only be redeemed once the payment they were bought with has cleared
+	gift, err := db.FiatGiftByCoupon(tx, coupon.ID)
+	if err != nil {
+		gamelog.L.Error().Err(err).Interface("coupon code: ", couponCode).Msg("failed to load gift")
+		return terror.Error(err, "Issue finding coupon code, try again or contact support.")
+	}
+	if gift != nil {
+		cleared, err := db.FiatGiftPaymentCleared(tx, gift, PaymentStatusSuccess)
+		if err != nil {
+			gamelog.L.Error().Err(err).Interface("coupon code: ", couponCode).Msg("failed to check gift payment")
+			return terror.Error(err, "Issue finding coupon code, try again or contact support.")
+		}
+		if !cleared {
+			_ = tx.Rollback()
+			err = db.CouponEventInsert(gamedb.StdConn, coupon.ID, db.CouponEventRejected, null.StringFrom(user.ID), "gift payment has not cleared")
+			if err != nil {
+				gamelog.L.Error().Err(err).Str("coupon_id", coupon.ID).Msg("failed to record rejected coupon")
+			}
+			return terror.Error(fmt.Errorf("gift payment has not cleared"), "This gift code is on hold, please try again later or contact support.")
+		}
+	}
+
+	// items of codes that can be redeemed more than once are never marked as claimed
+	singleUse := db.CouponIsSingleUse(coupon)
+	var itemMods qm.QueryMod
+	if singleUse {
+		itemMods = boiler.CouponItemWhere.Claimed.EQ(false)
+	}
+	err = coupon.L.LoadCouponItems(tx, true, coupon, itemMods)
 	if err != nil {
-		rollbackRedeem()
 		gamelog.L.Error().Err(err).Interface("coupon code: ", couponCode).Msg("failed to find coupon code loading items")
 		return err
 	}
@@ -251,28 +268,36 @@ func (cc *CouponController) CodeRedemptionHandler(ctx context.Context, user *boi
 		return terror.Error(err, "Failed to get mech crate for claim, please try again or contact support.")
 	}
 
+	// changes made outside the transaction are undone when the redemption fails
+	var undoFuncs []func()
+	fail := func(err error, message string) error {
+		for _, fn := range undoFuncs {
+			fn()
+		}
+		return terror.Error(err, message)
+	}
+
 	var rewards []*Reward
+	var factionPassHolder *boiler.Player
+	playerAbilitiesChanged := false
 
 	for _, ci := range coupon.R.CouponItems {
-		if ci.Claimed {
+		if singleUse && ci.Claimed {
 			continue
 		}
 
-		tx, err := gamedb.StdConn.Begin()
-		defer tx.Rollback()
-		if err != nil {
-			gamelog.L.Error().Err(err).Msg("unable to begin tx")
-			rollbackRedeem()
-			return terror.Error(err, "Issue claiming mystery crate, please try again or contact support.")
-		}
-
 		switch ci.ItemType {
 		case boiler.CouponItemTypeSUPS:
 			txID, err := transferSups(user.ID, ci.Amount.Decimal.String(), cc.API, req.Payload.Code)
 			if err != nil {
-				rollbackRedeem()
-				return terror.Error(err, "Issue claiming $SUPS, please try again or contact support.")
+				return fail(err, "Issue claiming $SUPS, please try again or contact support.")
 			}
+			undoFuncs = append(undoFuncs, func() {
+				_, err := cc.API.Passport.RefundSupsMessage(txID)
+				if err != nil {
+					gamelog.L.Error().Err(err).Str("txID", txID).Msg("failed to refund coupon sups")
+				}
+			})
 			reward := &Reward{
 				Label:  "Sups",
 				Amount: ci.Amount,
@@ -283,15 +308,13 @@ func (cc *CouponController) CodeRedemptionHandler(ctx context.Context, user *boi
 		case boiler.CouponItemTypeMECH_CRATE:
 			assignedMechCrate, xa, err := assignAndRegisterPurchasedCrate(user.ID, storeMechCrate, tx, cc.API)
 			if err != nil {
-				rollbackRedeem()
-				return terror.Error(err, "Issue claiming mech crate, please try again or contact support.")
+				return fail(err, "Issue claiming mech crate, please try again or contact support.")
 			}
 
 			err = cc.API.Passport.AssetRegister(xa)
 			if err != nil {
-				rollbackRedeem()
 				gamelog.L.Error().Err(err).Interface("mystery crate", "").Msg("failed to register to XSYN")
-				return terror.Error(err, "Failed to get mystery crate, please try again or contact support.")
+				return fail(err, "Failed to get mystery crate, please try again or contact support.")
 			}
 			ci.ItemID = null.StringFrom(assignedMechCrate.ID)
 			reward := &Reward{
@@ -309,14 +332,12 @@ func (cc *CouponController) CodeRedemptionHandler(ctx context.Context, user *boi
 		case boiler.CouponItemTypeWEAPON_CRATE:
 			assignedWeaponCrate, xa, err := assignAndRegisterPurchasedCrate(user.ID, storeWeaponCrate, tx, cc.API)
 			if err != nil {
-				rollbackRedeem()
-				return terror.Error(err, "Issue claiming weapon crate, please try again or contact support.")
+				return fail(err, "Issue claiming weapon crate, please try again or contact support.")
 			}
 			err = cc.API.Passport.AssetRegister(xa)
 			if err != nil {
-				rollbackRedeem()
 				gamelog.L.Error().Err(err).Interface("mystery crate", "").Msg("failed to register to XSYN")
-				return terror.Error(err, "Failed to get mystery crate, please try again or contact support.")
+				return fail(err, "Failed to get mystery crate, please try again or contact support.")
 			}
 
 			ci.ItemID = null.StringFrom(assignedWeaponCrate.ID)
@@ -332,28 +353,179 @@ func (cc *CouponController) CodeRedemptionHandler(ctx context.Context, user *boi
 			ws.PublishMessage(fmt.Sprintf("/faction/%s/crate/%s", factionID, assignedWeaponCrate.ID), server.HubKeyMysteryCrateSubscribe, serverWeaponCrate)
 
 			rewards = append(rewards, reward)
+		case boiler.CouponItemTypeFIAT_PRODUCT:
+			product, err := db.FiatProduct(tx, ci.ItemID.String)
+			if err != nil {
+				return fail(err, "Issue claiming gift, please try again or contact support.")
+			}
+			if product.FactionID != factionID {
+				return fail(fmt.Errorf("gift product belongs to faction %s", product.FactionID), "This gift code can only be redeemed by players in the faction it was bought for.")
+			}
+
+			orderItemID := ""
+			if gift != nil {
+				orderItemID = gift.OrderItemID.String
+			}
+			err = deliverFiatProduct(tx, cc.API.Passport, product, user.ID, orderItemID, 1)
+			if err != nil {
+				return fail(err, "Issue claiming gift, please try again or contact support.")
+			}
+
+			rewards = append(rewards, &Reward{
+				Label:    product.Name,
+				ImageURL: product.AvatarURL,
+				Amount:   ci.Amount,
+			})
+		case boiler.CouponItemTypeFACTION_PASS:
+			// passes are either a faction pass, or a number of days when there is no pass
+			days := 0
+			label := ""
+			if ci.ItemID.Valid {
+				fp, err := boiler.FindFactionPass(tx, ci.ItemID.String)
+				if err != nil {
+					return fail(err, "Issue claiming faction pass, please try again or contact support.")
+				}
+				days = fp.LastForDays
+				label = fmt.Sprintf("%s faction pass", fp.Label)
+			} else {
+				days = int(ci.Amount.Decimal.IntPart())
+				label = fmt.Sprintf("%d day faction pass", days)
+			}
+
+			// gifted passes last as long as the pass did when it was bought
+			if gift != nil && gift.FactionPassPurchaseLogID.Valid {
+				fpp, err := boiler.FindFactionPassPurchaseLog(tx, gift.FactionPassPurchaseLogID.String)
+				if err != nil {
+					return fail(err, "Issue claiming faction pass, please try again or contact support.")
+				}
+				days = fpp.ExpendFactionPassDays
+			}
+
+			player, err := boiler.FindPlayer(tx, user.ID)
+			if err != nil {
+				return fail(err, "Issue claiming faction pass, please try again or contact support.")
+			}
+			startFrom := time.Now()
+			if player.FactionPassExpiresAt.Valid && player.FactionPassExpiresAt.Time.After(startFrom) {
+				startFrom = player.FactionPassExpiresAt.Time
+			}
+			player.FactionPassExpiresAt = null.TimeFrom(startFrom.Add(time.Duration(days) * 24 * time.Hour))
+			_, err = player.Update(tx, boil.Whitelist(boiler.PlayerColumns.FactionPassExpiresAt))
+			if err != nil {
+				return fail(err, "Issue claiming faction pass, please try again or contact support.")
+			}
+			factionPassHolder = player
+
+			rewards = append(rewards, &Reward{
+				Label:  label,
+				Amount: ci.Amount,
+			})
+		case boiler.CouponItemTypeBLUEPRINT:
+			quantity := 1
+			if ci.Amount.Valid && ci.Amount.Decimal.IsPositive() {
+				quantity = int(ci.Amount.Decimal.IntPart())
+			}
+
+			reward, err := asset.GiveBlueprint(tx, user.ID, ci.BlueprintType.String, ci.ItemID.String, quantity)
+			if err != nil {
+				return fail(err, "Issue claiming item, please try again or contact support.")
+			}
+
+			if len(reward.XsynAssets) > 0 {
+				err = cc.API.Passport.AssetsRegister(reward.XsynAssets)
+				if err != nil {
+					gamelog.L.Error().Err(err).Str("blueprint_id", ci.ItemID.String).Msg("failed to register to XSYN")
+					return fail(err, "Issue claiming item, please try again or contact support.")
+				}
+			}
+			if reward.Keycard != nil {
+				keycard := reward.Keycard
+				err = marketplace.UpdateKeycardCountXSYN(cc.API.Passport, keycard, user.PublicAddress.String, quantity, true)
+				if err != nil {
+					return fail(err, "Issue claiming keycard, please try again or contact support.")
+				}
+				undoFuncs = append(undoFuncs, func() {
+					err := marketplace.UpdateKeycardCountXSYN(cc.API.Passport, keycard, user.PublicAddress.String, quantity, false)
+					if err != nil {
+						gamelog.L.Error().Err(err).Str("blueprint_keycard_id", keycard.ID).Msg("failed to remove coupon keycards on xsyn")
+					}
+				})
+			}
+			if ci.BlueprintType.String == asset.BlueprintTypePlayerAbility {
+				playerAbilitiesChanged = true
+			}
+
+			rewards = append(rewards, &Reward{
+				Label:  reward.Label,
+				Amount: decimal.NewNullDecimal(decimal.NewFromInt(int64(quantity))),
+			})
+		case boiler.CouponItemTypeDISCOUNT:
+			pd := &boiler.PlayerDiscount{
+				PlayerID:   user.ID,
+				CouponID:   coupon.ID,
+				Target:     ci.DiscountTarget.String,
+				Percentage: ci.DiscountPercentage,
+				ExpiresAt:  coupon.ExpiryDate,
+			}
+			if !pd.Percentage.Valid {
+				pd.Amount = ci.Amount
+			}
+			err = pd.Insert(tx, boil.Infer())
+			if err != nil {
+				return fail(err, "Issue claiming discount, please try again or contact support.")
+			}
+
+			rewards = append(rewards, &Reward{
+				Label:    playerDiscountLabel(pd),
+				Amount:   ci.Amount,
+				Discount: pd,
+			})
 		case boiler.CouponItemTypeGENESIS_MECH:
 			//	TODO: genesis mech handle
 			continue
 		default:
-			rollbackRedeem()
-			return terror.Error(fmt.Errorf("invalid coupon item type: %s", ci.ItemType))
+			return fail(fmt.Errorf("invalid coupon item type: %s", ci.ItemType), "Issue redeeming code, please try again or contact support.")
 		}
 
-		ci.Claimed = true
-		_, err = ci.Update(tx, boil.Infer())
-		if err != nil {
-			rollbackRedeem()
-			return terror.Error(err, "Issue claiming mystery crate, please try again or contact support.")
+		if singleUse {
+			ci.Claimed = true
+			_, err = ci.Update(tx, boil.Infer())
+			if err != nil {
+				return fail(err, "Issue claiming mystery crate, please try again or contact support.")
+			}
 		}
+	}
 
-		err = tx.Commit()
+	if gift != nil {
+		gift.RecipientID = null.StringFrom(user.ID)
+		_, err = gift.Update(tx, boil.Whitelist(boiler.FiatGiftColumns.RecipientID))
 		if err != nil {
-			rollbackRedeem()
-			gamelog.L.Error().Err(err).Msg("failed to commit mystery crate transaction")
-			return terror.Error(err, "Issue claiming mystery crate, please try again or contact support.")
+			gamelog.L.Error().Err(err).Str("fiat_gift_id", gift.ID).Msg("failed to record gift recipient")
+			return fail(err, "Issue redeeming code, please try again or contact support.")
 		}
+	}
+	err = db.CouponEventInsert(tx, coupon.ID, db.CouponEventRedeemed, null.StringFrom(user.ID), "")
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("coupon_id", coupon.ID).Msg("failed to record redeemed coupon")
+		return fail(err, "Issue redeeming code, please try again or contact support.")
+	}
 
+	err = tx.Commit()
+	if err != nil {
+		gamelog.L.Error().Err(err).Msg("failed to commit coupon redemption transaction")
+		return fail(err, "Issue redeeming code, please try again or contact support.")
+	}
+
+	if factionPassHolder != nil {
+		ws.PublishMessage(fmt.Sprintf("/secure/user/%s/faction_pass_expiry_date", factionPassHolder.ID), HubKeyPlayerFactionPassExpiryDate, factionPassHolder.FactionPassExpiresAt)
+	}
+	if playerAbilitiesChanged {
+		pas, err := db.PlayerAbilitiesList(user.ID)
+		if err != nil {
+			gamelog.L.Error().Err(err).Str("player_id", user.ID).Msg("unable to get player abilities")
+		} else {
+			ws.PublishMessage(fmt.Sprintf("/secure/user/%s/player_abilities", user.ID), server.HubKeyPlayerAbilitiesList, pas)
+		}
 	}
 
 	reply(CodeRedemptionResponse{
@@ -362,6 +534,85 @@ func (cc *CouponController) CodeRedemptionHandler(ctx context.Context, user *boi
 	return nil
 }
 
+// recordRejectedCode adds a failed redemption of an existing coupon code to its audit trail,
+// and returns the reason to show the player.
+func (cc *CouponController) recordRejectedCode(code string, playerID string, factionID string) string {
+	coupon, err := boiler.Coupons(boiler.CouponWhere.Code.EQ(code)).One(gamedb.StdConn)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ""
+	}
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("coupon code", code).Msg("failed to load rejected coupon")
+		return ""
+	}
+
+	note := "expired"
+	reason := "This code has expired."
+	switch {
+	case coupon.DisabledAt.Valid:
+		note = "disabled"
+		reason = "This code is no longer available."
+	case coupon.Redeemed:
+		note = "already redeemed"
+		reason = "This code has already been redeemed."
+	case !coupon.ExpiryDate.After(time.Now()):
+		// expired codes are rejected as expired, even before they started or in another faction
+	case coupon.StartsAt.Valid && coupon.StartsAt.Time.After(time.Now()):
+		note = "not started"
+		reason = "This code cannot be redeemed yet."
+	case coupon.FactionID.Valid && coupon.FactionID.String != factionID:
+		note = "restricted to another faction"
+		reason = "This code can only be redeemed by players in the faction it was issued to."
+	}
+
+	err = db.CouponEventInsert(gamedb.StdConn, coupon.ID, db.CouponEventRejected, null.StringFrom(playerID), note)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("coupon_id", coupon.ID).Msg("failed to record rejected coupon")
+	}
+	return reason
+}
+
+// playerDiscountLabel describes a discount to the player.
+func playerDiscountLabel(pd *boiler.PlayerDiscount) string {
+	store := "the store"
+	switch pd.Target {
+	case db.DiscountTargetFiat:
+		store = "the fiat store"
+	case db.DiscountTargetSups:
+		store = "the SUPS store"
+	}
+
+	switch {
+	case pd.Percentage.Valid:
+		return fmt.Sprintf("%s%% off in %s", pd.Percentage.Decimal.String(), store)
+	case pd.Target == db.DiscountTargetFiat:
+		return fmt.Sprintf("$%s off in %s", pd.Amount.Decimal.Shift(-2).StringFixed(2), store)
+	default:
+		return fmt.Sprintf("%s SUPS off in %s", pd.Amount.Decimal.Shift(-18).String(), store)
+	}
+}
+
+const HubKeyPlayerDiscountList = "PLAYER:DISCOUNT:LIST"
+
+// PlayerDiscountListHandler lists the discounts the player can still use.
+func (cc *CouponController) PlayerDiscountListHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	discounts, err := db.PlayerDiscountsAvailable(gamedb.StdConn, user.ID)
+	if err != nil {
+		return terror.Error(err, "Failed to load discounts.")
+	}
+
+	resp := []*PlayerDiscount{}
+	for _, pd := range discounts {
+		resp = append(resp, &PlayerDiscount{
+			PlayerDiscount: pd,
+			Label:          playerDiscountLabel(pd),
+		})
+	}
+
+	reply(resp)
+	return nil
+}
+
 func transferSups(userID string, amount string, api *API, code string) (string, error) {
 	txID, err := api.Passport.SpendSupMessage(xsyn_rpcclient.SpendSupsReq{
 		Amount:               amount,
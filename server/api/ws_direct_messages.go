@@ -0,0 +1,329 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"html"
+	"server"
+	"server/db"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+	"strings"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/ninja-software/terror/v2"
+	"github.com/ninja-syndicate/ws"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const DirectMessageLengthLimit = 280
+
+type DirectMessageController struct {
+	API *API
+}
+
+func NewDirectMessageController(api *API) *DirectMessageController {
+	dmc := &DirectMessageController{
+		API: api,
+	}
+
+	api.SecureUserCommand(HubKeyDirectMessageSend, dmc.DirectMessageSendHandler)
+	api.SecureUserCommand(HubKeyDirectMessageList, dmc.DirectMessageListHandler)
+	api.SecureUserCommand(HubKeyDirectMessageRead, dmc.DirectMessageReadHandler)
+	api.SecureUserCommand(HubKeyDirectMessageMute, dmc.DirectMessageMuteHandler)
+
+	return dmc
+}
+
+// DirectMessageUpdate is sent to the player's direct message subscription.
+// It contains every conversation on subscribe, and only the changed conversation afterwards.
+type DirectMessageUpdate struct {
+	TotalUnread   int                                    `json:"total_unread"`
+	Conversations []*db.DirectMessageConversationSummary `json:"conversations"`
+	NewMessage    *boiler.DirectMessage                  `json:"new_message,omitempty"`
+}
+
+func (dmc *DirectMessageController) DirectMessageSubscribeHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	conversations, err := db.DirectMessageConversationSummaries(user.ID)
+	if err != nil {
+		return err
+	}
+
+	totalUnread, err := db.DirectMessageUnreadTotal(user.ID)
+	if err != nil {
+		return err
+	}
+
+	reply(&DirectMessageUpdate{
+		TotalUnread:   totalUnread,
+		Conversations: conversations,
+	})
+
+	return nil
+}
+
+// broadcastConversationUpdate sends the latest state of the conversation to the player
+func broadcastConversationUpdate(playerID string, conversationID string, newMessage *boiler.DirectMessage) {
+	conversations, err := db.DirectMessageConversationSummaries(playerID, conversationID)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("player id", playerID).Str("conversation id", conversationID).Msg("Failed to load conversation summary.")
+		return
+	}
+
+	totalUnread, err := db.DirectMessageUnreadTotal(playerID)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("player id", playerID).Msg("Failed to count unread direct messages.")
+		return
+	}
+
+	ws.PublishMessage(fmt.Sprintf("/secure/user/%s/direct_messages", playerID), server.HubKeyDirectMessageSubscribe, &DirectMessageUpdate{
+		TotalUnread:   totalUnread,
+		Conversations: conversations,
+		NewMessage:    newMessage,
+	})
+}
+
+type DirectMessageSendRequest struct {
+	Payload struct {
+		ReceiverID string `json:"receiver_id"`
+		Message    string `json:"message"`
+	} `json:"payload"`
+}
+
+const HubKeyDirectMessageSend = "DIRECT:MESSAGE:SEND"
+
+func (dmc *DirectMessageController) DirectMessageSendHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	b1 := bucket.Add(user.ID, 1)
+
+	if b1 == 0 {
+		return terror.Warn(fmt.Errorf("too many messages"), "Too many messages.")
+	}
+
+	req := &DirectMessageSendRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	if req.Payload.ReceiverID == user.ID {
+		return terror.Error(fmt.Errorf("player cannot message themselves"), "You cannot message yourself.")
+	}
+
+	receiver, err := boiler.FindPlayer(gamedb.StdConn, req.Payload.ReceiverID)
+	if errors.Is(err, sql.ErrNoRows) || (receiver != nil && receiver.IsAi) {
+		return terror.Error(fmt.Errorf("receiver not found"), "Player not found.")
+	}
+	if err != nil {
+		return terror.Error(err, "Failed to load player.")
+	}
+
+	// direct messages follow the same chat restrictions
+	err = checkChatBan(user.ID)
+	if err != nil {
+		return err
+	}
+
+	isBlocked, err := db.PlayerBlocked(receiver.ID, user.ID)
+	if err != nil {
+		return err
+	}
+	if isBlocked {
+		return terror.Error(fmt.Errorf("player is blocked by the receiver"), "You cannot message this player.")
+	}
+
+	hasBlocked, err := db.PlayerBlocked(user.ID, receiver.ID)
+	if err != nil {
+		return err
+	}
+	if hasBlocked {
+		return terror.Error(fmt.Errorf("receiver is blocked by the player"), "Unblock the player before sending a message.")
+	}
+
+	// user's fingerprint banned (shadow ban)
+	if isFingerPrintBanned(user.ID) {
+		reply(true)
+		return nil
+	}
+
+	msg := strings.TrimSpace(html.UnescapeString(bm.Sanitize(req.Payload.Message)))
+	if msg == "" {
+		return terror.Error(terror.ErrInvalidInput, "Message should not be empty.")
+	}
+	msg = dmc.API.ProfanityManager.Detector.Censor(msg)
+	if len(msg) > DirectMessageLengthLimit {
+		msg = firstN(msg, DirectMessageLengthLimit)
+	}
+
+	conversation, err := db.DirectMessageConversationGetOrCreate(user.ID, receiver.ID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := gamedb.StdConn.Begin()
+	if err != nil {
+		return terror.Error(err, "Failed to start db transaction.")
+	}
+	defer tx.Rollback()
+
+	dm := &boiler.DirectMessage{
+		ConversationID: conversation.ID,
+		SenderID:       user.ID,
+		Message:        msg,
+	}
+	err = dm.Insert(tx, boil.Infer())
+	if err != nil {
+		return terror.Error(err, "Failed to send message.")
+	}
+
+	conversation.LastMessageAt = null.TimeFrom(dm.CreatedAt)
+	_, err = conversation.Update(tx, boil.Whitelist(boiler.DirectMessageConversationColumns.LastMessageAt))
+	if err != nil {
+		return terror.Error(err, "Failed to send message.")
+	}
+
+	// the sender has read the conversation
+	_, err = boiler.DirectMessageConversationMembers(
+		boiler.DirectMessageConversationMemberWhere.ConversationID.EQ(conversation.ID),
+		boiler.DirectMessageConversationMemberWhere.PlayerID.EQ(user.ID),
+	).UpdateAll(tx, boiler.M{boiler.DirectMessageConversationMemberColumns.LastReadAt: dm.CreatedAt})
+	if err != nil {
+		return terror.Error(err, "Failed to send message.")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return terror.Error(err, "Failed to commit db transaction.")
+	}
+
+	go broadcastConversationUpdate(user.ID, conversation.ID, dm)
+	go broadcastConversationUpdate(receiver.ID, conversation.ID, dm)
+
+	reply(dm)
+
+	return nil
+}
+
+type DirectMessageListRequest struct {
+	Payload struct {
+		ConversationID string                  `json:"conversation_id"`
+		Before         *db.DirectMessageCursor `json:"before"`
+		PageSize       int                     `json:"page_size"`
+	} `json:"payload"`
+}
+
+type DirectMessageListResponse struct {
+	Messages boiler.DirectMessageSlice `json:"messages"`
+	HasMore  bool                      `json:"has_more"`
+}
+
+const HubKeyDirectMessageList = "DIRECT:MESSAGE:LIST"
+
+func (dmc *DirectMessageController) DirectMessageListHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &DirectMessageListRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	// check the player is part of the conversation
+	_, err = db.DirectMessageConversationMember(req.Payload.ConversationID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	pageSize := req.Payload.PageSize
+	if pageSize <= 0 || pageSize > ChatHistoryPageSizeLimit {
+		pageSize = PersistChatMessageLimit
+	}
+
+	// load an extra message to find out whether there are more messages to load
+	msgs, err := db.DirectMessageList(req.Payload.ConversationID, req.Payload.Before, pageSize+1)
+	if err != nil {
+		return err
+	}
+
+	resp := &DirectMessageListResponse{}
+	if len(msgs) > pageSize {
+		resp.HasMore = true
+		msgs = msgs[:pageSize]
+	}
+
+	// oldest message first
+	for i, j := 0, len(msgs)-1; i < j; i, j = i+1, j-1 {
+		msgs[i], msgs[j] = msgs[j], msgs[i]
+	}
+	resp.Messages = msgs
+
+	reply(resp)
+
+	return nil
+}
+
+type DirectMessageConversationRequest struct {
+	Payload struct {
+		ConversationID string `json:"conversation_id"`
+		IsMuted        bool   `json:"is_muted"`
+	} `json:"payload"`
+}
+
+const HubKeyDirectMessageRead = "DIRECT:MESSAGE:READ"
+
+func (dmc *DirectMessageController) DirectMessageReadHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &DirectMessageConversationRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	member, err := db.DirectMessageConversationMember(req.Payload.ConversationID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	member.LastReadAt = time.Now()
+	_, err = member.Update(gamedb.StdConn, boil.Whitelist(boiler.DirectMessageConversationMemberColumns.LastReadAt))
+	if err != nil {
+		return terror.Error(err, "Failed to mark conversation as read.")
+	}
+
+	go broadcastConversationUpdate(user.ID, member.ConversationID, nil)
+
+	reply(true)
+
+	return nil
+}
+
+const HubKeyDirectMessageMute = "DIRECT:MESSAGE:MUTE"
+
+func (dmc *DirectMessageController) DirectMessageMuteHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &DirectMessageConversationRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	member, err := db.DirectMessageConversationMember(req.Payload.ConversationID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	member.MutedAt = null.Time{}
+	if req.Payload.IsMuted {
+		member.MutedAt = null.TimeFrom(time.Now())
+	}
+	_, err = member.Update(gamedb.StdConn, boil.Whitelist(boiler.DirectMessageConversationMemberColumns.MutedAt))
+	if err != nil {
+		return terror.Error(err, "Failed to update conversation.")
+	}
+
+	go broadcastConversationUpdate(user.ID, member.ConversationID, nil)
+
+	reply(true)
+
+	return nil
+}
@@ -220,6 +220,9 @@ func (api *API) FactionPassSupsPurchase(ctx context.Context, user *boiler.Player
 const PaymentStatusSuccess = "SUCCESS"
 const PaymentStatusFail = "FAIL"
 const PaymentStatusPending = "PENDING"
+const PaymentStatusDisputed = "DISPUTED"
+const PaymentStatusRefunded = "REFUNDED"
+const PaymentStatusChargeback = "CHARGEBACK"
 
 type FactionPassPaymentClaimRequest struct {
 	Payload struct {
@@ -277,6 +280,13 @@ func (api *API) FactionPassPaymentClaim(ctx context.Context, user *boiler.Player
 	return nil
 }
 
+type FactionPassStripePaymentIntentRequest struct {
+	Payload struct {
+		GiftRecipient string `json:"gift_recipient"` // username, gid or "username#gid" of the player to send the faction pass to
+		GiftCode      bool   `json:"gift_code"`      // buy a gift code to hand out instead of the faction pass
+	} `json:"payload"`
+}
+
 const HubKeyFactionPassStripePaymentIntent = "FACTION:PASS:STRIPE:PAYMENT:INTENT"
 
 func (api *API) FactionPassStripePaymentIntent(ctx context.Context, user *boiler.Player, factionID string, key string, payload []byte, reply ws.ReplyFunc) error {
@@ -285,6 +295,29 @@ func (api *API) FactionPassStripePaymentIntent(ctx context.Context, user *boiler
 		return fmt.Errorf("faction pass id is required")
 	}
 
+	req := &FactionPassStripePaymentIntentRequest{}
+	if len(payload) > 0 {
+		err := json.Unmarshal(payload, req)
+		if err != nil {
+			return terror.Error(err, "Invalid request received.")
+		}
+	}
+
+	giftRecipientID := ""
+	if req.Payload.GiftRecipient != "" {
+		if req.Payload.GiftCode {
+			return terror.Error(fmt.Errorf("gift code with gift recipient"), "A gift code cannot be sent to a player.")
+		}
+		recipient, err := db.FiatGiftRecipient(gamedb.StdConn, req.Payload.GiftRecipient)
+		if err != nil {
+			return err
+		}
+		if recipient.ID == user.ID {
+			return terror.Error(fmt.Errorf("gift recipient is the buyer"), "You cannot send a gift to yourself.")
+		}
+		giftRecipientID = recipient.ID
+	}
+
 	l := gamelog.L.With().Str("func", "FactionPassStripePaymentIntent").Str("faction pass id", factionPassID).Logger()
 
 	// load faction pass
@@ -306,6 +339,12 @@ func (api *API) FactionPassStripePaymentIntent(ctx context.Context, user *boiler
 	params.AddMetadata("sale_type", "faction pass")
 	params.AddMetadata("faction_pass_id", fp.ID)
 	params.AddMetadata("player_id", user.ID)
+	if giftRecipientID != "" {
+		params.AddMetadata("gift_recipient_id", giftRecipientID)
+	}
+	if req.Payload.GiftCode {
+		params.AddMetadata("gift_code", "true")
+	}
 
 	pi, err := paymentintent.New(params)
 	if err != nil {
@@ -15,6 +15,7 @@ import (
 	"github.com/friendsofgo/errors"
 	"github.com/ninja-software/terror/v2"
 	"github.com/ninja-syndicate/ws"
+	"github.com/shopspring/decimal"
 	"github.com/stripe/stripe-go/v72"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
@@ -204,12 +205,16 @@ func (f *FiatController) BillingHistoryGetHandler(ctx context.Context, user *boi
 
 type FiatCheckoutSetupRequest struct {
 	Payload struct {
-		ReceiptEmail string `json:"receipt_email"`
+		ReceiptEmail     string `json:"receipt_email"`
+		PlayerDiscountID string `json:"player_discount_id"` // optional discount redeemed from a coupon code
 	} `json:"payload"`
 }
 
 const HubKeyFiatCheckoutSetup = "FIAT:CHECKOUT:SETUP"
 
+// stripeMinimumChargeUSD is the smallest amount in cents stripe can charge, discounts don't take the total below it
+const stripeMinimumChargeUSD = 50
+
 func (f *FiatController) CheckoutSetupHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
 	errMsg := "Something went wrong with beginning checkout process."
 	req := &FiatCheckoutSetupRequest{}
@@ -225,6 +230,19 @@ func (f *FiatController) CheckoutSetupHandler(ctx context.Context, user *boiler.
 	}
 	shoppingCart := server.ShoppingCartFromBoiler(cart, cartItems)
 
+	// Check discount
+	var discount *boiler.PlayerDiscount
+	if req.Payload.PlayerDiscountID != "" {
+		err = f.releaseUnpaidDiscount(user.ID, req.Payload.PlayerDiscountID)
+		if err != nil {
+			return err
+		}
+		discount, err = db.PlayerDiscountAvailable(gamedb.StdConn, req.Payload.PlayerDiscountID, user.ID, db.DiscountTargetFiat)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Setup Stripe Customer
 	player, err := boiler.FindPlayer(gamedb.StdConn, user.ID)
 	if err != nil {
@@ -249,10 +267,14 @@ func (f *FiatController) CheckoutSetupHandler(ctx context.Context, user *boiler.
 	}
 
 	// Prepare invoice
+	subtotal := decimal.Zero
 	for _, item := range shoppingCart.Items {
 		if item.Product == nil {
 			return terror.Error(fmt.Errorf("cart item is missing data"), errMsg)
 		}
+		if !fiatProductDeliverable(item.Product.ProductType) {
+			return terror.Error(fmt.Errorf("unsupported product type: %s", item.Product.ProductType), fmt.Sprintf("%s is not available for purchase.", item.Product.Name))
+		}
 
 		// TODO: Handle different currencies?
 		var priceUSD *server.FiatProductPricing
@@ -274,10 +296,37 @@ func (f *FiatController) CheckoutSetupHandler(ctx context.Context, user *boiler.
 			Description: stripe.String(item.Product.Name),
 		}
 		invoiceItemParams.AddMetadata("fiat_product_id", item.Product.ID)
+		if item.GiftRecipientID.Valid {
+			invoiceItemParams.AddMetadata("gift_recipient_id", item.GiftRecipientID.String)
+		}
+		if item.GiftCode {
+			invoiceItemParams.AddMetadata("gift_code", "true")
+		}
 		_, err = f.API.StripeClient.InvoiceItems.New(invoiceItemParams)
 		if err != nil {
 			return terror.Error(err, errMsg)
 		}
+		subtotal = subtotal.Add(decimal.NewFromInt(priceUSD.Amount.IntPart() * int64(item.Quantity)))
+	}
+
+	// The discount is reserved by the invoice, so it can't be put on a second invoice before this one is paid
+	reserveDiscount := false
+	if discount != nil {
+		discountValue := db.PlayerDiscountValue(discount, subtotal.Sub(decimal.NewFromInt(stripeMinimumChargeUSD)))
+		if discountValue.IsPositive() {
+			reserveDiscount = true
+			invoiceItemParams := &stripe.InvoiceItemParams{
+				Customer:    stripe.String(player.StripeCustomerID.String),
+				Currency:    stripe.String(server.FiatCurrencyCodeUSD),
+				Amount:      stripe.Int64(-discountValue.IntPart()),
+				Description: stripe.String(playerDiscountLabel(discount)),
+			}
+			invoiceItemParams.AddMetadata("player_discount_id", discount.ID)
+			_, err = f.API.StripeClient.InvoiceItems.New(invoiceItemParams)
+			if err != nil {
+				return terror.Error(err, errMsg)
+			}
+		}
 	}
 
 	invoiceParams := &stripe.InvoiceParams{
@@ -288,11 +337,32 @@ func (f *FiatController) CheckoutSetupHandler(ctx context.Context, user *boiler.
 		return terror.Error(err, errMsg)
 	}
 
+	if reserveDiscount {
+		reserved, err := db.PlayerDiscountUse(gamedb.StdConn, discount.ID, invoice.ID)
+		if err == nil && !reserved {
+			err = terror.Error(fmt.Errorf("discount already used"), "This discount has been used or has expired.")
+		}
+		if err != nil {
+			_, delErr := f.API.StripeClient.Invoices.Del(invoice.ID, nil)
+			if delErr != nil {
+				gamelog.L.Error().Err(delErr).Str("invoice_id", invoice.ID).Msg("failed to delete draft invoice")
+			}
+			return err
+		}
+	}
+
 	// Setup Payment Intent
-	invoice, err = f.API.StripeClient.Invoices.FinalizeInvoice(invoice.ID, nil)
+	finalizedInvoice, err := f.API.StripeClient.Invoices.FinalizeInvoice(invoice.ID, nil)
 	if err != nil {
+		if reserveDiscount {
+			err := db.PlayerDiscountRelease(gamedb.StdConn, invoice.ID)
+			if err != nil {
+				gamelog.L.Error().Err(err).Str("invoice_id", invoice.ID).Msg("failed to release discount")
+			}
+		}
 		return terror.Error(err, errMsg)
 	}
+	invoice = finalizedInvoice
 
 	paymentIntent, err := f.API.StripeClient.PaymentIntents.Get(invoice.PaymentIntent.ID, nil)
 	if err != nil {
@@ -304,10 +374,41 @@ func (f *FiatController) CheckoutSetupHandler(ctx context.Context, user *boiler.
 	return nil
 }
 
+// releaseUnpaidDiscount voids the unpaid invoice of an earlier checkout holding the discount, so the player can check out again.
+func (f *FiatController) releaseUnpaidDiscount(playerID string, playerDiscountID string) error {
+	discount, err := db.PlayerDiscountReserved(gamedb.StdConn, playerDiscountID, playerID, db.DiscountTargetFiat)
+	if err != nil || discount == nil {
+		return err
+	}
+
+	invoiceID := discount.UsedReference.String
+	invoice, err := f.API.StripeClient.Invoices.Get(invoiceID, nil)
+	if err != nil {
+		return terror.Error(err, "Failed to load discount.")
+	}
+	switch invoice.Status {
+	case stripe.InvoiceStatusDraft:
+		_, err = f.API.StripeClient.Invoices.Del(invoiceID, nil)
+	case stripe.InvoiceStatusOpen:
+		_, err = f.API.StripeClient.Invoices.VoidInvoice(invoiceID, nil)
+	case stripe.InvoiceStatusVoid:
+	default:
+		// paid invoices keep the discount
+		return nil
+	}
+	if err != nil {
+		return terror.Error(err, "Failed to release discount from previous checkout.")
+	}
+
+	return db.PlayerDiscountRelease(gamedb.StdConn, invoiceID)
+}
+
 type ShoppingCartAddItemRequest struct {
 	Payload struct {
-		ProductID string `json:"product_id"`
-		Quantity  int    `json:"quantity"`
+		ProductID     string `json:"product_id"`
+		Quantity      int    `json:"quantity"`
+		GiftRecipient string `json:"gift_recipient"` // username, gid or "username#gid" of the player to send the gift to
+		GiftCode      bool   `json:"gift_code"`      // buy a gift code to hand out instead of the product
 	} `json:"payload"`
 }
 
@@ -341,6 +442,25 @@ func (f *FiatController) ShoppingCartAddItemHandler(ctx context.Context, user *b
 		return terror.Error(fmt.Errorf("product does not belong to player's faction"), "Invalid Product ID received.")
 	}
 
+	// Check gift recipient
+	giftRecipientID := null.String{}
+	if req.Payload.GiftRecipient != "" {
+		if req.Payload.GiftCode {
+			return terror.Error(fmt.Errorf("gift code with gift recipient"), "A gift code cannot be sent to a player.")
+		}
+		recipient, err := db.FiatGiftRecipient(gamedb.StdConn, req.Payload.GiftRecipient)
+		if err != nil {
+			return err
+		}
+		if recipient.ID == user.ID {
+			return terror.Error(fmt.Errorf("gift recipient is the buyer"), "You cannot send a gift to yourself.")
+		}
+		if recipient.FactionID.String != product.FactionID {
+			return terror.Error(fmt.Errorf("gift recipient is not in the product's faction"), "Gifts can only be sent to players in your faction.")
+		}
+		giftRecipientID = null.StringFrom(recipient.ID)
+	}
+
 	// Add to cart
 	isNewCart := false
 	cartItemID := ""
@@ -359,7 +479,7 @@ func (f *FiatController) ShoppingCartAddItemHandler(ctx context.Context, user *b
 	}
 	for _, item := range cartItems {
 		// TODO: Handle cart item attributes as unique eg. Shirt Sizes :/
-		if item.ProductID == req.Payload.ProductID {
+		if item.ProductID == req.Payload.ProductID && item.GiftRecipientID == giftRecipientID && item.GiftCode == req.Payload.GiftCode {
 			cartItemID = item.ID
 			break
 		}
@@ -371,7 +491,7 @@ func (f *FiatController) ShoppingCartAddItemHandler(ctx context.Context, user *b
 			return terror.Error(err, errMsg)
 		}
 	} else {
-		err = db.ShoppingCartItemAdd(gamedb.StdConn, cart.ID, product.ID, req.Payload.Quantity)
+		err = db.ShoppingCartItemAdd(gamedb.StdConn, cart.ID, product.ID, req.Payload.Quantity, giftRecipientID, req.Payload.GiftCode)
 		if err != nil {
 			return terror.Error(err, errMsg)
 		}
@@ -23,6 +23,8 @@ func NewLeaderboardController(api *API) {
 	api.Command(HubKeyPlayerAbilityTriggersLeaderboard, api.GetPlayerAbilityTriggersLeaderboardHandler)
 	api.Command(HubKeyPlayerMechsOwnedLeaderboard, api.GetPlayerMechsOwnedLeaderboardHandler)
 	api.Command(HubKeyPlayerRepairBlockLeaderboard, api.GetPlayerRepairBlockLeaderboardHandler)
+	api.Command(HubKeyMechRatingLeaderboard, api.GetMechRatingLeaderboardHandler)
+	api.Command(HubKeyPlayerRatingLeaderboard, api.GetPlayerRatingLeaderboardHandler)
 }
 
 const HubKeyLeaderboardRounds = "LEADERBOARD:ROUNDS"
@@ -200,3 +202,50 @@ func (api *API) GetPlayerMechsOwnedLeaderboardHandler(ctx context.Context, key s
 	reply(resp)
 	return nil
 }
+
+/**
+* Get the highest rated mechs and pilots
+ */
+type RatingLeaderboardRequest struct {
+	Payload struct {
+		FactionID null.String `json:"faction_id"`
+	} `json:"payload"`
+}
+
+const HubKeyMechRatingLeaderboard = "LEADERBOARD:MECH:RATING"
+
+func (api *API) GetMechRatingLeaderboardHandler(ctx context.Context, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &RatingLeaderboardRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received")
+	}
+
+	resp, err := db.TopRatedMechs(req.Payload.FactionID)
+	if err != nil {
+		gamelog.L.Error().Err(err).Msg("Failed to load mech rating leaderboard")
+		return err
+	}
+
+	reply(resp)
+	return nil
+}
+
+const HubKeyPlayerRatingLeaderboard = "LEADERBOARD:PLAYER:RATING"
+
+func (api *API) GetPlayerRatingLeaderboardHandler(ctx context.Context, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &RatingLeaderboardRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received")
+	}
+
+	resp, err := db.TopRatedPilots(req.Payload.FactionID)
+	if err != nil {
+		gamelog.L.Error().Err(err).Msg("Failed to load pilot rating leaderboard")
+		return err
+	}
+
+	reply(resp)
+	return nil
+}
@@ -47,11 +47,27 @@ func NewMarketplaceController(api *API) *MarketplaceController {
 	api.SecureUserFactionCommand(HubKeyMarketplaceSalesKeycardGet, marketplaceHub.SalesKeycardGetHandler)
 	api.SecureUserFactionCommand(HubKeyMarketplaceSalesCreate, WithMarketLockCheck(marketplaceHub.SalesCreateHandler))
 	api.SecureUserFactionCommand(HubKeyMarketplaceSalesKeycardCreate, WithMarketLockCheck(marketplaceHub.SalesKeycardCreateHandler))
+	api.SecureUserFactionCommand(HubKeyMarketplaceSalesBundleCreate, WithMarketLockCheck(marketplaceHub.SalesBundleCreateHandler))
 	api.SecureUserFactionCommand(HubKeyMarketplaceSalesArchive, marketplaceHub.SalesArchiveHandler)
 	api.SecureUserFactionCommand(HubKeyMarketplaceSalesKeycardArchive, marketplaceHub.SalesKeycardArchiveHandler)
 	api.SecureUserFactionCommand(HubKeyMarketplaceSalesBuy, WithMarketLockCheck(marketplaceHub.SalesBuyHandler))
 	api.SecureUserFactionCommand(HubKeyMarketplaceSalesKeycardBuy, WithMarketLockCheck(marketplaceHub.SalesKeycardBuyHandler))
 	api.SecureUserFactionCommand(HubKeyMarketplaceSalesBid, WithMarketLockCheck(marketplaceHub.SalesBidHandler))
+	api.SecureUserFactionCommand(HubKeyMarketplaceOfferList, marketplaceHub.OfferListHandler)
+	api.SecureUserFactionCommand(HubKeyMarketplaceOfferCreate, WithMarketLockCheck(marketplaceHub.OfferCreateHandler))
+	api.SecureUserFactionCommand(HubKeyMarketplaceOfferAccept, WithMarketLockCheck(marketplaceHub.OfferAcceptHandler))
+	api.SecureUserFactionCommand(HubKeyMarketplaceOfferReject, marketplaceHub.OfferRejectHandler)
+	api.SecureUserFactionCommand(HubKeyMarketplaceOfferCancel, marketplaceHub.OfferCancelHandler)
+	api.SecureUserFactionCommand(HubKeyMarketplaceOfferCounter, WithMarketLockCheck(marketplaceHub.OfferCounterHandler))
+	api.SecureUserFactionCommand(HubKeyMarketplacePriceHistory, marketplaceHub.PriceHistoryHandler)
+	api.SecureUserFactionCommand(HubKeyMarketplacePriceSuggest, marketplaceHub.PriceSuggestHandler)
+	api.SecureUserFactionCommand(HubKeyMarketplacePortfolioValuation, marketplaceHub.PortfolioValuationHandler)
+	api.SecureUserFactionCommand(HubKeyMarketplaceWatchlist, marketplaceHub.WatchlistHandler)
+	api.SecureUserFactionCommand(HubKeyMarketplaceWatchlistAdd, marketplaceHub.WatchlistAddHandler)
+	api.SecureUserFactionCommand(HubKeyMarketplaceWatchlistRemove, marketplaceHub.WatchlistRemoveHandler)
+	api.SecureUserFactionCommand(HubKeyMarketplaceSavedSearchList, marketplaceHub.SavedSearchListHandler)
+	api.SecureUserFactionCommand(HubKeyMarketplaceSavedSearchCreate, marketplaceHub.SavedSearchCreateHandler)
+	api.SecureUserFactionCommand(HubKeyMarketplaceSavedSearchDelete, marketplaceHub.SavedSearchDeleteHandler)
 
 	return marketplaceHub
 }
@@ -366,6 +382,43 @@ type MarketplaceSalesCreateRequest struct {
 	} `json:"payload"`
 }
 
+// salesListingType works out which sale types a listing has from its price input.
+func salesListingType(
+	askingPrice decimal.NullDecimal,
+	auctionCurrentPrice decimal.NullDecimal,
+	auctionReservedPrice decimal.NullDecimal,
+	dutchAuctionDropRate decimal.NullDecimal,
+) (hasBuyout bool, hasAuction bool, hasDutchAuction bool, err error) {
+	if askingPrice.Valid {
+		if askingPrice.Decimal.LessThanOrEqual(decimal.Zero) {
+			return false, false, false, terror.Error(fmt.Errorf("invalid asking price"), "Invalid asking price received.")
+		}
+		hasBuyout = true
+	}
+	if dutchAuctionDropRate.Valid {
+		if dutchAuctionDropRate.Decimal.LessThanOrEqual(decimal.Zero) {
+			return false, false, false, terror.Error(fmt.Errorf("invalid drop rate"), "Invalid drop rate received.")
+		}
+		hasDutchAuction = true
+		hasBuyout = false
+	}
+	if auctionCurrentPrice.Valid || (auctionReservedPrice.Valid && !dutchAuctionDropRate.Valid) {
+		if auctionCurrentPrice.Valid && auctionCurrentPrice.Decimal.LessThan(decimal.Zero) {
+			return false, false, false, terror.Error(fmt.Errorf("invalid auction current price"), "Invalid auction current price received.")
+		}
+		if auctionReservedPrice.Valid && auctionReservedPrice.Decimal.LessThan(decimal.Zero) {
+			return false, false, false, terror.Error(fmt.Errorf("invalid auction reserved price"), "Invalid auction reserved price received.")
+		}
+		hasAuction = true
+	}
+
+	if !hasBuyout && !hasAuction && !hasDutchAuction {
+		return false, false, false, terror.Error(fmt.Errorf("invalid sales input received"), "Unable to determine listing sale type from given input.")
+	}
+
+	return hasBuyout, hasAuction, hasDutchAuction, nil
+}
+
 func (mp *MarketplaceController) SalesCreateHandler(ctx context.Context, user *boiler.Player, fID string, key string, payload []byte, reply ws.ReplyFunc) error {
 	errMsg := "Issue processing create sale item, try again or contact support."
 	req := &MarketplaceSalesCreateRequest{}
@@ -390,35 +443,14 @@ func (mp *MarketplaceController) SalesCreateHandler(ctx context.Context, user *b
 	}
 
 	// Check price input
-	hasBuyout := false
-	hasAuction := false
-	hasDutchAuction := false
-
-	if req.Payload.AskingPrice.Valid {
-		if req.Payload.AskingPrice.Decimal.LessThanOrEqual(decimal.Zero) {
-			return terror.Error(fmt.Errorf("invalid asking price"), "Invalid asking price received.")
-		}
-		hasBuyout = true
-	}
-	if req.Payload.DutchAuctionDropRate.Valid {
-		if req.Payload.DutchAuctionDropRate.Decimal.LessThanOrEqual(decimal.Zero) {
-			return terror.Error(fmt.Errorf("invalid drop rate"), "Invalid drop rate received.")
-		}
-		hasDutchAuction = true
-		hasBuyout = false
-	}
-	if req.Payload.AuctionCurrentPrice.Valid || (req.Payload.AuctionReservedPrice.Valid && !req.Payload.DutchAuctionDropRate.Valid) {
-		if req.Payload.AuctionCurrentPrice.Valid && req.Payload.AuctionCurrentPrice.Decimal.LessThan(decimal.Zero) {
-			return terror.Error(fmt.Errorf("invalid auction current price"), "Invalid auction current price received.")
-		}
-		if req.Payload.AuctionReservedPrice.Valid && req.Payload.AuctionReservedPrice.Decimal.LessThan(decimal.Zero) {
-			return terror.Error(fmt.Errorf("invalid auction reserved price"), "Invalid auction reserved price received.")
-		}
-		hasAuction = true
-	}
-
-	if !hasBuyout && !hasAuction && !hasDutchAuction {
-		return terror.Error(fmt.Errorf("invalid sales input received"), "Unable to determine listing sale type from given input.")
+	hasBuyout, hasAuction, hasDutchAuction, err := salesListingType(
+		req.Payload.AskingPrice,
+		req.Payload.AuctionCurrentPrice,
+		req.Payload.AuctionReservedPrice,
+		req.Payload.DutchAuctionDropRate,
+	)
+	if err != nil {
+		return err
 	}
 
 	// Check if allowed to sell item
@@ -455,6 +487,10 @@ func (mp *MarketplaceController) SalesCreateHandler(ctx context.Context, user *b
 		return terror.Error(fmt.Errorf("asset does not live on supremacy"))
 	}
 
+	if collectionItem.ClawbackLocked {
+		return terror.Error(fmt.Errorf("asset is clawback locked"), "Item is locked while its payment is under review.")
+	}
+
 	ciUUID := uuid.FromStringOrNil(collectionItem.ID)
 
 	if ciUUID.IsNil() {
@@ -680,8 +716,14 @@ func (mp *MarketplaceController) SalesCreateHandler(ctx context.Context, user *b
 		return terror.Error(err, errMsg)
 	}
 
+	if obj.Auction {
+		mp.API.MarketplaceController.ScheduleAuctionClose(obj.ID, obj.EndAt)
+	}
+
 	reply(obj)
 
+	go mp.API.MarketplaceController.AlertNewListing(obj.ID)
+
 	// Log Event
 	err = db.MarketplaceAddEvent(boiler.MarketplaceEventCreated, user.ID, decimal.NullDecimal{}, obj.ID, boiler.TableNames.ItemSales)
 	if err != nil {
@@ -989,6 +1031,7 @@ func (mp *MarketplaceController) SalesArchiveHandler(ctx context.Context, user *
 				boiler.ItemSalesBidHistoryColumns.BidderID,
 				boiler.ItemSalesBidHistoryColumns.BidTXID,
 				boiler.ItemSalesBidHistoryColumns.BidPrice,
+				boiler.ItemSalesBidHistoryColumns.MaxBidPrice,
 			),
 			boiler.ItemSalesBidHistoryWhere.ItemSaleID.EQ(saleItem.ID),
 			boiler.ItemSalesBidHistoryWhere.CancelledAt.IsNull(),
@@ -1007,13 +1050,18 @@ func (mp *MarketplaceController) SalesArchiveHandler(ctx context.Context, user *
 				l.Error().Err(err).Str("bidTID", lastBid.BidTXID).Msg("unable to get find faction account")
 				return terror.Error(err, errMsg)
 			}
+			// Proxy bids hold their max bid amount
+			heldAmount := lastBid.BidPrice
+			if lastBid.MaxBidPrice.Valid {
+				heldAmount = lastBid.MaxBidPrice.Decimal
+			}
 			factID := uuid.Must(uuid.FromString(factionAccountID))
 			syndicateBalance := mp.API.Passport.UserBalanceGet(factID)
-			if syndicateBalance.LessThanOrEqual(lastBid.BidPrice) {
+			if syndicateBalance.LessThanOrEqual(heldAmount) {
 				txid, err := mp.API.Passport.SpendSupMessage(xsyn_rpcclient.SpendSupsReq{
 					FromUserID:           uuid.UUID(server.XsynTreasuryUserID),
 					ToUserID:             factID,
-					Amount:               lastBid.BidPrice.StringFixed(0),
+					Amount:               heldAmount.StringFixed(0),
 					TransactionReference: server.TransactionReference(fmt.Sprintf("bid_refunds|%s|%d", lastBid.BidderID, time.Now().UnixNano())),
 					Group:                string(server.TransactionGroupSupremacy),
 					SubGroup:             string(server.TransactionGroupMarketplace),
@@ -1022,14 +1070,14 @@ func (mp *MarketplaceController) SalesArchiveHandler(ctx context.Context, user *
 				if err != nil {
 					l.Error().
 						Str("Faction ID", factionAccountID).
-						Str("Amount", lastBid.BidPrice.StringFixed(0)).
+						Str("Amount", heldAmount.StringFixed(0)).
 						Err(err).
 						Msg("Could not transfer money from treasury into syndicate account!!")
 					return terror.Error(err, errMsg)
 				}
 				l.Warn().
 					Str("Faction ID", factionAccountID).
-					Str("Amount", lastBid.BidPrice.StringFixed(0)).
+					Str("Amount", heldAmount.StringFixed(0)).
 					Str("TXID", txid).
 					Err(err).
 					Msg("Had to transfer funds to the syndicate account")
@@ -1043,7 +1091,7 @@ func (mp *MarketplaceController) SalesArchiveHandler(ctx context.Context, user *
 			if err != nil {
 				return terror.Error(err, errMsg)
 			}
-			err = db.MarketplaceAddEvent(boiler.MarketplaceEventBidRefund, lastBid.BidderID, decimal.NewNullDecimal(lastBid.BidPrice), saleItem.ID, boiler.TableNames.ItemSales)
+			err = db.MarketplaceAddEvent(boiler.MarketplaceEventBidRefund, lastBid.BidderID, decimal.NewNullDecimal(heldAmount), saleItem.ID, boiler.TableNames.ItemSales)
 			if err != nil {
 				l.Error().
 					Str("txid", lastBid.BidTXID).
@@ -1063,6 +1111,14 @@ func (mp *MarketplaceController) SalesArchiveHandler(ctx context.Context, user *
 	if err != nil {
 		return terror.Error(err, errMsg)
 	}
+	mp.API.MarketplaceController.CancelAuctionClose(saleItem.ID)
+	if saleItem.Bundle {
+		// failed releases are retried by the marketplace controller
+		err = marketplace.ReleaseBundleItems(mp.API.Passport, saleItem.ID)
+		if err != nil {
+			l.Error().Err(err).Msg("Failed to release bundled items.")
+		}
+	}
 
 	reply(true)
 
@@ -1237,6 +1293,16 @@ func (mp *MarketplaceController) SalesBuyHandler(ctx context.Context, user *boil
 		l.Error().Err(err).Msg("item already sold")
 		return terror.Error(err, "Item is no longer for sale.")
 	}
+	clawbackLocked, err := db.MarketplaceSaleClawbackLocked(gamedb.StdConn, saleItem.ID)
+	if err != nil {
+		l.Error().Err(err).Msg("unable to check clawback lock")
+		return terror.Error(err, errMsg)
+	}
+	if clawbackLocked {
+		err = fmt.Errorf("item is clawback locked")
+		l.Warn().Err(err).Msg("item is clawback locked")
+		return terror.Error(err, "Item is no longer for sale.")
+	}
 	userID, err := uuid.FromString(user.ID)
 	if err != nil {
 		l.Error().Err(err).Msg("unable to retrieve buyer's user id")
@@ -1388,6 +1454,16 @@ func (mp *MarketplaceController) SalesBuyHandler(ctx context.Context, user *boil
 		return terror.Error(err, errMsg)
 	}
 
+	// Transfer the other items of a bundle
+	bundleTransferRollback, err := marketplace.TransferBundleItems(tx, mp.API.Passport, saleItem.ID, saleItem.OwnerID, user.ID, txid)
+	if err != nil {
+		mp.API.Passport.RefundSupsMessage(feeTXID)
+		mp.API.Passport.RefundSupsMessage(txid)
+		rpcAssetTransferRollback()
+		l.Error().Err(err).Msg("Failed to transfer bundled items to new owner")
+		return terror.Error(err, errMsg)
+	}
+
 	// Unlock Listed Item
 	collectionItem := boiler.CollectionItem{
 		ID:                  saleItem.CollectionItemID,
@@ -1401,6 +1477,7 @@ func (mp *MarketplaceController) SalesBuyHandler(ctx context.Context, user *boil
 		mp.API.Passport.RefundSupsMessage(feeTXID)
 		mp.API.Passport.RefundSupsMessage(txid)
 		rpcAssetTransferRollback()
+		bundleTransferRollback()
 		err = fmt.Errorf("failed to complete payment transaction")
 		l.Error().Err(err).Msg("Failed to unlock marketplace listed collection item.")
 		return terror.Error(err, errMsg)
@@ -1412,9 +1489,11 @@ func (mp *MarketplaceController) SalesBuyHandler(ctx context.Context, user *boil
 		mp.API.Passport.RefundSupsMessage(feeTXID)
 		mp.API.Passport.RefundSupsMessage(txid)
 		rpcAssetTransferRollback()
+		bundleTransferRollback()
 		l.Error().Err(err).Msg("Failed to commit purchase sale item db transaction.")
 		return terror.Error(err, errMsg)
 	}
+	mp.API.MarketplaceController.CancelAuctionClose(saleItem.ID)
 
 	// Log event
 	err = db.MarketplaceAddEvent(boiler.MarketplaceEventPurchase, user.ID, decimal.NewNullDecimal(saleItemCost), saleItem.ID, boiler.TableNames.ItemSales)
@@ -1425,6 +1504,7 @@ func (mp *MarketplaceController) SalesBuyHandler(ctx context.Context, user *boil
 	if err != nil {
 		l.Error().Err(err).Msg("failed to log sold event")
 	}
+	mp.API.MarketplaceController.PublishSaleQuestEvent(saleItem.OwnerID, saleItem.CollectionItemType)
 
 	// Refund bids
 	bids, err := db.MarketplaceSaleCancelBids(gamedb.StdConn, uuid.Must(uuid.FromString(saleItem.ID)), "Item bought out")
@@ -1716,6 +1796,7 @@ func (mp *MarketplaceController) SalesKeycardBuyHandler(ctx context.Context, use
 	if err != nil {
 		l.Error().Err(err).Msg("failed to log sold event")
 	}
+	mp.API.MarketplaceController.PublishSaleQuestEvent(saleItem.OwnerID, db.QuestObjectiveItemTypeKeycard)
 
 	// Success
 	reply(true)
@@ -1729,6 +1810,8 @@ type MarketplaceSalesBidRequest struct {
 	Payload struct {
 		ID     uuid.UUID       `json:"id"`
 		Amount decimal.Decimal `json:"amount"`
+		// MaxAmount makes the bid a proxy bid, which is raised automatically when outbid up to this amount
+		MaxAmount decimal.NullDecimal `json:"max_amount"`
 	} `json:"payload"`
 }
 
@@ -1792,12 +1875,22 @@ func (mp *MarketplaceController) SalesBidHandler(ctx context.Context, user *boil
 		l.Error().Err(err).Bool("xsynLocked", saleItem.CollectionItem.XsynLocked).Bool("marketLocked", saleItem.CollectionItem.MarketLocked).Msg("item is locked")
 		return terror.Error(err, "Item is no longer for sale.")
 	}
-	bidAmount := req.Payload.Amount.Mul(decimal.New(1, 18))
-	if bidAmount.LessThanOrEqual(saleItem.AuctionCurrentPrice.Decimal) {
-		err = fmt.Errorf("bid amount less than current bid amount")
-		l.Warn().Err(err).Str("bidAmount", bidAmount.String()).Msg("bid amount less than current bid amount")
-		return terror.Error(err, "Invalid bid amount, must be above the current bid price.")
+	clawbackLocked, err := db.MarketplaceSaleClawbackLocked(gamedb.StdConn, saleItem.ID)
+	if err != nil {
+		l.Error().Err(err).Msg("unable to check clawback lock")
+		return terror.Error(err, errMsg)
+	}
+	if clawbackLocked {
+		err = fmt.Errorf("item is clawback locked")
+		l.Warn().Err(err).Msg("item is clawback locked")
+		return terror.Error(err, "Item is no longer for sale.")
 	}
+	if !saleItem.EndAt.After(time.Now()) {
+		err = fmt.Errorf("auction has ended")
+		l.Warn().Err(err).Msg("auction has ended")
+		return terror.Error(err, "Auction has ended.")
+	}
+	bidAmount := req.Payload.Amount.Mul(decimal.New(1, 18))
 
 	// Check if bid amount is greater than Dutch Auction drop rate
 	if saleItem.DutchAuction {
@@ -1821,10 +1914,69 @@ func (mp *MarketplaceController) SalesBidHandler(ctx context.Context, user *boil
 			return terror.Error(fmt.Errorf("bid amount is less than dutch auction dropped price"), "Bid Amount is cheaper than Dutch Auction Dropped Price, buy the item instead.")
 		}
 	}
+
+	// Work out proxy bidding
+	maxBidAmount := decimal.NullDecimal{}
+	if req.Payload.MaxAmount.Valid {
+		maxBidAmount = decimal.NewNullDecimal(req.Payload.MaxAmount.Decimal.Mul(decimal.New(1, 18)))
+		if maxBidAmount.Decimal.LessThan(bidAmount) {
+			return terror.Error(fmt.Errorf("max bid amount less than bid amount"), "Invalid max bid amount, must be at least the bid amount.")
+		}
+	}
+	bidTopAmount := bidAmount
+	if maxBidAmount.Valid {
+		bidTopAmount = maxBidAmount.Decimal
+	}
+	bidIncrement := db.MarketplaceAuctionBidIncrement()
+
+	// Stop the auction closing while the bid changes its price and end
+	mp.API.MarketplaceController.LockAuctions()
+	defer mp.API.MarketplaceController.UnlockAuctions()
+
+	auction, err := boiler.FindItemSale(gamedb.StdConn, saleItem.ID)
+	if err != nil {
+		l.Error().Err(err).Msg("unable to retrieve sale item")
+		return terror.Error(err, errMsg)
+	}
+	if auction.SoldAt.Valid || auction.DeletedAt.Valid || !auction.EndAt.After(time.Now()) {
+		err = fmt.Errorf("auction has ended")
+		l.Warn().Err(err).Msg("auction has ended")
+		return terror.Error(err, "Auction has ended.")
+	}
+
+	leadingBid, err := db.MarketplaceSaleActiveBid(gamedb.StdConn, saleItem.ID)
+	if err != nil {
+		l.Error().Err(err).Msg("unable to get leading bid")
+		return terror.Error(err, errMsg)
+	}
+
+	// Check the bid against the current price of the reloaded auction, another bid may have been placed since it was loaded
+	if bidAmount.LessThanOrEqual(auction.AuctionCurrentPrice.Decimal) || (leadingBid != nil && bidAmount.LessThanOrEqual(leadingBid.BidPrice)) {
+		err = fmt.Errorf("bid amount less than current bid amount")
+		l.Warn().Err(err).Str("bidAmount", bidAmount.String()).Msg("bid amount less than current bid amount")
+		return terror.Error(err, "Invalid bid amount, must be above the current bid price.")
+	}
+
+	leadingMaxBid := decimal.NullDecimal{}
+	if leadingBid != nil && leadingBid.BidderID != user.ID {
+		leadingMaxBid = leadingBid.MaxBidPrice
+	}
+	auctionBid := server.AuctionBidResolve(bidAmount, maxBidAmount, leadingMaxBid, saleItem.AuctionReservedPrice, bidIncrement)
+	if auctionBid.LeadingBidWins {
+		err = mp.proxyBidRaise(saleItem, leadingBid, auctionBid.LeadingBidPrice)
+		if err != nil {
+			l.Error().Err(err).Msg("unable to raise leading proxy bid")
+			return terror.Error(err, errMsg)
+		}
+		return terror.Error(fmt.Errorf("outbid by proxy bid"), "You have been outbid by another player's maximum bid.")
+	}
+	bidAmount = auctionBid.Price
+
+	// Proxy bids hold their max bid amount
 	txid, err := mp.API.Passport.SpendSupMessage(xsyn_rpcclient.SpendSupsReq{
 		FromUserID:           userID,
 		ToUserID:             uuid.Must(uuid.FromString(factionAccountID)),
-		Amount:               bidAmount.String(),
+		Amount:               bidTopAmount.String(),
 		TransactionReference: server.TransactionReference(fmt.Sprintf("marketplace_buy_item:auction_bid|%s|%d", saleItem.ID, time.Now().UnixNano())),
 		Group:                string(server.TransactionGroupSupremacy),
 		SubGroup:             string(server.TransactionGroupMarketplace),
@@ -1853,7 +2005,7 @@ func (mp *MarketplaceController) SalesBidHandler(ctx context.Context, user *boil
 	}
 
 	// Place Bid
-	_, err = db.MarketplaceSaleBidHistoryCreate(tx, req.Payload.ID, userID, req.Payload.Amount, txid)
+	_, err = db.MarketplaceSaleBidHistoryCreate(tx, req.Payload.ID, userID, bidAmount, maxBidAmount, txid)
 	if err != nil {
 		mp.API.Passport.RefundSupsMessage(txid)
 		l.Error().Err(err).Msg("unable to place bid")
@@ -1867,6 +2019,14 @@ func (mp *MarketplaceController) SalesBidHandler(ctx context.Context, user *boil
 		return terror.Error(err, errMsg)
 	}
 
+	// Extend auction if bid is placed near the end
+	extendedEndAt, err := db.MarketplaceSaleAuctionSoftClose(tx, saleItem.ID)
+	if err != nil {
+		mp.API.Passport.RefundSupsMessage(txid)
+		l.Error().Err(err).Msg("unable to extend auction")
+		return terror.Error(err, errMsg)
+	}
+
 	// Refund other bids
 	for _, b := range refundBids {
 		factionAccountID, ok := server.FactionUsers[b.FactionID.String]
@@ -1925,8 +2085,14 @@ func (mp *MarketplaceController) SalesBidHandler(ctx context.Context, user *boil
 		return terror.Error(err, errMsg)
 	}
 
+	if extendedEndAt.Valid {
+		mp.API.MarketplaceController.ScheduleAuctionClose(saleItem.ID, extendedEndAt.Time)
+	}
+
 	reply(true)
 
+	go mp.API.MarketplaceController.AlertOutbid(saleItem.ID, user.ID, bidAmount)
+
 	// Broadcast new current price
 	totalBids, err := boiler.ItemSalesBidHistories(boiler.ItemSalesBidHistoryWhere.ItemSaleID.EQ(req.Payload.ID.String())).Count(gamedb.StdConn)
 	if err != nil {
@@ -1936,7 +2102,7 @@ func (mp *MarketplaceController) SalesBidHandler(ctx context.Context, user *boil
 	}
 
 	resp := &SaleItemUpdate{
-		AuctionCurrentPrice: req.Payload.Amount.Mul(decimal.New(1, 18)).String(),
+		AuctionCurrentPrice: bidAmount.String(),
 		TotalBids:           totalBids,
 		LastBid: server.MarketplaceBidder{
 			ID:            null.StringFrom(user.ID),
@@ -1945,11 +2111,12 @@ func (mp *MarketplaceController) SalesBidHandler(ctx context.Context, user *boil
 			PublicAddress: user.PublicAddress,
 			Gid:           null.IntFrom(user.Gid),
 		},
+		EndAt: extendedEndAt,
 	}
 	ws.PublishMessage(fmt.Sprintf("/faction/%s/marketplace/%s", fID, req.Payload.ID.String()), HubKeyMarketplaceSalesItemUpdate, resp)
 
 	// Log Event
-	err = db.MarketplaceAddEvent(boiler.MarketplaceEventBid, user.ID, decimal.NewNullDecimal(req.Payload.Amount.Mul(decimal.New(1, 18))), saleItem.ID, boiler.TableNames.ItemSales)
+	err = db.MarketplaceAddEvent(boiler.MarketplaceEventBid, user.ID, decimal.NewNullDecimal(bidAmount), saleItem.ID, boiler.TableNames.ItemSales)
 	if err != nil {
 		l.Error().Err(err).Msg("failed to log bid event")
 	}
@@ -1957,12 +2124,79 @@ func (mp *MarketplaceController) SalesBidHandler(ctx context.Context, user *boil
 	return nil
 }
 
+// proxyBidRaise raises the leading proxy bid of an auction after it is outbid by a lower max amount.
+func (mp *MarketplaceController) proxyBidRaise(saleItem *server.MarketplaceSaleItem, leadingBid *boiler.ItemSalesBidHistory, bidPrice decimal.Decimal) error {
+	tx, err := gamedb.StdConn.Begin()
+	if err != nil {
+		return terror.Error(err)
+	}
+	defer tx.Rollback()
+
+	err = db.MarketplaceSaleBidRaise(tx, saleItem.ID, leadingBid.BidTXID, bidPrice)
+	if err != nil {
+		return err
+	}
+	err = db.MarketplaceSaleAuctionSync(tx, uuid.Must(uuid.FromString(saleItem.ID)))
+	if err != nil {
+		return err
+	}
+	extendedEndAt, err := db.MarketplaceSaleAuctionSoftClose(tx, saleItem.ID)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return terror.Error(err)
+	}
+
+	if extendedEndAt.Valid {
+		mp.API.MarketplaceController.ScheduleAuctionClose(saleItem.ID, extendedEndAt.Time)
+	}
+
+	go mp.API.MarketplaceController.AlertOutbid(saleItem.ID, leadingBid.BidderID, decimal.Min(bidPrice, leadingBid.MaxBidPrice.Decimal))
+
+	// Broadcast new current price
+	bidder, err := boiler.FindPlayer(gamedb.StdConn, leadingBid.BidderID)
+	if err != nil {
+		gamelog.L.Error().Str("player_id", leadingBid.BidderID).Err(err).Msg("unable to get leading bidder")
+		return nil
+	}
+	totalBids, err := boiler.ItemSalesBidHistories(boiler.ItemSalesBidHistoryWhere.ItemSaleID.EQ(saleItem.ID)).Count(gamedb.StdConn)
+	if err != nil {
+		gamelog.L.Error().Str("item_sale_id", saleItem.ID).Err(err).Msg("unable to get current total bids")
+		return nil
+	}
+	resp := &SaleItemUpdate{
+		AuctionCurrentPrice: decimal.Min(bidPrice, leadingBid.MaxBidPrice.Decimal).String(),
+		TotalBids:           totalBids,
+		LastBid: server.MarketplaceBidder{
+			ID:            null.StringFrom(bidder.ID),
+			FactionID:     bidder.FactionID,
+			Username:      bidder.Username,
+			PublicAddress: bidder.PublicAddress,
+			Gid:           null.IntFrom(bidder.Gid),
+		},
+		EndAt: extendedEndAt,
+	}
+	ws.PublishMessage(fmt.Sprintf("/faction/%s/marketplace/%s", saleItem.FactionID, saleItem.ID), HubKeyMarketplaceSalesItemUpdate, resp)
+
+	// Log Event
+	err = db.MarketplaceAddEvent(boiler.MarketplaceEventBid, bidder.ID, decimal.NewNullDecimal(decimal.Min(bidPrice, leadingBid.MaxBidPrice.Decimal)), saleItem.ID, boiler.TableNames.ItemSales)
+	if err != nil {
+		gamelog.L.Error().Str("item_sale_id", saleItem.ID).Err(err).Msg("failed to log bid event")
+	}
+
+	return nil
+}
+
 const HubKeyMarketplaceSalesItemUpdate = "MARKETPLACE:SALES:ITEM:UPDATE"
 
 type SaleItemUpdate struct {
 	AuctionCurrentPrice string                   `json:"auction_current_price"`
 	TotalBids           int64                    `json:"total_bids"`
 	LastBid             server.MarketplaceBidder `json:"last_bid,omitempty"`
+	EndAt               null.Time                `json:"end_at,omitempty"`
 }
 
 func (mp *MarketplaceController) SalesItemUpdateSubscriber(ctx context.Context, user *boiler.Player, factionID string, key string, payload []byte, reply ws.ReplyFunc) error {
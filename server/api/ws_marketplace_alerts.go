@@ -0,0 +1,271 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"server"
+	"server/db"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+	"strings"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/gofrs/uuid"
+	"github.com/ninja-software/terror/v2"
+	"github.com/ninja-syndicate/hub"
+	"github.com/ninja-syndicate/ws"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	maxWatchedItemSales = 100
+	maxSavedSearches    = 20
+)
+
+const HubKeyMarketplaceWatchlist = "MARKETPLACE:WATCHLIST"
+
+type MarketplaceWatchlistItem struct {
+	*boiler.ItemSaleWatch
+	SaleItem *server.MarketplaceSaleItem `json:"sale_item"`
+}
+
+func (mp *MarketplaceController) WatchlistHandler(ctx context.Context, user *boiler.Player, fID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	l := gamelog.L.With().Str("func", "WatchlistHandler").Str("user_id", user.ID).Logger()
+
+	watches, err := boiler.ItemSaleWatches(
+		boiler.ItemSaleWatchWhere.PlayerID.EQ(user.ID),
+		qm.OrderBy(boiler.ItemSaleWatchColumns.CreatedAt+" DESC"),
+	).All(gamedb.StdConn)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to get watchlist.")
+		return terror.Error(err, "Failed to get watchlist.")
+	}
+
+	resp := []*MarketplaceWatchlistItem{}
+	for _, watch := range watches {
+		saleItem, err := db.MarketplaceItemSale(uuid.Must(uuid.FromString(watch.ItemSaleID)))
+		if err != nil {
+			l.Error().Err(err).Str("item_sale_id", watch.ItemSaleID).Msg("Failed to get watched sale item.")
+			return terror.Error(err, "Failed to get watchlist.")
+		}
+		resp = append(resp, &MarketplaceWatchlistItem{
+			ItemSaleWatch: watch,
+			SaleItem:      saleItem,
+		})
+	}
+
+	reply(resp)
+	return nil
+}
+
+const HubKeyMarketplaceWatchlistAdd = "MARKETPLACE:WATCHLIST:ADD"
+
+type MarketplaceWatchlistAddRequest struct {
+	*hub.HubCommandRequest
+	Payload struct {
+		ItemSaleID uuid.UUID `json:"item_sale_id"`
+		// DutchAuctionThreshold alerts the player once a dutch auction drops to this price
+		DutchAuctionThreshold decimal.NullDecimal `json:"dutch_auction_threshold"`
+	} `json:"payload"`
+}
+
+func (mp *MarketplaceController) WatchlistAddHandler(ctx context.Context, user *boiler.Player, fID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	l := gamelog.L.With().Str("func", "WatchlistAddHandler").Str("user_id", user.ID).Logger()
+
+	req := &MarketplaceWatchlistAddRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	itemSale, err := boiler.FindItemSale(gamedb.StdConn, req.Payload.ItemSaleID.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return terror.Error(err, "Item not found.")
+	}
+	if err != nil {
+		l.Error().Err(err).Str("item_sale_id", req.Payload.ItemSaleID.String()).Msg("Failed to get sale item.")
+		return terror.Error(err, "Failed to watch item.")
+	}
+	if itemSale.FactionID != fID {
+		return terror.Error(fmt.Errorf("item does not belong to users faction"), "Item does not belong to user's faction.")
+	}
+
+	dutchAuctionThreshold := decimal.NullDecimal{}
+	if req.Payload.DutchAuctionThreshold.Valid {
+		if !itemSale.DutchAuction {
+			return terror.Error(fmt.Errorf("item is not a dutch auction"), "Price alerts are only available on dutch auctions.")
+		}
+		if req.Payload.DutchAuctionThreshold.Decimal.LessThanOrEqual(decimal.Zero) {
+			return terror.Error(fmt.Errorf("invalid threshold"), "Invalid price alert received.")
+		}
+		dutchAuctionThreshold = decimal.NewNullDecimal(req.Payload.DutchAuctionThreshold.Decimal.Mul(decimal.New(1, 18)))
+	}
+
+	// watches on listings which have sold or ended don't count towards the limit
+	count, err := db.MarketplaceItemSaleWatchCount(user.ID, itemSale.ID)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to count watched items.")
+		return terror.Error(err, "Failed to watch item.")
+	}
+	if count >= maxWatchedItemSales {
+		return terror.Error(fmt.Errorf("watchlist is full"), fmt.Sprintf("You can only watch up to %d items.", maxWatchedItemSales))
+	}
+
+	watch, err := db.MarketplaceItemSaleWatchUpsert(user.ID, itemSale.ID, dutchAuctionThreshold)
+	if err != nil {
+		l.Error().Err(err).Str("item_sale_id", itemSale.ID).Msg("Failed to watch item.")
+		return terror.Error(err, "Failed to watch item.")
+	}
+
+	reply(watch)
+	return nil
+}
+
+const HubKeyMarketplaceWatchlistRemove = "MARKETPLACE:WATCHLIST:REMOVE"
+
+type MarketplaceWatchlistRemoveRequest struct {
+	*hub.HubCommandRequest
+	Payload struct {
+		ItemSaleID uuid.UUID `json:"item_sale_id"`
+	} `json:"payload"`
+}
+
+func (mp *MarketplaceController) WatchlistRemoveHandler(ctx context.Context, user *boiler.Player, fID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &MarketplaceWatchlistRemoveRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	_, err = boiler.ItemSaleWatches(
+		boiler.ItemSaleWatchWhere.PlayerID.EQ(user.ID),
+		boiler.ItemSaleWatchWhere.ItemSaleID.EQ(req.Payload.ItemSaleID.String()),
+	).DeleteAll(gamedb.StdConn)
+	if err != nil {
+		gamelog.L.Error().Str("func", "WatchlistRemoveHandler").Str("user_id", user.ID).Str("item_sale_id", req.Payload.ItemSaleID.String()).Err(err).Msg("Failed to remove watched item.")
+		return terror.Error(err, "Failed to remove item from watchlist.")
+	}
+
+	reply(true)
+	return nil
+}
+
+const HubKeyMarketplaceSavedSearchList = "MARKETPLACE:SAVED_SEARCH:LIST"
+
+func (mp *MarketplaceController) SavedSearchListHandler(ctx context.Context, user *boiler.Player, fID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	searches, err := boiler.MarketplaceSavedSearches(
+		boiler.MarketplaceSavedSearchWhere.PlayerID.EQ(user.ID),
+		boiler.MarketplaceSavedSearchWhere.DeletedAt.IsNull(),
+		qm.OrderBy(boiler.MarketplaceSavedSearchColumns.CreatedAt+" DESC"),
+	).All(gamedb.StdConn)
+	if err != nil {
+		gamelog.L.Error().Str("func", "SavedSearchListHandler").Str("user_id", user.ID).Err(err).Msg("Failed to get saved searches.")
+		return terror.Error(err, "Failed to get saved searches.")
+	}
+
+	reply(searches)
+	return nil
+}
+
+const HubKeyMarketplaceSavedSearchCreate = "MARKETPLACE:SAVED_SEARCH:CREATE"
+
+type MarketplaceSavedSearchCreateRequest struct {
+	*hub.HubCommandRequest
+	Payload struct {
+		Name   string                           `json:"name"`
+		Filter *db.MarketplaceSavedSearchFilter `json:"filter"`
+	} `json:"payload"`
+}
+
+func (mp *MarketplaceController) SavedSearchCreateHandler(ctx context.Context, user *boiler.Player, fID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	l := gamelog.L.With().Str("func", "SavedSearchCreateHandler").Str("user_id", user.ID).Logger()
+
+	req := &MarketplaceSavedSearchCreateRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	name := strings.TrimSpace(req.Payload.Name)
+	if name == "" || len(name) > 64 {
+		return terror.Error(fmt.Errorf("invalid name"), "Saved search name must be between 1 and 64 characters long.")
+	}
+	if req.Payload.Filter == nil {
+		return terror.Error(fmt.Errorf("missing filter"), "Invalid saved search received.")
+	}
+	if req.Payload.Filter.ItemType != "" && !db.IsValidCollectionItemType(req.Payload.Filter.ItemType) {
+		return terror.Error(fmt.Errorf("invalid item type"), "Invalid Item Type input received.")
+	}
+
+	count, err := boiler.MarketplaceSavedSearches(
+		boiler.MarketplaceSavedSearchWhere.PlayerID.EQ(user.ID),
+		boiler.MarketplaceSavedSearchWhere.DeletedAt.IsNull(),
+	).Count(gamedb.StdConn)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to count saved searches.")
+		return terror.Error(err, "Failed to save search.")
+	}
+	if count >= maxSavedSearches {
+		return terror.Error(fmt.Errorf("too many saved searches"), fmt.Sprintf("You can only save up to %d searches.", maxSavedSearches))
+	}
+
+	filter, err := json.Marshal(req.Payload.Filter)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to marshal saved search filter.")
+		return terror.Error(err, "Failed to save search.")
+	}
+
+	search := &boiler.MarketplaceSavedSearch{
+		PlayerID: user.ID,
+		Name:     name,
+		Filter:   filter,
+	}
+	err = search.Insert(gamedb.StdConn, boil.Infer())
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to insert saved search.")
+		return terror.Error(err, "Failed to save search.")
+	}
+
+	reply(search)
+	return nil
+}
+
+const HubKeyMarketplaceSavedSearchDelete = "MARKETPLACE:SAVED_SEARCH:DELETE"
+
+type MarketplaceSavedSearchDeleteRequest struct {
+	*hub.HubCommandRequest
+	Payload struct {
+		ID uuid.UUID `json:"id"`
+	} `json:"payload"`
+}
+
+func (mp *MarketplaceController) SavedSearchDeleteHandler(ctx context.Context, user *boiler.Player, fID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &MarketplaceSavedSearchDeleteRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	_, err = boiler.MarketplaceSavedSearches(
+		boiler.MarketplaceSavedSearchWhere.ID.EQ(req.Payload.ID.String()),
+		boiler.MarketplaceSavedSearchWhere.PlayerID.EQ(user.ID),
+		boiler.MarketplaceSavedSearchWhere.DeletedAt.IsNull(),
+	).UpdateAll(gamedb.StdConn, boiler.M{
+		boiler.MarketplaceSavedSearchColumns.DeletedAt: null.TimeFrom(time.Now()),
+		boiler.MarketplaceSavedSearchColumns.UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		gamelog.L.Error().Str("func", "SavedSearchDeleteHandler").Str("user_id", user.ID).Str("saved_search_id", req.Payload.ID.String()).Err(err).Msg("Failed to delete saved search.")
+		return terror.Error(err, "Failed to delete saved search.")
+	}
+
+	reply(true)
+	return nil
+}
@@ -0,0 +1,310 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"server/db"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+	"server/marketplace"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/gofrs/uuid"
+	"github.com/ninja-software/terror/v2"
+	"github.com/ninja-syndicate/hub"
+	"github.com/ninja-syndicate/ws"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const HubKeyMarketplaceSalesBundleCreate = "MARKETPLACE:SALES:BUNDLE:CREATE"
+
+type MarketplaceSalesBundleCreateRequest struct {
+	*hub.HubCommandRequest
+	Payload struct {
+		// Items are the assets sold in the bundle, the first item is the lead item of the listing
+		Items []struct {
+			ItemType string    `json:"item_type"`
+			ItemID   uuid.UUID `json:"item_id"`
+		} `json:"items"`
+		Keycards []struct {
+			PlayerKeycardID uuid.UUID `json:"player_keycard_id"`
+			Count           int       `json:"count"`
+		} `json:"keycards"`
+		AskingPrice          decimal.NullDecimal `json:"asking_price"`
+		AuctionReservedPrice decimal.NullDecimal `json:"auction_reserved_price"`
+		AuctionCurrentPrice  decimal.NullDecimal `json:"auction_current_price"`
+		DutchAuctionDropRate decimal.NullDecimal `json:"dutch_auction_drop_rate"`
+		ListingDurationHours time.Duration       `json:"listing_duration_hours"`
+	} `json:"payload"`
+}
+
+type bundleKeycard struct {
+	playerKeycard *boiler.PlayerKeycard
+	blueprint     *boiler.BlueprintKeycard
+	count         int
+}
+
+func (mp *MarketplaceController) SalesBundleCreateHandler(ctx context.Context, user *boiler.Player, fID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	l := gamelog.L.With().Str("func", "SalesBundleCreateHandler").Str("user_id", user.ID).Str("faction_id", fID).Logger()
+
+	errMsg := "Issue processing create bundle sale item, try again or contact support."
+	req := &MarketplaceSalesBundleCreateRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	userID, err := uuid.FromString(user.ID)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to get player requesting to sell item")
+		return terror.Error(err, errMsg)
+	}
+
+	factionID, err := uuid.FromString(fID)
+	if err != nil {
+		l.Error().Err(err).Msg("Player is not in a faction")
+		return terror.Error(err, errMsg)
+	}
+
+	// Check price input
+	hasBuyout, hasAuction, hasDutchAuction, err := salesListingType(
+		req.Payload.AskingPrice,
+		req.Payload.AuctionCurrentPrice,
+		req.Payload.AuctionReservedPrice,
+		req.Payload.DutchAuctionDropRate,
+	)
+	if err != nil {
+		return err
+	}
+
+	if len(req.Payload.Items) == 0 {
+		return terror.Error(fmt.Errorf("bundle has no items"), "A bundle needs at least one war machine, weapon or mystery crate.")
+	}
+	if len(req.Payload.Items)+len(req.Payload.Keycards) < 2 {
+		return terror.Error(fmt.Errorf("bundle has one item"), "A bundle needs at least two items, list the item on its own instead.")
+	}
+
+	// The lead item is what the listing is shown as, so it needs to be something that can be listed on its own
+	leadItemType := req.Payload.Items[0].ItemType
+	if leadItemType != boiler.ItemTypeMech && leadItemType != boiler.ItemTypeMysteryCrate && leadItemType != boiler.ItemTypeWeapon {
+		return terror.Error(fmt.Errorf("invalid lead item type"), "The first item of a bundle must be a war machine, weapon or mystery crate.")
+	}
+
+	// Check items
+	collectionItems := boiler.CollectionItemSlice{}
+	seenItems := map[string]bool{}
+	for _, item := range req.Payload.Items {
+		if !db.IsValidCollectionItemType(item.ItemType) {
+			return terror.Error(fmt.Errorf("invalid item type"), "Invalid Item Type input received.")
+		}
+
+		collectionItem, err := boiler.CollectionItems(
+			boiler.CollectionItemWhere.ItemID.EQ(item.ItemID.String()),
+			boiler.CollectionItemWhere.ItemType.EQ(item.ItemType),
+		).One(gamedb.StdConn)
+		if errors.Is(err, sql.ErrNoRows) {
+			return terror.Error(err, "Item not found.")
+		}
+		if err != nil {
+			l.Error().Err(err).Str("item_id", item.ItemID.String()).Str("item_type", item.ItemType).Msg("Failed to get collection item.")
+			return terror.Error(err, errMsg)
+		}
+
+		if seenItems[collectionItem.ID] {
+			return terror.Error(fmt.Errorf("duplicate item"), "The same item cannot be added to a bundle twice.")
+		}
+		seenItems[collectionItem.ID] = true
+
+		err = marketplace.BundleAssetCheck(gamedb.StdConn, collectionItem, user.ID)
+		if err != nil {
+			return err
+		}
+
+		collectionItems = append(collectionItems, collectionItem)
+	}
+
+	// Check keycards
+	keycards := []*bundleKeycard{}
+	seenKeycards := map[string]bool{}
+	for _, kc := range req.Payload.Keycards {
+		if kc.Count < 1 {
+			return terror.Error(fmt.Errorf("invalid keycard count"), "Invalid keycard count received.")
+		}
+
+		playerKeycard, err := boiler.FindPlayerKeycard(gamedb.StdConn, kc.PlayerKeycardID.String())
+		if errors.Is(err, sql.ErrNoRows) {
+			return terror.Error(err, "Keycard not found.")
+		}
+		if err != nil {
+			l.Error().Err(err).Str("player_keycard_id", kc.PlayerKeycardID.String()).Msg("Failed to get player keycard.")
+			return terror.Error(err, errMsg)
+		}
+		if playerKeycard.PlayerID != user.ID {
+			return terror.Error(terror.ErrUnauthorised, "Item does not belong to user.")
+		}
+		if seenKeycards[playerKeycard.ID] {
+			return terror.Error(fmt.Errorf("duplicate keycard"), "The same keycard cannot be added to a bundle twice.")
+		}
+		seenKeycards[playerKeycard.ID] = true
+		if playerKeycard.Count < kc.Count {
+			return terror.Error(fmt.Errorf("not enough keycards"), "You do not have enough keycards to list.")
+		}
+
+		blueprint, err := boiler.FindBlueprintKeycard(gamedb.StdConn, playerKeycard.BlueprintKeycardID)
+		if err != nil {
+			l.Error().Err(err).Str("player_keycard_id", playerKeycard.ID).Msg("Failed to get blueprint keycard.")
+			return terror.Error(err, errMsg)
+		}
+
+		keycards = append(keycards, &bundleKeycard{
+			playerKeycard: playerKeycard,
+			blueprint:     blueprint,
+			count:         kc.Count,
+		})
+	}
+
+	// Begin transaction
+	tx, err := gamedb.StdConn.Begin()
+	if err != nil {
+		l.Error().Err(err).Msg("Unable to start db transaction (new bundle sale item).")
+		return terror.Error(err, errMsg)
+	}
+	defer tx.Rollback()
+
+	// Create Sales Item
+	endAt := time.Now()
+	if mp.API.Config.Environment == "staging" {
+		endAt = endAt.Add(time.Minute * 5)
+	} else {
+		endAt = endAt.Add(time.Hour * req.Payload.ListingDurationHours)
+	}
+	obj, err := db.MarketplaceSaleCreate(
+		tx,
+		userID,
+		factionID,
+		null.String{},
+		endAt,
+		uuid.Must(uuid.FromString(collectionItems[0].ID)),
+		hasBuyout,
+		req.Payload.AskingPrice,
+		hasAuction,
+		req.Payload.AuctionReservedPrice,
+		req.Payload.AuctionCurrentPrice,
+		hasDutchAuction,
+		req.Payload.DutchAuctionDropRate,
+	)
+	if err != nil {
+		l.Error().Err(err).Msg("Unable to create new bundle sale item.")
+		return terror.Error(err, errMsg)
+	}
+
+	l = l.With().Str("item_sale_id", obj.ID).Logger()
+
+	itemSale := &boiler.ItemSale{
+		ID:     obj.ID,
+		Bundle: true,
+	}
+	_, err = itemSale.Update(tx, boil.Whitelist(boiler.ItemSaleColumns.Bundle))
+	if err != nil {
+		l.Error().Err(err).Msg("Unable to flag sale item as a bundle.")
+		return terror.Error(err, errMsg)
+	}
+	obj.Bundle = true
+
+	// Lock Items
+	for i, collectionItem := range collectionItems {
+		collectionItem.LockedToMarketplace = true
+		_, err = collectionItem.Update(tx, boil.Whitelist(
+			boiler.CollectionItemColumns.ID,
+			boiler.CollectionItemColumns.LockedToMarketplace,
+		))
+		if err != nil {
+			l.Error().Err(err).Str("collection_item_id", collectionItem.ID).Msg("Unable to lock bundled item.")
+			return terror.Error(err, errMsg)
+		}
+
+		// the lead item lives on the sale item itself
+		if i == 0 {
+			continue
+		}
+		err = db.MarketplaceSaleBundleCollectionItemAdd(tx, obj.ID, collectionItem.ID)
+		if err != nil {
+			l.Error().Err(err).Str("collection_item_id", collectionItem.ID).Msg("Unable to add item to bundle.")
+			return terror.Error(err, errMsg)
+		}
+	}
+
+	// Take Keycards
+	for _, kc := range keycards {
+		err = db.MarketplaceSaleBundleKeycardAdd(tx, obj.ID, user.ID, kc.playerKeycard.ID, kc.count)
+		if err != nil {
+			l.Error().Err(err).Str("player_keycard_id", kc.playerKeycard.ID).Msg("Unable to add keycards to bundle.")
+			return err
+		}
+	}
+
+	// Take keycards off xsyn, putting back the ones already taken if one fails
+	xsynKeycardRollback := func(taken []*bundleKeycard) {
+		for _, kc := range taken {
+			err := marketplace.UpdateKeycardCountXSYN(mp.API.Passport, kc.blueprint, user.PublicAddress.String, kc.count, true)
+			if err != nil {
+				l.Error().Err(err).Str("player_keycard_id", kc.playerKeycard.ID).Msg("Failed to rollback keycards taken on xsyn.")
+			}
+		}
+	}
+	for i, kc := range keycards {
+		err = marketplace.UpdateKeycardCountXSYN(mp.API.Passport, kc.blueprint, user.PublicAddress.String, kc.count, false)
+		if err != nil {
+			xsynKeycardRollback(keycards[:i])
+			l.Error().Err(err).Str("player_keycard_id", kc.playerKeycard.ID).Msg("Unable to update XSYN asset count.")
+			return err
+		}
+	}
+
+	// Commit Transaction
+	err = tx.Commit()
+	if err != nil {
+		xsynKeycardRollback(keycards)
+		l.Error().Err(err).Msg("Unable to commit db transaction (new bundle sale item).")
+		return terror.Error(err, errMsg)
+	}
+
+	bundleItems, err := db.MarketplaceSaleBundleItems(gamedb.StdConn, obj.ID)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to load bundled items.")
+	}
+	obj.BundleItems = bundleItems[obj.ID]
+
+	if obj.Auction {
+		mp.API.MarketplaceController.ScheduleAuctionClose(obj.ID, obj.EndAt)
+	}
+
+	reply(obj)
+
+	go mp.API.MarketplaceController.AlertNewListing(obj.ID)
+
+	// Log Event
+	err = db.MarketplaceAddEvent(boiler.MarketplaceEventCreated, user.ID, decimal.NullDecimal{}, obj.ID, boiler.TableNames.ItemSales)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to log create sale item event (bundle).")
+	}
+
+	// Broadcast Queue Status Market
+	mechIDs := []string{}
+	for _, collectionItem := range collectionItems {
+		if collectionItem.ItemType == boiler.ItemTypeMech {
+			mechIDs = append(mechIDs, collectionItem.ItemID)
+		}
+	}
+	if len(mechIDs) > 0 {
+		mp.API.ArenaManager.MechDebounceBroadcastChan <- mechIDs
+	}
+
+	return nil
+}
@@ -0,0 +1,409 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"server"
+	"server/db"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+	"server/marketplace"
+	"server/xsyn_rpcclient"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/gofrs/uuid"
+	"github.com/ninja-software/terror/v2"
+	"github.com/ninja-syndicate/hub"
+	"github.com/ninja-syndicate/ws"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+)
+
+const HubKeyMarketplaceOfferList = "MARKETPLACE:OFFER:LIST"
+
+type MarketplaceOfferListResponse struct {
+	Incoming boiler.ItemOfferSlice `json:"incoming"`
+	Outgoing boiler.ItemOfferSlice `json:"outgoing"`
+}
+
+func (mp *MarketplaceController) OfferListHandler(ctx context.Context, user *boiler.Player, fID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	incoming, outgoing, err := db.MarketplaceItemOfferList(user.ID)
+	if err != nil {
+		return err
+	}
+
+	reply(&MarketplaceOfferListResponse{
+		Incoming: incoming,
+		Outgoing: outgoing,
+	})
+
+	return nil
+}
+
+const HubKeyMarketplaceOfferCreate = "MARKETPLACE:OFFER:CREATE"
+
+type MarketplaceOfferCreateRequest struct {
+	*hub.HubCommandRequest
+	Payload struct {
+		ItemType string          `json:"item_type"`
+		ItemID   uuid.UUID       `json:"item_id"`
+		Amount   decimal.Decimal `json:"amount"`
+	} `json:"payload"`
+}
+
+func (mp *MarketplaceController) OfferCreateHandler(ctx context.Context, user *boiler.Player, fID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	l := gamelog.L.With().Str("func", "OfferCreateHandler").Str("user_id", user.ID).Str("faction_id", fID).Logger()
+
+	errMsg := "Issue making offer, try again or contact support."
+	req := &MarketplaceOfferCreateRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	if req.Payload.Amount.LessThanOrEqual(decimal.Zero) {
+		return terror.Error(fmt.Errorf("invalid offer amount"), "Invalid offer amount received.")
+	}
+
+	// Get Faction Account holding the offer amount
+	factionAccountID, ok := server.FactionUsers[fID]
+	if !ok {
+		err = fmt.Errorf("failed to get hard coded syndicate player id")
+		l.Error().Err(err).Msg("unable to get hard coded syndicate player ID from faction ID")
+		return terror.Error(err, errMsg)
+	}
+
+	collectionItem, err := boiler.CollectionItems(
+		boiler.CollectionItemWhere.ItemID.EQ(req.Payload.ItemID.String()),
+		boiler.CollectionItemWhere.ItemType.EQ(req.Payload.ItemType),
+	).One(gamedb.StdConn)
+	if errors.Is(err, sql.ErrNoRows) {
+		return terror.Error(err, "Item not found.")
+	}
+	if err != nil {
+		l.Error().Err(err).Str("item_id", req.Payload.ItemID.String()).Msg("failed to get collection item")
+		return terror.Error(err, errMsg)
+	}
+
+	l = l.With().Str("collection_item_id", collectionItem.ID).Logger()
+
+	if collectionItem.OwnerID == user.ID {
+		return terror.Error(fmt.Errorf("offer on own item"), "You cannot make an offer on your own item.")
+	}
+
+	owner, err := boiler.FindPlayer(gamedb.StdConn, collectionItem.OwnerID)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to get item owner")
+		return terror.Error(err, errMsg)
+	}
+	if owner.FactionID.String != fID {
+		return terror.Error(fmt.Errorf("item does not belong to users faction"), "Item does not belong to user's faction.")
+	}
+
+	err = marketplace.ItemOfferAssetCheck(gamedb.StdConn, collectionItem, owner.ID)
+	if err != nil {
+		return err
+	}
+
+	offerAmount := req.Payload.Amount.Mul(decimal.New(1, 18))
+	txid, err := mp.API.Passport.SpendSupMessage(xsyn_rpcclient.SpendSupsReq{
+		FromUserID:           uuid.FromStringOrNil(user.ID),
+		ToUserID:             uuid.Must(uuid.FromString(factionAccountID)),
+		Amount:               offerAmount.String(),
+		TransactionReference: server.TransactionReference(fmt.Sprintf("marketplace_buy_item:offer|%s|%d", collectionItem.ID, time.Now().UnixNano())),
+		Group:                string(server.TransactionGroupSupremacy),
+		SubGroup:             string(server.TransactionGroupMarketplace),
+		Description:          fmt.Sprintf("Marketplace Offer Item: %s", collectionItem.ID),
+	})
+	if err != nil {
+		l.Error().Err(err).Msg("payment failed")
+		return terror.Error(err, "Issue making offer transaction.")
+	}
+
+	offer, err := db.MarketplaceItemOfferCreate(gamedb.StdConn, collectionItem, fID, user.ID, offerAmount, null.StringFrom(txid), null.String{})
+	if err != nil {
+		mp.API.Passport.RefundSupsMessage(txid)
+		l.Error().Err(err).Msg("unable to create offer")
+		return terror.Error(err, errMsg)
+	}
+
+	reply(offer)
+
+	// Log Event
+	err = db.MarketplaceAddEvent(boiler.MarketplaceEventOffer, user.ID, decimal.NewNullDecimal(offerAmount), offer.ID, boiler.TableNames.ItemOffers)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to log offer event")
+	}
+	err = db.MarketplaceAddEvent(boiler.MarketplaceEventOfferReceived, offer.OwnerID, decimal.NewNullDecimal(offerAmount), offer.ID, boiler.TableNames.ItemOffers)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to log offer received event")
+	}
+
+	return nil
+}
+
+type MarketplaceOfferRequest struct {
+	*hub.HubCommandRequest
+	Payload struct {
+		ID uuid.UUID `json:"id"`
+	} `json:"payload"`
+}
+
+// offerRespondable loads a pending offer and checks whether the user is the one who has to respond to it.
+// Offers are responded to by the item owner, and counter offers by the offerer.
+func offerRespondable(user *boiler.Player, offerID uuid.UUID) (*boiler.ItemOffer, error) {
+	offer, err := db.MarketplaceItemOffer(gamedb.StdConn, offerID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	responderID := offer.OwnerID
+	if offer.CounterOfferOf.Valid {
+		responderID = offer.OffererID
+	}
+	if responderID != user.ID {
+		return nil, terror.Error(terror.ErrUnauthorised, "Offer was not made to you.")
+	}
+	if offer.Status != boiler.ItemOfferStatusPENDING || offer.ExpiresAt.Before(time.Now()) {
+		return nil, terror.Error(fmt.Errorf("offer is not pending"), "Offer is no longer available.")
+	}
+
+	return offer, nil
+}
+
+const HubKeyMarketplaceOfferAccept = "MARKETPLACE:OFFER:ACCEPT"
+
+func (mp *MarketplaceController) OfferAcceptHandler(ctx context.Context, user *boiler.Player, fID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	l := gamelog.L.With().Str("func", "OfferAcceptHandler").Str("user_id", user.ID).Logger()
+
+	errMsg := "Issue accepting offer, try again or contact support."
+	req := &MarketplaceOfferRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	offer, err := offerRespondable(user, req.Payload.ID)
+	if err != nil {
+		return err
+	}
+
+	l = l.With().Str("item_offer_id", offer.ID).Logger()
+
+	claimed, err := db.MarketplaceItemOfferClaim(gamedb.StdConn, offer.ID, boiler.ItemOfferStatusPENDING, boiler.ItemOfferStatusACCEPTED)
+	if err != nil {
+		l.Error().Err(err).Msg("unable to accept offer")
+		return terror.Error(err, errMsg)
+	}
+	if !claimed {
+		return terror.Error(fmt.Errorf("offer is not pending"), "Offer is no longer available.")
+	}
+
+	// Counter offers are paid by the offerer on acceptance
+	if offer.CounterOfferOf.Valid {
+		factionAccountID, ok := server.FactionUsers[offer.FactionID]
+		if !ok {
+			err = fmt.Errorf("failed to get hard coded syndicate player id")
+			l.Error().Err(err).Msg("unable to get hard coded syndicate player ID from faction ID")
+			_, _ = db.MarketplaceItemOfferClaim(gamedb.StdConn, offer.ID, boiler.ItemOfferStatusACCEPTED, boiler.ItemOfferStatusPENDING)
+			return terror.Error(err, errMsg)
+		}
+
+		txid, err := mp.API.Passport.SpendSupMessage(xsyn_rpcclient.SpendSupsReq{
+			FromUserID:           uuid.FromStringOrNil(user.ID),
+			ToUserID:             uuid.Must(uuid.FromString(factionAccountID)),
+			Amount:               offer.Amount.String(),
+			TransactionReference: server.TransactionReference(fmt.Sprintf("marketplace_buy_item:offer|%s|%d", offer.CollectionItemID, time.Now().UnixNano())),
+			Group:                string(server.TransactionGroupSupremacy),
+			SubGroup:             string(server.TransactionGroupMarketplace),
+			Description:          fmt.Sprintf("Marketplace Offer Item: %s", offer.CollectionItemID),
+		})
+		if err != nil {
+			l.Error().Err(err).Msg("payment failed")
+			_, _ = db.MarketplaceItemOfferClaim(gamedb.StdConn, offer.ID, boiler.ItemOfferStatusACCEPTED, boiler.ItemOfferStatusPENDING)
+			return terror.Error(err, "Issue making offer transaction.")
+		}
+		offer.OfferTXID = null.StringFrom(txid)
+
+		err = marketplace.ItemOfferComplete(mp.API.Passport, offer)
+		if err != nil {
+			mp.API.Passport.RefundSupsMessage(txid)
+			_, _ = db.MarketplaceItemOfferClaim(gamedb.StdConn, offer.ID, boiler.ItemOfferStatusACCEPTED, boiler.ItemOfferStatusPENDING)
+			return err
+		}
+		mp.publishOfferSaleQuestEvent(offer)
+
+		reply(true)
+		return nil
+	}
+
+	err = marketplace.ItemOfferComplete(mp.API.Passport, offer)
+	if err != nil {
+		_, _ = db.MarketplaceItemOfferClaim(gamedb.StdConn, offer.ID, boiler.ItemOfferStatusACCEPTED, boiler.ItemOfferStatusPENDING)
+		return err
+	}
+	mp.publishOfferSaleQuestEvent(offer)
+
+	reply(true)
+
+	return nil
+}
+
+// publishOfferSaleQuestEvent progresses the marketplace sale objective quests of the owner of an accepted offer.
+func (mp *MarketplaceController) publishOfferSaleQuestEvent(offer *boiler.ItemOffer) {
+	colItem, err := boiler.FindCollectionItem(gamedb.StdConn, offer.CollectionItemID, boiler.CollectionItemColumns.ItemType)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("item_offer_id", offer.ID).Msg("unable to load offer collection item")
+		return
+	}
+
+	mp.API.MarketplaceController.PublishSaleQuestEvent(offer.OwnerID, colItem.ItemType)
+}
+
+const HubKeyMarketplaceOfferReject = "MARKETPLACE:OFFER:REJECT"
+
+func (mp *MarketplaceController) OfferRejectHandler(ctx context.Context, user *boiler.Player, fID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &MarketplaceOfferRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	offer, err := offerRespondable(user, req.Payload.ID)
+	if err != nil {
+		return err
+	}
+
+	err = mp.closeOffer(offer, boiler.ItemOfferStatusREJECTED)
+	if err != nil {
+		return err
+	}
+
+	reply(true)
+
+	// Log Event
+	err = db.MarketplaceAddEvent(boiler.MarketplaceEventOfferRejected, user.ID, decimal.NewNullDecimal(offer.Amount), offer.ID, boiler.TableNames.ItemOffers)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("item_offer_id", offer.ID).Msg("failed to log offer rejected event")
+	}
+
+	return nil
+}
+
+const HubKeyMarketplaceOfferCancel = "MARKETPLACE:OFFER:CANCEL"
+
+func (mp *MarketplaceController) OfferCancelHandler(ctx context.Context, user *boiler.Player, fID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &MarketplaceOfferRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	offer, err := db.MarketplaceItemOffer(gamedb.StdConn, req.Payload.ID.String())
+	if err != nil {
+		return err
+	}
+
+	// Offers are cancelled by the offerer, and counter offers by the owner
+	makerID := offer.OffererID
+	if offer.CounterOfferOf.Valid {
+		makerID = offer.OwnerID
+	}
+	if makerID != user.ID {
+		return terror.Error(terror.ErrUnauthorised, "Offer was not made by you.")
+	}
+	if offer.Status != boiler.ItemOfferStatusPENDING {
+		return terror.Error(fmt.Errorf("offer is not pending"), "Offer is no longer available.")
+	}
+
+	err = mp.closeOffer(offer, boiler.ItemOfferStatusCANCELLED)
+	if err != nil {
+		return err
+	}
+
+	reply(true)
+
+	return nil
+}
+
+const HubKeyMarketplaceOfferCounter = "MARKETPLACE:OFFER:COUNTER"
+
+type MarketplaceOfferCounterRequest struct {
+	*hub.HubCommandRequest
+	Payload struct {
+		ID     uuid.UUID       `json:"id"`
+		Amount decimal.Decimal `json:"amount"`
+	} `json:"payload"`
+}
+
+func (mp *MarketplaceController) OfferCounterHandler(ctx context.Context, user *boiler.Player, fID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	l := gamelog.L.With().Str("func", "OfferCounterHandler").Str("user_id", user.ID).Logger()
+
+	req := &MarketplaceOfferCounterRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	if req.Payload.Amount.LessThanOrEqual(decimal.Zero) {
+		return terror.Error(fmt.Errorf("invalid offer amount"), "Invalid offer amount received.")
+	}
+
+	offer, err := offerRespondable(user, req.Payload.ID)
+	if err != nil {
+		return err
+	}
+	if offer.CounterOfferOf.Valid {
+		return terror.Error(fmt.Errorf("offer is a counter offer"), "Counter offers can only be accepted or rejected.")
+	}
+
+	collectionItem, err := boiler.FindCollectionItem(gamedb.StdConn, offer.CollectionItemID)
+	if err != nil {
+		l.Error().Err(err).Str("item_offer_id", offer.ID).Msg("failed to get collection item")
+		return terror.Error(err, "Failed to load item.")
+	}
+
+	err = mp.closeOffer(offer, boiler.ItemOfferStatusCOUNTERED)
+	if err != nil {
+		return err
+	}
+
+	counterAmount := req.Payload.Amount.Mul(decimal.New(1, 18))
+	counterOffer, err := db.MarketplaceItemOfferCreate(gamedb.StdConn, collectionItem, offer.FactionID, offer.OffererID, counterAmount, null.String{}, null.StringFrom(offer.ID))
+	if err != nil {
+		l.Error().Err(err).Str("item_offer_id", offer.ID).Msg("unable to create counter offer")
+		return err
+	}
+
+	reply(counterOffer)
+
+	// Log Event
+	err = db.MarketplaceAddEvent(boiler.MarketplaceEventOfferCountered, offer.OffererID, decimal.NewNullDecimal(counterAmount), counterOffer.ID, boiler.TableNames.ItemOffers)
+	if err != nil {
+		l.Error().Err(err).Str("item_offer_id", counterOffer.ID).Msg("failed to log offer countered event")
+	}
+
+	return nil
+}
+
+// closeOffer moves a pending offer into a closed status and refunds anything held for it
+func (mp *MarketplaceController) closeOffer(offer *boiler.ItemOffer, status string) error {
+	claimed, err := db.MarketplaceItemOfferClaim(gamedb.StdConn, offer.ID, boiler.ItemOfferStatusPENDING, status)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("item_offer_id", offer.ID).Str("status", status).Msg("unable to close offer")
+		return terror.Error(err, "Issue closing offer, try again or contact support.")
+	}
+	if !claimed {
+		return terror.Error(fmt.Errorf("offer is not pending"), "Offer is no longer available.")
+	}
+	offer.Status = status
+
+	// failed refunds are retried by the marketplace worker
+	_ = marketplace.ItemOfferRefund(mp.API.Passport, offer)
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"server/db"
+	"server/db/boiler"
+	"server/gamelog"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/ninja-software/terror/v2"
+	"github.com/ninja-syndicate/hub"
+	"github.com/ninja-syndicate/ws"
+	"github.com/shopspring/decimal"
+)
+
+// maxPriceHistoryDays is the furthest back price history can be requested
+const maxPriceHistoryDays = 365
+
+// priceHistoryFrom returns the start of the price history being requested, defaulting to the last 30 days.
+func priceHistoryFrom(days int) (time.Time, error) {
+	if days == 0 {
+		days = 30
+	}
+	if days < 0 || days > maxPriceHistoryDays {
+		return time.Time{}, terror.Error(fmt.Errorf("invalid days: %d", days), fmt.Sprintf("Price history is only available for up to %d days.", maxPriceHistoryDays))
+	}
+	return time.Now().AddDate(0, 0, -days), nil
+}
+
+const HubKeyMarketplacePriceHistory = "MARKETPLACE:PRICE:HISTORY"
+
+type MarketplacePriceHistoryRequest struct {
+	*hub.HubCommandRequest
+	Payload struct {
+		db.MarketplacePriceFilter
+		Bucket string `json:"bucket"`
+		Days   int    `json:"days"`
+	} `json:"payload"`
+}
+
+func (mp *MarketplaceController) PriceHistoryHandler(ctx context.Context, user *boiler.Player, fID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &MarketplacePriceHistoryRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	if _, err := uuid.FromString(req.Payload.BlueprintID); err != nil {
+		return terror.Error(err, "Invalid blueprint received.")
+	}
+	if req.Payload.Bucket == "" {
+		req.Payload.Bucket = db.PriceHistoryBucketDay
+	}
+	from, err := priceHistoryFrom(req.Payload.Days)
+	if err != nil {
+		return err
+	}
+
+	resp, err := db.MarketplacePriceHistory(&req.Payload.MarketplacePriceFilter, req.Payload.Bucket, from)
+	if err != nil {
+		gamelog.L.Error().Str("func", "PriceHistoryHandler").Interface("filter", req.Payload.MarketplacePriceFilter).Err(err).Msg("Failed to get price history.")
+		return terror.Error(err, "Failed to get price history.")
+	}
+
+	reply(resp)
+	return nil
+}
+
+const HubKeyMarketplacePriceSuggest = "MARKETPLACE:PRICE:SUGGEST"
+
+type MarketplacePriceSuggestRequest struct {
+	*hub.HubCommandRequest
+	Payload struct {
+		ItemType string    `json:"item_type"`
+		ItemID   uuid.UUID `json:"item_id"`
+	} `json:"payload"`
+}
+
+type MarketplacePriceSuggestResponse struct {
+	*db.MarketplacePriceFilter
+	Price  decimal.NullDecimal `json:"price"`
+	Volume int64               `json:"volume"`
+}
+
+// PriceSuggestHandler suggests a listing price for an item from what the same kind of item recently sold for.
+func (mp *MarketplaceController) PriceSuggestHandler(ctx context.Context, user *boiler.Player, fID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &MarketplacePriceSuggestRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	filter, price, volume, err := db.MarketplaceSuggestedPriceForItem(req.Payload.ItemType, req.Payload.ItemID.String())
+	if err != nil {
+		gamelog.L.Error().Str("func", "PriceSuggestHandler").Str("item_type", req.Payload.ItemType).Str("item_id", req.Payload.ItemID.String()).Err(err).Msg("Failed to get suggested price.")
+		return terror.Error(err, "Failed to get suggested price.")
+	}
+
+	reply(&MarketplacePriceSuggestResponse{
+		MarketplacePriceFilter: filter,
+		Price:                  price,
+		Volume:                 volume,
+	})
+	return nil
+}
+
+const HubKeyMarketplacePortfolioValuation = "MARKETPLACE:PORTFOLIO:VALUATION"
+
+// PortfolioValuationHandler estimates the value of the player's assets for the hangar.
+func (mp *MarketplaceController) PortfolioValuationHandler(ctx context.Context, user *boiler.Player, fID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	resp, err := db.MarketplacePlayerValuation(user.ID)
+	if err != nil {
+		gamelog.L.Error().Str("func", "PortfolioValuationHandler").Str("user_id", user.ID).Err(err).Msg("Failed to get portfolio valuation.")
+		return terror.Error(err, "Failed to get portfolio valuation.")
+	}
+
+	reply(resp)
+	return nil
+}
@@ -25,13 +25,13 @@ import (
 )
 
 func NewModToolsController(api *API) {
-	api.SecureAdminCommand(HubKeyModToolsGetUser, api.ModToolsGetUser)
-	api.SecureAdminCommand(HubKeyModToolsBanUser, api.ModToolBanUser)
-	api.SecureAdminCommand(HubKeyModToolsUnbanUser, api.ModToolUnbanUser)
-	api.SecureAdminCommand(HubKeyModToolRestartServer, api.ModToolRestartServer)
-	api.SecureAdminCommand(HubKeyModToolLookupHistory, api.ModToolLookupHistory)
-	api.SecureAdminCommand(HubKeyModToolRenameMech, api.ModToolRenameMech)
-	api.SecureAdminCommand(HubKeyModToolRenamePlayer, api.ModToolRenamePlayer)
+	api.SecurePermissionCommand(server.PermUserRead, HubKeyModToolsGetUser, api.ModToolsGetUser)
+	api.SecurePermissionCommand(server.PermUserBan, HubKeyModToolsBanUser, api.ModToolBanUser)
+	api.SecurePermissionCommand(server.PermUserUnban, HubKeyModToolsUnbanUser, api.ModToolUnbanUser)
+	api.SecurePermissionCommand(server.PermServerRestart, HubKeyModToolRestartServer, api.ModToolRestartServer)
+	api.SecurePermissionCommand(server.PermUserActivityList, HubKeyModToolLookupHistory, api.ModToolLookupHistory)
+	api.SecurePermissionCommand(server.PermMechRename, HubKeyModToolRenameMech, api.ModToolRenameMech)
+	api.SecurePermissionCommand(server.PermUserUpdate, HubKeyModToolRenamePlayer, api.ModToolRenamePlayer)
 }
 
 const HubKeyModToolsGetUser = "MOD:GET:USER"
@@ -63,7 +63,12 @@ func (api *API) ModToolsGetUser(ctx context.Context, user *boiler.Player, key st
 
 	supsAmount := api.Passport.UserBalanceGet(uuid.FromStringOrNil(player.ID))
 
-	userResp, err := db.ModToolGetUserData(player.ID, user.R.Role.RoleType == boiler.RoleNameADMIN, supsAmount)
+	canReadAssets, err := db.RoleHasPermission(user.RoleID, server.PermUserAssetRead)
+	if err != nil {
+		return err
+	}
+
+	userResp, err := db.ModToolGetUserData(player.ID, canReadAssets, supsAmount)
 	if err != nil {
 		return terror.Error(err, "Failed to get user data in mod tool")
 	}
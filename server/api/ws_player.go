@@ -1245,9 +1245,26 @@ type PlayerPreferencesUpdateRequest struct {
 		EnableSMSNotifications      bool   `json:"enable_sms_notifications"`
 		EnablePushNotifications     bool   `json:"enable_push_notifications"`
 		MobileNumber                string `json:"mobile_number"`
+
+		// marketplace alert channels, left unchanged when not given
+		EnableMarketplaceSystemMessageNotifications null.Bool `json:"enable_marketplace_system_message_notifications"`
+		EnableMarketplaceTelegramNotifications      null.Bool `json:"enable_marketplace_telegram_notifications"`
+		EnableMarketplaceWSNotifications            null.Bool `json:"enable_marketplace_ws_notifications"`
 	} `json:"payload"`
 }
 
+func (req *PlayerPreferencesUpdateRequest) applyMarketplacePreferences(prefs *boiler.PlayerSettingsPreference) {
+	if req.Payload.EnableMarketplaceSystemMessageNotifications.Valid {
+		prefs.EnableMarketplaceSystemMessageNotifications = req.Payload.EnableMarketplaceSystemMessageNotifications.Bool
+	}
+	if req.Payload.EnableMarketplaceTelegramNotifications.Valid {
+		prefs.EnableMarketplaceTelegramNotifications = req.Payload.EnableMarketplaceTelegramNotifications.Bool
+	}
+	if req.Payload.EnableMarketplaceWSNotifications.Valid {
+		prefs.EnableMarketplaceWSNotifications = req.Payload.EnableMarketplaceWSNotifications.Bool
+	}
+}
+
 func (pc *PlayerController) PlayerPreferencesUpdateHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
 	errMsg := "Issue updating settings, try again or contact support."
 	req := &PlayerPreferencesUpdateRequest{}
@@ -1269,7 +1286,11 @@ func (pc *PlayerController) PlayerPreferencesUpdateHandler(ctx context.Context,
 			EnableTelegramNotifications: req.Payload.EnableTelegramNotifications,
 			EnableSMSNotifications:      req.Payload.EnableSMSNotifications,
 			EnablePushNotifications:     req.Payload.EnablePushNotifications,
+
+			EnableMarketplaceSystemMessageNotifications: true,
+			EnableMarketplaceWSNotifications:            true,
 		}
+		req.applyMarketplacePreferences(_prefs)
 
 		// check mobile number
 		if req.Payload.MobileNumber != "" && req.Payload.EnableSMSNotifications {
@@ -1283,7 +1304,12 @@ func (pc *PlayerController) PlayerPreferencesUpdateHandler(ctx context.Context,
 			_prefs.MobileNumber = null.StringFrom(mobileNumber)
 		}
 
-		err = _prefs.Insert(gamedb.StdConn, boil.Infer())
+		// marketplace preferences default to true, so they are always inserted
+		err = _prefs.Insert(gamedb.StdConn, boil.Greylist(
+			boiler.PlayerSettingsPreferenceColumns.EnableMarketplaceSystemMessageNotifications,
+			boiler.PlayerSettingsPreferenceColumns.EnableMarketplaceTelegramNotifications,
+			boiler.PlayerSettingsPreferenceColumns.EnableMarketplaceWSNotifications,
+		))
 		if err != nil {
 			return terror.Error(err, errMsg)
 		}
@@ -1304,6 +1330,7 @@ func (pc *PlayerController) PlayerPreferencesUpdateHandler(ctx context.Context,
 	prefs.EnableTelegramNotifications = req.Payload.EnableTelegramNotifications
 	prefs.EnableSMSNotifications = req.Payload.EnableSMSNotifications
 	prefs.EnablePushNotifications = req.Payload.EnablePushNotifications
+	req.applyMarketplacePreferences(prefs)
 	if !prefs.EnableTelegramNotifications {
 		prefs.Shortcode = ""
 	}
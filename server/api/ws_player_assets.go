@@ -11,6 +11,7 @@ import (
 	"server"
 	"server/db"
 	"server/db/boiler"
+	"server/fiat"
 	"server/gamedb"
 	"server/gamelog"
 	"server/helpers"
@@ -673,11 +674,15 @@ func TrimName(username string) string {
 
 type OpenCrateRequest struct {
 	Payload struct {
-		Id       string `json:"id"`
-		IsHangar bool   `json:"is_hangar"`
+		Id         string `json:"id"`
+		IsHangar   bool   `json:"is_hangar"`
+		ClientSeed string `json:"client_seed"` // optional, mixed into the rolls of crates with a committed seed
 	} `json:"payload"`
 }
 
+// openCrateClientSeedMaxLength keeps the client seed short enough to store and display
+const openCrateClientSeedMaxLength = 64
+
 type OpenCrateResponse struct {
 	ID          string               `json:"id"`
 	Mech        *server.Mech         `json:"mech,omitempty"`
@@ -703,6 +708,10 @@ func (pac *PlayerAssetsControllerWS) OpenCrateHandler(ctx context.Context, user
 		return terror.Error(err, "Invalid request received.")
 	}
 
+	if len(req.Payload.ClientSeed) > openCrateClientSeedMaxLength {
+		return terror.Error(fmt.Errorf("client seed too long"), fmt.Sprintf("Client seed can not be longer than %d characters.", openCrateClientSeedMaxLength))
+	}
+
 	var collectionItem *boiler.CollectionItem
 	if req.Payload.IsHangar {
 		collectionItem, err = boiler.CollectionItems(
@@ -729,6 +738,9 @@ func (pac *PlayerAssetsControllerWS) OpenCrateHandler(ctx context.Context, user
 	if collectionItem.XsynLocked {
 		return terror.Error(fmt.Errorf("user: %s attempted to open crate: %s while XSYN locked", user.ID, req.Payload.Id), "This crate is locked to XSYN, move asset to Supremacy and try again.")
 	}
+	if collectionItem.AssetHidden.Valid {
+		return terror.Error(fmt.Errorf("user: %s attempted to open crate: %s while hidden: %s", user.ID, req.Payload.Id, collectionItem.AssetHidden.String), "This crate is locked, contact support.")
+	}
 	if collectionItem.LockedToMarketplace {
 		return terror.Error(fmt.Errorf("user: %s attempted to open crate: %s while market locked", user.ID, req.Payload.Id), "This crate is still on Marketplace, try again or contact support.")
 	}
@@ -775,7 +787,8 @@ func (pac *PlayerAssetsControllerWS) OpenCrateHandler(ctx context.Context, user
 	}
 	defer tx.Rollback()
 
-	blueprintItems, err := crate.MysteryCrateBlueprints().All(gamedb.StdConn)
+	// roll the contents from the committed seed, or load the pre-assigned contents
+	blueprintItems, err := db.MysteryCrateContents(tx, crate.ID, req.Payload.ClientSeed)
 	if err != nil {
 		crateRollback()
 		gamelog.L.Error().Err(err).Msg(fmt.Sprintf("failed to get blueprint relationships from crate: %s, for user: %s, CRATE:OPEN", crate.ID, user.ID))
@@ -1033,6 +1046,21 @@ func (pac *PlayerAssetsControllerWS) OpenCrateHandler(ctx context.Context, user
 		}
 	}
 
+	// crates bought with fiat pass their order on to their contents
+	packageContents := &fiat.ProductItemPackageContents{
+		Mech:        items.Mech,
+		MechSkins:   items.MechSkins,
+		Weapons:     items.Weapons,
+		WeaponSkins: items.WeaponSkins,
+		PowerCore:   items.PowerCore,
+	}
+	err = db.FiatOrderCrateContentsInsert(tx, crate.ID, packageContents.OrderAssets()...)
+	if err != nil {
+		crateRollback()
+		gamelog.L.Error().Err(err).Interface("crate", crate).Msg(fmt.Sprintf("failed to record order contents during CRATE:OPEN crate: %s", crate.ID))
+		return terror.Error(err, "Could not open crate, try again or contact support.")
+	}
+
 	err = pac.API.Passport.AssetsRegister(xsynAssets) // register new assets
 	if err != nil {
 		gamelog.L.Error().Err(err).Msg("issue inserting new mechs to xsyn for RegisterAllNewAssets")
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"server"
 	"server/db"
 	"server/db/boiler"
 	"server/gamedb"
@@ -323,7 +324,7 @@ func (pc *PlayerController) PlayerProfileCustomAvatarDelete(ctx context.Context,
 	return nil
 }
 
-const HubKeyPlayerFactionPassExpiryDate = "PLAYER:FACTION:PASS:EXPIRY:DATE"
+const HubKeyPlayerFactionPassExpiryDate = server.HubKeyPlayerFactionPassExpiryDate
 
 func (api *API) PlayerFactionPassExpiryDate(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
 	if !user.FactionPassExpiresAt.Valid || !user.FactionPassExpiresAt.Time.After(time.Now()) {
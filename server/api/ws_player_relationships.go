@@ -0,0 +1,315 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"server"
+	"server/db"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+
+	"github.com/ninja-software/terror/v2"
+	"github.com/ninja-syndicate/ws"
+	"golang.org/x/exp/slices"
+)
+
+type PlayerRelationshipController struct {
+	API *API
+}
+
+func NewPlayerRelationshipController(api *API) *PlayerRelationshipController {
+	prc := &PlayerRelationshipController{
+		API: api,
+	}
+
+	api.SecureUserCommand(HubKeyPlayerFriendRequestSend, prc.FriendRequestSendHandler)
+	api.SecureUserCommand(HubKeyPlayerFriendRequestRespond, prc.FriendRequestRespondHandler)
+	api.SecureUserCommand(HubKeyPlayerFriendRequestCancel, prc.FriendRequestCancelHandler)
+	api.SecureUserCommand(HubKeyPlayerFriendRemove, prc.FriendRemoveHandler)
+	api.SecureUserCommand(HubKeyPlayerFollow, prc.FollowHandler)
+	api.SecureUserCommand(HubKeyPlayerBlock, prc.BlockHandler)
+	api.SecureUserCommand(HubKeyPlayerBlockList, prc.BlockListHandler)
+
+	return prc
+}
+
+type PlayerFriend struct {
+	*server.PublicPlayer
+	IsOnline bool `json:"is_online"`
+}
+
+type PlayerFriendsUpdate struct {
+	Friends          []*PlayerFriend                 `json:"friends"`
+	Following        []*server.PublicPlayer          `json:"following"`
+	IncomingRequests []*db.PlayerFriendRequestDetail `json:"incoming_requests"`
+	OutgoingRequests []*db.PlayerFriendRequestDetail `json:"outgoing_requests"`
+}
+
+func playerFriendsUpdate(playerID string) (*PlayerFriendsUpdate, error) {
+	friends, err := db.PlayerFriendList(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	following, err := db.PlayerFollowingList(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	incoming, outgoing, err := db.PlayerFriendRequestList(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	onlinePlayerIDs := ws.TrackedIdents()
+
+	resp := &PlayerFriendsUpdate{
+		Friends:          []*PlayerFriend{},
+		Following:        following,
+		IncomingRequests: incoming,
+		OutgoingRequests: outgoing,
+	}
+	for _, f := range friends {
+		resp.Friends = append(resp.Friends, &PlayerFriend{
+			PublicPlayer: f,
+			IsOnline:     slices.Contains(onlinePlayerIDs, f.ID),
+		})
+	}
+
+	return resp, nil
+}
+
+func (prc *PlayerRelationshipController) FriendsSubscribeHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	resp, err := playerFriendsUpdate(user.ID)
+	if err != nil {
+		return err
+	}
+
+	reply(resp)
+
+	return nil
+}
+
+// broadcastPlayerFriendsUpdate sends the latest friend list to the players
+func broadcastPlayerFriendsUpdate(playerIDs ...string) {
+	for _, playerID := range playerIDs {
+		resp, err := playerFriendsUpdate(playerID)
+		if err != nil {
+			gamelog.L.Error().Err(err).Str("player id", playerID).Msg("Failed to load player friends.")
+			continue
+		}
+
+		ws.PublishMessage(fmt.Sprintf("/secure/user/%s/friends", playerID), server.HubKeyPlayerFriendsSubscribe, resp)
+	}
+}
+
+type PlayerFriendPresence struct {
+	PlayerID string `json:"player_id"`
+	IsOnline bool   `json:"is_online"`
+}
+
+const HubKeyPlayerFriendPresenceUpdated = "PLAYER:FRIEND:PRESENCE:UPDATED"
+
+// broadcastFriendPresence tells online players when their friends come online or go offline.
+// It is only called from debounceSendingViewerCount, so the previous online players do not need a lock.
+func (api *API) broadcastFriendPresence() {
+	onlinePlayerIDs := make(map[string]bool)
+	for _, playerID := range ws.TrackedIdents() {
+		onlinePlayerIDs[playerID] = true
+	}
+
+	changedPlayerIDs := []string{}
+	for playerID := range onlinePlayerIDs {
+		if !api.friendPresence[playerID] {
+			changedPlayerIDs = append(changedPlayerIDs, playerID)
+		}
+	}
+	for playerID := range api.friendPresence {
+		if !onlinePlayerIDs[playerID] {
+			changedPlayerIDs = append(changedPlayerIDs, playerID)
+		}
+	}
+	api.friendPresence = onlinePlayerIDs
+
+	if len(changedPlayerIDs) == 0 {
+		return
+	}
+
+	pfs, err := boiler.PlayerFriends(
+		boiler.PlayerFriendWhere.PlayerID.IN(changedPlayerIDs),
+	).All(gamedb.StdConn)
+	if err != nil {
+		gamelog.L.Error().Err(err).Msg("Failed to load friends of players whose presence changed.")
+		return
+	}
+
+	for _, pf := range pfs {
+		if !onlinePlayerIDs[pf.FriendID] {
+			continue
+		}
+
+		ws.PublishMessage(fmt.Sprintf("/secure/user/%s/friends", pf.FriendID), HubKeyPlayerFriendPresenceUpdated, &PlayerFriendPresence{
+			PlayerID: pf.PlayerID,
+			IsOnline: onlinePlayerIDs[pf.PlayerID],
+		})
+	}
+}
+
+type PlayerRelationshipRequest struct {
+	Payload struct {
+		PlayerID    string `json:"player_id"`
+		IsFollowing bool   `json:"is_following"`
+		IsBlocked   bool   `json:"is_blocked"`
+	} `json:"payload"`
+}
+
+const HubKeyPlayerFriendRequestSend = "PLAYER:FRIEND:REQUEST:SEND"
+
+func (prc *PlayerRelationshipController) FriendRequestSendHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &PlayerRelationshipRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	_, err = db.PlayerFriendRequestSend(user.ID, req.Payload.PlayerID)
+	if err != nil {
+		return err
+	}
+
+	go broadcastPlayerFriendsUpdate(user.ID, req.Payload.PlayerID)
+
+	reply(true)
+
+	return nil
+}
+
+type PlayerFriendRequestRespondRequest struct {
+	Payload struct {
+		RequestID string `json:"request_id"`
+		Accept    bool   `json:"accept"`
+	} `json:"payload"`
+}
+
+const HubKeyPlayerFriendRequestRespond = "PLAYER:FRIEND:REQUEST:RESPOND"
+
+func (prc *PlayerRelationshipController) FriendRequestRespondHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &PlayerFriendRequestRespondRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	pfr, err := db.PlayerFriendRequestRespond(req.Payload.RequestID, user.ID, req.Payload.Accept)
+	if err != nil {
+		return err
+	}
+
+	go broadcastPlayerFriendsUpdate(pfr.SenderID, pfr.ReceiverID)
+
+	reply(true)
+
+	return nil
+}
+
+const HubKeyPlayerFriendRequestCancel = "PLAYER:FRIEND:REQUEST:CANCEL"
+
+func (prc *PlayerRelationshipController) FriendRequestCancelHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &PlayerFriendRequestRespondRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	pfr, err := db.PlayerFriendRequestCancel(req.Payload.RequestID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	go broadcastPlayerFriendsUpdate(pfr.SenderID, pfr.ReceiverID)
+
+	reply(true)
+
+	return nil
+}
+
+const HubKeyPlayerFriendRemove = "PLAYER:FRIEND:REMOVE"
+
+func (prc *PlayerRelationshipController) FriendRemoveHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &PlayerRelationshipRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	err = db.PlayerFriendRemove(user.ID, req.Payload.PlayerID)
+	if err != nil {
+		return err
+	}
+
+	go broadcastPlayerFriendsUpdate(user.ID, req.Payload.PlayerID)
+
+	reply(true)
+
+	return nil
+}
+
+const HubKeyPlayerFollow = "PLAYER:FOLLOW"
+
+func (prc *PlayerRelationshipController) FollowHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &PlayerRelationshipRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	err = db.PlayerFollowSet(user.ID, req.Payload.PlayerID, req.Payload.IsFollowing)
+	if err != nil {
+		return err
+	}
+
+	go broadcastPlayerFriendsUpdate(user.ID)
+
+	reply(true)
+
+	return nil
+}
+
+const HubKeyPlayerBlock = "PLAYER:BLOCK"
+
+func (prc *PlayerRelationshipController) BlockHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &PlayerRelationshipRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	err = db.PlayerBlockSet(user.ID, req.Payload.PlayerID, req.Payload.IsBlocked)
+	if err != nil {
+		return err
+	}
+
+	// blocking removes the friendship, follows and pending requests between the players
+	if req.Payload.IsBlocked {
+		go broadcastPlayerFriendsUpdate(user.ID, req.Payload.PlayerID)
+	}
+
+	reply(true)
+
+	return nil
+}
+
+const HubKeyPlayerBlockList = "PLAYER:BLOCK:LIST"
+
+func (prc *PlayerRelationshipController) BlockListHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	resp, err := db.PlayerBlockedList(user.ID)
+	if err != nil {
+		return err
+	}
+
+	reply(resp)
+
+	return nil
+}
@@ -11,7 +11,9 @@ import (
 	"server/db/boiler"
 	"server/gamedb"
 	"server/gamelog"
+	"server/quest"
 	"server/xsyn_rpcclient"
+	"strconv"
 	"time"
 
 	"github.com/friendsofgo/errors"
@@ -1219,6 +1221,24 @@ func (api *API) completeRepairAgent(repairAgentID string, userID string) error {
 
 	}
 
+	// progress the flawless repair objective quests, if the agent did not fail a block
+	failed, err := boiler.RepairGameBlockLogs(
+		boiler.RepairGameBlockLogWhere.RepairAgentID.EQ(ra.ID),
+		boiler.RepairGameBlockLogWhere.IsFailed.EQ(true),
+	).Exists(gamedb.StdConn)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to check failed repair game blocks")
+	} else if !failed {
+		api.questManager.Publish(&quest.Event{
+			Type:     db.QuestObjectiveEventRepairGameFlawless,
+			PlayerID: userID,
+			Amount:   1,
+			Fields: map[string]string{
+				"for_other": strconv.FormatBool(ra.R.RepairOffer.OfferedByID.Valid && ra.R.RepairOffer.OfferedByID.String != userID),
+			},
+		})
+	}
+
 	// broadcast result if repair is not completed
 	if rc.BlocksRepaired < rc.BlocksRequiredRepair {
 		canDeployRatio := db.GetDecimalWithDefault(db.KeyCanDeployDamagedRatio, decimal.NewFromFloat(0.5))
@@ -16,8 +16,8 @@ func (api *API) SecureUserCommand(key string, fn server.SecureCommandFunc) {
 	api.SecureUserCommander.Command(string(key), server.MustSecure(server.SecureUserTracer(fn, api.Config.Environment)))
 }
 
-func (api *API) SecureAdminCommand(key string, fn server.SecureCommandFunc) {
-	api.SecureUserCommander.Command(string(key), server.MustSecureAdmin(server.SecureUserTracer(fn, api.Config.Environment)))
+func (api *API) SecurePermissionCommand(perm server.Perm, key string, fn server.SecureCommandFunc) {
+	api.SecureUserCommander.Command(string(key), server.MustHavePermission(perm, server.SecureUserTracer(fn, api.Config.Environment)))
 }
 
 func (api *API) SecureUserFactionCommand(key string, fn server.SecureFactionCommandFunc) {
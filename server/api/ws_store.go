@@ -5,8 +5,8 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
-	"math/rand"
 	"server"
+	"server/asset"
 	"server/db"
 	"server/db/boiler"
 	"server/gamedb"
@@ -89,8 +89,9 @@ func (sc *StoreController) MysteryCrateSubscribeHandler(ctx context.Context, use
 
 type MysteryCratePurchaseRequest struct {
 	Payload struct {
-		Type     string `json:"type"`
-		Quantity int    `json:"quantity"`
+		Type             string `json:"type"`
+		Quantity         int    `json:"quantity"`
+		PlayerDiscountID string `json:"player_discount_id"` // optional discount redeemed from a coupon code
 	} `json:"payload"`
 }
 
@@ -135,9 +136,22 @@ func (sc *StoreController) PurchaseMysteryCrateHandler(ctx context.Context, user
 		return terror.Error(fmt.Errorf("unable to find correct pricing for crate"), "Failed to get crate for purchase, please try again or contact support.")
 	}
 
+	totalPrice := supPrice.Mul(decimal.NewFromInt(int64(req.Payload.Quantity)))
+	var discount *boiler.PlayerDiscount
+	if req.Payload.PlayerDiscountID != "" {
+		discount, err = db.PlayerDiscountAvailable(gamedb.StdConn, req.Payload.PlayerDiscountID, user.ID, db.DiscountTargetSups)
+		if err != nil {
+			return err
+		}
+		// discounted purchases still cost at least 1 SUPS, so they can be charged and refunded like any other
+		totalPrice = totalPrice.Sub(db.PlayerDiscountValue(discount, totalPrice.Sub(decimal.New(1, 18))))
+	}
+	// the price recorded against each crate
+	paidPrice := totalPrice.Div(decimal.NewFromInt(int64(req.Payload.Quantity))).Floor()
+
 	// -------------------------------------
 	supTransactionID, err := sc.API.Passport.SpendSupMessage(xsyn_rpcclient.SpendSupsReq{
-		Amount:               supPrice.Mul(decimal.NewFromInt(int64(req.Payload.Quantity))).String(),
+		Amount:               totalPrice.String(),
 		FromUserID:           uuid.FromStringOrNil(user.ID),
 		ToUserID:             uuid.FromStringOrNil(server.SupremacyGameUserID),
 		TransactionReference: server.TransactionReference(fmt.Sprintf("player_mystery_crate_purchase|%s|%d", storeCrate.ID, time.Now().UnixNano())),
@@ -162,7 +176,7 @@ func (sc *StoreController) PurchaseMysteryCrateHandler(ctx context.Context, user
 
 		txItem := &boiler.StorePurchaseHistory{
 			PlayerID:    user.ID,
-			Amount:      supPrice.Mul(decimal.NewFromInt(int64(req.Payload.Quantity))),
+			Amount:      totalPrice,
 			ItemType:    "mystery_crate",
 			ItemID:      storeCrate.ID,
 			Description: "refunding mystery crate due to failed transaction",
@@ -185,6 +199,19 @@ func (sc *StoreController) PurchaseMysteryCrateHandler(ctx context.Context, user
 	}
 	defer tx.Rollback()
 
+	if discount != nil {
+		used, err := db.PlayerDiscountUse(tx, discount.ID, supTransactionID)
+		if err != nil {
+			refundFunc()
+			gamelog.L.Error().Err(err).Str("player_discount_id", discount.ID).Msg("failed to mark discount as used")
+			return terror.Error(err, "Issue purchasing mystery crate, please try again or contact support.")
+		}
+		if !used {
+			refundFunc()
+			return terror.Error(fmt.Errorf("player discount %s has already been used", discount.ID), "This discount has been used or has expired.")
+		}
+	}
+
 	// Assign multiple crate purchases
 	var resp []Reward
 	var xsynAssets []*rpctypes.XsynAsset
@@ -197,7 +224,7 @@ func (sc *StoreController) PurchaseMysteryCrateHandler(ctx context.Context, user
 
 		txItem := &boiler.StorePurchaseHistory{
 			PlayerID:    user.ID,
-			Amount:      supPrice,
+			Amount:      paidPrice,
 			ItemType:    "mystery_crate",
 			ItemID:      assignedCrate.ID,
 			Description: "purchased mystery crate",
@@ -245,61 +272,5 @@ func (sc *StoreController) PurchaseMysteryCrateHandler(ctx context.Context, user
 }
 
 func assignAndRegisterPurchasedCrate(userID string, storeCrate *boiler.StorefrontMysteryCrate, tx *sql.Tx, api *API) (*server.MysteryCrate, *rpctypes.XsynAsset, error) {
-	availableCrates, err := boiler.MysteryCrates(
-		boiler.MysteryCrateWhere.FactionID.EQ(storeCrate.FactionID),
-		boiler.MysteryCrateWhere.Type.EQ(storeCrate.MysteryCrateType),
-		boiler.MysteryCrateWhere.Purchased.EQ(false),
-		boiler.MysteryCrateWhere.Opened.EQ(false),
-		qm.Load(boiler.MysteryCrateRels.Blueprint),
-	).All(tx)
-	if err != nil {
-		return nil, nil, terror.Error(err, "Failed to get available crates, please try again or contact support.")
-	}
-
-	faction, err := boiler.FindFaction(tx, storeCrate.FactionID)
-	if err != nil {
-		return nil, nil, terror.Error(err, "Failed to find faction, please try again or contact support.")
-	}
-
-	//randomly assigning crate to user
-	rand.Seed(time.Now().UnixNano())
-	assignedCrate := availableCrates[rand.Intn(len(availableCrates))]
-
-	//update purchased value
-	assignedCrate.Purchased = true
-
-	// set newly bought crates openable on staging/dev (this is so people cannot open already purchased crates and see what is in them)
-	if server.IsDevelopmentEnv() || server.IsStagingEnv() {
-		assignedCrate.LockedUntil = time.Now()
-	}
-
-	_, err = assignedCrate.Update(tx, boil.Infer())
-	if err != nil {
-		gamelog.L.Error().Err(err).Msg("unable to update assigned crate information")
-		return nil, nil, terror.Error(err, "Failed to get mystery crate, please try again or contact support.")
-	}
-
-	collectionItem, err := db.InsertNewCollectionItem(tx,
-		"supremacy-general",
-		boiler.ItemTypeMysteryCrate,
-		assignedCrate.ID,
-		"",
-		userID,
-	)
-	if err != nil {
-		gamelog.L.Error().Err(err).Interface("mystery crate", assignedCrate).Msg("failed to insert into collection items")
-		return nil, nil, terror.Error(err, "Failed to purchase mystery crate, please try again or contact support.")
-	}
-	storeCrate.AmountSold = storeCrate.AmountSold + 1
-	_, err = storeCrate.Update(tx, boil.Whitelist(boiler.StorefrontMysteryCrateColumns.AmountSold))
-	if err != nil {
-		gamelog.L.Error().Err(err).Interface("mystery crate", assignedCrate).Msg("failed to update crate amount sold")
-		return nil, nil, terror.Error(err, "Failed to get mystery crate, please try again or contact support.")
-	}
-
-	//register
-	assignedCrateServer := server.MysteryCrateFromBoiler(assignedCrate, collectionItem, null.String{})
-	xsynAsset := rpctypes.ServerMysteryCrateToXsynAsset(assignedCrateServer, faction.Label)
-
-	return assignedCrateServer, xsynAsset, nil
+	return asset.GiveMysteryCrate(tx, userID, storeCrate)
 }
@@ -0,0 +1,254 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"server"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+	"server/syndicate"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/ninja-software/terror/v2"
+	"github.com/ninja-syndicate/ws"
+	"github.com/sasha-s/go-deadlock"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// SyndicateChatrooms holds a chatroom for each syndicate, the chatrooms follow the syndicates of the syndicate system
+type SyndicateChatrooms struct {
+	deadlock.RWMutex
+	rooms map[string]*Chatroom
+}
+
+type MessageSyndicateNotice struct {
+	Notice string `json:"notice"`
+}
+
+// NewSyndicateChatrooms rehydrates the chatroom of every active syndicate
+func NewSyndicateChatrooms(ss *syndicate.System) *SyndicateChatrooms {
+	sc := &SyndicateChatrooms{
+		rooms: make(map[string]*Chatroom),
+	}
+
+	for _, syndicateID := range ss.SyndicateIDs() {
+		s, err := boiler.FindSyndicate(gamedb.StdConn, syndicateID)
+		if err != nil {
+			gamelog.L.Error().Err(err).Str("syndicate id", syndicateID).Msg("Failed to load syndicate for syndicate chat")
+			continue
+		}
+		sc.rooms[s.ID] = newChatroom(s.ID, s.FactionID)
+	}
+
+	return sc
+}
+
+func (sc *SyndicateChatrooms) get(syndicateID string) *Chatroom {
+	sc.RLock()
+	defer sc.RUnlock()
+
+	return sc.rooms[syndicateID]
+}
+
+func (sc *SyndicateChatrooms) handleEvent(event *syndicate.ChatEvent) {
+	switch event.Type {
+	case syndicate.ChatEventTypeCreated:
+		sc.Lock()
+		sc.rooms[event.SyndicateID] = newChatroom(event.SyndicateID, event.FactionID)
+		sc.Unlock()
+
+	case syndicate.ChatEventTypeRemoved:
+		sc.Lock()
+		delete(sc.rooms, event.SyndicateID)
+		sc.Unlock()
+
+	case syndicate.ChatEventTypeNotice:
+		err := sc.postNotice(event.SyndicateID, event.FactionID, event.Notice)
+		if err != nil {
+			gamelog.L.Error().Err(err).Interface("event", event).Msg("Failed to post notice in syndicate chat")
+		}
+	}
+}
+
+// postNotice stores the notice in the chat history and sends it to the syndicate members
+func (sc *SyndicateChatrooms) postNotice(syndicateID string, factionID string, notice string) error {
+	room := sc.get(syndicateID)
+	if room == nil {
+		return nil
+	}
+
+	cm := &ChatMessage{
+		ID:     uuid.Must(uuid.NewV4()).String(),
+		Type:   ChatMessageTypeSyndicateNotice,
+		SentAt: time.Now(),
+		Data:   &MessageSyndicateNotice{Notice: notice},
+	}
+
+	var jsonMeta null.JSON
+	err := jsonMeta.Marshal(cm)
+	if err != nil {
+		return err
+	}
+
+	ch := &boiler.ChatHistory{
+		ID:         cm.ID,
+		FactionID:  null.StringFrom(factionID),
+		PlayerID:   server.SupremacySystemAdminUserID,
+		MSGType:    boiler.ChatMSGTypeEnumSYNDICATE_NOTICE,
+		ChatStream: syndicateID,
+		Metadata:   jsonMeta,
+	}
+	err = ch.Insert(gamedb.StdConn, boil.Infer())
+	if err != nil {
+		return terror.Error(err, "Could not create SYNDICATE_NOTICE message in chat history.")
+	}
+
+	room.AddMessage(cm)
+	ws.PublishMessage(syndicateChatRoute(factionID, syndicateID), server.HubKeySyndicateChatSubscribe, []*ChatMessage{cm})
+
+	return nil
+}
+
+func syndicateChatRoute(factionID string, syndicateID string) string {
+	return fmt.Sprintf("/faction/%s/syndicate/%s/chat", factionID, syndicateID)
+}
+
+// syndicateChatroom returns the chatroom of the player's syndicate
+func (fc *ChatController) syndicateChatroom(user *boiler.Player) (*Chatroom, error) {
+	if !user.SyndicateID.Valid {
+		return nil, terror.Error(fmt.Errorf("player has no syndicate"), "You have not joined any syndicate.")
+	}
+
+	room := fc.API.SyndicateChats.get(user.SyndicateID.String)
+	if room == nil {
+		return nil, terror.Error(fmt.Errorf("syndicate chatroom not found"), "Syndicate chat is not available.")
+	}
+
+	return room, nil
+}
+
+func (fc *ChatController) SyndicateChatSubscribeHandler(ctx context.Context, user *boiler.Player, factionID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	room, err := fc.syndicateChatroom(user)
+	if err != nil {
+		return err
+	}
+
+	resp := []*ChatMessage{}
+	room.ReadRange(func(message *ChatMessage) bool {
+		resp = append(resp, message)
+		return true
+	})
+
+	reply(resp)
+	return nil
+}
+
+type SyndicateChatRequest struct {
+	Payload struct {
+		ID              string `json:"id"`
+		MessageColor    string `json:"message_color"`
+		Message         string `json:"message"`
+		TaggedUsersGids []int  `json:"tagged_users_gids"`
+	} `json:"payload"`
+}
+
+const HubKeySyndicateChatMessage = "SYNDICATE:CHAT:MESSAGE"
+
+func (fc *ChatController) SyndicateChatMessageHandler(ctx context.Context, user *boiler.Player, factionID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	b1 := bucket.Add(user.ID, 1)
+
+	if b1 == 0 {
+		return terror.Warn(fmt.Errorf("too many messages"), "Too many messages.")
+	}
+
+	req := &SyndicateChatRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	room, err := fc.syndicateChatroom(user)
+	if err != nil {
+		return err
+	}
+
+	draft, err := fc.draftChatMessage(user, req.Payload.Message, req.Payload.TaggedUsersGids)
+	if err != nil {
+		return err
+	}
+
+	// user's fingerprint banned (shadow ban)
+	if draft == nil {
+		reply(true)
+		return nil
+	}
+
+	killCount := ""
+	if draft.stat != nil {
+		killCount = fmt.Sprintf("%d", draft.stat.AbilityKillCount)
+	}
+
+	cm := boiler.ChatHistory{
+		ID:           req.Payload.ID,
+		FactionID:    null.StringFrom(factionID),
+		PlayerID:     user.ID,
+		MessageColor: req.Payload.MessageColor,
+		MSGType:      boiler.ChatMSGTypeEnumTEXT,
+		UserRank:     user.Rank,
+		KillCount:    killCount,
+		Text:         draft.text,
+		ChatStream:   user.SyndicateID.String,
+		Lang:         draft.language,
+		Metadata:     draft.metadata,
+	}
+
+	err = cm.Insert(gamedb.StdConn, boil.Infer())
+	if err != nil {
+		gamelog.L.Error().Err(err).Msg("unable to insert msg into chat history")
+	}
+
+	// check player quest reward
+	fc.API.questManager.ChatMessageQuestCheck(user.ID)
+
+	chatMessage := &ChatMessage{
+		ID:     cm.ID,
+		Type:   boiler.ChatMSGTypeEnumTEXT,
+		SentAt: cm.CreatedAt,
+		Data: &MessageText{
+			ID:           cm.ID,
+			Message:      draft.text,
+			MessageColor: req.Payload.MessageColor,
+			FromUser:     draft.player,
+			UserRank:     draft.player.Rank,
+			FromUserStat: draft.stat,
+			Lang:         draft.language,
+			Metadata:     draft.metadata,
+		},
+	}
+
+	room.AddMessage(chatMessage)
+	ws.PublishMessage(syndicateChatRoute(factionID, user.SyndicateID.String), server.HubKeySyndicateChatSubscribe, []*ChatMessage{chatMessage})
+	reply(true)
+
+	return nil
+}
+
+const HubKeySyndicateChatHistoryList = "SYNDICATE:CHAT:HISTORY:LIST"
+
+func (fc *ChatController) SyndicateChatHistoryListHandler(ctx context.Context, user *boiler.Player, factionID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	if !user.SyndicateID.Valid {
+		return terror.Error(fmt.Errorf("player has no syndicate"), "You have not joined any syndicate.")
+	}
+
+	resp, err := chatHistoryList(user.SyndicateID.String, payload)
+	if err != nil {
+		return err
+	}
+
+	reply(resp)
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/kevinms/leakybucket-go"
 	"github.com/ninja-software/terror/v2"
 	"github.com/ninja-syndicate/ws"
+	"github.com/shopspring/decimal"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -38,6 +39,9 @@ func NewSyndicateController(api *API) {
 	api.SecureUserFactionCommand(HubKeySyndicateVoteMotion, api.SyndicateVoteMotionHandler)
 	api.SecureUserFactionCommand(HubKeySyndicateMotionList, api.SyndicateMotionListHandler)
 
+	// dues
+	api.SecureUserFactionCommand(HubKeySyndicateMemberDuesList, api.SyndicateMemberDuesListHandler)
+
 	// leader action
 	api.SecureUserFactionCommand(HubKeySyndicateLeaderFinaliseMotion, api.SyndicateLeaderFinaliseMotionHandler)
 	api.SecureUserFactionCommand(HubKeySyndicateLeaderFinaliseJoinApplication, api.SyndicateLeaderFinaliseJoinApplicationHandler)
@@ -289,6 +293,9 @@ func (api *API) SyndicateLeaveHandler(ctx context.Context, user *boiler.Player,
 			gamelog.L.Error().Err(err).Msg("Failed to commit db transaction")
 			return terror.Error(err, "Failed to exit syndicate")
 		}
+
+		api.SyndicateSystem.RemoveSyndicateChat(syndicate.ID, syndicate.FactionID)
+
 		reply(true)
 		return nil
 	} else {
@@ -535,6 +542,50 @@ func (api *API) SyndicateMotionListHandler(ctx context.Context, user *boiler.Pla
 	return nil
 }
 
+type SyndicateMemberDuesListRequest struct {
+	Payload struct {
+		PageSize   int `json:"page_size"`
+		PageNumber int `json:"page_number"`
+	} `json:"payload"`
+}
+
+type SyndicateMemberDuesListResponse struct {
+	SyndicateMemberDues []*boiler.SyndicateMemberDue `json:"syndicate_member_dues"`
+	Arrears             decimal.Decimal              `json:"arrears"`
+	Total               int64                        `json:"total"`
+}
+
+const HubKeySyndicateMemberDuesList = "SYNDICATE:MEMBER:DUES:LIST"
+
+func (api *API) SyndicateMemberDuesListHandler(ctx context.Context, user *boiler.Player, factionID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	if !user.SyndicateID.Valid {
+		return terror.Error(fmt.Errorf("player has no syndicate"), "You have not join any syndicate yet.")
+	}
+
+	req := &SyndicateMemberDuesListRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	limit := req.Payload.PageSize
+	offset := req.Payload.PageNumber * req.Payload.PageSize
+
+	dues, total, err := db.SyndicateMemberDuesList(user.SyndicateID.String, user.ID, limit, offset)
+	if err != nil {
+		return err
+	}
+
+	arrears, err := db.SyndicateMemberArrears(user.SyndicateID.String, user.ID)
+	if err != nil {
+		return err
+	}
+
+	reply(&SyndicateMemberDuesListResponse{dues, arrears, total})
+
+	return nil
+}
+
 type SyndicateLeaderFinaliseMotionRequest struct {
 	Payload struct {
 		IsAccepted bool   `json:"is_accepted"`
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"server"
@@ -12,12 +13,12 @@ import (
 	"server/system_messages"
 	"time"
 
+	"github.com/friendsofgo/errors"
 	"github.com/ninja-software/terror/v2"
 	"github.com/ninja-syndicate/hub"
 	"github.com/ninja-syndicate/ws"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
-	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 type SystemMessagesController struct {
@@ -48,6 +49,8 @@ type SystemMessageListRequest struct {
 type SystemMessageDetailed struct {
 	*boiler.SystemMessage
 	Sender boiler.Player `json:"sender"`
+	// IsBroadcast is set on the messages sent to an audience of players, rather than to the player alone
+	IsBroadcast bool `json:"is_broadcast"`
 }
 
 type SystemMessageListResponse struct {
@@ -56,6 +59,7 @@ type SystemMessageListResponse struct {
 	SystemMessages []*SystemMessageDetailed `json:"system_messages"`
 }
 
+// SystemMessageListHandler lists the personal and broadcast system messages of the player together, newest first.
 func (smc *SystemMessagesController) SystemMessageListHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
 	l := gamelog.L.With().Str("func", "SystemMessageListHandler").Logger()
 
@@ -76,57 +80,53 @@ func (smc *SystemMessagesController) SystemMessageListHandler(ctx context.Contex
 		offset = req.Payload.Page * pageSize
 	}
 
-	queryMods := []qm.QueryMod{}
-	queryMods = append(queryMods,
-		boiler.SystemMessageWhere.PlayerID.EQ(user.ID),
-	)
-
-	if req.Payload.HideRead {
-		queryMods = append(queryMods,
-			boiler.SystemMessageWhere.ReadAt.IsNull(),
-		)
-	}
-
-	total, err := boiler.SystemMessages(queryMods...).Count(gamedb.StdConn)
+	sms, total, totalUnread, err := db.PlayerSystemMessageList(user.ID, req.Payload.HideRead, pageSize, offset)
 	if err != nil {
-		l.Error().Err(err).Interface("queryMods", queryMods).Msg("failed to get system message total count")
+		l.Error().Err(err).Msg("failed to get system messages")
 		return terror.Error(err, "Failed to fetch system messages. Please try again later.")
 	}
 
-	unreadQueryMods := []qm.QueryMod{}
-	unreadQueryMods = append(unreadQueryMods, queryMods...)
-	unreadQueryMods = append(unreadQueryMods, boiler.SystemMessageWhere.ReadAt.IsNull())
-	l = l.With().Interface("unreadQueryMods", unreadQueryMods).Logger()
-	totalUnread, err := boiler.SystemMessages(unreadQueryMods...).Count(gamedb.StdConn)
-	if err != nil {
-		l.Error().Err(err).Msg("failed to get system message unread count")
-		return terror.Error(err, "Failed to fetch system messages. Please try again later.")
+	senderIDs := []string{}
+	for _, s := range sms {
+		senderIDs = append(senderIDs, s.SenderID)
 	}
 
-	queryMods = append(queryMods,
-		qm.OrderBy(fmt.Sprintf("%s desc", boiler.SystemMessageColumns.SentAt)),
-		qm.Limit(pageSize),
-		qm.Offset(offset),
-		qm.Load(boiler.SystemMessageRels.Sender),
-	)
-	l = l.With().Interface("queryMods", queryMods).Logger()
-	sms, err := boiler.SystemMessages(queryMods...).All(gamedb.StdConn)
+	senders, err := boiler.Players(boiler.PlayerWhere.ID.IN(senderIDs)).All(gamedb.StdConn)
 	if err != nil {
-		l.Error().Err(err).Msg("failed to get system messages")
+		l.Error().Err(err).Msg("failed to get system message senders")
 		return terror.Error(err, "Failed to fetch system messages. Please try again later.")
 	}
 
+	sendersByID := make(map[string]*boiler.Player)
+	for _, sender := range senders {
+		sendersByID[sender.ID] = sender
+	}
+
 	detailedSms := []*SystemMessageDetailed{}
 	for _, s := range sms {
-		detailedSms = append(detailedSms, &SystemMessageDetailed{
-			SystemMessage: s,
-			Sender:        *s.R.Sender,
-		})
+		dsm := &SystemMessageDetailed{
+			SystemMessage: &boiler.SystemMessage{
+				ID:       s.ID,
+				PlayerID: user.ID,
+				SenderID: s.SenderID,
+				Title:    s.Title,
+				Message:  s.Message,
+				Data:     s.Data,
+				DataType: s.DataType,
+				SentAt:   s.SentAt,
+				ReadAt:   s.ReadAt,
+			},
+			IsBroadcast: s.IsBroadcast,
+		}
+		if sender, ok := sendersByID[s.SenderID]; ok {
+			dsm.Sender = *sender
+		}
+		detailedSms = append(detailedSms, dsm)
 	}
 
 	reply(&SystemMessageListResponse{
-		Total:          int(total),
-		TotalUnread:    int(totalUnread),
+		Total:          total,
+		TotalUnread:    totalUnread,
 		SystemMessages: detailedSms,
 	})
 
@@ -140,6 +140,7 @@ type SystemMessageDismissRequest struct {
 	} `json:"payload"`
 }
 
+// SystemMessageDismissHandler marks a personal system message as read, or records a read receipt of a broadcast system message.
 func (smc *SystemMessagesController) SystemMessageDismissHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
 	l := gamelog.L.With().Str("func", "SystemMessageDismissHandler").Logger()
 
@@ -154,12 +155,37 @@ func (smc *SystemMessagesController) SystemMessageDismissHandler(ctx context.Con
 		return terror.Error(terror.ErrInvalidInput)
 	}
 
-	sm, err := boiler.FindSystemMessage(gamedb.StdConn, req.Payload.ID)
-	if err != nil {
+	sm, err := boiler.SystemMessages(
+		boiler.SystemMessageWhere.ID.EQ(req.Payload.ID),
+		boiler.SystemMessageWhere.PlayerID.EQ(user.ID),
+	).One(gamedb.StdConn)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		l.Error().Err(err).Msg("failed to find system message")
 		return terror.Error(err, "Failed to dismiss system message. Please try again later.")
 	}
 
+	// not a personal message, so it is a broadcast
+	if sm == nil {
+		visible, err := db.BroadcastSystemMessageVisible(gamedb.StdConn, user.ID, req.Payload.ID)
+		if err != nil {
+			l.Error().Err(err).Msg("failed to find broadcast system message")
+			return terror.Error(err, "Failed to dismiss system message. Please try again later.")
+		}
+		if !visible {
+			return terror.Error(fmt.Errorf("system message not found"), "System message not found.")
+		}
+
+		err = db.BroadcastSystemMessageRead(gamedb.StdConn, user.ID, req.Payload.ID)
+		if err != nil {
+			l.Error().Err(err).Msg("failed to insert broadcast system message receipt")
+			return terror.Error(err, "Failed to dismiss system message. Please try again later.")
+		}
+
+		ws.PublishMessage(fmt.Sprintf("/secure/user/%s/system_messages", user.ID), server.HubKeySystemMessageListUpdatedSubscribe, true)
+
+		return nil
+	}
+
 	if sm.ReadAt.Valid {
 		return nil
 	}
@@ -0,0 +1,515 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"server"
+	"server/battle"
+	"server/db"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+	"server/xsyn_rpcclient"
+	"strings"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/gofrs/uuid"
+	"github.com/ninja-software/terror/v2"
+	"github.com/ninja-syndicate/ws"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func NewTournamentController(api *API) {
+	api.Command(HubKeyTournamentList, api.TournamentList)
+	api.SecureUserFactionCommand(HubKeyTournamentCreate, api.TournamentCreate)
+	api.SecureUserFactionCommand(HubKeyTournamentJoin, api.TournamentJoin)
+	api.SecureUserCommand(HubKeyTournamentLeave, api.TournamentLeave)
+	api.SecureUserCommand(HubKeyTournamentStart, api.TournamentStart)
+	api.SecureUserCommand(HubKeyTournamentCancel, api.TournamentCancel)
+}
+
+type TournamentCreateRequest struct {
+	Payload struct {
+		Name               string            `json:"name"`
+		Format             string            `json:"format"`
+		EntryFee           decimal.Decimal   `json:"entry_fee"`  // in sups
+		HostPrize          decimal.Decimal   `json:"host_prize"` // in sups, added to the prize pool
+		MinEntrants        int               `json:"min_entrants"`
+		MaxEntrants        int               `json:"max_entrants"`
+		SwissRounds        null.Int          `json:"swiss_rounds"`
+		RegistrationEndsAt time.Time         `json:"registration_ends_at"`
+		GameMapIDs         []string          `json:"game_map_ids"`
+		PrizeSplits        []decimal.Decimal `json:"prize_splits"` // share of the prize pool from first place down
+		SyndicateID        null.String       `json:"syndicate_id"` // syndicate tournaments are only open to its members
+	} `json:"payload"`
+}
+
+const HubKeyTournamentCreate = "TOURNAMENT:CREATE"
+
+// TournamentCreate creates a tournament hosted by a player, or by a syndicate when the player runs the syndicate.
+func (api *API) TournamentCreate(ctx context.Context, user *boiler.Player, factionID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &TournamentCreateRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	if req.Payload.SyndicateID.Valid {
+		syndicate, err := boiler.FindSyndicate(gamedb.StdConn, req.Payload.SyndicateID.String)
+		if errors.Is(err, sql.ErrNoRows) {
+			return terror.Error(err, "Syndicate does not exist.")
+		}
+		if err != nil {
+			return terror.Error(err, "Failed to load syndicate.")
+		}
+
+		if syndicate.AdminID.String != user.ID && syndicate.CeoPlayerID.String != user.ID {
+			return terror.Error(fmt.Errorf("player is not the admin or ceo of the syndicate"), "Only the admin or CEO of a syndicate can host its tournaments.")
+		}
+	}
+
+	t, err := tournamentCreate(api, req, user.ID, user.ID, false)
+	if err != nil {
+		return err
+	}
+
+	reply(t)
+	return nil
+}
+
+// tournamentCreate validates and inserts a tournament, and takes the host prize from the account paying it.
+func tournamentCreate(api *API, req *TournamentCreateRequest, hostByID string, prizePayerID string, isAdminHosted bool) (*db.TournamentState, error) {
+	name := strings.TrimSpace(req.Payload.Name)
+	if name == "" {
+		return nil, terror.Error(fmt.Errorf("missing tournament name"), "Tournament name is required.")
+	}
+	if !db.TournamentFormatIsValid(req.Payload.Format) {
+		return nil, terror.Error(fmt.Errorf("invalid format %s", req.Payload.Format), "Invalid tournament format.")
+	}
+	if req.Payload.EntryFee.IsNegative() || req.Payload.HostPrize.IsNegative() {
+		return nil, terror.Error(fmt.Errorf("negative entry fee or host prize"), "Entry fee and prize cannot be negative.")
+	}
+	if req.Payload.MinEntrants == 0 {
+		req.Payload.MinEntrants = 2
+	}
+	if req.Payload.MinEntrants < 2 || req.Payload.MaxEntrants < req.Payload.MinEntrants {
+		return nil, terror.Error(fmt.Errorf("invalid entrant limits"), "A tournament needs at least two entrants, and the maximum cannot be less than the minimum.")
+	}
+	if req.Payload.SwissRounds.Valid && (req.Payload.Format != db.TournamentFormatSwiss || req.Payload.SwissRounds.Int < 1) {
+		return nil, terror.Error(fmt.Errorf("invalid swiss rounds"), "Swiss rounds can only be set to at least one round of a Swiss tournament.")
+	}
+	if !req.Payload.RegistrationEndsAt.After(time.Now()) {
+		return nil, terror.Error(fmt.Errorf("registration ends in the past"), "Registration must end in the future.")
+	}
+	if len(req.Payload.PrizeSplits) == 0 || len(req.Payload.PrizeSplits) > req.Payload.MaxEntrants {
+		return nil, terror.Error(fmt.Errorf("invalid prize split count"), "Prize splits must be given for at least one and at most every placement.")
+	}
+
+	total := decimal.Zero
+	for _, share := range req.Payload.PrizeSplits {
+		if !share.IsPositive() || share.GreaterThan(decimal.NewFromInt(1)) {
+			return nil, terror.Error(fmt.Errorf("invalid prize split"), "Each prize split must be more than zero and at most one.")
+		}
+		total = total.Add(share)
+	}
+	if !total.Equal(decimal.NewFromInt(1)) {
+		return nil, terror.Error(fmt.Errorf("prize splits add up to %s", total), "Prize splits must add up to one.")
+	}
+
+	for _, gameMapID := range req.Payload.GameMapIDs {
+		exists, err := boiler.GameMaps(
+			boiler.GameMapWhere.ID.EQ(gameMapID),
+			boiler.GameMapWhere.DisabledAt.IsNull(),
+		).Exists(gamedb.StdConn)
+		if err != nil {
+			return nil, terror.Error(err, "Failed to check game map.")
+		}
+		if !exists {
+			return nil, terror.Error(fmt.Errorf("game map %s is not available", gameMapID), "A map in the map pool is not available.")
+		}
+	}
+
+	t := &boiler.Tournament{
+		Name:               name,
+		HostByID:           hostByID,
+		SyndicateID:        req.Payload.SyndicateID,
+		IsAdminHosted:      isAdminHosted,
+		Format:             req.Payload.Format,
+		Status:             db.TournamentStatusRegistration,
+		EntryFee:           req.Payload.EntryFee.Mul(decimal.New(1, 18)),
+		HostPrize:          req.Payload.HostPrize.Mul(decimal.New(1, 18)),
+		MinEntrants:        req.Payload.MinEntrants,
+		MaxEntrants:        req.Payload.MaxEntrants,
+		SwissRounds:        req.Payload.SwissRounds,
+		RegistrationEndsAt: req.Payload.RegistrationEndsAt,
+	}
+
+	tx, err := gamedb.StdConn.Begin()
+	if err != nil {
+		gamelog.L.Error().Err(err).Msg("Failed to start db transaction.")
+		return nil, terror.Error(err, "Failed to create tournament.")
+	}
+	defer tx.Rollback()
+
+	err = t.Insert(tx, boil.Infer())
+	if err != nil {
+		gamelog.L.Error().Err(err).Interface("tournament", t).Msg("Failed to insert tournament.")
+		return nil, terror.Error(err, "Failed to create tournament.")
+	}
+
+	for i, share := range req.Payload.PrizeSplits {
+		split := &boiler.TournamentPrizeSplit{
+			TournamentID: t.ID,
+			Placement:    i + 1,
+			Share:        share,
+		}
+		err = split.Insert(tx, boil.Infer())
+		if err != nil {
+			gamelog.L.Error().Err(err).Interface("prize split", split).Msg("Failed to insert tournament prize split.")
+			return nil, terror.Error(err, "Failed to create tournament.")
+		}
+	}
+
+	for _, gameMapID := range req.Payload.GameMapIDs {
+		tm := &boiler.TournamentMap{
+			TournamentID: t.ID,
+			GameMapID:    gameMapID,
+		}
+		err = tm.Insert(tx, boil.Infer())
+		if err != nil {
+			gamelog.L.Error().Err(err).Interface("tournament map", tm).Msg("Failed to insert tournament map.")
+			return nil, terror.Error(err, "Failed to create tournament.")
+		}
+	}
+
+	if t.HostPrize.IsPositive() {
+		if api.Passport.UserBalanceGet(uuid.FromStringOrNil(prizePayerID)).LessThan(t.HostPrize) {
+			return nil, terror.Error(fmt.Errorf("insufficient balance"), "Insufficient balance to put up the prize.")
+		}
+
+		txID, err := api.Passport.SpendSupMessage(xsyn_rpcclient.SpendSupsReq{
+			FromUserID:           uuid.Must(uuid.FromString(prizePayerID)),
+			ToUserID:             uuid.Must(uuid.FromString(server.SupremacyBattleUserID)),
+			Amount:               t.HostPrize.StringFixed(0),
+			TransactionReference: server.TransactionReference(fmt.Sprintf("tournament_host_prize|%s|%d", t.ID, time.Now().UnixNano())),
+			Group:                string(server.TransactionGroupSupremacy),
+			SubGroup:             string(server.TransactionGroupBattle),
+			Description:          fmt.Sprintf("prize for hosting tournament %s.", t.Name),
+		})
+		if err != nil {
+			return nil, terror.Error(err, "Failed to pay the tournament prize.")
+		}
+
+		t.HostPrizeTXID = null.StringFrom(txID)
+		_, err = t.Update(tx, boil.Whitelist(boiler.TournamentColumns.HostPrizeTXID))
+		if err != nil {
+			gamelog.L.Error().Err(err).Interface("tournament", t).Msg("Failed to update tournament host prize.")
+			_, refundErr := api.Passport.RefundSupsMessage(txID)
+			if refundErr != nil {
+				gamelog.L.Error().Err(refundErr).Str("tx id", txID).Msg("Failed to refund tournament host prize.")
+			}
+			return nil, terror.Error(err, "Failed to create tournament.")
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		gamelog.L.Error().Err(err).Msg("Failed to commit db transaction.")
+		if t.HostPrizeTXID.Valid {
+			_, refundErr := api.Passport.RefundSupsMessage(t.HostPrizeTXID.String)
+			if refundErr != nil {
+				gamelog.L.Error().Err(refundErr).Str("tx id", t.HostPrizeTXID.String).Msg("Failed to refund tournament host prize.")
+			}
+		}
+		return nil, terror.Error(err, "Failed to create tournament.")
+	}
+
+	battle.BroadcastTournament(t.ID)
+
+	return db.TournamentGet(gamedb.StdConn, t.ID)
+}
+
+type TournamentJoinRequest struct {
+	Payload struct {
+		TournamentID string `json:"tournament_id"`
+		MechID       string `json:"mech_id"`
+	} `json:"payload"`
+}
+
+const HubKeyTournamentJoin = "TOURNAMENT:JOIN"
+
+// TournamentJoin enters a player into a tournament with one of their mechs, paying the entry fee.
+func (api *API) TournamentJoin(ctx context.Context, user *boiler.Player, factionID string, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &TournamentJoinRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	mechIDs, err := MechAuthorisationFilter(user, factionID, []string{req.Payload.MechID})
+	if err != nil {
+		return err
+	}
+	if len(mechIDs) == 0 {
+		return terror.Error(fmt.Errorf("mech not available"), "The mech cannot be entered into a tournament.")
+	}
+
+	err = api.ArenaManager.SendTournamentFunc(func() error {
+		t, err := boiler.FindTournament(gamedb.StdConn, req.Payload.TournamentID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return terror.Error(err, "Tournament does not exist.")
+		}
+		if err != nil {
+			return terror.Error(err, "Failed to load tournament.")
+		}
+
+		if t.Status != db.TournamentStatusRegistration || !t.RegistrationEndsAt.After(time.Now()) {
+			return terror.Error(fmt.Errorf("registration is closed"), "Registration for this tournament has closed.")
+		}
+
+		if t.SyndicateID.Valid && user.SyndicateID.String != t.SyndicateID.String {
+			return terror.Error(fmt.Errorf("player is not in the syndicate"), "This tournament is only open to members of the hosting syndicate.")
+		}
+
+		entrants, err := db.TournamentEntrants(gamedb.StdConn, t.ID)
+		if err != nil {
+			return err
+		}
+		if len(entrants) >= t.MaxEntrants {
+			return terror.Error(fmt.Errorf("tournament is full"), "This tournament is full.")
+		}
+		for _, te := range entrants {
+			if te.PlayerID == user.ID {
+				return terror.Error(fmt.Errorf("already entered"), "You have already entered this tournament.")
+			}
+			if te.MechID == req.Payload.MechID {
+				return terror.Error(fmt.Errorf("mech already entered"), "This mech has already been entered into this tournament.")
+			}
+		}
+
+		entered, err := db.TournamentMechEntered(gamedb.StdConn, req.Payload.MechID)
+		if err != nil {
+			return err
+		}
+		if entered {
+			return terror.Error(fmt.Errorf("mech entered into another tournament"), "This mech is already entered into another tournament.")
+		}
+
+		te := &boiler.TournamentEntrant{
+			TournamentID: t.ID,
+			PlayerID:     user.ID,
+			FactionID:    factionID,
+			MechID:       req.Payload.MechID,
+		}
+
+		if t.EntryFee.IsPositive() {
+			if api.Passport.UserBalanceGet(uuid.FromStringOrNil(user.ID)).LessThan(t.EntryFee) {
+				return terror.Error(fmt.Errorf("insufficient balance"), "Insufficient balance to pay the entry fee.")
+			}
+
+			paidTXID, err := api.Passport.SpendSupMessage(xsyn_rpcclient.SpendSupsReq{
+				FromUserID:           uuid.Must(uuid.FromString(user.ID)),
+				ToUserID:             uuid.Must(uuid.FromString(server.SupremacyBattleUserID)),
+				Amount:               t.EntryFee.StringFixed(0),
+				TransactionReference: server.TransactionReference(fmt.Sprintf("tournament_entry_fee|%s|%s|%d", t.ID, user.ID, time.Now().UnixNano())),
+				Group:                string(server.TransactionGroupSupremacy),
+				SubGroup:             string(server.TransactionGroupBattle),
+				Description:          fmt.Sprintf("entry fee of tournament %s.", t.Name),
+			})
+			if err != nil {
+				return terror.Error(err, "Failed to pay the entry fee.")
+			}
+			te.PaidTXID = null.StringFrom(paidTXID)
+		}
+
+		err = te.Insert(gamedb.StdConn, boil.Infer())
+		if err != nil {
+			gamelog.L.Error().Err(err).Interface("tournament entrant", te).Msg("Failed to insert tournament entrant.")
+			if te.PaidTXID.Valid {
+				_, refundErr := api.Passport.RefundSupsMessage(te.PaidTXID.String)
+				if refundErr != nil {
+					gamelog.L.Error().Err(refundErr).Str("paid tx id", te.PaidTXID.String).Msg("Failed to refund tournament entry fee.")
+				}
+			}
+			return terror.Error(err, "Failed to enter tournament.")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	battle.BroadcastTournament(req.Payload.TournamentID)
+
+	reply(true)
+	return nil
+}
+
+type TournamentRequest struct {
+	Payload struct {
+		TournamentID string `json:"tournament_id"`
+	} `json:"payload"`
+}
+
+const HubKeyTournamentLeave = "TOURNAMENT:LEAVE"
+
+// TournamentLeave withdraws a player from a tournament before it starts and refunds their entry fee.
+func (api *API) TournamentLeave(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &TournamentRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	err = api.ArenaManager.SendTournamentFunc(func() error {
+		t, err := boiler.FindTournament(gamedb.StdConn, req.Payload.TournamentID)
+		if err != nil {
+			return terror.Error(err, "Failed to load tournament.")
+		}
+		if t.Status != db.TournamentStatusRegistration {
+			return terror.Error(fmt.Errorf("tournament has started"), "You cannot leave a tournament after it has started.")
+		}
+
+		te, err := boiler.TournamentEntrants(
+			boiler.TournamentEntrantWhere.TournamentID.EQ(t.ID),
+			boiler.TournamentEntrantWhere.PlayerID.EQ(user.ID),
+			boiler.TournamentEntrantWhere.WithdrawnAt.IsNull(),
+		).One(gamedb.StdConn)
+		if errors.Is(err, sql.ErrNoRows) {
+			return terror.Error(err, "You have not entered this tournament.")
+		}
+		if err != nil {
+			return terror.Error(err, "Failed to load tournament entry.")
+		}
+
+		if te.PaidTXID.Valid && !te.RefundTXID.Valid {
+			refundTXID, err := api.Passport.RefundSupsMessage(te.PaidTXID.String)
+			if err != nil {
+				gamelog.L.Error().Err(err).Str("paid tx id", te.PaidTXID.String).Msg("Failed to refund tournament entry fee.")
+				return terror.Error(err, "Failed to refund the entry fee.")
+			}
+			te.RefundTXID = null.StringFrom(refundTXID)
+		}
+
+		te.WithdrawnAt = null.TimeFrom(time.Now())
+		_, err = te.Update(gamedb.StdConn, boil.Whitelist(boiler.TournamentEntrantColumns.RefundTXID, boiler.TournamentEntrantColumns.WithdrawnAt))
+		if err != nil {
+			gamelog.L.Error().Err(err).Interface("tournament entrant", te).Msg("Failed to withdraw tournament entrant.")
+			return terror.Error(err, "Failed to leave tournament.")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	battle.BroadcastTournament(req.Payload.TournamentID)
+
+	reply(true)
+	return nil
+}
+
+// tournamentHostCheck returns an error when a player is not the host of a tournament.
+func tournamentHostCheck(user *boiler.Player, tournamentID string) error {
+	t, err := boiler.FindTournament(gamedb.StdConn, tournamentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return terror.Error(err, "Tournament does not exist.")
+	}
+	if err != nil {
+		return terror.Error(err, "Failed to load tournament.")
+	}
+	if t.HostByID != user.ID || t.IsAdminHosted {
+		return terror.Error(fmt.Errorf("player is not the host"), "Only the host can manage this tournament.")
+	}
+	return nil
+}
+
+const HubKeyTournamentStart = "TOURNAMENT:START"
+
+// TournamentStart lets the host start a tournament before registration ends.
+func (api *API) TournamentStart(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &TournamentRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	err = tournamentHostCheck(user, req.Payload.TournamentID)
+	if err != nil {
+		return err
+	}
+
+	err = api.ArenaManager.TournamentStart(req.Payload.TournamentID)
+	if err != nil {
+		return err
+	}
+
+	reply(true)
+	return nil
+}
+
+const HubKeyTournamentCancel = "TOURNAMENT:CANCEL"
+
+// TournamentCancel lets the host cancel a tournament before it starts, which refunds every entrant.
+func (api *API) TournamentCancel(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &TournamentRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	err = tournamentHostCheck(user, req.Payload.TournamentID)
+	if err != nil {
+		return err
+	}
+
+	err = api.ArenaManager.TournamentCancel(req.Payload.TournamentID, true)
+	if err != nil {
+		return err
+	}
+
+	reply(true)
+	return nil
+}
+
+const HubKeyTournamentList = "TOURNAMENT:LIST"
+
+// TournamentList returns the tournaments which are open for registration or being played.
+func (api *API) TournamentList(ctx context.Context, key string, payload []byte, reply ws.ReplyFunc) error {
+	ts, err := db.TournamentList(gamedb.StdConn, db.TournamentStatusRegistration, db.TournamentStatusInProgress)
+	if err != nil {
+		return err
+	}
+
+	reply(ts)
+	return nil
+}
+
+// TournamentListSubscribeHandler sends the tournaments which are open for registration or being played.
+func (api *API) TournamentListSubscribeHandler(ctx context.Context, key string, payload []byte, reply ws.ReplyFunc) error {
+	return api.TournamentList(ctx, key, payload, reply)
+}
+
+// TournamentSubscribeHandler sends the bracket state of a tournament.
+func (api *API) TournamentSubscribeHandler(ctx context.Context, key string, payload []byte, reply ws.ReplyFunc) error {
+	tournamentID := chi.RouteContext(ctx).URLParam("tournament_id")
+	if tournamentID == "" {
+		return terror.Error(fmt.Errorf("missing tournament id"), "Missing tournament id.")
+	}
+
+	state, err := db.TournamentGet(gamedb.StdConn, tournamentID)
+	if err != nil {
+		return err
+	}
+
+	reply(state)
+	return nil
+}
@@ -0,0 +1,308 @@
+package asset
+
+import (
+	"database/sql"
+	"fmt"
+	"math/rand"
+	"server"
+	"server/db"
+	"server/db/boiler"
+	"server/gamelog"
+	"server/rpctypes"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Blueprint types that can be given to players as rewards
+const (
+	BlueprintTypeMech          = boiler.ItemTypeMech
+	BlueprintTypeWeapon        = boiler.ItemTypeWeapon
+	BlueprintTypeMechSkin      = boiler.ItemTypeMechSkin
+	BlueprintTypeWeaponSkin    = boiler.ItemTypeWeaponSkin
+	BlueprintTypePowerCore     = boiler.ItemTypePowerCore
+	BlueprintTypePlayerAbility = "player_ability"
+	BlueprintTypeKeycard       = "keycard"
+)
+
+// BlueprintReward is what a player was given by GiveBlueprint.
+type BlueprintReward struct {
+	Label string
+	// XsynAssets are the new assets, which the caller registers on xsyn, either before the transaction is committed or
+	// after it commits from a record which lets a failed registration be retried
+	XsynAssets []*rpctypes.XsynAsset
+	// Keycard is set when keycards were given, their count needs updating on xsyn before the transaction is committed
+	Keycard *boiler.BlueprintKeycard
+}
+
+// IsValidBlueprintType returns true if items of the blueprint type can be given as rewards.
+func IsValidBlueprintType(blueprintType string) bool {
+	switch blueprintType {
+	case BlueprintTypeMech,
+		BlueprintTypeWeapon,
+		BlueprintTypeMechSkin,
+		BlueprintTypeWeaponSkin,
+		BlueprintTypePowerCore,
+		BlueprintTypePlayerAbility,
+		BlueprintTypeKeycard:
+		return true
+	}
+	return false
+}
+
+// BlueprintLabel returns the label of a blueprint, failing if it does not exist.
+func BlueprintLabel(conn boil.Executor, blueprintType string, blueprintID string) (string, error) {
+	switch blueprintType {
+	case BlueprintTypeMech:
+		bp, err := boiler.FindBlueprintMech(conn, blueprintID)
+		if err != nil {
+			return "", terror.Error(err, "Failed to find mech blueprint.")
+		}
+		return bp.Label, nil
+	case BlueprintTypeWeapon:
+		bp, err := boiler.FindBlueprintWeapon(conn, blueprintID)
+		if err != nil {
+			return "", terror.Error(err, "Failed to find weapon blueprint.")
+		}
+		return bp.Label, nil
+	case BlueprintTypeMechSkin:
+		bp, err := boiler.FindBlueprintMechSkin(conn, blueprintID)
+		if err != nil {
+			return "", terror.Error(err, "Failed to find mech skin blueprint.")
+		}
+		return bp.Label, nil
+	case BlueprintTypeWeaponSkin:
+		bp, err := boiler.FindBlueprintWeaponSkin(conn, blueprintID)
+		if err != nil {
+			return "", terror.Error(err, "Failed to find weapon skin blueprint.")
+		}
+		return bp.Label, nil
+	case BlueprintTypePowerCore:
+		bp, err := boiler.FindBlueprintPowerCore(conn, blueprintID)
+		if err != nil {
+			return "", terror.Error(err, "Failed to find power core blueprint.")
+		}
+		return bp.Label, nil
+	case BlueprintTypePlayerAbility:
+		bp, err := boiler.FindBlueprintPlayerAbility(conn, blueprintID)
+		if err != nil {
+			return "", terror.Error(err, "Failed to find player ability blueprint.")
+		}
+		return bp.Label, nil
+	case BlueprintTypeKeycard:
+		bp, err := boiler.FindBlueprintKeycard(conn, blueprintID)
+		if err != nil {
+			return "", terror.Error(err, "Failed to find keycard blueprint.")
+		}
+		return bp.Label, nil
+	}
+	return "", terror.Error(fmt.Errorf("invalid blueprint type: %s", blueprintType), "Invalid blueprint type.")
+}
+
+// GiveBlueprint gives a player a number of new items of a blueprint.
+func GiveBlueprint(tx *sql.Tx, playerID string, blueprintType string, blueprintID string, quantity int) (*BlueprintReward, error) {
+	if quantity <= 0 {
+		return nil, terror.Error(fmt.Errorf("invalid quantity: %d", quantity), "Invalid reward quantity.")
+	}
+
+	ownerID := uuid.FromStringOrNil(playerID)
+	reward := &BlueprintReward{}
+
+	switch blueprintType {
+	case BlueprintTypeMech:
+		bp, err := boiler.BlueprintMechs(
+			boiler.BlueprintMechWhere.ID.EQ(blueprintID),
+			qm.Load(boiler.BlueprintMechRels.DefaultChassisSkin),
+		).One(tx)
+		if err != nil {
+			return nil, terror.Error(err, "Failed to find mech blueprint.")
+		}
+		mechs := []*server.Mech{}
+		mechSkins := []*server.MechSkin{}
+		for i := 0; i < quantity; i++ {
+			mech, mechSkin, err := db.InsertNewMechAndSkin(tx, ownerID, server.BlueprintMechFromBoiler(bp), server.BlueprintMechSkinFromBoiler(bp.R.DefaultChassisSkin))
+			if err != nil {
+				return nil, terror.Error(err, "Failed to give mech.")
+			}
+			mechs = append(mechs, mech)
+			mechSkins = append(mechSkins, mechSkin)
+		}
+		reward.Label = bp.Label
+		reward.XsynAssets = append(rpctypes.ServerMechsToXsynAsset(mechs), rpctypes.ServerMechSkinsToXsynAsset(tx, mechSkins)...)
+
+	case BlueprintTypeWeapon:
+		bp, err := boiler.BlueprintWeapons(
+			boiler.BlueprintWeaponWhere.ID.EQ(blueprintID),
+			qm.Load(boiler.BlueprintWeaponRels.DefaultSkin),
+		).One(tx)
+		if err != nil {
+			return nil, terror.Error(err, "Failed to find weapon blueprint.")
+		}
+		weapons := []*server.Weapon{}
+		weaponSkins := []*server.WeaponSkin{}
+		for i := 0; i < quantity; i++ {
+			weapon, weaponSkin, err := db.InsertNewWeapon(tx, ownerID, server.BlueprintWeaponFromBoiler(bp), server.BlueprintWeaponSkinFromBoiler(bp.R.DefaultSkin))
+			if err != nil {
+				return nil, terror.Error(err, "Failed to give weapon.")
+			}
+			weapons = append(weapons, weapon)
+			weaponSkins = append(weaponSkins, weaponSkin)
+		}
+		reward.Label = bp.Label
+		reward.XsynAssets = append(rpctypes.ServerWeaponsToXsynAsset(weapons), rpctypes.ServerWeaponSkinsToXsynAsset(tx, weaponSkins)...)
+
+	case BlueprintTypeMechSkin:
+		bp, err := boiler.FindBlueprintMechSkin(tx, blueprintID)
+		if err != nil {
+			return nil, terror.Error(err, "Failed to find mech skin blueprint.")
+		}
+		mechSkins := []*server.MechSkin{}
+		for i := 0; i < quantity; i++ {
+			mechSkin, err := db.InsertNewMechSkin(tx, ownerID, server.BlueprintMechSkinFromBoiler(bp), nil)
+			if err != nil {
+				return nil, terror.Error(err, "Failed to give mech skin.")
+			}
+			mechSkins = append(mechSkins, mechSkin)
+		}
+		reward.Label = bp.Label
+		reward.XsynAssets = rpctypes.ServerMechSkinsToXsynAsset(tx, mechSkins)
+
+	case BlueprintTypeWeaponSkin:
+		bp, err := boiler.FindBlueprintWeaponSkin(tx, blueprintID)
+		if err != nil {
+			return nil, terror.Error(err, "Failed to find weapon skin blueprint.")
+		}
+		weaponSkins := []*server.WeaponSkin{}
+		for i := 0; i < quantity; i++ {
+			weaponSkin, err := db.InsertNewWeaponSkin(tx, ownerID, server.BlueprintWeaponSkinFromBoiler(bp), nil)
+			if err != nil {
+				return nil, terror.Error(err, "Failed to give weapon skin.")
+			}
+			weaponSkins = append(weaponSkins, weaponSkin)
+		}
+		reward.Label = bp.Label
+		reward.XsynAssets = rpctypes.ServerWeaponSkinsToXsynAsset(tx, weaponSkins)
+
+	case BlueprintTypePowerCore:
+		bp, err := boiler.FindBlueprintPowerCore(tx, blueprintID)
+		if err != nil {
+			return nil, terror.Error(err, "Failed to find power core blueprint.")
+		}
+		powerCores := []*server.PowerCore{}
+		for i := 0; i < quantity; i++ {
+			powerCore, err := db.InsertNewPowerCore(tx, ownerID, server.BlueprintPowerCoreFromBoiler(bp))
+			if err != nil {
+				return nil, terror.Error(err, "Failed to give power core.")
+			}
+			powerCores = append(powerCores, powerCore)
+		}
+		reward.Label = bp.Label
+		reward.XsynAssets = rpctypes.ServerPowerCoresToXsynAsset(powerCores)
+
+	case BlueprintTypePlayerAbility:
+		bp, err := boiler.FindBlueprintPlayerAbility(tx, blueprintID)
+		if err != nil {
+			return nil, terror.Error(err, "Failed to find player ability blueprint.")
+		}
+		err = db.PlayerAbilityCountAdd(tx, playerID, blueprintID, quantity)
+		if err != nil {
+			return nil, terror.Error(err, "Failed to give player ability.")
+		}
+		reward.Label = bp.Label
+
+	case BlueprintTypeKeycard:
+		bp, err := boiler.FindBlueprintKeycard(tx, blueprintID)
+		if err != nil {
+			return nil, terror.Error(err, "Failed to find keycard blueprint.")
+		}
+		err = db.AddPlayerKeycardCount(tx, playerID, blueprintID, quantity)
+		if err != nil {
+			return nil, terror.Error(err, "Failed to give keycard.")
+		}
+		reward.Label = bp.Label
+		reward.Keycard = bp
+
+	default:
+		return nil, terror.Error(fmt.Errorf("invalid blueprint type: %s", blueprintType), "Invalid blueprint type.")
+	}
+
+	return reward, nil
+}
+
+// GiveMysteryCrate gives a player a random unsold crate of a storefront crate, and returns the crate to register on xsyn.
+func GiveMysteryCrate(tx *sql.Tx, userID string, storeCrate *boiler.StorefrontMysteryCrate) (*server.MysteryCrate, *rpctypes.XsynAsset, error) {
+	availableCrates, err := boiler.MysteryCrates(
+		boiler.MysteryCrateWhere.FactionID.EQ(storeCrate.FactionID),
+		boiler.MysteryCrateWhere.Type.EQ(storeCrate.MysteryCrateType),
+		boiler.MysteryCrateWhere.Purchased.EQ(false),
+		boiler.MysteryCrateWhere.Opened.EQ(false),
+		qm.Load(boiler.MysteryCrateRels.Blueprint),
+	).All(tx)
+	if err != nil {
+		return nil, nil, terror.Error(err, "Failed to get available crates, please try again or contact support.")
+	}
+
+	faction, err := boiler.FindFaction(tx, storeCrate.FactionID)
+	if err != nil {
+		return nil, nil, terror.Error(err, "Failed to find faction, please try again or contact support.")
+	}
+
+	if len(availableCrates) == 0 {
+		return nil, nil, terror.Error(fmt.Errorf("no %s crates left for faction %s", storeCrate.MysteryCrateType, storeCrate.FactionID), "There are no mystery crates left.")
+	}
+
+	//randomly assigning crate to user
+	rand.Seed(time.Now().UnixNano())
+	assignedCrate := availableCrates[rand.Intn(len(availableCrates))]
+
+	//update purchased value
+	assignedCrate.Purchased = true
+
+	// set newly bought crates openable on staging/dev (this is so people cannot open already purchased crates and see what is in them)
+	if server.IsDevelopmentEnv() || server.IsStagingEnv() {
+		assignedCrate.LockedUntil = time.Now()
+	}
+
+	_, err = assignedCrate.Update(tx, boil.Infer())
+	if err != nil {
+		gamelog.L.Error().Err(err).Msg("unable to update assigned crate information")
+		return nil, nil, terror.Error(err, "Failed to get mystery crate, please try again or contact support.")
+	}
+
+	collectionItem, err := db.InsertNewCollectionItem(tx,
+		"supremacy-general",
+		boiler.ItemTypeMysteryCrate,
+		assignedCrate.ID,
+		"",
+		userID,
+	)
+	if err != nil {
+		gamelog.L.Error().Err(err).Interface("mystery crate", assignedCrate).Msg("failed to insert into collection items")
+		return nil, nil, terror.Error(err, "Failed to purchase mystery crate, please try again or contact support.")
+	}
+	storeCrate.AmountSold = storeCrate.AmountSold + 1
+	_, err = storeCrate.Update(tx, boil.Whitelist(boiler.StorefrontMysteryCrateColumns.AmountSold))
+	if err != nil {
+		gamelog.L.Error().Err(err).Interface("mystery crate", assignedCrate).Msg("failed to update crate amount sold")
+		return nil, nil, terror.Error(err, "Failed to get mystery crate, please try again or contact support.")
+	}
+
+	// commit the seed of the crate contents
+	seed, err := db.MysteryCrateSeedCommit(tx, assignedCrate.ID, storeCrate.ID)
+	if err != nil {
+		return nil, nil, terror.Error(err, "Failed to get mystery crate, please try again or contact support.")
+	}
+
+	//register
+	assignedCrateServer := server.MysteryCrateFromBoiler(assignedCrate, collectionItem, null.String{})
+	if seed != nil {
+		assignedCrateServer.ServerSeedHash = null.StringFrom(seed.ServerSeedHash)
+	}
+	xsynAsset := rpctypes.ServerMysteryCrateToXsynAsset(assignedCrateServer, faction.Label)
+
+	return assignedCrateServer, xsynAsset, nil
+}
@@ -67,6 +67,7 @@ type ArenaManager struct {
 	RepairFuncMx             deadlock.Mutex
 	BattleQueueFuncMx        deadlock.Mutex
 	MechStakeMx              deadlock.Mutex // IMPORTANT: never lock MechStakeMx before BattleQueueFuncMx
+	TournamentFuncMx         deadlock.Mutex // IMPORTANT: never lock TournamentFuncMx after BattleQueueFuncMx
 	QuestManager             *quest.System
 
 	arenas           map[string]*Arena
@@ -307,24 +308,48 @@ func (am *ArenaManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	gamelog.L.Info().Str("arena id", arenaID).Msg("New arena is connected.")
+	ip := r.Header.Get("X-Forwarded-For")
+	if ip == "" {
+		ipaddr, _, _ := net.SplitHostPort(r.RemoteAddr)
+		userIP := net.ParseIP(ipaddr)
+		if userIP == nil {
+			ip = ipaddr
+		} else {
+			ip = userIP.String()
+		}
+	}
 
 	wsConn, err := websocket.Accept(w, r, nil)
 	if err != nil {
-		ip := r.Header.Get("X-Forwarded-For")
-		if ip == "" {
-			ipaddr, _, _ := net.SplitHostPort(r.RemoteAddr)
-			userIP := net.ParseIP(ipaddr)
-			if userIP == nil {
-				ip = ipaddr
-			} else {
-				ip = userIP.String()
-			}
-		}
 		gamelog.L.Warn().Str("request_ip", ip).Err(err).Msg("unable to start Battle Arena server")
 		return
 	}
 
+	// verify game client credential and build number
+	handshake, err := am.gameClientHandshake(wsConn, battleArena)
+	if err != nil {
+		gamelog.L.Warn().Str("arena id", arenaID).Str("request_ip", ip).Err(err).Msg("Game client handshake failed.")
+
+		code := CloseCodeHandshakeInvalid
+		reason := "game client handshake failed"
+		var hErr *gameClientHandshakeError
+		if errors.As(err, &hErr) {
+			code = hErr.code
+			reason = hErr.reason
+		}
+		rejectGameClient(wsConn, code, reason)
+		return
+	}
+
+	// tell the new game client to shut down, if the arena is still served by a live game client
+	if am.connectedArena(battleArena.ID) != nil {
+		gamelog.L.Warn().Str("arena id", arenaID).Str("request_ip", ip).Msg("Arena is already connected to another game client.")
+		rejectGameClient(wsConn, CloseCodeArenaOccupied, "arena is already connected to another game client")
+		return
+	}
+
+	gamelog.L.Info().Str("arena id", arenaID).Str("build no", handshake.BuildNo).Msg("New arena is connected.")
+
 	// create new arena
 	arena, err := am.NewArena(battleArena, wsConn)
 	if err != nil {
@@ -438,6 +463,9 @@ func (am *ArenaManager) NewArena(battleArena *boiler.BattleArena, wsConn *websoc
 		// set connected flag of the prev arena to false
 		a.connected.Store(false)
 
+		// tell the previous game client to shut down, so it does not try to reconnect again
+		go a.shutdownGameClient(CloseCodeArenaTakenOver, "arena has been taken over by another game client")
+
 		// change arena state to hijacked
 		a.Stage.Store(ArenaStageHijacked)
 
@@ -829,22 +857,12 @@ func (arena *Arena) Start() {
 }
 
 func (arena *Arena) Message(cmd string, payload interface{}) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	b, err := json.Marshal(struct {
-		Command string      `json:"battle_command"`
-		Payload interface{} `json:"payload"`
-	}{Payload: payload, Command: cmd})
-	if err != nil {
-		gamelog.L.Fatal().Str("log_name", "battle arena").Interface("payload", payload).Err(err).Msg("unable to marshal data for battle arena")
-	}
-	err = arena.socket.Write(ctx, websocket.MessageBinary, b)
+	err := writeGameClientMessage(arena.socket, cmd, payload)
 	if err != nil {
 		gamelog.L.Error().Str("log_name", "battle arena").Interface("payload", payload).Err(err).Msg("failed to write websocket message to game client")
 		return
 	}
-	gamelog.L.Info().RawJSON("message data", b).Msg("game client message sent")
+	gamelog.L.Info().Str("battle_command", cmd).Interface("payload", payload).Msg("game client message sent")
 }
 
 type LocationSelectRequest struct {
@@ -1162,6 +1180,11 @@ type BattleEndPayload struct {
 	} `json:"winning_war_machines"`
 	BattleID     string `json:"battle_id"`
 	WinCondition string `json:"win_condition"`
+	WeaponShots  []struct {
+		WarMachineHash string `json:"war_machine_hash"`
+		WeaponHash     string `json:"weapon_hash"`
+		ShotsFired     int    `json:"shots_fired"`
+	} `json:"weapon_shots"`
 }
 
 type AbilityMoveCommandCompletePayload struct {
@@ -1262,15 +1285,16 @@ func (arena *Arena) start() {
 			break
 		}
 
-		// if connected flag is false, skip all the process
+		// if connected flag is false, the arena has been taken over by another game client
 		// NOTE: this only happen when there are multiple game clients spin up at the same time
 		if !arena.connected.Load() {
 			btl := arena.CurrentBattle()
 			if btl != nil {
 				arena.storeCurrentBattle(nil)
 			}
-			// TODO: send shutdown command to the game client to prevent it from reconnecting again.
-			continue
+
+			// shutdown command is sent to the game client when the arena is taken over, so stop reading from it.
+			break
 		}
 
 		if len(payload) == 0 {
@@ -2509,6 +2533,63 @@ func (btl *Battle) BattleStartSystemMessage() {
 	}
 }
 
+type SystemMessageFollowedMechWon struct {
+	PlayerID     string               `json:"player_id"`
+	BattleID     string               `json:"battle_id"`
+	BattleNumber int                  `json:"battle_number"`
+	Mechs        []*SystemMessageMech `json:"mechs"`
+}
+
+// FollowedMechWonSystemMessage notifies the followers of the players whose mechs won the battle
+func (btl *Battle) FollowedMechWonSystemMessage(winningWarMachines []*WarMachine) {
+	l := gamelog.L.With().Str("func", "FollowedMechWonSystemMessage").Str("battle id", btl.ID).Logger()
+
+	playerMechs := make(map[string][]*WarMachine)
+	for _, wm := range winningWarMachines {
+		// skip AI mechs
+		if wm.AIType != nil {
+			continue
+		}
+		playerMechs[wm.OwnedByID] = append(playerMechs[wm.OwnedByID], wm)
+	}
+
+	for playerID, mechs := range playerMechs {
+		fmw := &SystemMessageFollowedMechWon{
+			PlayerID:     playerID,
+			BattleID:     btl.ID,
+			BattleNumber: btl.BattleNumber,
+			Mechs:        []*SystemMessageMech{},
+		}
+
+		for _, mech := range mechs {
+			smm := &SystemMessageMech{
+				MechID:    mech.ID,
+				FactionID: mech.FactionID,
+				Name:      mech.Label,
+				ImageUrl:  mech.ImageAvatar,
+				Tier:      mech.Tier,
+			}
+
+			if mech.Name != "" {
+				smm.Name = mech.Name
+			}
+
+			fmw.Mechs = append(fmw.Mechs, smm)
+		}
+
+		message := fmt.Sprintf("%s's mech won battle #%d", mechs[0].OwnerUsername, btl.BattleNumber)
+		if len(mechs) > 1 {
+			message = fmt.Sprintf("%s's mechs won battle #%d", mechs[0].OwnerUsername, btl.BattleNumber)
+		}
+
+		var data interface{} = fmw
+		err := system_messages.BroadcastFollowerSystemMessage(playerID, "Mech Victory", message, system_messages.SystemMessageDataTypeFollowedMechWon, &data)
+		if err != nil {
+			l.Error().Err(err).Str("player id", playerID).Msg("Failed to notify followers.")
+		}
+	}
+}
+
 func (arena *Arena) UserStatUpdatedSubscribeHandler(ctx context.Context, user *boiler.Player, key string, payload []byte, reply ws.ReplyFunc) error {
 	userID, err := uuid.FromString(user.ID)
 	if err != nil {
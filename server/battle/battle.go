@@ -683,6 +683,15 @@ func (btl *Battle) handleBattleEnd(payload *BattleEndPayload) {
 		}
 	}
 
+	// update mech and pilot ratings
+	btl.updateRatings(battleMechs, winningFactionIDOrder)
+
+	// progress the battle objective quests of the pilots
+	btl.publishBattleQuestEvents(battleMechs)
+
+	// advance the tournament, if the battle was a tournament match
+	go btl.arena.Manager.TournamentMatchResult(btl.lobby.ID, battleMechs, winningFactionIDOrder)
+
 	sublogger.Debug().Str("correlation_id", "7cff4d31-55c7-4306-bd3e-cf66669159fb").Msg("declare rewards")
 	// declare rewards
 	btl.playerBattleCompleteMessage = []*PlayerBattleCompleteMessage{}
@@ -692,6 +701,9 @@ func (btl *Battle) handleBattleEnd(payload *BattleEndPayload) {
 	// reward mech owners
 	btl.RewardBattleMechOwners(winningFactionIDOrder)
 
+	// notify the followers of the winning mech owners
+	go btl.FollowedMechWonSystemMessage(winningWarMachines)
+
 	sublogger.Debug().Str("correlation_id", "6fea54ab-5dc3-408b-bae9-8fb454cb92b7").Msg("end info")
 	// end info
 	endInfo := &BattleEndDetail{
@@ -1536,6 +1548,9 @@ func (btl *Battle) end(payload *BattleEndPayload) {
 		sublogger.Debug().Msg("non AI match, setup repairs")
 		// assigning repair case
 		btl.processWarMachineRepair()
+
+		// consume the ammo of the shots fired
+		go btl.consumeWeaponAmmo(payload)
 	}
 
 	// clean up current battle
@@ -2343,6 +2358,9 @@ func (btl *Battle) Destroyed(dp *BattleWMDestroyedPayload) {
 			}
 		}
 
+		// progress the weapon damage objective quests of the mech owners
+		btl.publishWeaponDamageQuestEvents(dp)
+
 		_, err = db.UpdateKilledBattleMech(btl.ID, warMachineID, destroyedWarMachine.OwnedByID, destroyedWarMachine.FactionID, killByWarMachineID)
 		if err != nil {
 			gamelog.L.Error().Str("log_name", "battle arena").
@@ -2611,6 +2629,11 @@ func (btl *Battle) Load(battleLobby *boiler.BattleLobby) error {
 
 	btl.WarMachines = btl.MechsToWarMachines(mechs)
 
+	// ammo is not used in AI driven matches
+	if !battleLobby.IsAiDrivenMatch {
+		btl.loadWeaponAmmo()
+	}
+
 	// set mechs current health
 	rcs, err := boiler.RepairCases(
 		boiler.RepairCaseWhere.MechID.IN(btl.warMachineIDs),
@@ -0,0 +1,204 @@
+package battle
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"server/db"
+	"server/db/boiler"
+	"server/gamelog"
+	"strconv"
+	"time"
+
+	"github.com/ninja-software/terror/v2"
+	"nhooyr.io/websocket"
+)
+
+const (
+	GameClientCommandHandshake = "GAME_CLIENT:HANDSHAKE"
+	GameClientCommandShutdown  = "GAME_CLIENT:SHUTDOWN"
+)
+
+// close codes sent to the game client when a connection is rejected
+// NOTE: 4000-4999 is the range reserved for application use
+const (
+	CloseCodeHandshakeInvalid websocket.StatusCode = 4000
+	CloseCodeUnauthorised     websocket.StatusCode = 4001
+	CloseCodeOutdatedBuild    websocket.StatusCode = 4002
+	CloseCodeArenaOccupied    websocket.StatusCode = 4003
+	CloseCodeArenaTakenOver   websocket.StatusCode = 4004
+)
+
+const (
+	gameClientHandshakeTimeout = 10 * time.Second
+	gameClientHandshakeMaxSkew = 60 * time.Second
+)
+
+type GameClientHandshakePayload struct {
+	ArenaID   string `json:"arena_id"`
+	BuildNo   string `json:"build_no"`
+	Timestamp int64  `json:"timestamp"`
+	Signature string `json:"signature"` // hex encoded HMAC-SHA256 of "{arena_id}:{build_no}:{timestamp}"
+}
+
+type GameClientShutdownPayload struct {
+	Reason string `json:"reason"`
+}
+
+// GameClientHandshakeSignature generates the signature a game client should present in its handshake frame
+func GameClientHandshakeSignature(secret string, arenaID string, buildNo string, timestamp int64) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(fmt.Sprintf("%s:%s:%d", arenaID, buildNo, timestamp)))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+type gameClientHandshakeError struct {
+	code   websocket.StatusCode
+	reason string
+}
+
+func (e *gameClientHandshakeError) Error() string {
+	return fmt.Sprintf("game client handshake failed (%d): %s", e.code, e.reason)
+}
+
+// gameClientHandshake reads the first frame from the game client and verifies its credential and build number.
+// The game client must send the handshake frame before any other message.
+func (am *ArenaManager) gameClientHandshake(wsConn *websocket.Conn, battleArena *boiler.BattleArena) (*GameClientHandshakePayload, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), gameClientHandshakeTimeout)
+	defer cancel()
+
+	_, frame, err := wsConn.Read(ctx)
+	if err != nil {
+		return nil, &gameClientHandshakeError{CloseCodeHandshakeInvalid, "handshake frame not received"}
+	}
+
+	if len(frame) < 2 || MessageType(frame[0]) != JSON {
+		return nil, &gameClientHandshakeError{CloseCodeHandshakeInvalid, "invalid handshake frame"}
+	}
+
+	msg := &BattleMsg{}
+	err = json.Unmarshal(frame[1:], msg)
+	if err != nil || msg.BattleCommand != GameClientCommandHandshake {
+		return nil, &gameClientHandshakeError{CloseCodeHandshakeInvalid, "first message must be a handshake"}
+	}
+
+	payload := &GameClientHandshakePayload{}
+	err = json.Unmarshal(msg.Payload, payload)
+	if err != nil {
+		return nil, &gameClientHandshakeError{CloseCodeHandshakeInvalid, "invalid handshake payload"}
+	}
+
+	if payload.ArenaID != battleArena.ID {
+		return nil, &gameClientHandshakeError{CloseCodeUnauthorised, "arena id does not match"}
+	}
+
+	// reject stale or future handshakes, a captured frame signed within the window is caught by the key's last handshake below
+	signedAt := time.Unix(payload.Timestamp, 0)
+	if time.Since(signedAt) > gameClientHandshakeMaxSkew || time.Until(signedAt) > gameClientHandshakeMaxSkew {
+		return nil, &gameClientHandshakeError{CloseCodeUnauthorised, "handshake timestamp out of range"}
+	}
+
+	keys, err := db.BattleArenaActiveClientKeys(battleArena.ID)
+	if err != nil {
+		return nil, &gameClientHandshakeError{websocket.StatusInternalError, "failed to load arena credentials"}
+	}
+
+	signature, err := hex.DecodeString(payload.Signature)
+	if err != nil {
+		return nil, &gameClientHandshakeError{CloseCodeUnauthorised, "invalid signature"}
+	}
+
+	var matchedKey *boiler.BattleArenaClientKey
+	for _, key := range keys {
+		expected, _ := hex.DecodeString(GameClientHandshakeSignature(key.Secret, payload.ArenaID, payload.BuildNo, payload.Timestamp))
+		if hmac.Equal(expected, signature) {
+			matchedKey = key
+			break
+		}
+	}
+	if matchedKey == nil {
+		return nil, &gameClientHandshakeError{CloseCodeUnauthorised, "invalid signature"}
+	}
+
+	// a handshake must be signed after the last one accepted for the key, so a captured frame can not be replayed
+	accepted, err := db.BattleArenaClientKeyUsed(matchedKey.ID, payload.Timestamp)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("key id", matchedKey.ID).Msg("Failed to record client key usage.")
+		return nil, &gameClientHandshakeError{websocket.StatusInternalError, "failed to record arena credential usage"}
+	}
+	if !accepted {
+		return nil, &gameClientHandshakeError{CloseCodeUnauthorised, "handshake has already been used"}
+	}
+
+	// only check build number after the credential is verified
+	buildNo, err := strconv.ParseUint(payload.BuildNo, 10, 64)
+	if err != nil {
+		return nil, &gameClientHandshakeError{CloseCodeHandshakeInvalid, "invalid build number"}
+	}
+
+	if buildNo < am.gameClientMinimumBuildNo {
+		return nil, &gameClientHandshakeError{CloseCodeOutdatedBuild, fmt.Sprintf("game client build %d is lower than the minimum build %d", buildNo, am.gameClientMinimumBuildNo)}
+	}
+
+	return payload, nil
+}
+
+// rejectGameClient tells the game client to shut down and closes the connection with the given code
+func rejectGameClient(wsConn *websocket.Conn, code websocket.StatusCode, reason string) {
+	err := writeGameClientMessage(wsConn, GameClientCommandShutdown, &GameClientShutdownPayload{Reason: reason})
+	if err != nil {
+		gamelog.L.Warn().Err(err).Msg("Failed to send shutdown command to game client.")
+	}
+
+	err = wsConn.Close(code, reason)
+	if err != nil {
+		gamelog.L.Debug().Err(err).Msg("Failed to close game client connection.")
+	}
+}
+
+// writeGameClientMessage sends a battle command to the game client
+func writeGameClientMessage(wsConn *websocket.Conn, cmd string, payload interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	b, err := json.Marshal(struct {
+		Command string      `json:"battle_command"`
+		Payload interface{} `json:"payload"`
+	}{Payload: payload, Command: cmd})
+	if err != nil {
+		return terror.Error(err, "Failed to marshal game client message.")
+	}
+
+	return wsConn.Write(ctx, websocket.MessageBinary, b)
+}
+
+// connectedArena returns the arena which is currently served by a live game client
+func (am *ArenaManager) connectedArena(arenaID string) *Arena {
+	am.RLock()
+	arena, ok := am.arenas[arenaID]
+	am.RUnlock()
+
+	if !ok || !arena.connected.Load() {
+		return nil
+	}
+
+	// make sure the connection is still alive, a dead connection will be taken over by the new game client
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	err := arena.socket.Ping(ctx)
+	if err != nil {
+		gamelog.L.Warn().Err(err).Str("arena id", arenaID).Msg("Connected game client did not respond to ping.")
+		return nil
+	}
+
+	return arena
+}
+
+// shutdownGameClient sends a shutdown command to the game client of the arena, so it does not try to reconnect
+func (arena *Arena) shutdownGameClient(code websocket.StatusCode, reason string) {
+	rejectGameClient(arena.socket, code, reason)
+}
@@ -0,0 +1,34 @@
+package battle
+
+import (
+	"testing"
+)
+
+func TestGameClientHandshakeSignature(t *testing.T) {
+	// expected value is HMAC-SHA256("secret", "arena-1:1234:1670000000"), generated outside of go
+	signature := GameClientHandshakeSignature("secret", "arena-1", "1234", 1670000000)
+	if signature != "eefb4cbe1c59dd82dc429ceed47ac36bfad402bdf47739e088840c8e61cc9244" {
+		t.Fatalf("unexpected signature: %s", signature)
+	}
+
+	tests := []struct {
+		name      string
+		secret    string
+		arenaID   string
+		buildNo   string
+		timestamp int64
+	}{
+		{"different secret", "secret2", "arena-1", "1234", 1670000000},
+		{"different arena", "secret", "arena-2", "1234", 1670000000},
+		{"different build", "secret", "arena-1", "1235", 1670000000},
+		{"different timestamp", "secret", "arena-1", "1234", 1670000001},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if GameClientHandshakeSignature(tt.secret, tt.arenaID, tt.buildNo, tt.timestamp) == signature {
+				t.Fatalf("signature did not change")
+			}
+		})
+	}
+}
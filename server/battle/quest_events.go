@@ -0,0 +1,92 @@
+package battle
+
+import (
+	"server/db"
+	"server/db/boiler"
+	"server/quest"
+)
+
+// publishBattleQuestEvents publishes the battle win and survive quest events of the pilots in the battle.
+// AI driven matches do not progress quests.
+func (btl *Battle) publishBattleQuestEvents(battleMechs boiler.BattleMechSlice) {
+	if btl.lobby == nil || btl.lobby.IsAiDrivenMatch {
+		return
+	}
+
+	modelIDs := make(map[string]string)
+	for _, wm := range btl.WarMachines {
+		modelIDs[wm.ID] = wm.ModelID
+	}
+
+	for _, bm := range battleMechs {
+		fields := map[string]string{
+			"mech_model_id": modelIDs[bm.MechID],
+			"faction_id":    bm.FactionID,
+		}
+
+		if bm.FactionWon.Valid && bm.FactionWon.Bool {
+			btl.arena.Manager.QuestManager.Publish(&quest.Event{
+				Type:     db.QuestObjectiveEventBattleWin,
+				PlayerID: bm.PilotedByID,
+				Amount:   1,
+				Fields:   fields,
+			})
+		}
+
+		if bm.MechSurvived.Valid && bm.MechSurvived.Bool {
+			btl.arena.Manager.QuestManager.Publish(&quest.Event{
+				Type:     db.QuestObjectiveEventBattleSurvive,
+				PlayerID: bm.PilotedByID,
+				Amount:   1,
+				Fields:   fields,
+			})
+		}
+	}
+}
+
+// publishWeaponDamageQuestEvents publishes the damage each mech dealt to a destroyed mech with each of its weapons,
+// to the owner of the mech. Damage from abilities has no instigator and is skipped. The game client only reports the
+// damage history of a mech when it is destroyed, so damage dealt to mechs which survive the battle is not counted.
+func (btl *Battle) publishWeaponDamageQuestEvents(dp *BattleWMDestroyedPayload) {
+	if btl.lobby == nil || btl.lobby.IsAiDrivenMatch {
+		return
+	}
+
+	type weaponDamage struct {
+		wm         *WarMachine
+		weaponType string
+	}
+
+	damages := make(map[weaponDamage]int)
+	for _, damage := range dp.DamageHistory {
+		if damage.InstigatorHash == "" || damage.SourceHash == "" {
+			continue
+		}
+
+		for _, wm := range btl.WarMachines {
+			if wm.Hash != damage.InstigatorHash {
+				continue
+			}
+
+			for _, wpn := range wm.Weapons {
+				if wpn.Hash == damage.SourceHash {
+					damages[weaponDamage{wm, wpn.weaponType}] += int(damage.Amount.IntPart())
+					break
+				}
+			}
+			break
+		}
+	}
+
+	for wd, amount := range damages {
+		btl.arena.Manager.QuestManager.Publish(&quest.Event{
+			Type:     db.QuestObjectiveEventWeaponDamage,
+			PlayerID: wd.wm.OwnedByID,
+			Amount:   amount,
+			Fields: map[string]string{
+				"weapon_type":   wd.weaponType,
+				"mech_model_id": wd.wm.ModelID,
+			},
+		})
+	}
+}
@@ -577,6 +577,11 @@ func (am *ArenaManager) DefaultPublicLobbiesCheck() error {
 	bls, err := boiler.BattleLobbies(
 		boiler.BattleLobbyWhere.ReadyAt.IsNull(),
 		boiler.BattleLobbyWhere.GeneratedBySystem.EQ(true),
+		qm.Load(
+			boiler.BattleLobbyRels.BattleLobbiesMechs,
+			boiler.BattleLobbiesMechWhere.RefundTXID.IsNull(),
+			boiler.BattleLobbiesMechWhere.DeletedAt.IsNull(),
+		),
 	).All(gamedb.StdConn)
 	if err != nil {
 		gamelog.L.Error().Err(err).Msg("Failed to load active public battle lobbies.")
@@ -585,12 +590,26 @@ func (am *ArenaManager) DefaultPublicLobbiesCheck() error {
 
 	count := len(bls)
 
-	if count >= publicLobbiesCount {
+	// public lobbies are filled by rating, so keep an empty one for the mechs which are outside the rating of every lobby
+	hasEmptyLobby := false
+	for _, bl := range bls {
+		if bl.R == nil || len(bl.R.BattleLobbiesMechs) == 0 {
+			hasEmptyLobby = true
+			break
+		}
+	}
+
+	newLobbyCount := publicLobbiesCount - count
+	if newLobbyCount <= 0 && !hasEmptyLobby {
+		newLobbyCount = 1
+	}
+
+	if newLobbyCount <= 0 {
 		return nil
 	}
 
 	// fill up battle lobbies
-	for i := 0; i < publicLobbiesCount-count; i++ {
+	for i := 0; i < newLobbyCount; i++ {
 		bl := &boiler.BattleLobby{
 			Name:                  helpers.GenerateAdjectiveName(),
 			HostByID:              server.SupremacyBattleUserID,
@@ -1045,6 +1064,9 @@ func (am *ArenaManager) AIMechFillingProcess(battleLobbyID string) {
 		factionSlots[index].availableSlots -= 1
 	}
 
+	// public lobbies are filled with the staked mechs closest to the rating of the mechs already queued
+	rating := lobbyRating(bl)
+
 	var insertRows []string
 	for _, factionSlot := range factionSlots {
 		if factionSlot.availableSlots <= 0 {
@@ -1063,7 +1085,7 @@ func (am *ArenaManager) AIMechFillingProcess(battleLobbyID string) {
 		}
 
 		// load available staked mechs
-		sms, err := boiler.StakedMechs(
+		stakedMechQueryMods := []qm.QueryMod{
 			boiler.StakedMechWhere.FactionID.EQ(factionSlot.factionID),
 			qm.Where(fmt.Sprintf(
 				"NOT EXISTS (SELECT 1 FROM %s WHERE %s = %s AND %s ISNULL AND %s ISNULL AND %s ISNULL)",
@@ -1091,7 +1113,12 @@ func (am *ArenaManager) AIMechFillingProcess(battleLobbyID string) {
 				boiler.RepairCaseTableColumns.CompletedAt,
 			)),
 			qm.Limit(factionSlot.availableSlots),
-		).All(gamedb.StdConn)
+		}
+		if rating.Valid {
+			stakedMechQueryMods = append(stakedMechQueryMods, mechRatingOrderBy(boiler.StakedMechTableColumns.MechID, rating.Decimal))
+		}
+
+		sms, err := boiler.StakedMechs(stakedMechQueryMods...).All(gamedb.StdConn)
 		if err != nil {
 			gamelog.L.Error().Err(err).Msg("Failed to load staked mech.")
 		}
@@ -0,0 +1,281 @@
+package battle
+
+import (
+	"math"
+	"server/db"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// ratingEntry is a mech in a rated battle, with the pilot who queued it.
+type ratingEntry struct {
+	battleMech *boiler.BattleMech
+	placement  int
+	mech       *boiler.MechRating
+	pilot      *boiler.PlayerRating
+	mechRated  bool
+	pilotRated bool
+	mechDelta  float64
+	pilotDelta float64
+}
+
+// ratingExpectedScore returns the chance of a rating beating an opponent rating.
+func ratingExpectedScore(rating float64, opponentRating float64) float64 {
+	return 1 / (1 + math.Pow(10, (opponentRating-rating)/400))
+}
+
+// ratingKFactor returns how much a single battle can move a rating, which is more while the rating is provisional.
+func ratingKFactor(battlesRated int) float64 {
+	if battlesRated < db.RatingProvisionalBattles() {
+		return float64(db.GetIntWithDefault(db.KeyRatingProvisionalKFactor, 40))
+	}
+	return float64(db.GetIntWithDefault(db.KeyRatingKFactor, 20))
+}
+
+// ratingDelta returns how much a rating changes after a battle. Each other faction is an opponent,
+// scored as a win when the faction placed below, a loss when it placed above and a draw when they are tied.
+func ratingDelta(rating float64, k float64, placement int, opponentPlacements map[string]int, opponentRatings map[string]float64) float64 {
+	if len(opponentRatings) == 0 {
+		return 0
+	}
+
+	total := 0.0
+	for factionID, opponentRating := range opponentRatings {
+		score := 0.5
+		if placement < opponentPlacements[factionID] {
+			score = 1
+		} else if placement > opponentPlacements[factionID] {
+			score = 0
+		}
+		total += score - ratingExpectedScore(rating, opponentRating)
+	}
+
+	return k * total / float64(len(opponentRatings))
+}
+
+// updateRatings updates the ratings of the mechs and pilots in a battle from where their factions placed.
+// Battles of private lobbies and AI driven matches are not rated, and neither are AI mechs or AI pilots.
+func (btl *Battle) updateRatings(battleMechs boiler.BattleMechSlice, winningFactionIDOrder []string) {
+	if btl.lobby == nil || btl.lobby.IsPrivate || btl.lobby.IsAiDrivenMatch || len(battleMechs) == 0 {
+		return
+	}
+
+	l := gamelog.L.With().Str("func", "updateRatings").Str("battle_id", btl.ID).Logger()
+
+	// factions missing from the order were destroyed without being recorded, so they share the last placement
+	placements := make(map[string]int)
+	for i, factionID := range winningFactionIDOrder {
+		if _, ok := placements[factionID]; !ok && factionID != "" {
+			placements[factionID] = i
+		}
+	}
+	for _, bm := range battleMechs {
+		if _, ok := placements[bm.FactionID]; !ok {
+			placements[bm.FactionID] = len(winningFactionIDOrder)
+		}
+	}
+	if len(placements) < 2 {
+		return
+	}
+
+	mechIDs := []string{}
+	pilotIDs := []string{}
+	for _, bm := range battleMechs {
+		mechIDs = append(mechIDs, bm.MechID)
+		pilotIDs = append(pilotIDs, bm.PilotedByID)
+	}
+
+	// AI mechs and the faction AI players who queue staked mechs keep their rating
+	aiMechs, err := boiler.CollectionItems(
+		boiler.CollectionItemWhere.ItemID.IN(mechIDs),
+		qm.InnerJoin(boiler.TableNames.Players+" ON "+boiler.PlayerTableColumns.ID+" = "+boiler.CollectionItemTableColumns.OwnerID),
+		boiler.PlayerWhere.IsAi.EQ(true),
+	).All(gamedb.StdConn)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to load AI mechs.")
+		return
+	}
+	aiPilots, err := boiler.Players(
+		boiler.PlayerWhere.ID.IN(pilotIDs),
+		boiler.PlayerWhere.IsAi.EQ(true),
+	).All(gamedb.StdConn)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to load AI pilots.")
+		return
+	}
+
+	tx, err := gamedb.StdConn.Begin()
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to start db transaction.")
+		return
+	}
+	defer tx.Rollback()
+
+	rated, err := db.BattleIsRated(tx, btl.ID)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to check battle rating changes.")
+		return
+	}
+	if rated {
+		return
+	}
+
+	mechRatings, err := db.MechRatingsGet(tx, mechIDs)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to load mech ratings.")
+		return
+	}
+	pilotRatings, err := db.PlayerRatingsGet(tx, pilotIDs)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to load pilot ratings.")
+		return
+	}
+
+	entries := []*ratingEntry{}
+	for _, bm := range battleMechs {
+		entry := &ratingEntry{
+			battleMech: bm,
+			placement:  placements[bm.FactionID],
+			mech:       mechRatings[bm.MechID],
+			pilot:      pilotRatings[bm.PilotedByID],
+			mechRated:  true,
+			pilotRated: true,
+		}
+		for _, ci := range aiMechs {
+			if ci.ItemID == bm.MechID {
+				entry.mechRated = false
+			}
+		}
+		for _, p := range aiPilots {
+			if p.ID == bm.PilotedByID {
+				entry.pilotRated = false
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	// average rating of each faction, which is what the mechs and pilots of the other factions play against
+	factionMechRatings := make(map[string]float64)
+	factionPilotRatings := make(map[string]float64)
+	factionCounts := make(map[string]int)
+	for _, entry := range entries {
+		factionMechRatings[entry.battleMech.FactionID] += entry.mech.Rating.InexactFloat64()
+		factionPilotRatings[entry.battleMech.FactionID] += entry.pilot.Rating.InexactFloat64()
+		factionCounts[entry.battleMech.FactionID] += 1
+	}
+	for factionID, count := range factionCounts {
+		factionMechRatings[factionID] /= float64(count)
+		factionPilotRatings[factionID] /= float64(count)
+	}
+
+	// a pilot with more than one mech in the battle moves by the average of their mechs
+	pilotRatingsBefore := make(map[string]decimal.Decimal)
+	pilotDeltas := make(map[string]float64)
+	pilotMechCounts := make(map[string]int)
+	for _, entry := range entries {
+		opponentMechRatings := make(map[string]float64)
+		opponentPilotRatings := make(map[string]float64)
+		for factionID := range factionCounts {
+			if factionID == entry.battleMech.FactionID {
+				continue
+			}
+			opponentMechRatings[factionID] = factionMechRatings[factionID]
+			opponentPilotRatings[factionID] = factionPilotRatings[factionID]
+		}
+
+		if entry.mechRated {
+			entry.mechDelta = ratingDelta(entry.mech.Rating.InexactFloat64(), ratingKFactor(entry.mech.BattlesRated), entry.placement, placements, opponentMechRatings)
+		}
+		if entry.pilotRated {
+			entry.pilotDelta = ratingDelta(entry.pilot.Rating.InexactFloat64(), ratingKFactor(entry.pilot.BattlesRated), entry.placement, placements, opponentPilotRatings)
+			pilotRatingsBefore[entry.pilot.PlayerID] = entry.pilot.Rating
+			pilotDeltas[entry.pilot.PlayerID] += entry.pilotDelta
+			pilotMechCounts[entry.pilot.PlayerID] += 1
+		}
+	}
+
+	for _, entry := range entries {
+		brc := &boiler.BattleRatingChange{
+			BattleID:    btl.ID,
+			MechID:      entry.battleMech.MechID,
+			PilotedByID: entry.battleMech.PilotedByID,
+			FactionID:   entry.battleMech.FactionID,
+			Placement:   entry.placement + 1,
+		}
+
+		if entry.mechRated {
+			brc.MechRatingBefore = decimal.NewNullDecimal(entry.mech.Rating)
+			entry.mech.Rating = entry.mech.Rating.Add(decimal.NewFromFloat(entry.mechDelta)).Round(2)
+			entry.mech.BattlesRated += 1
+			brc.MechRatingAfter = decimal.NewNullDecimal(entry.mech.Rating)
+
+			err = db.MechRatingSave(tx, entry.mech)
+			if err != nil {
+				l.Error().Err(err).Str("mech id", entry.mech.MechID).Msg("Failed to save mech rating.")
+				return
+			}
+		}
+
+		if entry.pilotRated {
+			brc.PilotRatingBefore = decimal.NewNullDecimal(pilotRatingsBefore[entry.pilot.PlayerID])
+
+			// pilots are only updated once, however many mechs they had in the battle
+			if count, ok := pilotMechCounts[entry.pilot.PlayerID]; ok {
+				entry.pilot.Rating = entry.pilot.Rating.Add(decimal.NewFromFloat(pilotDeltas[entry.pilot.PlayerID] / float64(count))).Round(2)
+				entry.pilot.BattlesRated += 1
+				delete(pilotMechCounts, entry.pilot.PlayerID)
+
+				err = db.PlayerRatingSave(tx, entry.pilot)
+				if err != nil {
+					l.Error().Err(err).Str("player id", entry.pilot.PlayerID).Msg("Failed to save pilot rating.")
+					return
+				}
+			}
+
+			brc.PilotRatingAfter = decimal.NewNullDecimal(entry.pilot.Rating)
+		}
+
+		err = brc.Insert(tx, boil.Infer())
+		if err != nil {
+			l.Error().Err(err).Interface("rating change", brc).Msg("Failed to insert battle rating change.")
+			return
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to commit battle rating changes.")
+		return
+	}
+}
+
+// mechRatingOrderBy orders mechs by how close their rating is to a rating, for filling a lobby with mechs of similar skill.
+func mechRatingOrderBy(mechIDColumn string, rating decimal.Decimal) qm.QueryMod {
+	return qm.OrderBy(
+		"ABS(COALESCE((SELECT "+boiler.MechRatingTableColumns.Rating+" FROM "+boiler.TableNames.MechRatings+" WHERE "+boiler.MechRatingTableColumns.MechID+" = "+mechIDColumn+"), ?) - ?)",
+		db.RatingDefault,
+		rating,
+	)
+}
+
+// lobbyRating returns the rating of a lobby, or null when it is not filled by rating.
+func lobbyRating(bl *boiler.BattleLobby) decimal.NullDecimal {
+	if !db.BattleLobbyFilledByRating(bl) {
+		return decimal.NullDecimal{}
+	}
+	lrs, err := db.BattleLobbyRatings(gamedb.StdConn, []string{bl.ID})
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("battle lobby id", bl.ID).Msg("Failed to load battle lobby rating.")
+		return decimal.NullDecimal{}
+	}
+	lr, ok := lrs[bl.ID]
+	if !ok {
+		return decimal.NullDecimal{}
+	}
+	return decimal.NewNullDecimal(lr.Rating)
+}
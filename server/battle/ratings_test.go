@@ -0,0 +1,135 @@
+package battle
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRatingExpectedScore(t *testing.T) {
+	tests := []struct {
+		name           string
+		rating         float64
+		opponentRating float64
+		expected       float64
+	}{
+		{"equal", 1000, 1000, 0.5},
+		{"200 above", 1200, 1000, 0.759747},
+		{"200 below", 1000, 1200, 0.240253},
+		{"400 above", 1400, 1000, 0.909091},
+		{"400 below", 1000, 1400, 0.090909},
+		{"800 above", 1800, 1000, 0.990099},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score := ratingExpectedScore(tt.rating, tt.opponentRating)
+			if math.Abs(score-tt.expected) > 0.000001 {
+				t.Fatalf("unexpected expected score: %f, expected %f", score, tt.expected)
+			}
+			// the chances of both sides always add up to a whole battle
+			if total := score + ratingExpectedScore(tt.opponentRating, tt.rating); math.Abs(total-1) > 0.000001 {
+				t.Fatalf("expected scores add up to %f", total)
+			}
+		})
+	}
+}
+
+func TestRatingDelta(t *testing.T) {
+	tests := []struct {
+		name               string
+		rating             float64
+		k                  float64
+		placement          int
+		opponentPlacements map[string]int
+		opponentRatings    map[string]float64
+		expected           float64
+	}{
+		{
+			name:     "no opponents",
+			rating:   1000,
+			k:        20,
+			expected: 0,
+		},
+		{
+			name:               "first of three",
+			rating:             1000,
+			k:                  20,
+			placement:          0,
+			opponentPlacements: map[string]int{"b": 1, "c": 2},
+			opponentRatings:    map[string]float64{"b": 1000, "c": 1000},
+			expected:           10,
+		},
+		{
+			name:               "second of three",
+			rating:             1000,
+			k:                  20,
+			placement:          1,
+			opponentPlacements: map[string]int{"a": 0, "c": 2},
+			opponentRatings:    map[string]float64{"a": 1000, "c": 1000},
+			expected:           0,
+		},
+		{
+			name:               "last of three",
+			rating:             1000,
+			k:                  20,
+			placement:          2,
+			opponentPlacements: map[string]int{"a": 0, "b": 1},
+			opponentRatings:    map[string]float64{"a": 1000, "b": 1000},
+			expected:           -10,
+		},
+		{
+			name:               "tied",
+			rating:             1000,
+			k:                  20,
+			placement:          1,
+			opponentPlacements: map[string]int{"b": 1},
+			opponentRatings:    map[string]float64{"b": 1000},
+			expected:           0,
+		},
+		{
+			name:               "underdog wins",
+			rating:             1000,
+			k:                  20,
+			placement:          0,
+			opponentPlacements: map[string]int{"b": 1},
+			opponentRatings:    map[string]float64{"b": 1400},
+			expected:           18.181818,
+		},
+		{
+			name:               "favourite loses",
+			rating:             1400,
+			k:                  20,
+			placement:          1,
+			opponentPlacements: map[string]int{"a": 0},
+			opponentRatings:    map[string]float64{"a": 1000},
+			expected:           -18.181818,
+		},
+		{
+			name:               "favourite wins",
+			rating:             1400,
+			k:                  20,
+			placement:          0,
+			opponentPlacements: map[string]int{"b": 1},
+			opponentRatings:    map[string]float64{"b": 1000},
+			expected:           1.818182,
+		},
+		{
+			name:               "provisional k factor",
+			rating:             1000,
+			k:                  40,
+			placement:          0,
+			opponentPlacements: map[string]int{"b": 1, "c": 2},
+			opponentRatings:    map[string]float64{"b": 1000, "c": 1000},
+			expected:           20,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delta := ratingDelta(tt.rating, tt.k, tt.placement, tt.opponentPlacements, tt.opponentRatings)
+			if math.Abs(delta-tt.expected) > 0.000001 {
+				t.Fatalf("unexpected delta: %f, expected %f", delta, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package battle
+
+import (
+	"server/db"
+	"server/db/boiler"
+	"sort"
+)
+
+// tournamentPairing is a match of the next round of a tournament, b is nil when a has a bye.
+type tournamentPairing struct {
+	bracket string
+	a       *boiler.TournamentEntrant
+	b       *boiler.TournamentEntrant
+}
+
+// tournamentSortBySeed returns a copy of the entrants with the top seed first.
+func tournamentSortBySeed(entrants []*boiler.TournamentEntrant) []*boiler.TournamentEntrant {
+	sorted := make([]*boiler.TournamentEntrant, len(entrants))
+	copy(sorted, entrants)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Seed.Int < sorted[j].Seed.Int })
+	return sorted
+}
+
+// tournamentPairBySeed pairs the top seed against the bottom seed, the second seed against the second bottom seed and so on.
+// The top seed has a bye when there is an odd number of entrants.
+func tournamentPairBySeed(entrants []*boiler.TournamentEntrant, bracket string) []*tournamentPairing {
+	sorted := tournamentSortBySeed(entrants)
+
+	pairings := []*tournamentPairing{}
+	if len(sorted)%2 == 1 {
+		pairings = append(pairings, &tournamentPairing{bracket, sorted[0], nil})
+		sorted = sorted[1:]
+	}
+	for i := 0; i < len(sorted)/2; i++ {
+		pairings = append(pairings, &tournamentPairing{bracket, sorted[i], sorted[len(sorted)-1-i]})
+	}
+
+	return pairings
+}
+
+// tournamentNextRoundPairings returns the matches of the next round of a tournament, or nothing when the tournament is over.
+func tournamentNextRoundPairings(t *boiler.Tournament, entrants []*boiler.TournamentEntrant, matches []*boiler.TournamentMatch) []*tournamentPairing {
+	switch t.Format {
+	case db.TournamentFormatSingleElimination:
+		alive := []*boiler.TournamentEntrant{}
+		for _, te := range entrants {
+			if te.Losses == 0 {
+				alive = append(alive, te)
+			}
+		}
+		if len(alive) < 2 {
+			return nil
+		}
+		return tournamentPairBySeed(alive, db.TournamentBracketMain)
+
+	case db.TournamentFormatDoubleElimination:
+		winners := []*boiler.TournamentEntrant{}
+		losers := []*boiler.TournamentEntrant{}
+		for _, te := range entrants {
+			switch te.Losses {
+			case 0:
+				winners = append(winners, te)
+			case 1:
+				losers = append(losers, te)
+			}
+		}
+		if len(winners)+len(losers) < 2 {
+			return nil
+		}
+
+		// the last two entrants standing play the grand final, which is played again if the winners bracket champion loses
+		if len(winners)+len(losers) == 2 {
+			finalists := append(winners, losers...)
+			return []*tournamentPairing{{db.TournamentBracketGrandFinal, finalists[0], finalists[1]}}
+		}
+
+		pairings := []*tournamentPairing{}
+		if len(winners) > 0 {
+			pairings = append(pairings, tournamentPairBySeed(winners, db.TournamentBracketWinners)...)
+		}
+		if len(losers) > 0 {
+			pairings = append(pairings, tournamentPairBySeed(losers, db.TournamentBracketLosers)...)
+		}
+		return pairings
+
+	case db.TournamentFormatRoundRobin:
+		return tournamentRoundRobinPairings(entrants, t.CurrentRound+1)
+
+	case db.TournamentFormatSwiss:
+		if t.CurrentRound >= tournamentSwissRounds(t, len(entrants)) {
+			return nil
+		}
+		return tournamentSwissPairings(entrants, matches)
+	}
+
+	return nil
+}
+
+// tournamentRoundRobinPairings returns the matches of a round of a round robin with the circle method. The top seed stays
+// in place while everyone else rotates a place each round, so every entrant plays every other entrant once.
+func tournamentRoundRobinPairings(entrants []*boiler.TournamentEntrant, round int) []*tournamentPairing {
+	circle := tournamentSortBySeed(entrants)
+	if len(circle)%2 == 1 {
+		circle = append(circle, nil)
+	}
+	if len(circle) < 2 || round > len(circle)-1 {
+		return nil
+	}
+
+	rest := circle[1:]
+	rotated := []*boiler.TournamentEntrant{circle[0]}
+	for i := range rest {
+		rotated = append(rotated, rest[(i+round-1)%len(rest)])
+	}
+
+	pairings := []*tournamentPairing{}
+	for i := 0; i < len(rotated)/2; i++ {
+		a, b := rotated[i], rotated[len(rotated)-1-i]
+		if a == nil {
+			a, b = b, nil
+		}
+		pairings = append(pairings, &tournamentPairing{db.TournamentBracketMain, a, b})
+	}
+
+	return pairings
+}
+
+// tournamentSwissRounds returns the number of rounds of a Swiss tournament, which is enough rounds to
+// leave a single unbeaten entrant unless the host set the number of rounds.
+func tournamentSwissRounds(t *boiler.Tournament, entrantCount int) int {
+	if t.SwissRounds.Valid {
+		return t.SwissRounds.Int
+	}
+	rounds := 1
+	for 1<<rounds < entrantCount {
+		rounds += 1
+	}
+	return rounds
+}
+
+// tournamentSwissPairings pairs entrants on the same points against each other, avoiding rematches where it can.
+// The lowest ranked entrant who has not had a bye yet sits out when there is an odd number of entrants.
+func tournamentSwissPairings(entrants []*boiler.TournamentEntrant, matches []*boiler.TournamentMatch) []*tournamentPairing {
+	standings := tournamentSortBySeed(entrants)
+	sort.SliceStable(standings, func(i, j int) bool {
+		return standings[i].Wins+standings[i].Byes > standings[j].Wins+standings[j].Byes
+	})
+
+	played := make(map[string]bool)
+	for _, tm := range matches {
+		if tm.EntrantBID.Valid {
+			played[tm.EntrantAID+tm.EntrantBID.String] = true
+			played[tm.EntrantBID.String+tm.EntrantAID] = true
+		}
+	}
+
+	pairings := []*tournamentPairing{}
+	if len(standings)%2 == 1 {
+		index := len(standings) - 1
+		for i := len(standings) - 1; i >= 0; i-- {
+			if standings[i].Byes == 0 {
+				index = i
+				break
+			}
+		}
+		pairings = append(pairings, &tournamentPairing{db.TournamentBracketMain, standings[index], nil})
+		standings = append(standings[:index:index], standings[index+1:]...)
+	}
+
+	paired := make(map[string]bool)
+	for i, a := range standings {
+		if paired[a.ID] {
+			continue
+		}
+
+		var b *boiler.TournamentEntrant
+		for _, opponent := range standings[i+1:] {
+			if paired[opponent.ID] {
+				continue
+			}
+			if b == nil {
+				b = opponent
+			}
+			if !played[a.ID+opponent.ID] {
+				b = opponent
+				break
+			}
+		}
+		if b == nil {
+			continue
+		}
+
+		paired[a.ID] = true
+		paired[b.ID] = true
+		pairings = append(pairings, &tournamentPairing{db.TournamentBracketMain, a, b})
+	}
+
+	return pairings
+}
+
+// tournamentPlacements returns the final placement of each entrant. Elimination formats place entrants by the round
+// they were knocked out in and round robin and Swiss by points, where a bye is worth a win. Entrants who cannot be
+// separated share a placement.
+func tournamentPlacements(t *boiler.Tournament, entrants []*boiler.TournamentEntrant, matches []*boiler.TournamentMatch) map[string]int {
+	scores := make(map[string]int)
+	for _, te := range entrants {
+		switch t.Format {
+		case db.TournamentFormatSingleElimination, db.TournamentFormatDoubleElimination:
+			if !te.EliminatedAt.Valid {
+				scores[te.ID] = len(matches) + 1
+				continue
+			}
+			for _, tm := range matches {
+				if tm.LoserID.String == te.ID && tm.Round > scores[te.ID] {
+					scores[te.ID] = tm.Round
+				}
+			}
+		default:
+			scores[te.ID] = te.Wins + te.Byes
+		}
+	}
+
+	placements := make(map[string]int)
+	for _, te := range entrants {
+		placement := 1
+		for _, score := range scores {
+			if score > scores[te.ID] {
+				placement += 1
+			}
+		}
+		placements[te.ID] = placement
+	}
+
+	return placements
+}
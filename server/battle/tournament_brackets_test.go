@@ -0,0 +1,272 @@
+package battle
+
+import (
+	"fmt"
+	"server/db"
+	"server/db/boiler"
+	"testing"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+)
+
+func testTournamentEntrants(count int) []*boiler.TournamentEntrant {
+	entrants := []*boiler.TournamentEntrant{}
+	for i := 1; i <= count; i++ {
+		entrants = append(entrants, &boiler.TournamentEntrant{
+			ID:   fmt.Sprintf("seed-%d", i),
+			Seed: null.IntFrom(i),
+		})
+	}
+	return entrants
+}
+
+// testTournamentRound plays the next round of a tournament the same way tournamentMatchComplete records it, with the
+// winner of each match picked by the win func. Returns the matches of the round, nil when the tournament is over.
+func testTournamentRound(t *boiler.Tournament, entrants []*boiler.TournamentEntrant, matches []*boiler.TournamentMatch, win func(a *boiler.TournamentEntrant, b *boiler.TournamentEntrant) *boiler.TournamentEntrant) []*boiler.TournamentMatch {
+	pairings := tournamentNextRoundPairings(t, entrants, matches)
+	if len(pairings) == 0 {
+		return nil
+	}
+	t.CurrentRound += 1
+
+	round := []*boiler.TournamentMatch{}
+	for i, p := range pairings {
+		tm := &boiler.TournamentMatch{
+			Round:       t.CurrentRound,
+			MatchNumber: i + 1,
+			Bracket:     p.bracket,
+			EntrantAID:  p.a.ID,
+			WinnerID:    null.StringFrom(p.a.ID),
+		}
+		if p.b == nil {
+			p.a.Byes += 1
+			round = append(round, tm)
+			continue
+		}
+
+		tm.EntrantBID = null.StringFrom(p.b.ID)
+		winner, loser := p.a, p.b
+		if win(p.a, p.b) == p.b {
+			winner, loser = p.b, p.a
+		}
+		tm.WinnerID = null.StringFrom(winner.ID)
+		tm.LoserID = null.StringFrom(loser.ID)
+		winner.Wins += 1
+		loser.Losses += 1
+		if (t.Format == db.TournamentFormatSingleElimination && loser.Losses >= 1) ||
+			(t.Format == db.TournamentFormatDoubleElimination && loser.Losses >= 2) {
+			loser.EliminatedAt = null.TimeFrom(time.Now())
+		}
+		round = append(round, tm)
+	}
+
+	return round
+}
+
+func higherSeedWins(a *boiler.TournamentEntrant, b *boiler.TournamentEntrant) *boiler.TournamentEntrant {
+	if b.Seed.Int < a.Seed.Int {
+		return b
+	}
+	return a
+}
+
+func testTournamentMatchIDs(round []*boiler.TournamentMatch) []string {
+	ids := []string{}
+	for _, tm := range round {
+		ids = append(ids, fmt.Sprintf("%s:%s-%s", tm.Bracket, tm.EntrantAID, tm.EntrantBID.String))
+	}
+	return ids
+}
+
+func testTournamentExpectRound(t *testing.T, round []*boiler.TournamentMatch, expected []string) {
+	t.Helper()
+	ids := testTournamentMatchIDs(round)
+	if len(ids) != len(expected) {
+		t.Fatalf("unexpected matches: %v, expected %v", ids, expected)
+	}
+	for i := range ids {
+		if ids[i] != expected[i] {
+			t.Fatalf("unexpected matches: %v, expected %v", ids, expected)
+		}
+	}
+}
+
+func TestTournamentSingleElimination(t *testing.T) {
+	tournament := &boiler.Tournament{Format: db.TournamentFormatSingleElimination}
+	entrants := testTournamentEntrants(5)
+	matches := []*boiler.TournamentMatch{}
+
+	rounds := [][]string{
+		{"main:seed-1-", "main:seed-2-seed-5", "main:seed-3-seed-4"},
+		{"main:seed-1-", "main:seed-2-seed-3"},
+		{"main:seed-1-seed-2"},
+	}
+	for i, expected := range rounds {
+		round := testTournamentRound(tournament, entrants, matches, higherSeedWins)
+		if round == nil {
+			t.Fatalf("tournament ended after %d rounds", i)
+		}
+		testTournamentExpectRound(t, round, expected)
+		matches = append(matches, round...)
+	}
+	if round := testTournamentRound(tournament, entrants, matches, higherSeedWins); round != nil {
+		t.Fatalf("tournament should be over, got %v", testTournamentMatchIDs(round))
+	}
+
+	// entrants knocked out in the same round share a placement
+	placements := tournamentPlacements(tournament, entrants, matches)
+	expected := map[string]int{"seed-1": 1, "seed-2": 2, "seed-3": 3, "seed-4": 4, "seed-5": 4}
+	for id, placement := range expected {
+		if placements[id] != placement {
+			t.Fatalf("unexpected placement of %s: %d, expected %d", id, placements[id], placement)
+		}
+	}
+}
+
+func TestTournamentDoubleElimination_GrandFinalReset(t *testing.T) {
+	tournament := &boiler.Tournament{Format: db.TournamentFormatDoubleElimination}
+	entrants := testTournamentEntrants(4)
+	matches := []*boiler.TournamentMatch{}
+
+	// the higher seed wins every match until the grand final, which the losers bracket champion wins twice
+	win := func(a *boiler.TournamentEntrant, b *boiler.TournamentEntrant) *boiler.TournamentEntrant {
+		if tournament.CurrentRound >= 4 {
+			if a.ID == "seed-2" {
+				return a
+			}
+			return b
+		}
+		return higherSeedWins(a, b)
+	}
+
+	rounds := [][]string{
+		{"winners:seed-1-seed-4", "winners:seed-2-seed-3"},
+		{"winners:seed-1-seed-2", "losers:seed-3-seed-4"},
+		{"winners:seed-1-", "losers:seed-2-seed-3"},
+		{"grand_final:seed-1-seed-2"},
+		// the winners bracket champion lost their first match, so the grand final is played again
+		{"grand_final:seed-1-seed-2"},
+	}
+	for i, expected := range rounds {
+		round := testTournamentRound(tournament, entrants, matches, win)
+		if round == nil {
+			t.Fatalf("tournament ended after %d rounds", i)
+		}
+		testTournamentExpectRound(t, round, expected)
+		matches = append(matches, round...)
+	}
+	if round := testTournamentRound(tournament, entrants, matches, win); round != nil {
+		t.Fatalf("tournament should be over, got %v", testTournamentMatchIDs(round))
+	}
+
+	placements := tournamentPlacements(tournament, entrants, matches)
+	expected := map[string]int{"seed-2": 1, "seed-1": 2, "seed-3": 3, "seed-4": 4}
+	for id, placement := range expected {
+		if placements[id] != placement {
+			t.Fatalf("unexpected placement of %s: %d, expected %d", id, placements[id], placement)
+		}
+	}
+}
+
+func TestTournamentDoubleElimination_NoReset(t *testing.T) {
+	tournament := &boiler.Tournament{Format: db.TournamentFormatDoubleElimination}
+	entrants := testTournamentEntrants(4)
+	matches := []*boiler.TournamentMatch{}
+
+	for {
+		round := testTournamentRound(tournament, entrants, matches, higherSeedWins)
+		if round == nil {
+			break
+		}
+		matches = append(matches, round...)
+	}
+
+	// the winners bracket champion winning the first grand final ends the tournament
+	grandFinals := 0
+	for _, tm := range matches {
+		if tm.Bracket == db.TournamentBracketGrandFinal {
+			grandFinals += 1
+		}
+	}
+	if grandFinals != 1 {
+		t.Fatalf("unexpected grand finals: %d, expected 1", grandFinals)
+	}
+	if entrants[0].Losses != 0 {
+		t.Fatalf("top seed should be unbeaten")
+	}
+}
+
+func TestTournamentSwissRounds(t *testing.T) {
+	tests := []struct {
+		name         string
+		swissRounds  null.Int
+		entrantCount int
+		expected     int
+	}{
+		{"two entrants", null.Int{}, 2, 1},
+		{"four entrants", null.Int{}, 4, 2},
+		{"five entrants", null.Int{}, 5, 3},
+		{"eight entrants", null.Int{}, 8, 3},
+		{"nine entrants", null.Int{}, 9, 4},
+		{"set by host", null.IntFrom(5), 8, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rounds := tournamentSwissRounds(&boiler.Tournament{SwissRounds: tt.swissRounds}, tt.entrantCount)
+			if rounds != tt.expected {
+				t.Fatalf("unexpected rounds: %d, expected %d", rounds, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTournamentSwiss_Byes(t *testing.T) {
+	tournament := &boiler.Tournament{Format: db.TournamentFormatSwiss}
+	entrants := testTournamentEntrants(5)
+	matches := []*boiler.TournamentMatch{}
+
+	// the lowest ranked entrant without a bye sits out each round
+	expectedByes := []string{"seed-5", "seed-4", "seed-3"}
+	for i, expectedBye := range expectedByes {
+		round := testTournamentRound(tournament, entrants, matches, higherSeedWins)
+		if round == nil {
+			t.Fatalf("tournament ended after %d rounds", i)
+		}
+
+		played := make(map[string]bool)
+		byes := []string{}
+		for _, tm := range round {
+			for _, id := range []string{tm.EntrantAID, tm.EntrantBID.String} {
+				if id == "" {
+					continue
+				}
+				if played[id] {
+					t.Fatalf("round %d: %s plays more than once", i+1, id)
+				}
+				played[id] = true
+			}
+			if !tm.EntrantBID.Valid {
+				byes = append(byes, tm.EntrantAID)
+			}
+		}
+		if len(played) != len(entrants) {
+			t.Fatalf("round %d: unexpected entrants playing: %d", i+1, len(played))
+		}
+		if len(byes) != 1 || byes[0] != expectedBye {
+			t.Fatalf("round %d: unexpected byes: %v, expected %s", i+1, byes, expectedBye)
+		}
+
+		matches = append(matches, round...)
+	}
+
+	if round := testTournamentRound(tournament, entrants, matches, higherSeedWins); round != nil {
+		t.Fatalf("tournament should be over, got %v", testTournamentMatchIDs(round))
+	}
+	for _, te := range entrants {
+		if te.Byes > 1 {
+			t.Fatalf("%s had %d byes", te.ID, te.Byes)
+		}
+	}
+}
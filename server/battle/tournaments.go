@@ -0,0 +1,837 @@
+package battle
+
+import (
+	"fmt"
+	"math/rand"
+	"server"
+	"server/db"
+	"server/db/boiler"
+	"server/discord"
+	"server/gamedb"
+	"server/gamelog"
+	"server/xsyn_rpcclient"
+	"sort"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/ninja-software/terror/v2"
+	"github.com/ninja-syndicate/ws"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (am *ArenaManager) SendTournamentFunc(fn func() error) error {
+	am.TournamentFuncMx.Lock()
+	defer am.TournamentFuncMx.Unlock()
+	return fn()
+}
+
+// TournamentRegistrationChecker starts the tournaments whose registration has closed, and retries the match lobbies
+// and prize payouts of tournaments in progress which failed.
+func (am *ArenaManager) TournamentRegistrationChecker() {
+	ticker := time.NewTicker(30 * time.Second)
+
+	for {
+		<-ticker.C
+
+		am.tournamentRetry()
+
+		ts, err := boiler.Tournaments(
+			boiler.TournamentWhere.Status.EQ(db.TournamentStatusRegistration),
+			boiler.TournamentWhere.RegistrationEndsAt.LTE(time.Now()),
+		).All(gamedb.StdConn)
+		if err != nil {
+			gamelog.L.Error().Err(err).Msg("Failed to load tournaments which finished registration.")
+			continue
+		}
+
+		for _, t := range ts {
+			err = am.TournamentStart(t.ID)
+			if err != nil {
+				gamelog.L.Error().Err(err).Str("tournament id", t.ID).Msg("Failed to start tournament.")
+			}
+		}
+	}
+}
+
+// tournamentRetry creates the match lobbies which failed to be created, and pays out the prizes which failed to be paid.
+// A match whose lobby still can't be created after the retry period goes to the higher seed, so the tournament can't hang.
+func (am *ArenaManager) tournamentRetry() {
+	am.TournamentFuncMx.Lock()
+	defer am.TournamentFuncMx.Unlock()
+
+	ts, err := boiler.Tournaments(
+		boiler.TournamentWhere.Status.EQ(db.TournamentStatusInProgress),
+	).All(gamedb.StdConn)
+	if err != nil {
+		gamelog.L.Error().Err(err).Msg("Failed to load tournaments in progress.")
+		return
+	}
+
+	retryPeriod := time.Duration(db.GetIntWithDefault(db.KeyTournamentMatchLobbyRetryMinutes, 10)) * time.Minute
+
+	for _, t := range ts {
+		l := gamelog.L.With().Str("func", "tournamentRetry").Str("tournament id", t.ID).Logger()
+
+		entrants, err := db.TournamentEntrants(gamedb.StdConn, t.ID)
+		if err != nil {
+			l.Error().Err(err).Msg("Failed to load tournament entrants.")
+			continue
+		}
+		matches, err := db.TournamentMatches(gamedb.StdConn, t.ID)
+		if err != nil {
+			l.Error().Err(err).Msg("Failed to load tournament matches.")
+			continue
+		}
+
+		// placements are only recorded once the tournament is over, so it is waiting on its prizes
+		paying := false
+		for _, te := range entrants {
+			if te.Placement.Valid {
+				paying = true
+				break
+			}
+		}
+		if paying {
+			am.tournamentComplete(t, entrants, matches)
+			continue
+		}
+
+		stuck := false
+		for _, tm := range matches {
+			if tm.CompletedAt.Valid || tm.BattleLobbyID.Valid || !tm.EntrantBID.Valid || tm.Round != t.CurrentRound {
+				continue
+			}
+
+			a, b := tournamentEntrant(entrants, tm.EntrantAID), tournamentEntrant(entrants, tm.EntrantBID.String)
+			if a == nil || b == nil {
+				l.Error().Interface("tournament match", tm).Msg("Failed to find tournament match entrants.")
+				continue
+			}
+
+			if time.Since(tm.CreatedAt) > retryPeriod {
+				winner, loser := a, b
+				if b.Seed.Int < a.Seed.Int {
+					winner, loser = b, a
+				}
+				err = tournamentMatchComplete(t, tm, winner, loser, "lobby failed")
+				if err != nil {
+					l.Error().Err(err).Interface("tournament match", tm).Msg("Failed to complete tournament match.")
+					continue
+				}
+				stuck = true
+				continue
+			}
+
+			gameMaps, err := boiler.TournamentMaps(boiler.TournamentMapWhere.TournamentID.EQ(t.ID)).All(gamedb.StdConn)
+			if err != nil {
+				l.Error().Err(err).Msg("Failed to load tournament maps.")
+				continue
+			}
+			err = am.tournamentMatchLobbyCreate(t, tm, a, b, gameMaps)
+			if err != nil {
+				l.Error().Err(err).Interface("tournament match", tm).Msg("Failed to create tournament match lobby.")
+				continue
+			}
+			stuck = true
+		}
+
+		if stuck {
+			BroadcastTournament(t.ID)
+			am.tournamentRoundCheck(t)
+		}
+	}
+}
+
+// tournamentEntrant returns the entrant with the id, nil when they are not in the list.
+func tournamentEntrant(entrants boiler.TournamentEntrantSlice, entrantID string) *boiler.TournamentEntrant {
+	for _, te := range entrants {
+		if te.ID == entrantID {
+			return te
+		}
+	}
+	return nil
+}
+
+// TournamentStart closes the registration of a tournament, seeds the entrants and starts the first round.
+// The tournament is cancelled and everyone refunded when not enough players have entered.
+func (am *ArenaManager) TournamentStart(tournamentID string) error {
+	return am.SendTournamentFunc(func() error {
+		t, err := boiler.FindTournament(gamedb.StdConn, tournamentID)
+		if err != nil {
+			return terror.Error(err, "Failed to load tournament.")
+		}
+
+		if t.Status != db.TournamentStatusRegistration {
+			return terror.Error(fmt.Errorf("tournament is %s", t.Status), "The tournament has already started.")
+		}
+
+		entrants, err := db.TournamentEntrants(gamedb.StdConn, t.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(entrants) < t.MinEntrants {
+			return am.tournamentCancel(t, fmt.Sprintf("Tournament %s has been cancelled as not enough players entered, entry fees have been refunded.", t.Name))
+		}
+
+		// the highest rated mechs are the top seeds, and entrants with the same rating are seeded in the order they joined
+		mechIDs := []string{}
+		for _, te := range entrants {
+			mechIDs = append(mechIDs, te.MechID)
+		}
+		mrs, err := db.MechRatingsGet(gamedb.StdConn, mechIDs)
+		if err != nil {
+			return terror.Error(err, "Failed to load mech ratings.")
+		}
+		sort.SliceStable(entrants, func(i, j int) bool {
+			return mrs[entrants[i].MechID].Rating.GreaterThan(mrs[entrants[j].MechID].Rating)
+		})
+
+		tx, err := gamedb.StdConn.Begin()
+		if err != nil {
+			gamelog.L.Error().Err(err).Msg("Failed to start db transaction.")
+			return terror.Error(err, "Failed to start tournament.")
+		}
+		defer tx.Rollback()
+
+		for i, te := range entrants {
+			te.Seed = null.IntFrom(i + 1)
+			_, err = te.Update(tx, boil.Whitelist(boiler.TournamentEntrantColumns.Seed))
+			if err != nil {
+				gamelog.L.Error().Err(err).Interface("tournament entrant", te).Msg("Failed to seed tournament entrant.")
+				return terror.Error(err, "Failed to start tournament.")
+			}
+		}
+
+		t.Status = db.TournamentStatusInProgress
+		t.StartedAt = null.TimeFrom(time.Now())
+		t.UpdatedAt = time.Now()
+		_, err = t.Update(tx, boil.Whitelist(boiler.TournamentColumns.Status, boiler.TournamentColumns.StartedAt, boiler.TournamentColumns.UpdatedAt))
+		if err != nil {
+			gamelog.L.Error().Err(err).Interface("tournament", t).Msg("Failed to update tournament.")
+			return terror.Error(err, "Failed to start tournament.")
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			gamelog.L.Error().Err(err).Msg("Failed to commit db transaction.")
+			return terror.Error(err, "Failed to start tournament.")
+		}
+
+		am.tournamentNextRound(t)
+
+		return nil
+	})
+}
+
+// TournamentCancel cancels a tournament and refunds the entry fees and the prize put up by the host. A tournament can't be
+// cancelled once its matches have started or its prizes are being paid, and with registrationOnly it can only be cancelled
+// while registration is open.
+func (am *ArenaManager) TournamentCancel(tournamentID string, registrationOnly bool) error {
+	return am.SendTournamentFunc(func() error {
+		t, err := boiler.FindTournament(gamedb.StdConn, tournamentID)
+		if err != nil {
+			return terror.Error(err, "Failed to load tournament.")
+		}
+
+		if t.Status == db.TournamentStatusCompleted || t.Status == db.TournamentStatusCancelled {
+			return terror.Error(fmt.Errorf("tournament is %s", t.Status), "The tournament has already finished.")
+		}
+		if registrationOnly && t.Status != db.TournamentStatusRegistration {
+			return terror.Error(fmt.Errorf("tournament is %s", t.Status), "A tournament cannot be cancelled after it has started.")
+		}
+
+		entrants, err := db.TournamentEntrants(gamedb.StdConn, t.ID)
+		if err != nil {
+			return err
+		}
+		for _, te := range entrants {
+			if te.Placement.Valid || te.PrizeTXID.Valid {
+				return terror.Error(fmt.Errorf("tournament prizes are being paid"), "A tournament cannot be cancelled once its prizes are being paid.")
+			}
+		}
+
+		matches, err := db.TournamentMatches(gamedb.StdConn, t.ID)
+		if err != nil {
+			return err
+		}
+		for _, tm := range matches {
+			// byes are completed without being played
+			if tm.EntrantBID.Valid && (tm.BattleLobbyID.Valid || tm.CompletedAt.Valid) {
+				return terror.Error(fmt.Errorf("tournament matches have started"), "A tournament cannot be cancelled once its matches have started.")
+			}
+		}
+
+		return am.tournamentCancel(t, fmt.Sprintf("Tournament %s has been cancelled by the host, entry fees have been refunded.", t.Name))
+	})
+}
+
+// tournamentCancel refunds a tournament and announces why it was cancelled.
+func (am *ArenaManager) tournamentCancel(t *boiler.Tournament, announcement string) error {
+	entrants, err := db.TournamentEntrants(gamedb.StdConn, t.ID)
+	if err != nil {
+		return err
+	}
+
+	playerIDs := []string{}
+	for _, te := range entrants {
+		playerIDs = append(playerIDs, te.PlayerID)
+
+		if !te.PaidTXID.Valid || te.RefundTXID.Valid {
+			continue
+		}
+
+		refundTXID, err := am.RPCClient.RefundSupsMessage(te.PaidTXID.String)
+		if err != nil {
+			gamelog.L.Error().Err(err).Str("paid tx id", te.PaidTXID.String).Msg("Failed to refund tournament entry fee.")
+			continue
+		}
+
+		te.RefundTXID = null.StringFrom(refundTXID)
+		_, err = te.Update(gamedb.StdConn, boil.Whitelist(boiler.TournamentEntrantColumns.RefundTXID))
+		if err != nil {
+			gamelog.L.Error().Err(err).Interface("tournament entrant", te).Msg("Failed to update tournament entrant refund.")
+		}
+	}
+
+	if t.HostPrizeTXID.Valid && !t.HostPrizeRefundTXID.Valid {
+		refundTXID, err := am.RPCClient.RefundSupsMessage(t.HostPrizeTXID.String)
+		if err != nil {
+			gamelog.L.Error().Err(err).Str("host prize tx id", t.HostPrizeTXID.String).Msg("Failed to refund tournament host prize.")
+		} else {
+			t.HostPrizeRefundTXID = null.StringFrom(refundTXID)
+		}
+	}
+
+	t.Status = db.TournamentStatusCancelled
+	t.CancelledAt = null.TimeFrom(time.Now())
+	t.UpdatedAt = time.Now()
+	_, err = t.Update(gamedb.StdConn, boil.Whitelist(
+		boiler.TournamentColumns.Status,
+		boiler.TournamentColumns.CancelledAt,
+		boiler.TournamentColumns.HostPrizeRefundTXID,
+		boiler.TournamentColumns.UpdatedAt,
+	))
+	if err != nil {
+		gamelog.L.Error().Err(err).Interface("tournament", t).Msg("Failed to cancel tournament.")
+		return terror.Error(err, "Failed to cancel tournament.")
+	}
+
+	go tournamentAnnounce(am, announcement, playerIDs)
+	BroadcastTournament(t.ID)
+
+	return nil
+}
+
+// TournamentMatchResult records the result of a tournament match played in a battle lobby and moves the tournament on
+// once every match of the round is done. The entrant whose faction placed higher wins, and when both entrants were in the
+// same faction or their factions tied, it goes to the mech that survived, then the mech with more kills, then the higher seed.
+func (am *ArenaManager) TournamentMatchResult(battleLobbyID string, battleMechs boiler.BattleMechSlice, winningFactionIDOrder []string) {
+	am.TournamentFuncMx.Lock()
+	defer am.TournamentFuncMx.Unlock()
+
+	l := gamelog.L.With().Str("func", "TournamentMatchResult").Str("battle lobby id", battleLobbyID).Logger()
+
+	tm, err := db.TournamentMatchByBattleLobbyID(gamedb.StdConn, battleLobbyID)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to load tournament match.")
+		return
+	}
+	if tm == nil || tm.CompletedAt.Valid || !tm.EntrantBID.Valid {
+		return
+	}
+
+	t, err := boiler.FindTournament(gamedb.StdConn, tm.TournamentID)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to load tournament.")
+		return
+	}
+	if t.Status != db.TournamentStatusInProgress {
+		return
+	}
+
+	a, err := boiler.FindTournamentEntrant(gamedb.StdConn, tm.EntrantAID)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to load tournament entrant.")
+		return
+	}
+	b, err := boiler.FindTournamentEntrant(gamedb.StdConn, tm.EntrantBID.String)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to load tournament entrant.")
+		return
+	}
+
+	placements := make(map[string]int)
+	for i, factionID := range winningFactionIDOrder {
+		if _, ok := placements[factionID]; !ok {
+			placements[factionID] = i
+		}
+	}
+
+	type matchScore struct {
+		placement int
+		survived  bool
+		kills     int
+	}
+	score := func(te *boiler.TournamentEntrant) *matchScore {
+		ms := &matchScore{placement: len(winningFactionIDOrder) + 1}
+		for _, bm := range battleMechs {
+			if bm.MechID != te.MechID {
+				continue
+			}
+			if placement, ok := placements[bm.FactionID]; ok {
+				ms.placement = placement
+			} else {
+				ms.placement = len(winningFactionIDOrder)
+			}
+			ms.survived = bm.MechSurvived.Bool
+			ms.kills = bm.Kills
+		}
+		return ms
+	}
+
+	scoreA := score(a)
+	scoreB := score(b)
+
+	aWon := a.Seed.Int < b.Seed.Int
+	switch {
+	case scoreA.placement != scoreB.placement:
+		aWon = scoreA.placement < scoreB.placement
+	case scoreA.survived != scoreB.survived:
+		aWon = scoreA.survived
+	case scoreA.kills != scoreB.kills:
+		aWon = scoreA.kills > scoreB.kills
+	}
+
+	winner, loser := a, b
+	if !aWon {
+		winner, loser = b, a
+	}
+
+	err = tournamentMatchComplete(t, tm, winner, loser, "")
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to complete tournament match.")
+		return
+	}
+
+	BroadcastTournament(t.ID)
+
+	am.tournamentRoundCheck(t)
+}
+
+// tournamentRoundCheck starts the next round of a tournament once every match of the current round is done.
+func (am *ArenaManager) tournamentRoundCheck(t *boiler.Tournament) {
+	remaining, err := boiler.TournamentMatches(
+		boiler.TournamentMatchWhere.TournamentID.EQ(t.ID),
+		boiler.TournamentMatchWhere.Round.EQ(t.CurrentRound),
+		boiler.TournamentMatchWhere.CompletedAt.IsNull(),
+	).Count(gamedb.StdConn)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("tournament id", t.ID).Msg("Failed to count remaining tournament matches.")
+		return
+	}
+
+	if remaining > 0 {
+		return
+	}
+
+	am.tournamentNextRound(t)
+}
+
+// tournamentNextRound generates the matches of the next round of a tournament, or completes the tournament when it is over.
+// Byes and forfeits complete straight away, so a round where every match is decided moves on to the next one.
+func (am *ArenaManager) tournamentNextRound(t *boiler.Tournament) {
+	l := gamelog.L.With().Str("func", "tournamentNextRound").Str("tournament id", t.ID).Logger()
+
+	entrants, err := db.TournamentEntrants(gamedb.StdConn, t.ID)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to load tournament entrants.")
+		return
+	}
+	matches, err := db.TournamentMatches(gamedb.StdConn, t.ID)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to load tournament matches.")
+		return
+	}
+
+	pairings := tournamentNextRoundPairings(t, entrants, matches)
+	if len(pairings) == 0 {
+		am.tournamentComplete(t, entrants, matches)
+		return
+	}
+
+	gameMaps, err := boiler.TournamentMaps(boiler.TournamentMapWhere.TournamentID.EQ(t.ID)).All(gamedb.StdConn)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to load tournament maps.")
+		return
+	}
+
+	t.CurrentRound += 1
+	t.UpdatedAt = time.Now()
+	_, err = t.Update(gamedb.StdConn, boil.Whitelist(boiler.TournamentColumns.CurrentRound, boiler.TournamentColumns.UpdatedAt))
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to update tournament round.")
+		return
+	}
+
+	playerIDs := []string{}
+	for _, te := range entrants {
+		playerIDs = append(playerIDs, te.PlayerID)
+	}
+	go tournamentAnnounce(am, fmt.Sprintf("Round %d of tournament %s is starting with %d matches.", t.CurrentRound, t.Name, len(pairings)), playerIDs)
+
+	for i, p := range pairings {
+		tm := &boiler.TournamentMatch{
+			TournamentID: t.ID,
+			Round:        t.CurrentRound,
+			MatchNumber:  i + 1,
+			Bracket:      p.bracket,
+			EntrantAID:   p.a.ID,
+		}
+		if p.b != nil {
+			tm.EntrantBID = null.StringFrom(p.b.ID)
+		}
+
+		err = tm.Insert(gamedb.StdConn, boil.Infer())
+		if err != nil {
+			l.Error().Err(err).Interface("tournament match", tm).Msg("Failed to insert tournament match.")
+			return
+		}
+
+		if p.b == nil {
+			err = tournamentMatchComplete(t, tm, p.a, nil, "bye")
+			if err != nil {
+				l.Error().Err(err).Interface("tournament match", tm).Msg("Failed to record tournament bye.")
+			}
+			continue
+		}
+
+		err = am.tournamentMatchLobbyCreate(t, tm, p.a, p.b, gameMaps)
+		if err != nil {
+			l.Error().Err(err).Interface("tournament match", tm).Msg("Failed to create tournament match lobby.")
+		}
+	}
+
+	BroadcastTournament(t.ID)
+
+	am.tournamentRoundCheck(t)
+}
+
+// tournamentMatchLobbyCreate queues the mechs of both entrants into a private lobby on a map from the map pool, which
+// the AI fill process then completes with staked mechs. An entrant whose mech cannot be deployed forfeits the match.
+func (am *ArenaManager) tournamentMatchLobbyCreate(t *boiler.Tournament, tm *boiler.TournamentMatch, a *boiler.TournamentEntrant, b *boiler.TournamentEntrant, gameMaps boiler.TournamentMapSlice) error {
+	var bl *boiler.BattleLobby
+	var forfeited []*boiler.TournamentEntrant
+
+	err := am.SendBattleQueueFunc(func() error {
+		for _, te := range []*boiler.TournamentEntrant{a, b} {
+			owned, err := boiler.CollectionItems(
+				boiler.CollectionItemWhere.ItemID.EQ(te.MechID),
+				boiler.CollectionItemWhere.OwnerID.EQ(te.PlayerID),
+			).Exists(gamedb.StdConn)
+			if err != nil {
+				return terror.Error(err, "Failed to check mech owner.")
+			}
+
+			available := []string{}
+			if owned {
+				available, err = db.OverDamagedMechFilter([]string{te.MechID})
+				if err != nil {
+					return err
+				}
+			}
+			if len(available) > 0 {
+				available, err = db.NonQueuedMechFilter(available)
+				if err != nil {
+					return err
+				}
+			}
+
+			if len(available) == 0 {
+				forfeited = append(forfeited, te)
+			}
+		}
+
+		if len(forfeited) > 0 {
+			return nil
+		}
+
+		tx, err := gamedb.StdConn.Begin()
+		if err != nil {
+			gamelog.L.Error().Err(err).Msg("Failed to start db transaction.")
+			return terror.Error(err, "Failed to create tournament match lobby.")
+		}
+		defer tx.Rollback()
+
+		bl = &boiler.BattleLobby{
+			HostByID:              t.HostByID,
+			Name:                  fmt.Sprintf("%s R%d M%d", t.Name, tm.Round, tm.MatchNumber),
+			EntryFee:              decimal.Zero,
+			FirstFactionCut:       decimal.NewFromFloat(0.75),
+			SecondFactionCut:      decimal.NewFromFloat(0.25),
+			ThirdFactionCut:       decimal.Zero,
+			EachFactionMechAmount: db.FACTION_MECH_LIMIT,
+			MaxDeployPerPlayer:    1,
+			AccessCode:            null.StringFrom(uuid.Must(uuid.NewV4()).String()),
+			IsPrivate:             true,
+		}
+		if len(gameMaps) > 0 {
+			bl.GameMapID = null.StringFrom(gameMaps[rand.Intn(len(gameMaps))].GameMapID)
+		}
+
+		err = bl.Insert(tx, boil.Infer())
+		if err != nil {
+			gamelog.L.Error().Err(err).Interface("battle lobby", bl).Msg("Failed to insert tournament match lobby.")
+			return terror.Error(err, "Failed to create tournament match lobby.")
+		}
+
+		for _, te := range []*boiler.TournamentEntrant{a, b} {
+			blm := &boiler.BattleLobbiesMech{
+				BattleLobbyID: bl.ID,
+				MechID:        te.MechID,
+				QueuedByID:    te.PlayerID,
+				FactionID:     te.FactionID,
+			}
+			err = blm.Insert(tx, boil.Infer())
+			if err != nil {
+				gamelog.L.Error().Err(err).Interface("battle lobby mech", blm).Msg("Failed to insert tournament mech.")
+				return terror.Error(err, "Failed to create tournament match lobby.")
+			}
+		}
+
+		tm.BattleLobbyID = null.StringFrom(bl.ID)
+		_, err = tm.Update(tx, boil.Whitelist(boiler.TournamentMatchColumns.BattleLobbyID))
+		if err != nil {
+			gamelog.L.Error().Err(err).Interface("tournament match", tm).Msg("Failed to update tournament match lobby.")
+			return terror.Error(err, "Failed to create tournament match lobby.")
+		}
+
+		return tx.Commit()
+	})
+	if err != nil {
+		return err
+	}
+
+	switch len(forfeited) {
+	case 1:
+		winner := a
+		if forfeited[0].ID == a.ID {
+			winner = b
+		}
+		return tournamentMatchComplete(t, tm, winner, forfeited[0], "forfeit")
+	case 2:
+		// nobody could play, so the higher seed goes through
+		winner, loser := a, b
+		if b.Seed.Int < a.Seed.Int {
+			winner, loser = b, a
+		}
+		return tournamentMatchComplete(t, tm, winner, loser, "double forfeit")
+	}
+
+	mechIDs := []string{a.MechID, b.MechID}
+	err = am.PauseRepairCases(mechIDs)
+	if err != nil {
+		gamelog.L.Error().Err(err).Strs("mech ids", mechIDs).Msg("Failed to pause repair cases.")
+	}
+
+	am.MechDebounceBroadcastChan <- mechIDs
+	go BroadcastPlayerQueueStatus(a.PlayerID)
+	go BroadcastPlayerQueueStatus(b.PlayerID)
+
+	// fill the rest of the lobby, which makes it ready to be played
+	go am.AIMechFillingProcess(bl.ID)
+
+	return nil
+}
+
+// tournamentMatchComplete records the winner of a match. The loser is nil when the winner had a bye.
+// Losing knocks an entrant out of a single elimination tournament, and losing twice out of a double elimination one.
+func tournamentMatchComplete(t *boiler.Tournament, tm *boiler.TournamentMatch, winner *boiler.TournamentEntrant, loser *boiler.TournamentEntrant, note string) error {
+	tx, err := gamedb.StdConn.Begin()
+	if err != nil {
+		return terror.Error(err, "Failed to start db transaction.")
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+
+	tm.WinnerID = null.StringFrom(winner.ID)
+	tm.CompletedAt = null.TimeFrom(now)
+	if note != "" {
+		tm.ResultNote = null.StringFrom(note)
+	}
+
+	if loser == nil {
+		winner.Byes += 1
+	} else {
+		tm.LoserID = null.StringFrom(loser.ID)
+		winner.Wins += 1
+		loser.Losses += 1
+
+		if (t.Format == db.TournamentFormatSingleElimination && loser.Losses >= 1) ||
+			(t.Format == db.TournamentFormatDoubleElimination && loser.Losses >= 2) {
+			loser.EliminatedAt = null.TimeFrom(now)
+		}
+
+		_, err = loser.Update(tx, boil.Whitelist(boiler.TournamentEntrantColumns.Losses, boiler.TournamentEntrantColumns.EliminatedAt))
+		if err != nil {
+			return terror.Error(err, "Failed to update tournament entrant.")
+		}
+	}
+
+	_, err = winner.Update(tx, boil.Whitelist(boiler.TournamentEntrantColumns.Wins, boiler.TournamentEntrantColumns.Byes))
+	if err != nil {
+		return terror.Error(err, "Failed to update tournament entrant.")
+	}
+
+	_, err = tm.Update(tx, boil.Whitelist(
+		boiler.TournamentMatchColumns.WinnerID,
+		boiler.TournamentMatchColumns.LoserID,
+		boiler.TournamentMatchColumns.ResultNote,
+		boiler.TournamentMatchColumns.CompletedAt,
+	))
+	if err != nil {
+		return terror.Error(err, "Failed to update tournament match.")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return terror.Error(err, "Failed to commit db transaction.")
+	}
+
+	return nil
+}
+
+// tournamentComplete records the final placements of a tournament and pays out the prize pool. The tournament stays in
+// progress until every prize is paid, and the payouts which failed are retried by the registration checker.
+func (am *ArenaManager) tournamentComplete(t *boiler.Tournament, entrants boiler.TournamentEntrantSlice, matches boiler.TournamentMatchSlice) {
+	l := gamelog.L.With().Str("func", "tournamentComplete").Str("tournament id", t.ID).Logger()
+
+	splits, err := boiler.TournamentPrizeSplits(boiler.TournamentPrizeSplitWhere.TournamentID.EQ(t.ID)).All(gamedb.StdConn)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to load tournament prize splits.")
+		return
+	}
+
+	placements := tournamentPlacements(t, entrants, matches)
+	prizes := db.TournamentPrizes(splits, placements, db.TournamentPrizePool(t, len(entrants)))
+
+	playerIDs := []string{}
+	unpaid := 0
+	var champion *boiler.TournamentEntrant
+	for _, te := range entrants {
+		playerIDs = append(playerIDs, te.PlayerID)
+
+		te.Placement = null.IntFrom(placements[te.ID])
+		if te.Placement.Int == 1 && champion == nil {
+			champion = te
+		}
+
+		prize, ok := prizes[te.ID]
+		if ok && prize.IsPositive() && !te.PrizeTXID.Valid {
+			te.Prize = decimal.NewNullDecimal(prize)
+
+			// the reference is the same on every retry, so a prize is never paid twice
+			prizeTXID, err := am.RPCClient.SpendSupMessage(xsyn_rpcclient.SpendSupsReq{
+				FromUserID:           uuid.Must(uuid.FromString(server.SupremacyBattleUserID)),
+				ToUserID:             uuid.Must(uuid.FromString(te.PlayerID)),
+				Amount:               prize.StringFixed(0),
+				TransactionReference: server.TransactionReference(fmt.Sprintf("tournament_prize|%s|%s", t.ID, te.ID)),
+				Group:                string(server.TransactionGroupSupremacy),
+				SubGroup:             string(server.TransactionGroupBattle),
+				Description:          fmt.Sprintf("prize for placing %d in tournament %s.", te.Placement.Int, t.Name),
+			})
+			if err != nil {
+				l.Error().Err(err).Str("player id", te.PlayerID).Str("amount", prize.StringFixed(0)).Msg("Failed to pay tournament prize.")
+				unpaid += 1
+			} else {
+				te.PrizeTXID = null.StringFrom(prizeTXID)
+			}
+		}
+
+		_, err = te.Update(gamedb.StdConn, boil.Whitelist(
+			boiler.TournamentEntrantColumns.Placement,
+			boiler.TournamentEntrantColumns.Prize,
+			boiler.TournamentEntrantColumns.PrizeTXID,
+		))
+		if err != nil {
+			l.Error().Err(err).Interface("tournament entrant", te).Msg("Failed to update tournament entrant placement.")
+			if te.PrizeTXID.Valid {
+				unpaid += 1
+			}
+		}
+	}
+
+	if unpaid > 0 {
+		l.Warn().Int("unpaid", unpaid).Msg("Tournament prizes are unpaid, retrying later.")
+		BroadcastTournament(t.ID)
+		return
+	}
+
+	t.Status = db.TournamentStatusCompleted
+	t.EndedAt = null.TimeFrom(time.Now())
+	t.UpdatedAt = time.Now()
+	_, err = t.Update(gamedb.StdConn, boil.Whitelist(boiler.TournamentColumns.Status, boiler.TournamentColumns.EndedAt, boiler.TournamentColumns.UpdatedAt))
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to complete tournament.")
+		return
+	}
+
+	announcement := fmt.Sprintf("Tournament %s is over.", t.Name)
+	if champion != nil {
+		p, err := boiler.FindPlayer(gamedb.StdConn, champion.PlayerID)
+		if err == nil {
+			announcement = fmt.Sprintf("Tournament %s is over, congratulations to the champion %s #%d! 🏆", t.Name, p.Username.String, p.Gid)
+		}
+	}
+	go tournamentAnnounce(am, announcement, playerIDs)
+
+	BroadcastTournament(t.ID)
+}
+
+// tournamentAnnounce posts a tournament announcement to discord, and sends it to the entrants who enabled telegram notifications.
+func tournamentAnnounce(am *ArenaManager, msg string, playerIDs []string) {
+	if discord.Session != nil {
+		channelID := db.GetStrWithDefault(db.KeyDiscordTournamentChannelID, db.GetStrWithDefault(db.KeyDiscordBattleArenaChannelID, "973800997128392785"))
+		err := discord.Session.SendDiscordMessage(channelID, msg)
+		if err != nil {
+			gamelog.L.Error().Err(err).Str("message", msg).Msg("Failed to send tournament announcement to discord.")
+		}
+	}
+
+	if len(playerIDs) == 0 {
+		return
+	}
+
+	prefs, err := boiler.PlayerSettingsPreferences(
+		boiler.PlayerSettingsPreferenceWhere.PlayerID.IN(playerIDs),
+		boiler.PlayerSettingsPreferenceWhere.EnableTelegramNotifications.EQ(true),
+		qm.Where(fmt.Sprintf("%s NOTNULL", boiler.PlayerSettingsPreferenceColumns.TelegramID)),
+	).All(gamedb.StdConn)
+	if err != nil {
+		gamelog.L.Error().Err(err).Strs("player ids", playerIDs).Msg("Failed to load player preferences.")
+		return
+	}
+
+	for _, pref := range prefs {
+		err = am.telegram.Notify(pref.TelegramID.Int64, msg)
+		if err != nil {
+			gamelog.L.Error().Err(err).Str("player id", pref.PlayerID).Msg("Failed to send tournament telegram notification.")
+		}
+	}
+}
+
+// BroadcastTournament sends the bracket state of a tournament to the players watching it.
+func BroadcastTournament(tournamentID string) {
+	state, err := db.TournamentGet(gamedb.StdConn, tournamentID)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("tournament id", tournamentID).Msg("Failed to load tournament state.")
+		return
+	}
+
+	ws.PublishMessage(fmt.Sprintf("/public/tournament/%s", tournamentID), server.HubKeyTournamentUpdate, state)
+	ws.PublishMessage("/public/tournament_list", server.HubKeyTournamentListUpdate, []*boiler.Tournament{state.Tournament})
+}
@@ -88,6 +88,7 @@ type WarMachineGameClient struct {
 	HeightMeters float64  `json:"height"`
 
 	Weapons       []*Weapon               `json:"weapons"`
+	AmmoLoadout   []*WeaponAmmoLoadout    `json:"ammo_loadout"`
 	Customisation WarMachineCustomisation `json:"customisation"`
 
 	Health    uint32 `json:"health"`
@@ -206,6 +207,11 @@ type Weapon struct {
 	ChargeTimeSeconds   float64 `json:"charge_time"`
 	BurstRateOfFire     float64 `json:"burst_rate_of_fire"`
 	SocketIndex         int     `json:"socket_index"`
+
+	// these fields below are used by us and not game client
+	weaponType string
+	isMelee    bool
+	ammo       *WeaponAmmoLoadout
 }
 
 type Utility struct {
@@ -272,7 +278,8 @@ func WarMachineToClient(wm *WarMachine) *WarMachineGameClient {
 
 		HeightMeters: wm.HeightMeters,
 
-		Weapons: wm.Weapons,
+		Weapons:     wm.Weapons,
+		AmmoLoadout: []*WeaponAmmoLoadout{},
 
 		Health:                  wm.Health,
 		HealthMax:               wm.MaxHealth,
@@ -287,6 +294,12 @@ func WarMachineToClient(wm *WarMachine) *WarMachineGameClient {
 		Stats: wm.Stats,
 	}
 
+	for _, wpn := range wm.Weapons {
+		if wpn.ammo != nil {
+			wmgc.AmmoLoadout = append(wmgc.AmmoLoadout, wpn.ammo)
+		}
+	}
+
 	if wm.PowerCore != nil {
 		wmgc.PowerCore = PowerCoreGameClient{
 			ID:                       wm.PowerCore.ID,
@@ -354,6 +367,8 @@ func WeaponFromServer(weapon *server.Weapon, compatibilityList []string, inherit
 		IsArced:             weapon.IsArced.Bool,
 		ChargeTimeSeconds:   weapon.ChargeTimeSeconds.Decimal.InexactFloat64(),
 		BurstRateOfFire:     weapon.BurstRateOfFire.Decimal.InexactFloat64(),
+		weaponType:          weapon.WeaponType,
+		isMelee:             weapon.IsMelee,
 	}
 }
 
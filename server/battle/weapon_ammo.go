@@ -0,0 +1,129 @@
+package battle
+
+import (
+	"server/db"
+	"server/db/boiler"
+	"server/gamelog"
+
+	"github.com/shopspring/decimal"
+	"golang.org/x/exp/slices"
+)
+
+// WeaponAmmoLoadout is the ammo loaded into a weapon for the battle
+type WeaponAmmoLoadout struct {
+	WeaponHash      string `json:"weapon_hash"`
+	SocketIndex     int    `json:"socket_index"`
+	BlueprintAmmoID string `json:"blueprint_ammo_id"`
+	Label           string `json:"label"`
+	Count           int    `json:"count"`
+}
+
+// ammoMultiplier returns the multiplier of an ammo stat, unset multipliers do not change the stat
+func ammoMultiplier(m decimal.NullDecimal) float64 {
+	if !m.Valid || m.Decimal.IsZero() {
+		return 1
+	}
+	return m.Decimal.InexactFloat64()
+}
+
+// applyAmmo applies the stat multipliers of the loaded ammo to the weapon
+func (wpn *Weapon) applyAmmo(wa *boiler.WeaponAmmo) {
+	bpa := wa.R.BlueprintAmmo
+
+	wpn.Damage = int(float64(wpn.Damage) * ammoMultiplier(bpa.DamageMultiplier))
+	wpn.DamageFalloff = int(float64(wpn.DamageFalloff) * ammoMultiplier(bpa.DamageFalloffMultiplier))
+	wpn.DamageFalloffRate = int(float64(wpn.DamageFalloffRate) * ammoMultiplier(bpa.DamageFalloffRateMultiplier))
+	wpn.DamageRadius = int(float64(wpn.DamageRadius) * ammoMultiplier(bpa.RadiusMultiplier))
+	wpn.Spread = wpn.Spread * ammoMultiplier(bpa.SpreadMultiplier)
+	wpn.RateOfFire = wpn.RateOfFire * ammoMultiplier(bpa.RateOfFireMultiplier)
+	wpn.ProjectileSpeed = int(float64(wpn.ProjectileSpeed) * ammoMultiplier(bpa.ProjectileSpeedMultiplier))
+	wpn.PowerCost = wpn.PowerCost * ammoMultiplier(bpa.EnergyCostMultiplier)
+	wpn.MaxAmmo = int(float64(wpn.MaxAmmo) * ammoMultiplier(bpa.MaxAmmoMultiplier))
+
+	wpn.ammo = &WeaponAmmoLoadout{
+		WeaponHash:      wpn.Hash,
+		SocketIndex:     wpn.SocketIndex,
+		BlueprintAmmoID: bpa.ID,
+		Label:           bpa.Label,
+		Count:           wa.Count,
+	}
+}
+
+// applyNoAmmo reduces the stats of a weapon which is deployed without ammo
+func (wpn *Weapon) applyNoAmmo(multiplier float64) {
+	wpn.Damage = int(float64(wpn.Damage) * multiplier)
+	wpn.DotTickDamage = wpn.DotTickDamage * multiplier
+	wpn.RateOfFire = wpn.RateOfFire * multiplier
+}
+
+// loadWeaponAmmo applies the ammo loaded into the weapons of the war machines.
+// Weapons of a type which has ammo fall back to reduced stats when they are deployed without it.
+func (btl *Battle) loadWeaponAmmo() {
+	weaponIDs := []string{}
+	for _, wm := range btl.WarMachines {
+		for _, wpn := range wm.Weapons {
+			weaponIDs = append(weaponIDs, wpn.ID)
+		}
+	}
+
+	was, err := db.WeaponAmmoLoadouts(weaponIDs)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("battle id", btl.ID).Msg("Failed to load weapon ammo.")
+		return
+	}
+
+	ammoWeaponTypes, err := db.AmmoWeaponTypes()
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("battle id", btl.ID).Msg("Failed to load ammo weapon types.")
+		return
+	}
+
+	noAmmoMultiplier := db.GetDecimalWithDefault(db.KeyWeaponNoAmmoStatMultiplier, decimal.NewFromFloat(0.5)).InexactFloat64()
+
+	for _, wm := range btl.WarMachines {
+		for _, wpn := range wm.Weapons {
+			if wpn.isMelee || !slices.Contains(ammoWeaponTypes, wpn.weaponType) {
+				continue
+			}
+
+			index := slices.IndexFunc(was, func(wa *boiler.WeaponAmmo) bool { return wa.WeaponID == wpn.ID })
+			if index == -1 || was[index].R == nil || was[index].R.BlueprintAmmo == nil {
+				wpn.applyNoAmmo(noAmmoMultiplier)
+				continue
+			}
+
+			wpn.applyAmmo(was[index])
+		}
+	}
+}
+
+// consumeWeaponAmmo removes the shots the game client reported at the end of the battle from the loaded ammo
+func (btl *Battle) consumeWeaponAmmo(payload *BattleEndPayload) {
+	if payload == nil {
+		return
+	}
+
+	for _, shot := range payload.WeaponShots {
+		index := slices.IndexFunc(btl.WarMachines, func(wm *WarMachine) bool { return wm.Hash == shot.WarMachineHash })
+		if index == -1 {
+			continue
+		}
+		wm := btl.WarMachines[index]
+
+		index = slices.IndexFunc(wm.Weapons, func(wpn *Weapon) bool { return wpn.Hash == shot.WeaponHash })
+		if index == -1 {
+			continue
+		}
+		wpn := wm.Weapons[index]
+
+		// weapons without ammo loaded have nothing to consume
+		if wpn.ammo == nil {
+			continue
+		}
+
+		_, err := db.WeaponAmmoConsume(btl.ID, wpn.ID, shot.ShotsFired)
+		if err != nil {
+			gamelog.L.Error().Err(err).Str("battle id", btl.ID).Str("weapon id", wpn.ID).Int("shots fired", shot.ShotsFired).Msg("Failed to consume weapon ammo.")
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package battle
+
+import (
+	"server/db/boiler"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestAmmoMultiplier(t *testing.T) {
+	tests := []struct {
+		name       string
+		multiplier decimal.NullDecimal
+		expected   float64
+	}{
+		{"unset", decimal.NullDecimal{}, 1},
+		{"zero", decimal.NewNullDecimal(decimal.Zero), 1},
+		{"increase", decimal.NewNullDecimal(decimal.NewFromFloat(1.25)), 1.25},
+		{"decrease", decimal.NewNullDecimal(decimal.NewFromFloat(0.5)), 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if m := ammoMultiplier(tt.multiplier); m != tt.expected {
+				t.Fatalf("unexpected multiplier: %f, expected %f", m, tt.expected)
+			}
+		})
+	}
+}
+
+func testAmmoWeapon() *Weapon {
+	return &Weapon{
+		Hash:              "weapon-hash",
+		SocketIndex:       2,
+		Damage:            100,
+		DamageFalloff:     400,
+		DamageFalloffRate: 20,
+		DamageRadius:      50,
+		Spread:            4,
+		RateOfFire:        120,
+		ProjectileSpeed:   1000,
+		PowerCost:         10,
+		MaxAmmo:           30,
+		DotTickDamage:     8,
+	}
+}
+
+func TestWeapon_ApplyAmmo(t *testing.T) {
+	wa := &boiler.WeaponAmmo{
+		BlueprintAmmoID: "ammo-id",
+		Count:           12,
+	}
+	wa.R = wa.R.NewStruct()
+	wa.R.BlueprintAmmo = &boiler.BlueprintAmmo{
+		ID:                   "ammo-id",
+		Label:                "Armour Piercing",
+		DamageMultiplier:     decimal.NewNullDecimal(decimal.NewFromFloat(1.5)),
+		SpreadMultiplier:     decimal.NewNullDecimal(decimal.NewFromFloat(0.5)),
+		RateOfFireMultiplier: decimal.NewNullDecimal(decimal.NewFromFloat(0.75)),
+		RadiusMultiplier:     decimal.NewNullDecimal(decimal.Zero),
+		MaxAmmoMultiplier:    decimal.NewNullDecimal(decimal.NewFromFloat(0.5)),
+	}
+
+	wpn := testAmmoWeapon()
+	wpn.applyAmmo(wa)
+
+	expected := testAmmoWeapon()
+	expected.Damage = 150
+	expected.Spread = 2
+	expected.RateOfFire = 90
+	expected.MaxAmmo = 15
+
+	if wpn.Damage != expected.Damage {
+		t.Errorf("unexpected damage: %d, expected %d", wpn.Damage, expected.Damage)
+	}
+	if wpn.DamageFalloff != expected.DamageFalloff {
+		t.Errorf("unexpected damage falloff: %d, expected %d", wpn.DamageFalloff, expected.DamageFalloff)
+	}
+	if wpn.DamageFalloffRate != expected.DamageFalloffRate {
+		t.Errorf("unexpected damage falloff rate: %d, expected %d", wpn.DamageFalloffRate, expected.DamageFalloffRate)
+	}
+	if wpn.DamageRadius != expected.DamageRadius {
+		t.Errorf("unexpected damage radius: %d, expected %d", wpn.DamageRadius, expected.DamageRadius)
+	}
+	if wpn.Spread != expected.Spread {
+		t.Errorf("unexpected spread: %f, expected %f", wpn.Spread, expected.Spread)
+	}
+	if wpn.RateOfFire != expected.RateOfFire {
+		t.Errorf("unexpected rate of fire: %f, expected %f", wpn.RateOfFire, expected.RateOfFire)
+	}
+	if wpn.ProjectileSpeed != expected.ProjectileSpeed {
+		t.Errorf("unexpected projectile speed: %d, expected %d", wpn.ProjectileSpeed, expected.ProjectileSpeed)
+	}
+	if wpn.PowerCost != expected.PowerCost {
+		t.Errorf("unexpected power cost: %f, expected %f", wpn.PowerCost, expected.PowerCost)
+	}
+	if wpn.MaxAmmo != expected.MaxAmmo {
+		t.Errorf("unexpected max ammo: %d, expected %d", wpn.MaxAmmo, expected.MaxAmmo)
+	}
+
+	if wpn.ammo == nil {
+		t.Fatalf("ammo loadout is not set")
+	}
+	if wpn.ammo.WeaponHash != "weapon-hash" || wpn.ammo.SocketIndex != 2 || wpn.ammo.BlueprintAmmoID != "ammo-id" || wpn.ammo.Label != "Armour Piercing" || wpn.ammo.Count != 12 {
+		t.Errorf("unexpected ammo loadout: %+v", wpn.ammo)
+	}
+}
+
+func TestWeapon_ApplyNoAmmo(t *testing.T) {
+	wpn := testAmmoWeapon()
+	wpn.applyNoAmmo(0.5)
+
+	if wpn.Damage != 50 {
+		t.Errorf("unexpected damage: %d, expected 50", wpn.Damage)
+	}
+	if wpn.DotTickDamage != 4 {
+		t.Errorf("unexpected dot tick damage: %f, expected 4", wpn.DotTickDamage)
+	}
+	if wpn.RateOfFire != 60 {
+		t.Errorf("unexpected rate of fire: %f, expected 60", wpn.RateOfFire)
+	}
+
+	// only the damage and rate of fire are reduced
+	if wpn.Spread != 4 || wpn.MaxAmmo != 30 || wpn.ammo != nil {
+		t.Errorf("unexpected weapon change: %+v", wpn)
+	}
+}
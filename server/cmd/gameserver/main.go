@@ -16,6 +16,7 @@ import (
 	"server/comms"
 	"server/db"
 	"server/db/boiler"
+	"server/game_client_sim"
 	"server/gamedb"
 	"server/gamelog"
 	"server/profanities"
@@ -24,6 +25,7 @@ import (
 	"server/slack"
 	"server/sms"
 	"server/synctool"
+	"server/system_messages"
 	"server/telegram"
 	"server/voice_chat"
 	"server/xsyn_rpcclient"
@@ -417,13 +419,16 @@ func main() {
 
 					start = time.Now()
 					// initialise quest manager
-					qm, err := quest.New()
+					qm, err := quest.New(rpcClient)
 					if err != nil {
 						fmt.Println(err)
 						os.Exit(1)
 					}
 					gamelog.L.Info().Msgf("Quest manager took %s", time.Since(start))
 
+					// initialise system messaging manager
+					smm := system_messages.New()
+
 					start = time.Now()
 					// initialise battle arena
 					gamelog.L.Info().Str("battle_arena_addr", battleArenaAddr).Msg("Setting up battle arena")
@@ -435,6 +440,7 @@ func main() {
 						Telegram:                 telebot,
 						GameClientMinimumBuildNo: gameClientMinimumBuildNo,
 						QuestManager:             qm,
+						SystemMessagingManager:   smm,
 						// DiscordSession:           discordBot,
 					})
 					if err != nil {
@@ -534,6 +540,8 @@ func main() {
 					&cli.StringFlag{Name: "database_name", Value: "gameserver", EnvVars: []string{envPrefix + "_DATABASE_NAME", "DATABASE_NAME"}, Usage: "The database name"},
 					&cli.StringFlag{Name: "database_application_name", Value: "API Sync", EnvVars: []string{envPrefix + "_DATABASE_APPLICATION_NAME"}, Usage: "Postgres database name"},
 					&cli.StringFlag{Name: "static_path", Value: "./synctool/temp-sync/supremacy-static-data/", EnvVars: []string{envPrefix + "_STATIC_PATH"}, Usage: "Static path to file"},
+					&cli.BoolFlag{Name: "dry_run", Value: false, Usage: "Roll the sync back once the report of what would change is printed"},
+					&cli.StringFlag{Name: "report_path", Value: "", Usage: "Path to write the json report of the sync to"},
 					&cli.IntFlag{Name: "database_max_idle_conns", Value: 40, EnvVars: []string{envPrefix + "_DATABASE_MAX_IDLE_CONNS"}, Usage: "Database max idle conns"},
 					&cli.IntFlag{Name: "database_max_open_conns", Value: 50, EnvVars: []string{envPrefix + "_DATABASE_MAX_OPEN_CONNS"}, Usage: "Database max open conns"},
 				},
@@ -572,8 +580,10 @@ func main() {
 					}
 
 					dt := &synctool.StaticSyncTool{
-						DB:       sqlconn,
-						FilePath: filePath,
+						DB:         sqlconn,
+						FilePath:   filePath,
+						DryRun:     c.Bool("dry_run"),
+						ReportPath: c.String("report_path"),
 					}
 
 					err = synctool.SyncTool(dt)
@@ -584,6 +594,70 @@ func main() {
 					return nil
 				},
 			},
+			{
+				Name: "simulate",
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "arena_addr", Value: "ws://localhost:8083", EnvVars: []string{envPrefix + "_SIM_ARENA_ADDR"}, Usage: "websocket address of the battle arena server"},
+					&cli.StringFlag{Name: "arena_id", Required: true, EnvVars: []string{envPrefix + "_SIM_ARENA_ID"}, Usage: "id of the battle arena to connect to"},
+					&cli.StringFlag{Name: "client_key", Required: true, EnvVars: []string{envPrefix + "_SIM_CLIENT_KEY"}, Usage: "secret of the arena client key"},
+					&cli.StringFlag{Name: "build_no", Value: "0", EnvVars: []string{envPrefix + "_SIM_BUILD_NO"}, Usage: "game client build number to present in the handshake"},
+					&cli.IntFlag{Name: "battles", Value: 1, EnvVars: []string{envPrefix + "_SIM_BATTLES"}, Usage: "number of battles to play, 0 plays until interrupted"},
+					&cli.Int64Flag{Name: "seed", Value: 0, EnvVars: []string{envPrefix + "_SIM_SEED"}, Usage: "seed of the randomised battle outcomes, defaults to the current time"},
+					&cli.StringFlag{Name: "script", Value: "", EnvVars: []string{envPrefix + "_SIM_SCRIPT"}, Usage: "path of a json file which scripts the battle outcomes"},
+					&cli.DurationFlag{Name: "tick_interval", Value: 250 * time.Millisecond, Usage: "interval between tick frames"},
+					&cli.DurationFlag{Name: "intro_duration", Value: 5 * time.Second, Usage: "time between battle start and intro finished"},
+					&cli.DurationFlag{Name: "battle_duration", Value: 60 * time.Second, Usage: "maximum length of a randomised battle"},
+					&cli.DurationFlag{Name: "outro_duration", Value: 5 * time.Second, Usage: "time between battle end and outro finished"},
+					&cli.DurationFlag{Name: "ability_complete_delay", Value: 3 * time.Second, Usage: "time before an ability is reported as complete"},
+					&cli.StringFlag{Name: "log_level", Value: "InfoLevel", EnvVars: []string{envPrefix + "_LOG_LEVEL"}, Usage: "Set the log level for zerolog (Options: PanicLevel, FatalLevel, ErrorLevel, WarnLevel, InfoLevel, DebugLevel, TraceLevel"},
+					&cli.StringFlag{Name: "environment", Value: "development", DefaultText: "development", EnvVars: []string{envPrefix + "_ENVIRONMENT", "ENVIRONMENT"}, Usage: "This program environment (development, testing, training, staging, production), it sets the log levels"},
+				},
+				Usage: "run a headless game client against a battle arena",
+				Action: func(c *cli.Context) error {
+					gamelog.New(c.String("environment"), c.String("log_level"))
+
+					var script *game_client_sim.Script
+					if path := c.String("script"); path != "" {
+						var err error
+						script, err = game_client_sim.LoadScript(path)
+						if err != nil {
+							return err
+						}
+					}
+
+					seed := c.Int64("seed")
+					if seed == 0 {
+						seed = time.Now().UnixNano()
+					}
+					gamelog.L.Info().Int64("seed", seed).Msg("Starting game client simulator")
+
+					sim := game_client_sim.New(game_client_sim.Config{
+						ArenaAddr:            c.String("arena_addr"),
+						ArenaID:              c.String("arena_id"),
+						ClientKey:            c.String("client_key"),
+						BuildNo:              c.String("build_no"),
+						Battles:              c.Int("battles"),
+						Seed:                 seed,
+						Script:               script,
+						TickInterval:         c.Duration("tick_interval"),
+						IntroDuration:        c.Duration("intro_duration"),
+						BattleDuration:       c.Duration("battle_duration"),
+						OutroDuration:        c.Duration("outro_duration"),
+						AbilityCompleteDelay: c.Duration("ability_complete_delay"),
+					})
+
+					ctx, cancel := signal.NotifyContext(c.Context, os.Interrupt)
+					defer cancel()
+
+					err := sim.Run(ctx)
+					if err != nil {
+						return err
+					}
+
+					gamelog.L.Info().Int("battles played", sim.BattlesPlayed()).Msg("Game client simulator finished")
+					return nil
+				},
+			},
 		},
 	}
 
@@ -47,6 +47,15 @@ func (s *S) AssetUnlockFromSupremacyHandler(req AssetUnlockFromSupremacyReq, res
 		return nil
 	}
 
+	clawbackLocked, err := db.CollectionItemClawbackLocked(tx, collectionItem.ItemID)
+	if err != nil {
+		gamelog.L.Error().Err(err).Interface("req", req).Msg("failed to check clawback lock - AssetUnlockFromSupremacyHandler")
+		return terror.Error(err, "Failed to unlock asset from supremacy")
+	}
+	if clawbackLocked {
+		return fmt.Errorf("asset is locked while its payment is under review")
+	}
+
 	// check if asset is equipped // TODO after composable stuff comes out, we can change this to just unequipped it
 	switch collectionItem.ItemType {
 	case "utility":
@@ -0,0 +1,307 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"server/db/boiler"
+	"server/gamedb"
+
+	"github.com/friendsofgo/errors"
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// PlayerAmmo is a stack of ammo owned by a player
+type PlayerAmmo struct {
+	*boiler.BlueprintAmmo
+	Count int `json:"count"`
+}
+
+// AmmoStoreList returns the ammo which is sold in the store
+func AmmoStoreList() (boiler.BlueprintAmmoSlice, error) {
+	bas, err := boiler.BlueprintAmmos(
+		boiler.BlueprintAmmoWhere.SupsCost.IsNotNull(),
+		qm.OrderBy(fmt.Sprintf("%s, %s", boiler.BlueprintAmmoTableColumns.WeaponType, boiler.BlueprintAmmoTableColumns.Label)),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load ammo.")
+	}
+
+	return bas, nil
+}
+
+// PlayerAmmoList returns the ammo stacks the player has not loaded into weapons
+func PlayerAmmoList(playerID string) ([]*PlayerAmmo, error) {
+	as, err := boiler.Ammos(
+		boiler.AmmoWhere.OwnerID.EQ(playerID),
+		boiler.AmmoWhere.Count.GT(0),
+		qm.Load(boiler.AmmoRels.Blueprint),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load player ammo.")
+	}
+
+	resp := []*PlayerAmmo{}
+	for _, a := range as {
+		if a.R == nil || a.R.Blueprint == nil {
+			continue
+		}
+		resp = append(resp, &PlayerAmmo{
+			BlueprintAmmo: a.R.Blueprint,
+			Count:         a.Count,
+		})
+	}
+
+	return resp, nil
+}
+
+// AmmoAdd adds ammo to the player's stack of the ammo type
+func AmmoAdd(exec boil.Executor, playerID string, blueprintAmmoID string, count int) error {
+	if count <= 0 {
+		return terror.Error(fmt.Errorf("invalid ammo count %d", count), "Invalid ammo amount.")
+	}
+
+	q := fmt.Sprintf(`
+		INSERT INTO %[1]s (%[2]s, %[3]s, %[4]s)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (%[2]s, %[3]s) DO UPDATE SET %[4]s = %[1]s.%[4]s + EXCLUDED.%[4]s
+	`,
+		boiler.TableNames.Ammo,
+		boiler.AmmoColumns.BlueprintID,
+		boiler.AmmoColumns.OwnerID,
+		boiler.AmmoColumns.Count,
+	)
+	_, err := exec.Exec(q, blueprintAmmoID, playerID, count)
+	if err != nil {
+		return terror.Error(err, "Failed to add ammo.")
+	}
+
+	return nil
+}
+
+// ammoTake removes ammo from the player's stack, failing if the player does not have enough
+func ammoTake(exec boil.Executor, playerID string, blueprintAmmoID string, count int) error {
+	if count <= 0 {
+		return terror.Error(fmt.Errorf("invalid ammo count %d", count), "Invalid ammo amount.")
+	}
+
+	q := fmt.Sprintf(
+		`UPDATE %[1]s SET %[4]s = %[4]s - $3 WHERE %[2]s = $1 AND %[3]s = $2 AND %[4]s >= $3`,
+		boiler.TableNames.Ammo,
+		boiler.AmmoColumns.BlueprintID,
+		boiler.AmmoColumns.OwnerID,
+		boiler.AmmoColumns.Count,
+	)
+	result, err := exec.Exec(q, blueprintAmmoID, playerID, count)
+	if err != nil {
+		return terror.Error(err, "Failed to take ammo.")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return terror.Error(err, "Failed to take ammo.")
+	}
+	if affected == 0 {
+		return terror.Error(fmt.Errorf("player does not have enough ammo"), "You do not have enough ammo.")
+	}
+
+	return nil
+}
+
+// WeaponAmmoEquip loads the player's ammo into the weapon.
+// A weapon holds a single type of ammo, so any other type already loaded is returned to the player.
+func WeaponAmmoEquip(tx boil.Executor, playerID string, weaponID string, blueprintAmmoID string, count int) (*boiler.WeaponAmmo, error) {
+	bpa, err := boiler.FindBlueprintAmmo(tx, blueprintAmmoID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, terror.Error(err, "Ammo not found.")
+	}
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load ammo.")
+	}
+
+	weapon, err := boiler.Weapons(
+		boiler.WeaponWhere.ID.EQ(weaponID),
+		qm.Load(boiler.WeaponRels.Blueprint),
+	).One(tx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, terror.Error(err, "Weapon not found.")
+	}
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load weapon.")
+	}
+
+	if weapon.R == nil || weapon.R.Blueprint == nil || weapon.R.Blueprint.WeaponType != bpa.WeaponType {
+		return nil, terror.Error(fmt.Errorf("ammo %s does not fit weapon %s", bpa.ID, weapon.ID), "This ammo is not compatible with the weapon.")
+	}
+
+	wa, err := boiler.WeaponAmmos(
+		boiler.WeaponAmmoWhere.WeaponID.EQ(weaponID),
+		qm.For("UPDATE"),
+	).One(tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, terror.Error(err, "Failed to load weapon ammo.")
+	}
+
+	if wa != nil && wa.BlueprintAmmoID != blueprintAmmoID {
+		err = weaponAmmoUnload(tx, playerID, wa)
+		if err != nil {
+			return nil, err
+		}
+		wa = nil
+	}
+
+	err = ammoTake(tx, playerID, blueprintAmmoID, count)
+	if err != nil {
+		return nil, err
+	}
+
+	if wa == nil {
+		wa = &boiler.WeaponAmmo{
+			BlueprintAmmoID: blueprintAmmoID,
+			WeaponID:        weaponID,
+			Count:           count,
+		}
+		err = wa.Insert(tx, boil.Infer())
+		if err != nil {
+			return nil, terror.Error(err, "Failed to load ammo into weapon.")
+		}
+
+		return wa, nil
+	}
+
+	wa.Count += count
+	_, err = wa.Update(tx, boil.Whitelist(boiler.WeaponAmmoColumns.Count))
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load ammo into weapon.")
+	}
+
+	return wa, nil
+}
+
+// WeaponAmmoUnequip returns the ammo loaded in the weapon to the player
+func WeaponAmmoUnequip(tx boil.Executor, playerID string, weaponID string) error {
+	wa, err := boiler.WeaponAmmos(
+		boiler.WeaponAmmoWhere.WeaponID.EQ(weaponID),
+		qm.For("UPDATE"),
+	).One(tx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return terror.Error(err, "The weapon has no ammo loaded.")
+	}
+	if err != nil {
+		return terror.Error(err, "Failed to load weapon ammo.")
+	}
+
+	return weaponAmmoUnload(tx, playerID, wa)
+}
+
+func weaponAmmoUnload(tx boil.Executor, playerID string, wa *boiler.WeaponAmmo) error {
+	if wa.Count > 0 {
+		err := AmmoAdd(tx, playerID, wa.BlueprintAmmoID, wa.Count)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := wa.Delete(tx)
+	if err != nil {
+		return terror.Error(err, "Failed to unload ammo from weapon.")
+	}
+
+	return nil
+}
+
+// WeaponAmmoLoadouts returns the ammo loaded in the weapons, with the ammo blueprint
+func WeaponAmmoLoadouts(weaponIDs []string) (boiler.WeaponAmmoSlice, error) {
+	if len(weaponIDs) == 0 {
+		return boiler.WeaponAmmoSlice{}, nil
+	}
+
+	was, err := boiler.WeaponAmmos(
+		boiler.WeaponAmmoWhere.WeaponID.IN(weaponIDs),
+		boiler.WeaponAmmoWhere.Count.GT(0),
+		qm.Load(boiler.WeaponAmmoRels.BlueprintAmmo),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load weapon ammo.")
+	}
+
+	return was, nil
+}
+
+// AmmoWeaponTypes returns the weapon types which have ammo. Weapons of other types do not need ammo.
+func AmmoWeaponTypes() ([]string, error) {
+	bas, err := boiler.BlueprintAmmos(
+		qm.Distinct(boiler.BlueprintAmmoColumns.WeaponType),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load ammo weapon types.")
+	}
+
+	weaponTypes := []string{}
+	for _, ba := range bas {
+		weaponTypes = append(weaponTypes, ba.WeaponType)
+	}
+
+	return weaponTypes, nil
+}
+
+// WeaponAmmoConsume removes the shots fired by the weapon in the battle from its loaded ammo.
+// The usage is recorded per battle, so the shots of a battle are only consumed once.
+func WeaponAmmoConsume(battleID string, weaponID string, shotsFired int) (*boiler.BattleWeaponAmmoUsage, error) {
+	if shotsFired <= 0 {
+		return nil, nil
+	}
+
+	tx, err := gamedb.StdConn.Begin()
+	if err != nil {
+		return nil, terror.Error(err, "Failed to start db transaction.")
+	}
+	defer tx.Rollback()
+
+	wa, err := boiler.WeaponAmmos(
+		boiler.WeaponAmmoWhere.WeaponID.EQ(weaponID),
+		qm.For("UPDATE"),
+	).One(tx)
+	if errors.Is(err, sql.ErrNoRows) {
+		// no ammo loaded
+		return nil, nil
+	}
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load weapon ammo.")
+	}
+
+	consumed := shotsFired
+	if consumed > wa.Count {
+		consumed = wa.Count
+	}
+
+	usage := &boiler.BattleWeaponAmmoUsage{
+		BattleID:        battleID,
+		WeaponID:        weaponID,
+		BlueprintAmmoID: wa.BlueprintAmmoID,
+		ShotsFired:      shotsFired,
+		AmmoConsumed:    consumed,
+	}
+	err = usage.Insert(tx, boil.Infer())
+	if err != nil {
+		return nil, terror.Error(err, "Failed to record ammo usage.")
+	}
+
+	wa.Count -= consumed
+	if wa.Count == 0 {
+		_, err = wa.Delete(tx)
+	} else {
+		_, err = wa.Update(tx, boil.Whitelist(boiler.WeaponAmmoColumns.Count))
+	}
+	if err != nil {
+		return nil, terror.Error(err, "Failed to consume weapon ammo.")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, terror.Error(err, "Failed to commit db transaction.")
+	}
+
+	return usage, nil
+}
@@ -0,0 +1,94 @@
+package db
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"server/db/boiler"
+	"server/gamedb"
+	"time"
+
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// BattleArenaActiveClientKeys returns all the non-revoked game client keys of the given arena
+func BattleArenaActiveClientKeys(arenaID string) (boiler.BattleArenaClientKeySlice, error) {
+	keys, err := boiler.BattleArenaClientKeys(
+		boiler.BattleArenaClientKeyWhere.BattleArenaID.EQ(arenaID),
+		boiler.BattleArenaClientKeyWhere.RevokedAt.IsNull(),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load battle arena client keys.")
+	}
+
+	return keys, nil
+}
+
+// BattleArenaClientKeyCreate generates a new game client key for the given arena.
+// The secret is only ever returned here, so the caller has to hand it over to the game client.
+func BattleArenaClientKeyCreate(arenaID string, label string) (*boiler.BattleArenaClientKey, error) {
+	exists, err := boiler.BattleArenaExists(gamedb.StdConn, arenaID)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to check battle arena.")
+	}
+	if !exists {
+		return nil, terror.Error(fmt.Errorf("battle arena %s does not exist", arenaID), "Battle arena does not exist.")
+	}
+
+	secret := make([]byte, 32)
+	_, err = rand.Read(secret)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to generate client key.")
+	}
+
+	key := &boiler.BattleArenaClientKey{
+		BattleArenaID: arenaID,
+		Label:         label,
+		Secret:        hex.EncodeToString(secret),
+	}
+	err = key.Insert(gamedb.StdConn, boil.Infer())
+	if err != nil {
+		return nil, terror.Error(err, "Failed to insert client key.")
+	}
+
+	return key, nil
+}
+
+// BattleArenaClientKeyRevoke revokes a game client key, game clients using the key will be rejected on next handshake
+func BattleArenaClientKeyRevoke(arenaID string, keyID string) error {
+	_, err := boiler.BattleArenaClientKeys(
+		boiler.BattleArenaClientKeyWhere.ID.EQ(keyID),
+		boiler.BattleArenaClientKeyWhere.BattleArenaID.EQ(arenaID),
+		boiler.BattleArenaClientKeyWhere.RevokedAt.IsNull(),
+	).UpdateAll(gamedb.StdConn, boiler.M{
+		boiler.BattleArenaClientKeyColumns.RevokedAt: null.TimeFrom(time.Now()),
+	})
+	if err != nil {
+		return terror.Error(err, "Failed to revoke client key.")
+	}
+
+	return nil
+}
+
+// BattleArenaClientKeyUsed records a game client handshake signed by a key. The handshake is only accepted when it was
+// signed after the last handshake accepted for the key, so it returns false for a replayed handshake.
+func BattleArenaClientKeyUsed(keyID string, handshakeTimestamp int64) (bool, error) {
+	updated, err := boiler.BattleArenaClientKeys(
+		boiler.BattleArenaClientKeyWhere.ID.EQ(keyID),
+		qm.Expr(
+			boiler.BattleArenaClientKeyWhere.LastHandshakeTimestamp.IsNull(),
+			qm.Or2(boiler.BattleArenaClientKeyWhere.LastHandshakeTimestamp.LT(null.Int64From(handshakeTimestamp))),
+		),
+	).UpdateAll(gamedb.StdConn, boiler.M{
+		boiler.BattleArenaClientKeyColumns.LastUsedAt:             null.TimeFrom(time.Now()),
+		boiler.BattleArenaClientKeyColumns.LastHandshakeTimestamp: null.Int64From(handshakeTimestamp),
+	})
+	if err != nil {
+		return false, terror.Error(err, "Failed to update client key usage.")
+	}
+
+	return updated > 0, nil
+}
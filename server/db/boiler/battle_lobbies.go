@@ -47,6 +47,7 @@ type BattleLobby struct {
 	Name                  string          `boiler:"name" boil:"name" json:"name" toml:"name" yaml:"name"`
 	MaxDeployPerPlayer    int             `boiler:"max_deploy_per_player" boil:"max_deploy_per_player" json:"max_deploy_per_player" toml:"max_deploy_per_player" yaml:"max_deploy_per_player"`
 	ExpiresAt             null.Time       `boiler:"expires_at" boil:"expires_at" json:"expires_at,omitempty" toml:"expires_at" yaml:"expires_at,omitempty"`
+	IsPrivate             bool            `boiler:"is_private" boil:"is_private" json:"is_private" toml:"is_private" yaml:"is_private"`
 
 	R *battleLobbyR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L battleLobbyL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -76,6 +77,7 @@ var BattleLobbyColumns = struct {
 	Name                  string
 	MaxDeployPerPlayer    string
 	ExpiresAt             string
+	IsPrivate             string
 }{
 	ID:                    "id",
 	HostByID:              "host_by_id",
@@ -100,6 +102,7 @@ var BattleLobbyColumns = struct {
 	Name:                  "name",
 	MaxDeployPerPlayer:    "max_deploy_per_player",
 	ExpiresAt:             "expires_at",
+	IsPrivate:             "is_private",
 }
 
 var BattleLobbyTableColumns = struct {
@@ -126,6 +129,7 @@ var BattleLobbyTableColumns = struct {
 	Name                  string
 	MaxDeployPerPlayer    string
 	ExpiresAt             string
+	IsPrivate             string
 }{
 	ID:                    "battle_lobbies.id",
 	HostByID:              "battle_lobbies.host_by_id",
@@ -150,6 +154,7 @@ var BattleLobbyTableColumns = struct {
 	Name:                  "battle_lobbies.name",
 	MaxDeployPerPlayer:    "battle_lobbies.max_deploy_per_player",
 	ExpiresAt:             "battle_lobbies.expires_at",
+	IsPrivate:             "battle_lobbies.is_private",
 }
 
 // Generated where
@@ -178,6 +183,7 @@ var BattleLobbyWhere = struct {
 	Name                  whereHelperstring
 	MaxDeployPerPlayer    whereHelperint
 	ExpiresAt             whereHelpernull_Time
+	IsPrivate             whereHelperbool
 }{
 	ID:                    whereHelperstring{field: "\"battle_lobbies\".\"id\""},
 	HostByID:              whereHelperstring{field: "\"battle_lobbies\".\"host_by_id\""},
@@ -202,6 +208,7 @@ var BattleLobbyWhere = struct {
 	Name:                  whereHelperstring{field: "\"battle_lobbies\".\"name\""},
 	MaxDeployPerPlayer:    whereHelperint{field: "\"battle_lobbies\".\"max_deploy_per_player\""},
 	ExpiresAt:             whereHelpernull_Time{field: "\"battle_lobbies\".\"expires_at\""},
+	IsPrivate:             whereHelperbool{field: "\"battle_lobbies\".\"is_private\""},
 }
 
 // BattleLobbyRels is where relationship names are stored.
@@ -249,9 +256,9 @@ func (*battleLobbyR) NewStruct() *battleLobbyR {
 type battleLobbyL struct{}
 
 var (
-	battleLobbyAllColumns            = []string{"id", "host_by_id", "number", "entry_fee", "first_faction_cut", "second_faction_cut", "third_faction_cut", "each_faction_mech_amount", "game_map_id", "generated_by_system", "access_code", "will_not_start_until", "ready_at", "assigned_to_battle_id", "ended_at", "assigned_to_arena_id", "is_ai_driven_match", "created_at", "updated_at", "deleted_at", "name", "max_deploy_per_player", "expires_at"}
+	battleLobbyAllColumns            = []string{"id", "host_by_id", "number", "entry_fee", "first_faction_cut", "second_faction_cut", "third_faction_cut", "each_faction_mech_amount", "game_map_id", "generated_by_system", "access_code", "will_not_start_until", "ready_at", "assigned_to_battle_id", "ended_at", "assigned_to_arena_id", "is_ai_driven_match", "created_at", "updated_at", "deleted_at", "name", "max_deploy_per_player", "expires_at", "is_private"}
 	battleLobbyColumnsWithoutDefault = []string{"host_by_id"}
-	battleLobbyColumnsWithDefault    = []string{"id", "number", "entry_fee", "first_faction_cut", "second_faction_cut", "third_faction_cut", "each_faction_mech_amount", "game_map_id", "generated_by_system", "access_code", "will_not_start_until", "ready_at", "assigned_to_battle_id", "ended_at", "assigned_to_arena_id", "is_ai_driven_match", "created_at", "updated_at", "deleted_at", "name", "max_deploy_per_player", "expires_at"}
+	battleLobbyColumnsWithDefault    = []string{"id", "number", "entry_fee", "first_faction_cut", "second_faction_cut", "third_faction_cut", "each_faction_mech_amount", "game_map_id", "generated_by_system", "access_code", "will_not_start_until", "ready_at", "assigned_to_battle_id", "ended_at", "assigned_to_arena_id", "is_ai_driven_match", "created_at", "updated_at", "deleted_at", "name", "max_deploy_per_player", "expires_at", "is_private"}
 	battleLobbyPrimaryKeyColumns     = []string{"id"}
 	battleLobbyGeneratedColumns      = []string{}
 )
@@ -45,6 +45,7 @@ type BlueprintAmmo struct {
 	BackgroundColor             null.String         `boiler:"background_color" boil:"background_color" json:"background_color,omitempty" toml:"background_color" yaml:"background_color,omitempty"`
 	AnimationURL                null.String         `boiler:"animation_url" boil:"animation_url" json:"animation_url,omitempty" toml:"animation_url" yaml:"animation_url,omitempty"`
 	YoutubeURL                  null.String         `boiler:"youtube_url" boil:"youtube_url" json:"youtube_url,omitempty" toml:"youtube_url" yaml:"youtube_url,omitempty"`
+	SupsCost                    decimal.NullDecimal `boiler:"sups_cost" boil:"sups_cost" json:"sups_cost,omitempty" toml:"sups_cost" yaml:"sups_cost,omitempty"`
 
 	R *blueprintAmmoR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L blueprintAmmoL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -72,6 +73,7 @@ var BlueprintAmmoColumns = struct {
 	BackgroundColor             string
 	AnimationURL                string
 	YoutubeURL                  string
+	SupsCost                    string
 }{
 	ID:                          "id",
 	Label:                       "label",
@@ -94,6 +96,7 @@ var BlueprintAmmoColumns = struct {
 	BackgroundColor:             "background_color",
 	AnimationURL:                "animation_url",
 	YoutubeURL:                  "youtube_url",
+	SupsCost:                    "sups_cost",
 }
 
 var BlueprintAmmoTableColumns = struct {
@@ -118,6 +121,7 @@ var BlueprintAmmoTableColumns = struct {
 	BackgroundColor             string
 	AnimationURL                string
 	YoutubeURL                  string
+	SupsCost                    string
 }{
 	ID:                          "blueprint_ammo.id",
 	Label:                       "blueprint_ammo.label",
@@ -140,6 +144,7 @@ var BlueprintAmmoTableColumns = struct {
 	BackgroundColor:             "blueprint_ammo.background_color",
 	AnimationURL:                "blueprint_ammo.animation_url",
 	YoutubeURL:                  "blueprint_ammo.youtube_url",
+	SupsCost:                    "blueprint_ammo.sups_cost",
 }
 
 // Generated where
@@ -166,6 +171,7 @@ var BlueprintAmmoWhere = struct {
 	BackgroundColor             whereHelpernull_String
 	AnimationURL                whereHelpernull_String
 	YoutubeURL                  whereHelpernull_String
+	SupsCost                    whereHelperdecimal_NullDecimal
 }{
 	ID:                          whereHelperstring{field: "\"blueprint_ammo\".\"id\""},
 	Label:                       whereHelperstring{field: "\"blueprint_ammo\".\"label\""},
@@ -188,6 +194,7 @@ var BlueprintAmmoWhere = struct {
 	BackgroundColor:             whereHelpernull_String{field: "\"blueprint_ammo\".\"background_color\""},
 	AnimationURL:                whereHelpernull_String{field: "\"blueprint_ammo\".\"animation_url\""},
 	YoutubeURL:                  whereHelpernull_String{field: "\"blueprint_ammo\".\"youtube_url\""},
+	SupsCost:                    whereHelperdecimal_NullDecimal{field: "\"blueprint_ammo\".\"sups_cost\""},
 }
 
 // BlueprintAmmoRels is where relationship names are stored.
@@ -217,9 +224,9 @@ func (*blueprintAmmoR) NewStruct() *blueprintAmmoR {
 type blueprintAmmoL struct{}
 
 var (
-	blueprintAmmoAllColumns            = []string{"id", "label", "weapon_type", "collection", "damage_multiplier", "damage_falloff_multiplier", "damage_falloff_rate_multiplier", "spread_multiplier", "rate_of_fire_multiplier", "radius_multiplier", "projectile_speed_multiplier", "energy_cost_multiplier", "max_ammo_multiplier", "created_at", "image_url", "card_animation_url", "avatar_url", "large_image_url", "background_color", "animation_url", "youtube_url"}
+	blueprintAmmoAllColumns            = []string{"id", "label", "weapon_type", "collection", "damage_multiplier", "damage_falloff_multiplier", "damage_falloff_rate_multiplier", "spread_multiplier", "rate_of_fire_multiplier", "radius_multiplier", "projectile_speed_multiplier", "energy_cost_multiplier", "max_ammo_multiplier", "created_at", "image_url", "card_animation_url", "avatar_url", "large_image_url", "background_color", "animation_url", "youtube_url", "sups_cost"}
 	blueprintAmmoColumnsWithoutDefault = []string{"label", "weapon_type"}
-	blueprintAmmoColumnsWithDefault    = []string{"id", "collection", "damage_multiplier", "damage_falloff_multiplier", "damage_falloff_rate_multiplier", "spread_multiplier", "rate_of_fire_multiplier", "radius_multiplier", "projectile_speed_multiplier", "energy_cost_multiplier", "max_ammo_multiplier", "created_at", "image_url", "card_animation_url", "avatar_url", "large_image_url", "background_color", "animation_url", "youtube_url"}
+	blueprintAmmoColumnsWithDefault    = []string{"id", "collection", "damage_multiplier", "damage_falloff_multiplier", "damage_falloff_rate_multiplier", "spread_multiplier", "rate_of_fire_multiplier", "radius_multiplier", "projectile_speed_multiplier", "energy_cost_multiplier", "max_ammo_multiplier", "created_at", "image_url", "card_animation_url", "avatar_url", "large_image_url", "background_color", "animation_url", "youtube_url", "sups_cost"}
 	blueprintAmmoPrimaryKeyColumns     = []string{"id"}
 	blueprintAmmoGeneratedColumns      = []string{}
 )
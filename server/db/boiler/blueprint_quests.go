@@ -18,91 +18,113 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
 	"github.com/volatiletech/strmangle"
 )
 
 // BlueprintQuest is an object representing the database table.
 type BlueprintQuest struct {
-	ID             string    `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
-	QuestEventType string    `boiler:"quest_event_type" boil:"quest_event_type" json:"quest_event_type" toml:"quest_event_type" yaml:"quest_event_type"`
-	Key            string    `boiler:"key" boil:"key" json:"key" toml:"key" yaml:"key"`
-	Name           string    `boiler:"name" boil:"name" json:"name" toml:"name" yaml:"name"`
-	Description    string    `boiler:"description" boil:"description" json:"description" toml:"description" yaml:"description"`
-	RequestAmount  int       `boiler:"request_amount" boil:"request_amount" json:"request_amount" toml:"request_amount" yaml:"request_amount"`
-	CreatedAt      time.Time `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt      time.Time `boiler:"updated_at" boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	DeletedAt      null.Time `boiler:"deleted_at" boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ID              string      `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	QuestEventType  string      `boiler:"quest_event_type" boil:"quest_event_type" json:"quest_event_type" toml:"quest_event_type" yaml:"quest_event_type"`
+	Key             string      `boiler:"key" boil:"key" json:"key" toml:"key" yaml:"key"`
+	Name            string      `boiler:"name" boil:"name" json:"name" toml:"name" yaml:"name"`
+	Description     string      `boiler:"description" boil:"description" json:"description" toml:"description" yaml:"description"`
+	RequestAmount   int         `boiler:"request_amount" boil:"request_amount" json:"request_amount" toml:"request_amount" yaml:"request_amount"`
+	CreatedAt       time.Time   `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       time.Time   `boiler:"updated_at" boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt       null.Time   `boiler:"deleted_at" boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	PrerequisiteID  null.String `boiler:"prerequisite_id" boil:"prerequisite_id" json:"prerequisite_id,omitempty" toml:"prerequisite_id" yaml:"prerequisite_id,omitempty"`
+	ObjectiveEvent  null.String `boiler:"objective_event" boil:"objective_event" json:"objective_event,omitempty" toml:"objective_event" yaml:"objective_event,omitempty"`
+	ObjectiveFilter types.JSON  `boiler:"objective_filter" boil:"objective_filter" json:"objective_filter,omitempty" toml:"objective_filter" yaml:"objective_filter,omitempty"`
 
 	R *blueprintQuestR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L blueprintQuestL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var BlueprintQuestColumns = struct {
-	ID             string
-	QuestEventType string
-	Key            string
-	Name           string
-	Description    string
-	RequestAmount  string
-	CreatedAt      string
-	UpdatedAt      string
-	DeletedAt      string
+	ID              string
+	QuestEventType  string
+	Key             string
+	Name            string
+	Description     string
+	RequestAmount   string
+	CreatedAt       string
+	UpdatedAt       string
+	DeletedAt       string
+	PrerequisiteID  string
+	ObjectiveEvent  string
+	ObjectiveFilter string
 }{
-	ID:             "id",
-	QuestEventType: "quest_event_type",
-	Key:            "key",
-	Name:           "name",
-	Description:    "description",
-	RequestAmount:  "request_amount",
-	CreatedAt:      "created_at",
-	UpdatedAt:      "updated_at",
-	DeletedAt:      "deleted_at",
+	ID:              "id",
+	QuestEventType:  "quest_event_type",
+	Key:             "key",
+	Name:            "name",
+	Description:     "description",
+	RequestAmount:   "request_amount",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+	DeletedAt:       "deleted_at",
+	PrerequisiteID:  "prerequisite_id",
+	ObjectiveEvent:  "objective_event",
+	ObjectiveFilter: "objective_filter",
 }
 
 var BlueprintQuestTableColumns = struct {
-	ID             string
-	QuestEventType string
-	Key            string
-	Name           string
-	Description    string
-	RequestAmount  string
-	CreatedAt      string
-	UpdatedAt      string
-	DeletedAt      string
+	ID              string
+	QuestEventType  string
+	Key             string
+	Name            string
+	Description     string
+	RequestAmount   string
+	CreatedAt       string
+	UpdatedAt       string
+	DeletedAt       string
+	PrerequisiteID  string
+	ObjectiveEvent  string
+	ObjectiveFilter string
 }{
-	ID:             "blueprint_quests.id",
-	QuestEventType: "blueprint_quests.quest_event_type",
-	Key:            "blueprint_quests.key",
-	Name:           "blueprint_quests.name",
-	Description:    "blueprint_quests.description",
-	RequestAmount:  "blueprint_quests.request_amount",
-	CreatedAt:      "blueprint_quests.created_at",
-	UpdatedAt:      "blueprint_quests.updated_at",
-	DeletedAt:      "blueprint_quests.deleted_at",
+	ID:              "blueprint_quests.id",
+	QuestEventType:  "blueprint_quests.quest_event_type",
+	Key:             "blueprint_quests.key",
+	Name:            "blueprint_quests.name",
+	Description:     "blueprint_quests.description",
+	RequestAmount:   "blueprint_quests.request_amount",
+	CreatedAt:       "blueprint_quests.created_at",
+	UpdatedAt:       "blueprint_quests.updated_at",
+	DeletedAt:       "blueprint_quests.deleted_at",
+	PrerequisiteID:  "blueprint_quests.prerequisite_id",
+	ObjectiveEvent:  "blueprint_quests.objective_event",
+	ObjectiveFilter: "blueprint_quests.objective_filter",
 }
 
 // Generated where
 
 var BlueprintQuestWhere = struct {
-	ID             whereHelperstring
-	QuestEventType whereHelperstring
-	Key            whereHelperstring
-	Name           whereHelperstring
-	Description    whereHelperstring
-	RequestAmount  whereHelperint
-	CreatedAt      whereHelpertime_Time
-	UpdatedAt      whereHelpertime_Time
-	DeletedAt      whereHelpernull_Time
+	ID              whereHelperstring
+	QuestEventType  whereHelperstring
+	Key             whereHelperstring
+	Name            whereHelperstring
+	Description     whereHelperstring
+	RequestAmount   whereHelperint
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpertime_Time
+	DeletedAt       whereHelpernull_Time
+	PrerequisiteID  whereHelpernull_String
+	ObjectiveEvent  whereHelpernull_String
+	ObjectiveFilter whereHelpertypes_JSON
 }{
-	ID:             whereHelperstring{field: "\"blueprint_quests\".\"id\""},
-	QuestEventType: whereHelperstring{field: "\"blueprint_quests\".\"quest_event_type\""},
-	Key:            whereHelperstring{field: "\"blueprint_quests\".\"key\""},
-	Name:           whereHelperstring{field: "\"blueprint_quests\".\"name\""},
-	Description:    whereHelperstring{field: "\"blueprint_quests\".\"description\""},
-	RequestAmount:  whereHelperint{field: "\"blueprint_quests\".\"request_amount\""},
-	CreatedAt:      whereHelpertime_Time{field: "\"blueprint_quests\".\"created_at\""},
-	UpdatedAt:      whereHelpertime_Time{field: "\"blueprint_quests\".\"updated_at\""},
-	DeletedAt:      whereHelpernull_Time{field: "\"blueprint_quests\".\"deleted_at\""},
+	ID:              whereHelperstring{field: "\"blueprint_quests\".\"id\""},
+	QuestEventType:  whereHelperstring{field: "\"blueprint_quests\".\"quest_event_type\""},
+	Key:             whereHelperstring{field: "\"blueprint_quests\".\"key\""},
+	Name:            whereHelperstring{field: "\"blueprint_quests\".\"name\""},
+	Description:     whereHelperstring{field: "\"blueprint_quests\".\"description\""},
+	RequestAmount:   whereHelperint{field: "\"blueprint_quests\".\"request_amount\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"blueprint_quests\".\"created_at\""},
+	UpdatedAt:       whereHelpertime_Time{field: "\"blueprint_quests\".\"updated_at\""},
+	DeletedAt:       whereHelpernull_Time{field: "\"blueprint_quests\".\"deleted_at\""},
+	PrerequisiteID:  whereHelpernull_String{field: "\"blueprint_quests\".\"prerequisite_id\""},
+	ObjectiveEvent:  whereHelpernull_String{field: "\"blueprint_quests\".\"objective_event\""},
+	ObjectiveFilter: whereHelpertypes_JSON{field: "\"blueprint_quests\".\"objective_filter\""},
 }
 
 // BlueprintQuestRels is where relationship names are stored.
@@ -126,9 +148,9 @@ func (*blueprintQuestR) NewStruct() *blueprintQuestR {
 type blueprintQuestL struct{}
 
 var (
-	blueprintQuestAllColumns            = []string{"id", "quest_event_type", "key", "name", "description", "request_amount", "created_at", "updated_at", "deleted_at"}
-	blueprintQuestColumnsWithoutDefault = []string{"quest_event_type", "key", "name", "description", "request_amount"}
-	blueprintQuestColumnsWithDefault    = []string{"id", "created_at", "updated_at", "deleted_at"}
+	blueprintQuestAllColumns            = []string{"id", "quest_event_type", "key", "name", "description", "request_amount", "created_at", "updated_at", "deleted_at", "prerequisite_id", "objective_event", "objective_filter"}
+	blueprintQuestColumnsWithoutDefault = []string{"quest_event_type", "key", "name", "description", "request_amount", "prerequisite_id", "objective_event"}
+	blueprintQuestColumnsWithDefault    = []string{"id", "created_at", "updated_at", "deleted_at", "objective_filter"}
 	blueprintQuestPrimaryKeyColumns     = []string{"id"}
 	blueprintQuestGeneratedColumns      = []string{}
 )
@@ -11,6 +11,7 @@ var TableNames = struct {
 	BattleAbilityOptInLogs                             string
 	BattleAbilityTriggers                              string
 	BattleArena                                        string
+	BattleArenaClientKeys                              string
 	BattleContracts                                    string
 	BattleContributions                                string
 	BattleEvents                                       string
@@ -30,9 +31,11 @@ var TableNames = struct {
 	BattleQueueFeesOld                                 string
 	BattleQueueNotifications                           string
 	BattleQueueOld                                     string
+	BattleRatingChanges                                string
 	BattleReplays                                      string
 	BattleViewers                                      string
 	BattleWarMachineQueuesOld                          string
+	BattleWeaponAmmoUsages                             string
 	BattleWins                                         string
 	Battles                                            string
 	Blobs                                              string
@@ -47,6 +50,7 @@ var TableNames = struct {
 	BlueprintModules                                   string
 	BlueprintPlayerAbilities                           string
 	BlueprintPowerCores                                string
+	BlueprintQuestRewards                              string
 	BlueprintQuests                                    string
 	BlueprintShieldTypes                               string
 	BlueprintUtility                                   string
@@ -55,13 +59,19 @@ var TableNames = struct {
 	BlueprintWeapons                                   string
 	BlueprintWeaponsOld                                string
 	Brands                                             string
+	BroadcastSystemMessageReceipts                     string
+	BroadcastSystemMessages                            string
 	ChatBannedFingerprints                             string
 	ChatHistory                                        string
 	CollectionItems                                    string
 	ConsumedAbilities                                  string
+	CouponEvents                                       string
 	CouponItems                                        string
 	Coupons                                            string
 	Devices                                            string
+	DirectMessageConversationMembers                   string
+	DirectMessageConversations                         string
+	DirectMessages                                     string
 	DiscordLobbyAnnoucements                           string
 	DiscordLobbyFollowers                              string
 	FactionPalettes                                    string
@@ -71,6 +81,7 @@ var TableNames = struct {
 	Factions                                           string
 	FailedPlayerKeycardsSync                           string
 	Features                                           string
+	FiatGifts                                          string
 	FiatProductItemBlueprints                          string
 	FiatProductItems                                   string
 	FiatProductPricings                                string
@@ -81,16 +92,21 @@ var TableNames = struct {
 	GameMaps                                           string
 	GlobalAnnouncements                                string
 	ItemKeycardSales                                   string
+	ItemOffers                                         string
+	ItemSaleBundleItems                                string
+	ItemSaleWatches                                    string
 	ItemSales                                          string
 	ItemSalesBidHistory                                string
 	KV                                                 string
 	Languages                                          string
 	Layers                                             string
 	MarketplaceEvents                                  string
+	MarketplaceSavedSearches                           string
 	MechAbilityTriggerLogsOld                          string
 	MechAnimation                                      string
 	MechModelSkinCompatibilities                       string
 	MechMoveCommandLogs                                string
+	MechRatings                                        string
 	MechSkin                                           string
 	MechStats                                          string
 	MechUtility                                        string
@@ -101,13 +117,23 @@ var TableNames = struct {
 	Multipliers                                        string
 	MysteryCrate                                       string
 	MysteryCrateBlueprints                             string
+	MysteryCrateDropRates                              string
+	MysteryCrateRolls                                  string
+	MysteryCrateSeeds                                  string
+	OrderItemAssets                                    string
 	OrderItems                                         string
+	OrderStateHistories                                string
 	Orders                                             string
 	PlayerAbilities                                    string
 	PlayerActiveLogs                                   string
 	PlayerBans                                         string
 	PlayerBattleAbilities                              string
+	PlayerBlocks                                       string
+	PlayerDiscounts                                    string
 	PlayerFingerprints                                 string
+	PlayerFollows                                      string
+	PlayerFriendRequests                               string
+	PlayerFriends                                      string
 	PlayerIps                                          string
 	PlayerKeycards                                     string
 	PlayerKillLog                                      string
@@ -115,6 +141,9 @@ var TableNames = struct {
 	PlayerMechRepairSlots                              string
 	PlayerMultipliers                                  string
 	PlayerPreferences                                  string
+	PlayerQuestProgress                                string
+	PlayerQuestRewards                                 string
+	PlayerRatings                                      string
 	PlayerSettingsPreferences                          string
 	PlayerSpoilsOfWar                                  string
 	PlayerStats                                        string
@@ -131,6 +160,7 @@ var TableNames = struct {
 	PunishVoteInstantPassRecords                       string
 	PunishVotes                                        string
 	QuestEvents                                        string
+	QuestJobs                                          string
 	QuestionnaireAnswer                                string
 	QuestionnaireOptions                               string
 	Quests                                             string
@@ -141,6 +171,7 @@ var TableNames = struct {
 	RepairGameBlockLogs                                string
 	RepairGameBlocks                                   string
 	RepairOffers                                       string
+	RolePermissions                                    string
 	Roles                                              string
 	SalePlayerAbilities                                string
 	SchemaMigrations                                   string
@@ -155,10 +186,12 @@ var TableNames = struct {
 	StreamList                                         string
 	SyndicateCommittees                                string
 	SyndicateDirectors                                 string
+	SyndicateDuesCycles                                string
 	SyndicateElectionCandidates                        string
 	SyndicateElectionVotes                             string
 	SyndicateElections                                 string
 	SyndicateJoinApplications                          string
+	SyndicateMemberDues                                string
 	SyndicateMotionVotes                               string
 	SyndicateMotions                                   string
 	SyndicatePendingMotions                            string
@@ -170,6 +203,11 @@ var TableNames = struct {
 	TemplateBlueprints                                 string
 	Templates                                          string
 	TemplatesOld                                       string
+	TournamentEntrants                                 string
+	TournamentMaps                                     string
+	TournamentMatches                                  string
+	TournamentPrizeSplits                              string
+	Tournaments                                        string
 	Utility                                            string
 	UtilityShieldDontUse                               string
 	VoiceStreams                                       string
@@ -185,6 +223,7 @@ var TableNames = struct {
 	BattleAbilityOptInLogs:          "battle_ability_opt_in_logs",
 	BattleAbilityTriggers:           "battle_ability_triggers",
 	BattleArena:                     "battle_arena",
+	BattleArenaClientKeys:           "battle_arena_client_keys",
 	BattleContracts:                 "battle_contracts",
 	BattleContributions:             "battle_contributions",
 	BattleEvents:                    "battle_events",
@@ -192,163 +231,200 @@ var TableNames = struct {
 	BattleEventsState:               "battle_events_state",
 	BattleEventsWarMachineDestroyed: "battle_events_war_machine_destroyed",
 	BattleEventsWarMachineDestroyedAssistedWarMachines: "battle_events_war_machine_destroyed_assisted_war_machines",
-	BattleHistory:                  "battle_history",
-	BattleKills:                    "battle_kills",
-	BattleLobbies:                  "battle_lobbies",
-	BattleLobbiesMechs:             "battle_lobbies_mechs",
-	BattleLobbyExtraSupsRewards:    "battle_lobby_extra_sups_rewards",
-	BattleLobbySupporterOptIns:     "battle_lobby_supporter_opt_ins",
-	BattleLobbySupporters:          "battle_lobby_supporters",
-	BattleMapQueueOld:              "battle_map_queue_old",
-	BattleMechs:                    "battle_mechs",
-	BattleQueueFeesOld:             "battle_queue_fees_old",
-	BattleQueueNotifications:       "battle_queue_notifications",
-	BattleQueueOld:                 "battle_queue_old",
-	BattleReplays:                  "battle_replays",
-	BattleViewers:                  "battle_viewers",
-	BattleWarMachineQueuesOld:      "battle_war_machine_queues_old",
-	BattleWins:                     "battle_wins",
-	Battles:                        "battles",
-	Blobs:                          "blobs",
-	BlockMarketplace:               "block_marketplace",
-	BlueprintAmmo:                  "blueprint_ammo",
-	BlueprintChassis:               "blueprint_chassis",
-	BlueprintKeycards:              "blueprint_keycards",
-	BlueprintMechAnimation:         "blueprint_mech_animation",
-	BlueprintMechSkin:              "blueprint_mech_skin",
-	BlueprintMechs:                 "blueprint_mechs",
-	BlueprintMechsOld:              "blueprint_mechs_old",
-	BlueprintModules:               "blueprint_modules",
-	BlueprintPlayerAbilities:       "blueprint_player_abilities",
-	BlueprintPowerCores:            "blueprint_power_cores",
-	BlueprintQuests:                "blueprint_quests",
-	BlueprintShieldTypes:           "blueprint_shield_types",
-	BlueprintUtility:               "blueprint_utility",
-	BlueprintUtilityShieldOld:      "blueprint_utility_shield_old",
-	BlueprintWeaponSkin:            "blueprint_weapon_skin",
-	BlueprintWeapons:               "blueprint_weapons",
-	BlueprintWeaponsOld:            "blueprint_weapons_old",
-	Brands:                         "brands",
-	ChatBannedFingerprints:         "chat_banned_fingerprints",
-	ChatHistory:                    "chat_history",
-	CollectionItems:                "collection_items",
-	ConsumedAbilities:              "consumed_abilities",
-	CouponItems:                    "coupon_items",
-	Coupons:                        "coupons",
-	Devices:                        "devices",
-	DiscordLobbyAnnoucements:       "discord_lobby_annoucements",
-	DiscordLobbyFollowers:          "discord_lobby_followers",
-	FactionPalettes:                "faction_palettes",
-	FactionPassPurchaseLogs:        "faction_pass_purchase_logs",
-	FactionPasses:                  "faction_passes",
-	FactionStats:                   "faction_stats",
-	Factions:                       "factions",
-	FailedPlayerKeycardsSync:       "failed_player_keycards_sync",
-	Features:                       "features",
-	FiatProductItemBlueprints:      "fiat_product_item_blueprints",
-	FiatProductItems:               "fiat_product_items",
-	FiatProductPricings:            "fiat_product_pricings",
-	FiatProducts:                   "fiat_products",
-	FingerprintIps:                 "fingerprint_ips",
-	Fingerprints:                   "fingerprints",
-	GameAbilities:                  "game_abilities",
-	GameMaps:                       "game_maps",
-	GlobalAnnouncements:            "global_announcements",
-	ItemKeycardSales:               "item_keycard_sales",
-	ItemSales:                      "item_sales",
-	ItemSalesBidHistory:            "item_sales_bid_history",
-	KV:                             "kv",
-	Languages:                      "languages",
-	Layers:                         "layers",
-	MarketplaceEvents:              "marketplace_events",
-	MechAbilityTriggerLogsOld:      "mech_ability_trigger_logs_old",
-	MechAnimation:                  "mech_animation",
-	MechModelSkinCompatibilities:   "mech_model_skin_compatibilities",
-	MechMoveCommandLogs:            "mech_move_command_logs",
-	MechSkin:                       "mech_skin",
-	MechStats:                      "mech_stats",
-	MechUtility:                    "mech_utility",
-	MechWeapons:                    "mech_weapons",
-	Mechs:                          "mechs",
-	MechsOld:                       "mechs_old",
-	ModActionAudit:                 "mod_action_audit",
-	Multipliers:                    "multipliers",
-	MysteryCrate:                   "mystery_crate",
-	MysteryCrateBlueprints:         "mystery_crate_blueprints",
-	OrderItems:                     "order_items",
-	Orders:                         "orders",
-	PlayerAbilities:                "player_abilities",
-	PlayerActiveLogs:               "player_active_logs",
-	PlayerBans:                     "player_bans",
-	PlayerBattleAbilities:          "player_battle_abilities",
-	PlayerFingerprints:             "player_fingerprints",
-	PlayerIps:                      "player_ips",
-	PlayerKeycards:                 "player_keycards",
-	PlayerKillLog:                  "player_kill_log",
-	PlayerLanguages:                "player_languages",
-	PlayerMechRepairSlots:          "player_mech_repair_slots",
-	PlayerMultipliers:              "player_multipliers",
-	PlayerPreferences:              "player_preferences",
-	PlayerSettingsPreferences:      "player_settings_preferences",
-	PlayerSpoilsOfWar:              "player_spoils_of_war",
-	PlayerStats:                    "player_stats",
-	Players:                        "players",
-	PlayersFeatures:                "players_features",
-	PlayersObtainedQuests:          "players_obtained_quests",
-	PlayersProfileAvatars:          "players_profile_avatars",
-	PlayersPunishVotes:             "players_punish_votes",
-	PowerCores:                     "power_cores",
-	Profanities:                    "profanities",
-	ProfileAvatars:                 "profile_avatars",
-	ProfileCustomAvatars:           "profile_custom_avatars",
-	PunishOptions:                  "punish_options",
-	PunishVoteInstantPassRecords:   "punish_vote_instant_pass_records",
-	PunishVotes:                    "punish_votes",
-	QuestEvents:                    "quest_events",
-	QuestionnaireAnswer:            "questionnaire_answer",
-	QuestionnaireOptions:           "questionnaire_options",
-	Quests:                         "quests",
-	RepairAgentLogsOld:             "repair_agent_logs_old",
-	RepairAgents:                   "repair_agents",
-	RepairBlocks:                   "repair_blocks",
-	RepairCases:                    "repair_cases",
-	RepairGameBlockLogs:            "repair_game_block_logs",
-	RepairGameBlocks:               "repair_game_blocks",
-	RepairOffers:                   "repair_offers",
-	Roles:                          "roles",
-	SalePlayerAbilities:            "sale_player_abilities",
-	SchemaMigrations:               "schema_migrations",
-	ShoppingCartItems:              "shopping_cart_items",
-	ShoppingCarts:                  "shopping_carts",
-	SpoilsOfWar:                    "spoils_of_war",
-	StakedMechBattleLogs:           "staked_mech_battle_logs",
-	StakedMechs:                    "staked_mechs",
-	StaticMigrations:               "static_migrations",
-	StorePurchaseHistory:           "store_purchase_history",
-	StorefrontMysteryCrates:        "storefront_mystery_crates",
-	StreamList:                     "stream_list",
-	SyndicateCommittees:            "syndicate_committees",
-	SyndicateDirectors:             "syndicate_directors",
-	SyndicateElectionCandidates:    "syndicate_election_candidates",
-	SyndicateElectionVotes:         "syndicate_election_votes",
-	SyndicateElections:             "syndicate_elections",
-	SyndicateJoinApplications:      "syndicate_join_applications",
-	SyndicateMotionVotes:           "syndicate_motion_votes",
-	SyndicateMotions:               "syndicate_motions",
-	SyndicatePendingMotions:        "syndicate_pending_motions",
-	SyndicateQuestionnaires:        "syndicate_questionnaires",
-	SyndicateRules:                 "syndicate_rules",
-	Syndicates:                     "syndicates",
-	SystemMessages:                 "system_messages",
-	TelegramNotifications:          "telegram_notifications",
-	TemplateBlueprints:             "template_blueprints",
-	Templates:                      "templates",
-	TemplatesOld:                   "templates_old",
-	Utility:                        "utility",
-	UtilityShieldDontUse:           "utility_shield_dont_use",
-	VoiceStreams:                   "voice_streams",
-	WeaponAmmo:                     "weapon_ammo",
-	WeaponModelSkinCompatibilities: "weapon_model_skin_compatibilities",
-	WeaponSkin:                     "weapon_skin",
-	Weapons:                        "weapons",
+	BattleHistory:                    "battle_history",
+	BattleKills:                      "battle_kills",
+	BattleLobbies:                    "battle_lobbies",
+	BattleLobbiesMechs:               "battle_lobbies_mechs",
+	BattleLobbyExtraSupsRewards:      "battle_lobby_extra_sups_rewards",
+	BattleLobbySupporterOptIns:       "battle_lobby_supporter_opt_ins",
+	BattleLobbySupporters:            "battle_lobby_supporters",
+	BattleMapQueueOld:                "battle_map_queue_old",
+	BattleMechs:                      "battle_mechs",
+	BattleQueueFeesOld:               "battle_queue_fees_old",
+	BattleQueueNotifications:         "battle_queue_notifications",
+	BattleQueueOld:                   "battle_queue_old",
+	BattleRatingChanges:              "battle_rating_changes",
+	BattleReplays:                    "battle_replays",
+	BattleViewers:                    "battle_viewers",
+	BattleWarMachineQueuesOld:        "battle_war_machine_queues_old",
+	BattleWeaponAmmoUsages:           "battle_weapon_ammo_usages",
+	BattleWins:                       "battle_wins",
+	Battles:                          "battles",
+	Blobs:                            "blobs",
+	BlockMarketplace:                 "block_marketplace",
+	BlueprintAmmo:                    "blueprint_ammo",
+	BlueprintChassis:                 "blueprint_chassis",
+	BlueprintKeycards:                "blueprint_keycards",
+	BlueprintMechAnimation:           "blueprint_mech_animation",
+	BlueprintMechSkin:                "blueprint_mech_skin",
+	BlueprintMechs:                   "blueprint_mechs",
+	BlueprintMechsOld:                "blueprint_mechs_old",
+	BlueprintModules:                 "blueprint_modules",
+	BlueprintPlayerAbilities:         "blueprint_player_abilities",
+	BlueprintPowerCores:              "blueprint_power_cores",
+	BlueprintQuestRewards:            "blueprint_quest_rewards",
+	BlueprintQuests:                  "blueprint_quests",
+	BlueprintShieldTypes:             "blueprint_shield_types",
+	BlueprintUtility:                 "blueprint_utility",
+	BlueprintUtilityShieldOld:        "blueprint_utility_shield_old",
+	BlueprintWeaponSkin:              "blueprint_weapon_skin",
+	BlueprintWeapons:                 "blueprint_weapons",
+	BlueprintWeaponsOld:              "blueprint_weapons_old",
+	Brands:                           "brands",
+	BroadcastSystemMessageReceipts:   "broadcast_system_message_receipts",
+	BroadcastSystemMessages:          "broadcast_system_messages",
+	ChatBannedFingerprints:           "chat_banned_fingerprints",
+	ChatHistory:                      "chat_history",
+	CollectionItems:                  "collection_items",
+	ConsumedAbilities:                "consumed_abilities",
+	CouponEvents:                     "coupon_events",
+	CouponItems:                      "coupon_items",
+	Coupons:                          "coupons",
+	Devices:                          "devices",
+	DirectMessageConversationMembers: "direct_message_conversation_members",
+	DirectMessageConversations:       "direct_message_conversations",
+	DirectMessages:                   "direct_messages",
+	DiscordLobbyAnnoucements:         "discord_lobby_annoucements",
+	DiscordLobbyFollowers:            "discord_lobby_followers",
+	FactionPalettes:                  "faction_palettes",
+	FactionPassPurchaseLogs:          "faction_pass_purchase_logs",
+	FactionPasses:                    "faction_passes",
+	FactionStats:                     "faction_stats",
+	Factions:                         "factions",
+	FailedPlayerKeycardsSync:         "failed_player_keycards_sync",
+	Features:                         "features",
+	FiatGifts:                        "fiat_gifts",
+	FiatProductItemBlueprints:        "fiat_product_item_blueprints",
+	FiatProductItems:                 "fiat_product_items",
+	FiatProductPricings:              "fiat_product_pricings",
+	FiatProducts:                     "fiat_products",
+	FingerprintIps:                   "fingerprint_ips",
+	Fingerprints:                     "fingerprints",
+	GameAbilities:                    "game_abilities",
+	GameMaps:                         "game_maps",
+	GlobalAnnouncements:              "global_announcements",
+	ItemKeycardSales:                 "item_keycard_sales",
+	ItemOffers:                       "item_offers",
+	ItemSaleBundleItems:              "item_sale_bundle_items",
+	ItemSaleWatches:                  "item_sale_watches",
+	ItemSales:                        "item_sales",
+	ItemSalesBidHistory:              "item_sales_bid_history",
+	KV:                               "kv",
+	Languages:                        "languages",
+	Layers:                           "layers",
+	MarketplaceEvents:                "marketplace_events",
+	MarketplaceSavedSearches:         "marketplace_saved_searches",
+	MechAbilityTriggerLogsOld:        "mech_ability_trigger_logs_old",
+	MechAnimation:                    "mech_animation",
+	MechModelSkinCompatibilities:     "mech_model_skin_compatibilities",
+	MechMoveCommandLogs:              "mech_move_command_logs",
+	MechRatings:                      "mech_ratings",
+	MechSkin:                         "mech_skin",
+	MechStats:                        "mech_stats",
+	MechUtility:                      "mech_utility",
+	MechWeapons:                      "mech_weapons",
+	Mechs:                            "mechs",
+	MechsOld:                         "mechs_old",
+	ModActionAudit:                   "mod_action_audit",
+	Multipliers:                      "multipliers",
+	MysteryCrate:                     "mystery_crate",
+	MysteryCrateBlueprints:           "mystery_crate_blueprints",
+	MysteryCrateDropRates:            "mystery_crate_drop_rates",
+	MysteryCrateRolls:                "mystery_crate_rolls",
+	MysteryCrateSeeds:                "mystery_crate_seeds",
+	OrderItemAssets:                  "order_item_assets",
+	OrderItems:                       "order_items",
+	OrderStateHistories:              "order_state_histories",
+	Orders:                           "orders",
+	PlayerAbilities:                  "player_abilities",
+	PlayerActiveLogs:                 "player_active_logs",
+	PlayerBans:                       "player_bans",
+	PlayerBattleAbilities:            "player_battle_abilities",
+	PlayerBlocks:                     "player_blocks",
+	PlayerDiscounts:                  "player_discounts",
+	PlayerFingerprints:               "player_fingerprints",
+	PlayerFollows:                    "player_follows",
+	PlayerFriendRequests:             "player_friend_requests",
+	PlayerFriends:                    "player_friends",
+	PlayerIps:                        "player_ips",
+	PlayerKeycards:                   "player_keycards",
+	PlayerKillLog:                    "player_kill_log",
+	PlayerLanguages:                  "player_languages",
+	PlayerMechRepairSlots:            "player_mech_repair_slots",
+	PlayerMultipliers:                "player_multipliers",
+	PlayerPreferences:                "player_preferences",
+	PlayerQuestProgress:              "player_quest_progress",
+	PlayerQuestRewards:               "player_quest_rewards",
+	PlayerRatings:                    "player_ratings",
+	PlayerSettingsPreferences:        "player_settings_preferences",
+	PlayerSpoilsOfWar:                "player_spoils_of_war",
+	PlayerStats:                      "player_stats",
+	Players:                          "players",
+	PlayersFeatures:                  "players_features",
+	PlayersObtainedQuests:            "players_obtained_quests",
+	PlayersProfileAvatars:            "players_profile_avatars",
+	PlayersPunishVotes:               "players_punish_votes",
+	PowerCores:                       "power_cores",
+	Profanities:                      "profanities",
+	ProfileAvatars:                   "profile_avatars",
+	ProfileCustomAvatars:             "profile_custom_avatars",
+	PunishOptions:                    "punish_options",
+	PunishVoteInstantPassRecords:     "punish_vote_instant_pass_records",
+	PunishVotes:                      "punish_votes",
+	QuestEvents:                      "quest_events",
+	QuestJobs:                        "quest_jobs",
+	QuestionnaireAnswer:              "questionnaire_answer",
+	QuestionnaireOptions:             "questionnaire_options",
+	Quests:                           "quests",
+	RepairAgentLogsOld:               "repair_agent_logs_old",
+	RepairAgents:                     "repair_agents",
+	RepairBlocks:                     "repair_blocks",
+	RepairCases:                      "repair_cases",
+	RepairGameBlockLogs:              "repair_game_block_logs",
+	RepairGameBlocks:                 "repair_game_blocks",
+	RepairOffers:                     "repair_offers",
+	RolePermissions:                  "role_permissions",
+	Roles:                            "roles",
+	SalePlayerAbilities:              "sale_player_abilities",
+	SchemaMigrations:                 "schema_migrations",
+	ShoppingCartItems:                "shopping_cart_items",
+	ShoppingCarts:                    "shopping_carts",
+	SpoilsOfWar:                      "spoils_of_war",
+	StakedMechBattleLogs:             "staked_mech_battle_logs",
+	StakedMechs:                      "staked_mechs",
+	StaticMigrations:                 "static_migrations",
+	StorePurchaseHistory:             "store_purchase_history",
+	StorefrontMysteryCrates:          "storefront_mystery_crates",
+	StreamList:                       "stream_list",
+	SyndicateCommittees:              "syndicate_committees",
+	SyndicateDirectors:               "syndicate_directors",
+	SyndicateDuesCycles:              "syndicate_dues_cycles",
+	SyndicateElectionCandidates:      "syndicate_election_candidates",
+	SyndicateElectionVotes:           "syndicate_election_votes",
+	SyndicateElections:               "syndicate_elections",
+	SyndicateJoinApplications:        "syndicate_join_applications",
+	SyndicateMemberDues:              "syndicate_member_dues",
+	SyndicateMotionVotes:             "syndicate_motion_votes",
+	SyndicateMotions:                 "syndicate_motions",
+	SyndicatePendingMotions:          "syndicate_pending_motions",
+	SyndicateQuestionnaires:          "syndicate_questionnaires",
+	SyndicateRules:                   "syndicate_rules",
+	Syndicates:                       "syndicates",
+	SystemMessages:                   "system_messages",
+	TelegramNotifications:            "telegram_notifications",
+	TemplateBlueprints:               "template_blueprints",
+	Templates:                        "templates",
+	TemplatesOld:                     "templates_old",
+	TournamentEntrants:               "tournament_entrants",
+	TournamentMaps:                   "tournament_maps",
+	TournamentMatches:                "tournament_matches",
+	TournamentPrizeSplits:            "tournament_prize_splits",
+	Tournaments:                      "tournaments",
+	Utility:                          "utility",
+	UtilityShieldDontUse:             "utility_shield_dont_use",
+	VoiceStreams:                     "voice_streams",
+	WeaponAmmo:                       "weapon_ammo",
+	WeaponModelSkinCompatibilities:   "weapon_model_skin_compatibilities",
+	WeaponSkin:                       "weapon_skin",
+	Weapons:                          "weapons",
 }
@@ -180,6 +180,7 @@ const (
 	QuestEventTypeWeeklyQuest    = "weekly_quest"
 	QuestEventTypeMonthlyQuest   = "monthly_quest"
 	QuestEventTypeProvingGrounds = "proving_grounds"
+	QuestEventTypeSeasonalQuest  = "seasonal_quest"
 )
 
 // Enum values for QuestKey
@@ -190,6 +191,7 @@ const (
 	QuestKeyRepairForOther               = "repair_for_other"
 	QuestKeyChatSent                     = "chat_sent"
 	QuestKeyMechJoinBattle               = "mech_join_battle"
+	QuestKeyObjective                    = "objective"
 )
 
 // Enum values for UtilityType
@@ -215,10 +217,11 @@ const (
 
 // Enum values for ChatMSGTypeEnum
 const (
-	ChatMSGTypeEnumTEXT        = "TEXT"
-	ChatMSGTypeEnumPUNISH_VOTE = "PUNISH_VOTE"
-	ChatMSGTypeEnumSYSTEM_BAN  = "SYSTEM_BAN"
-	ChatMSGTypeEnumNEW_BATTLE  = "NEW_BATTLE"
+	ChatMSGTypeEnumTEXT             = "TEXT"
+	ChatMSGTypeEnumPUNISH_VOTE      = "PUNISH_VOTE"
+	ChatMSGTypeEnumSYSTEM_BAN       = "SYSTEM_BAN"
+	ChatMSGTypeEnumNEW_BATTLE       = "NEW_BATTLE"
+	ChatMSGTypeEnumSYNDICATE_NOTICE = "SYNDICATE_NOTICE"
 )
 
 // Enum values for  are not proper Go identifiers, cannot emit constants
@@ -241,6 +244,10 @@ const (
 	CouponItemTypeWEAPON_CRATE = "WEAPON_CRATE"
 	CouponItemTypeMECH_CRATE   = "MECH_CRATE"
 	CouponItemTypeGENESIS_MECH = "GENESIS_MECH"
+	CouponItemTypeFIAT_PRODUCT = "FIAT_PRODUCT"
+	CouponItemTypeFACTION_PASS = "FACTION_PASS"
+	CouponItemTypeBLUEPRINT    = "BLUEPRINT"
+	CouponItemTypeDISCOUNT     = "DISCOUNT"
 )
 
 // Enum values for PaymentMethods
@@ -299,12 +306,18 @@ const (
 
 // Enum values for MarketplaceEvent
 const (
-	MarketplaceEventBid       = "bid"
-	MarketplaceEventBidRefund = "bid_refund"
-	MarketplaceEventPurchase  = "purchase"
-	MarketplaceEventCreated   = "created"
-	MarketplaceEventSold      = "sold"
-	MarketplaceEventCancelled = "cancelled"
+	MarketplaceEventBid            = "bid"
+	MarketplaceEventBidRefund      = "bid_refund"
+	MarketplaceEventPurchase       = "purchase"
+	MarketplaceEventCreated        = "created"
+	MarketplaceEventSold           = "sold"
+	MarketplaceEventCancelled      = "cancelled"
+	MarketplaceEventOffer          = "offer"
+	MarketplaceEventOfferReceived  = "offer_received"
+	MarketplaceEventOfferRefund    = "offer_refund"
+	MarketplaceEventOfferAccepted  = "offer_accepted"
+	MarketplaceEventOfferRejected  = "offer_rejected"
+	MarketplaceEventOfferCountered = "offer_countered"
 )
 
 // Enum values for ModActionType
@@ -350,9 +363,11 @@ const (
 
 // Enum values for OrderStatuses
 const (
-	OrderStatusesPending   = "pending"
-	OrderStatusesCompleted = "completed"
-	OrderStatusesRefunded  = "refunded"
+	OrderStatusesPending    = "pending"
+	OrderStatusesCompleted  = "completed"
+	OrderStatusesRefunded   = "refunded"
+	OrderStatusesDisputed   = "disputed"
+	OrderStatusesChargeback = "chargeback"
 )
 
 // Enum values for BanFromType
@@ -503,3 +518,30 @@ const (
 	VoiceSenderTypeMECH_OWNER        = "MECH_OWNER"
 	VoiceSenderTypeFACTION_COMMANDER = "FACTION_COMMANDER"
 )
+
+// Enum values for SyndicateMemberDuesStatus
+const (
+	SyndicateMemberDuesStatusPENDING   = "PENDING"
+	SyndicateMemberDuesStatusPAID      = "PAID"
+	SyndicateMemberDuesStatusOVERDUE   = "OVERDUE"
+	SyndicateMemberDuesStatusDEFAULTED = "DEFAULTED"
+	SyndicateMemberDuesStatusCANCELLED = "CANCELLED"
+)
+
+// Enum values for FriendRequestStatusEnum
+const (
+	FriendRequestStatusEnumPENDING   = "PENDING"
+	FriendRequestStatusEnumACCEPTED  = "ACCEPTED"
+	FriendRequestStatusEnumDECLINED  = "DECLINED"
+	FriendRequestStatusEnumCANCELLED = "CANCELLED"
+)
+
+// Enum values for ItemOfferStatus
+const (
+	ItemOfferStatusPENDING   = "PENDING"
+	ItemOfferStatusACCEPTED  = "ACCEPTED"
+	ItemOfferStatusREJECTED  = "REJECTED"
+	ItemOfferStatusCOUNTERED = "COUNTERED"
+	ItemOfferStatusCANCELLED = "CANCELLED"
+	ItemOfferStatusEXPIRED   = "EXPIRED"
+)
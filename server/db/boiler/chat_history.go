@@ -24,7 +24,7 @@ import (
 // ChatHistory is an object representing the database table.
 type ChatHistory struct {
 	ID              string      `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
-	FactionID       string      `boiler:"faction_id" boil:"faction_id" json:"faction_id" toml:"faction_id" yaml:"faction_id"`
+	FactionID       null.String `boiler:"faction_id" boil:"faction_id" json:"faction_id" toml:"faction_id" yaml:"faction_id"`
 	PlayerID        string      `boiler:"player_id" boil:"player_id" json:"player_id" toml:"player_id" yaml:"player_id"`
 	MessageColor    string      `boiler:"message_color" boil:"message_color" json:"message_color" toml:"message_color" yaml:"message_color"`
 	Text            string      `boiler:"text" boil:"text" json:"text" toml:"text" yaml:"text"`
@@ -120,7 +120,7 @@ var ChatHistoryTableColumns = struct {
 
 var ChatHistoryWhere = struct {
 	ID              whereHelperstring
-	FactionID       whereHelperstring
+	FactionID       whereHelpernull_String
 	PlayerID        whereHelperstring
 	MessageColor    whereHelperstring
 	Text            whereHelperstring
@@ -137,7 +137,7 @@ var ChatHistoryWhere = struct {
 	ArenaID         whereHelpernull_String
 }{
 	ID:              whereHelperstring{field: "\"chat_history\".\"id\""},
-	FactionID:       whereHelperstring{field: "\"chat_history\".\"faction_id\""},
+	FactionID:       whereHelpernull_String{field: "\"chat_history\".\"faction_id\""},
 	PlayerID:        whereHelperstring{field: "\"chat_history\".\"player_id\""},
 	MessageColor:    whereHelperstring{field: "\"chat_history\".\"message_color\""},
 	Text:            whereHelperstring{field: "\"chat_history\".\"text\""},
@@ -726,7 +726,9 @@ func (chatHistoryL) LoadFaction(e boil.Executor, singular bool, maybeChatHistory
 		if object.R == nil {
 			object.R = &chatHistoryR{}
 		}
-		args = append(args, object.FactionID)
+		if !queries.IsNil(object.FactionID) {
+			args = append(args, object.FactionID)
+		}
 
 	} else {
 	Outer:
@@ -736,12 +738,14 @@ func (chatHistoryL) LoadFaction(e boil.Executor, singular bool, maybeChatHistory
 			}
 
 			for _, a := range args {
-				if a == obj.FactionID {
+				if queries.Equal(a, obj.FactionID) {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.FactionID)
+			if !queries.IsNil(obj.FactionID) {
+				args = append(args, obj.FactionID)
+			}
 
 		}
 	}
@@ -800,7 +804,7 @@ func (chatHistoryL) LoadFaction(e boil.Executor, singular bool, maybeChatHistory
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if local.FactionID == foreign.ID {
+			if queries.Equal(local.FactionID, foreign.ID) {
 				local.R.Faction = foreign
 				if foreign.R == nil {
 					foreign.R = &factionR{}
@@ -1103,7 +1107,7 @@ func (o *ChatHistory) SetFaction(exec boil.Executor, insert bool, related *Facti
 		return errors.Wrap(err, "failed to update local table")
 	}
 
-	o.FactionID = related.ID
+	queries.Assign(&o.FactionID, related.ID)
 	if o.R == nil {
 		o.R = &chatHistoryR{
 			Faction: related,
@@ -1123,6 +1127,39 @@ func (o *ChatHistory) SetFaction(exec boil.Executor, insert bool, related *Facti
 	return nil
 }
 
+// RemoveFaction relationship.
+// Sets o.R.Faction to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *ChatHistory) RemoveFaction(exec boil.Executor, related *Faction) error {
+	var err error
+
+	queries.SetScanner(&o.FactionID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("faction_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Faction = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ChatHistories {
+		if queries.Equal(o.FactionID, ri.FactionID) {
+			continue
+		}
+
+		ln := len(related.R.ChatHistories)
+		if ln > 1 && i < ln-1 {
+			related.R.ChatHistories[i] = related.R.ChatHistories[ln-1]
+		}
+		related.R.ChatHistories = related.R.ChatHistories[:ln-1]
+		break
+	}
+	return nil
+}
+
 // SetPlayer of the chatHistory to the related item.
 // Sets o.R.Player to related.
 // Adds o to related.R.ChatHistories.
@@ -36,6 +36,7 @@ type CollectionItem struct {
 	LockedToMarketplace bool        `boiler:"locked_to_marketplace" boil:"locked_to_marketplace" json:"locked_to_marketplace" toml:"locked_to_marketplace" yaml:"locked_to_marketplace"`
 	AssetHidden         null.String `boiler:"asset_hidden" boil:"asset_hidden" json:"asset_hidden,omitempty" toml:"asset_hidden" yaml:"asset_hidden,omitempty"`
 	DeletedAt           null.Time   `boiler:"deleted_at" boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ClawbackLocked      bool        `boiler:"clawback_locked" boil:"clawback_locked" json:"clawback_locked" toml:"clawback_locked" yaml:"clawback_locked"`
 
 	R *collectionItemR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L collectionItemL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -55,6 +56,7 @@ var CollectionItemColumns = struct {
 	LockedToMarketplace string
 	AssetHidden         string
 	DeletedAt           string
+	ClawbackLocked      string
 }{
 	ID:                  "id",
 	CollectionSlug:      "collection_slug",
@@ -69,6 +71,7 @@ var CollectionItemColumns = struct {
 	LockedToMarketplace: "locked_to_marketplace",
 	AssetHidden:         "asset_hidden",
 	DeletedAt:           "deleted_at",
+	ClawbackLocked:      "clawback_locked",
 }
 
 var CollectionItemTableColumns = struct {
@@ -85,6 +88,7 @@ var CollectionItemTableColumns = struct {
 	LockedToMarketplace string
 	AssetHidden         string
 	DeletedAt           string
+	ClawbackLocked      string
 }{
 	ID:                  "collection_items.id",
 	CollectionSlug:      "collection_items.collection_slug",
@@ -99,6 +103,7 @@ var CollectionItemTableColumns = struct {
 	LockedToMarketplace: "collection_items.locked_to_marketplace",
 	AssetHidden:         "collection_items.asset_hidden",
 	DeletedAt:           "collection_items.deleted_at",
+	ClawbackLocked:      "collection_items.clawback_locked",
 }
 
 // Generated where
@@ -117,6 +122,7 @@ var CollectionItemWhere = struct {
 	LockedToMarketplace whereHelperbool
 	AssetHidden         whereHelpernull_String
 	DeletedAt           whereHelpernull_Time
+	ClawbackLocked      whereHelperbool
 }{
 	ID:                  whereHelperstring{field: "\"collection_items\".\"id\""},
 	CollectionSlug:      whereHelperstring{field: "\"collection_items\".\"collection_slug\""},
@@ -131,6 +137,7 @@ var CollectionItemWhere = struct {
 	LockedToMarketplace: whereHelperbool{field: "\"collection_items\".\"locked_to_marketplace\""},
 	AssetHidden:         whereHelpernull_String{field: "\"collection_items\".\"asset_hidden\""},
 	DeletedAt:           whereHelpernull_Time{field: "\"collection_items\".\"deleted_at\""},
+	ClawbackLocked:      whereHelperbool{field: "\"collection_items\".\"clawback_locked\""},
 }
 
 // CollectionItemRels is where relationship names are stored.
@@ -157,9 +164,9 @@ func (*collectionItemR) NewStruct() *collectionItemR {
 type collectionItemL struct{}
 
 var (
-	collectionItemAllColumns            = []string{"id", "collection_slug", "hash", "token_id", "item_type", "item_id", "tier", "owner_id", "market_locked", "xsyn_locked", "locked_to_marketplace", "asset_hidden", "deleted_at"}
+	collectionItemAllColumns            = []string{"id", "collection_slug", "hash", "token_id", "item_type", "item_id", "tier", "owner_id", "market_locked", "xsyn_locked", "locked_to_marketplace", "asset_hidden", "deleted_at", "clawback_locked"}
 	collectionItemColumnsWithoutDefault = []string{"token_id", "item_type", "item_id", "owner_id"}
-	collectionItemColumnsWithDefault    = []string{"id", "collection_slug", "hash", "tier", "market_locked", "xsyn_locked", "locked_to_marketplace", "asset_hidden", "deleted_at"}
+	collectionItemColumnsWithDefault    = []string{"id", "collection_slug", "hash", "tier", "market_locked", "xsyn_locked", "locked_to_marketplace", "asset_hidden", "deleted_at", "clawback_locked"}
 	collectionItemPrimaryKeyColumns     = []string{"id"}
 	collectionItemGeneratedColumns      = []string{}
 )
@@ -24,79 +24,100 @@ import (
 
 // CouponItem is an object representing the database table.
 type CouponItem struct {
-	ID            string              `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
-	CouponID      string              `boiler:"coupon_id" boil:"coupon_id" json:"coupon_id" toml:"coupon_id" yaml:"coupon_id"`
-	ItemType      string              `boiler:"item_type" boil:"item_type" json:"item_type" toml:"item_type" yaml:"item_type"`
-	ItemID        null.String         `boiler:"item_id" boil:"item_id" json:"item_id,omitempty" toml:"item_id" yaml:"item_id,omitempty"`
-	Claimed       bool                `boiler:"claimed" boil:"claimed" json:"claimed" toml:"claimed" yaml:"claimed"`
-	Amount        decimal.NullDecimal `boiler:"amount" boil:"amount" json:"amount,omitempty" toml:"amount" yaml:"amount,omitempty"`
-	TransactionID null.String         `boiler:"transaction_id" boil:"transaction_id" json:"transaction_id,omitempty" toml:"transaction_id" yaml:"transaction_id,omitempty"`
-	CreatedAt     time.Time           `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ID                 string              `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	CouponID           string              `boiler:"coupon_id" boil:"coupon_id" json:"coupon_id" toml:"coupon_id" yaml:"coupon_id"`
+	ItemType           string              `boiler:"item_type" boil:"item_type" json:"item_type" toml:"item_type" yaml:"item_type"`
+	ItemID             null.String         `boiler:"item_id" boil:"item_id" json:"item_id,omitempty" toml:"item_id" yaml:"item_id,omitempty"`
+	Claimed            bool                `boiler:"claimed" boil:"claimed" json:"claimed" toml:"claimed" yaml:"claimed"`
+	Amount             decimal.NullDecimal `boiler:"amount" boil:"amount" json:"amount,omitempty" toml:"amount" yaml:"amount,omitempty"`
+	TransactionID      null.String         `boiler:"transaction_id" boil:"transaction_id" json:"transaction_id,omitempty" toml:"transaction_id" yaml:"transaction_id,omitempty"`
+	CreatedAt          time.Time           `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	BlueprintType      null.String         `boiler:"blueprint_type" boil:"blueprint_type" json:"blueprint_type,omitempty" toml:"blueprint_type" yaml:"blueprint_type,omitempty"`
+	DiscountTarget     null.String         `boiler:"discount_target" boil:"discount_target" json:"discount_target,omitempty" toml:"discount_target" yaml:"discount_target,omitempty"`
+	DiscountPercentage decimal.NullDecimal `boiler:"discount_percentage" boil:"discount_percentage" json:"discount_percentage,omitempty" toml:"discount_percentage" yaml:"discount_percentage,omitempty"`
 
 	R *couponItemR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L couponItemL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var CouponItemColumns = struct {
-	ID            string
-	CouponID      string
-	ItemType      string
-	ItemID        string
-	Claimed       string
-	Amount        string
-	TransactionID string
-	CreatedAt     string
+	ID                 string
+	CouponID           string
+	ItemType           string
+	ItemID             string
+	Claimed            string
+	Amount             string
+	TransactionID      string
+	CreatedAt          string
+	BlueprintType      string
+	DiscountTarget     string
+	DiscountPercentage string
 }{
-	ID:            "id",
-	CouponID:      "coupon_id",
-	ItemType:      "item_type",
-	ItemID:        "item_id",
-	Claimed:       "claimed",
-	Amount:        "amount",
-	TransactionID: "transaction_id",
-	CreatedAt:     "created_at",
+	ID:                 "id",
+	CouponID:           "coupon_id",
+	ItemType:           "item_type",
+	ItemID:             "item_id",
+	Claimed:            "claimed",
+	Amount:             "amount",
+	TransactionID:      "transaction_id",
+	CreatedAt:          "created_at",
+	BlueprintType:      "blueprint_type",
+	DiscountTarget:     "discount_target",
+	DiscountPercentage: "discount_percentage",
 }
 
 var CouponItemTableColumns = struct {
-	ID            string
-	CouponID      string
-	ItemType      string
-	ItemID        string
-	Claimed       string
-	Amount        string
-	TransactionID string
-	CreatedAt     string
+	ID                 string
+	CouponID           string
+	ItemType           string
+	ItemID             string
+	Claimed            string
+	Amount             string
+	TransactionID      string
+	CreatedAt          string
+	BlueprintType      string
+	DiscountTarget     string
+	DiscountPercentage string
 }{
-	ID:            "coupon_items.id",
-	CouponID:      "coupon_items.coupon_id",
-	ItemType:      "coupon_items.item_type",
-	ItemID:        "coupon_items.item_id",
-	Claimed:       "coupon_items.claimed",
-	Amount:        "coupon_items.amount",
-	TransactionID: "coupon_items.transaction_id",
-	CreatedAt:     "coupon_items.created_at",
+	ID:                 "coupon_items.id",
+	CouponID:           "coupon_items.coupon_id",
+	ItemType:           "coupon_items.item_type",
+	ItemID:             "coupon_items.item_id",
+	Claimed:            "coupon_items.claimed",
+	Amount:             "coupon_items.amount",
+	TransactionID:      "coupon_items.transaction_id",
+	CreatedAt:          "coupon_items.created_at",
+	BlueprintType:      "coupon_items.blueprint_type",
+	DiscountTarget:     "coupon_items.discount_target",
+	DiscountPercentage: "coupon_items.discount_percentage",
 }
 
 // Generated where
 
 var CouponItemWhere = struct {
-	ID            whereHelperstring
-	CouponID      whereHelperstring
-	ItemType      whereHelperstring
-	ItemID        whereHelpernull_String
-	Claimed       whereHelperbool
-	Amount        whereHelperdecimal_NullDecimal
-	TransactionID whereHelpernull_String
-	CreatedAt     whereHelpertime_Time
+	ID                 whereHelperstring
+	CouponID           whereHelperstring
+	ItemType           whereHelperstring
+	ItemID             whereHelpernull_String
+	Claimed            whereHelperbool
+	Amount             whereHelperdecimal_NullDecimal
+	TransactionID      whereHelpernull_String
+	CreatedAt          whereHelpertime_Time
+	BlueprintType      whereHelpernull_String
+	DiscountTarget     whereHelpernull_String
+	DiscountPercentage whereHelperdecimal_NullDecimal
 }{
-	ID:            whereHelperstring{field: "\"coupon_items\".\"id\""},
-	CouponID:      whereHelperstring{field: "\"coupon_items\".\"coupon_id\""},
-	ItemType:      whereHelperstring{field: "\"coupon_items\".\"item_type\""},
-	ItemID:        whereHelpernull_String{field: "\"coupon_items\".\"item_id\""},
-	Claimed:       whereHelperbool{field: "\"coupon_items\".\"claimed\""},
-	Amount:        whereHelperdecimal_NullDecimal{field: "\"coupon_items\".\"amount\""},
-	TransactionID: whereHelpernull_String{field: "\"coupon_items\".\"transaction_id\""},
-	CreatedAt:     whereHelpertime_Time{field: "\"coupon_items\".\"created_at\""},
+	ID:                 whereHelperstring{field: "\"coupon_items\".\"id\""},
+	CouponID:           whereHelperstring{field: "\"coupon_items\".\"coupon_id\""},
+	ItemType:           whereHelperstring{field: "\"coupon_items\".\"item_type\""},
+	ItemID:             whereHelpernull_String{field: "\"coupon_items\".\"item_id\""},
+	Claimed:            whereHelperbool{field: "\"coupon_items\".\"claimed\""},
+	Amount:             whereHelperdecimal_NullDecimal{field: "\"coupon_items\".\"amount\""},
+	TransactionID:      whereHelpernull_String{field: "\"coupon_items\".\"transaction_id\""},
+	CreatedAt:          whereHelpertime_Time{field: "\"coupon_items\".\"created_at\""},
+	BlueprintType:      whereHelpernull_String{field: "\"coupon_items\".\"blueprint_type\""},
+	DiscountTarget:     whereHelpernull_String{field: "\"coupon_items\".\"discount_target\""},
+	DiscountPercentage: whereHelperdecimal_NullDecimal{field: "\"coupon_items\".\"discount_percentage\""},
 }
 
 // CouponItemRels is where relationship names are stored.
@@ -120,9 +141,9 @@ func (*couponItemR) NewStruct() *couponItemR {
 type couponItemL struct{}
 
 var (
-	couponItemAllColumns            = []string{"id", "coupon_id", "item_type", "item_id", "claimed", "amount", "transaction_id", "created_at"}
+	couponItemAllColumns            = []string{"id", "coupon_id", "item_type", "item_id", "claimed", "amount", "transaction_id", "created_at", "blueprint_type", "discount_target", "discount_percentage"}
 	couponItemColumnsWithoutDefault = []string{"coupon_id", "item_type"}
-	couponItemColumnsWithDefault    = []string{"id", "item_id", "claimed", "amount", "transaction_id", "created_at"}
+	couponItemColumnsWithDefault    = []string{"id", "item_id", "claimed", "amount", "transaction_id", "created_at", "blueprint_type", "discount_target", "discount_percentage"}
 	couponItemPrimaryKeyColumns     = []string{"id"}
 	couponItemGeneratedColumns      = []string{}
 )
@@ -23,72 +23,128 @@ import (
 
 // Coupon is an object representing the database table.
 type Coupon struct {
-	ID           string      `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
-	Code         string      `boiler:"code" boil:"code" json:"code" toml:"code" yaml:"code"`
-	Redeemed     bool        `boiler:"redeemed" boil:"redeemed" json:"redeemed" toml:"redeemed" yaml:"redeemed"`
-	ExpiryDate   time.Time   `boiler:"expiry_date" boil:"expiry_date" json:"expiry_date" toml:"expiry_date" yaml:"expiry_date"`
-	CreatedAt    time.Time   `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	RedeemedAt   null.Time   `boiler:"redeemed_at" boil:"redeemed_at" json:"redeemed_at,omitempty" toml:"redeemed_at" yaml:"redeemed_at,omitempty"`
-	RedeemedByID null.String `boiler:"redeemed_by_id" boil:"redeemed_by_id" json:"redeemed_by_id,omitempty" toml:"redeemed_by_id" yaml:"redeemed_by_id,omitempty"`
+	ID               string      `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	Code             string      `boiler:"code" boil:"code" json:"code" toml:"code" yaml:"code"`
+	Redeemed         bool        `boiler:"redeemed" boil:"redeemed" json:"redeemed" toml:"redeemed" yaml:"redeemed"`
+	ExpiryDate       time.Time   `boiler:"expiry_date" boil:"expiry_date" json:"expiry_date" toml:"expiry_date" yaml:"expiry_date"`
+	CreatedAt        time.Time   `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	RedeemedAt       null.Time   `boiler:"redeemed_at" boil:"redeemed_at" json:"redeemed_at,omitempty" toml:"redeemed_at" yaml:"redeemed_at,omitempty"`
+	RedeemedByID     null.String `boiler:"redeemed_by_id" boil:"redeemed_by_id" json:"redeemed_by_id,omitempty" toml:"redeemed_by_id" yaml:"redeemed_by_id,omitempty"`
+	Label            string      `boiler:"label" boil:"label" json:"label" toml:"label" yaml:"label"`
+	FactionID        null.String `boiler:"faction_id" boil:"faction_id" json:"faction_id,omitempty" toml:"faction_id" yaml:"faction_id,omitempty"`
+	StartsAt         null.Time   `boiler:"starts_at" boil:"starts_at" json:"starts_at,omitempty" toml:"starts_at" yaml:"starts_at,omitempty"`
+	MaxUses          null.Int    `boiler:"max_uses" boil:"max_uses" json:"max_uses,omitempty" toml:"max_uses" yaml:"max_uses,omitempty"`
+	MaxUsesPerPlayer int         `boiler:"max_uses_per_player" boil:"max_uses_per_player" json:"max_uses_per_player" toml:"max_uses_per_player" yaml:"max_uses_per_player"`
+	Uses             int         `boiler:"uses" boil:"uses" json:"uses" toml:"uses" yaml:"uses"`
+	DisabledAt       null.Time   `boiler:"disabled_at" boil:"disabled_at" json:"disabled_at,omitempty" toml:"disabled_at" yaml:"disabled_at,omitempty"`
+	CreatedByID      null.String `boiler:"created_by_id" boil:"created_by_id" json:"created_by_id,omitempty" toml:"created_by_id" yaml:"created_by_id,omitempty"`
 
 	R *couponR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L couponL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var CouponColumns = struct {
-	ID           string
-	Code         string
-	Redeemed     string
-	ExpiryDate   string
-	CreatedAt    string
-	RedeemedAt   string
-	RedeemedByID string
+	ID               string
+	Code             string
+	Redeemed         string
+	ExpiryDate       string
+	CreatedAt        string
+	RedeemedAt       string
+	RedeemedByID     string
+	Label            string
+	FactionID        string
+	StartsAt         string
+	MaxUses          string
+	MaxUsesPerPlayer string
+	Uses             string
+	DisabledAt       string
+	CreatedByID      string
 }{
-	ID:           "id",
-	Code:         "code",
-	Redeemed:     "redeemed",
-	ExpiryDate:   "expiry_date",
-	CreatedAt:    "created_at",
-	RedeemedAt:   "redeemed_at",
-	RedeemedByID: "redeemed_by_id",
+	ID:               "id",
+	Code:             "code",
+	Redeemed:         "redeemed",
+	ExpiryDate:       "expiry_date",
+	CreatedAt:        "created_at",
+	RedeemedAt:       "redeemed_at",
+	RedeemedByID:     "redeemed_by_id",
+	Label:            "label",
+	FactionID:        "faction_id",
+	StartsAt:         "starts_at",
+	MaxUses:          "max_uses",
+	MaxUsesPerPlayer: "max_uses_per_player",
+	Uses:             "uses",
+	DisabledAt:       "disabled_at",
+	CreatedByID:      "created_by_id",
 }
 
 var CouponTableColumns = struct {
-	ID           string
-	Code         string
-	Redeemed     string
-	ExpiryDate   string
-	CreatedAt    string
-	RedeemedAt   string
-	RedeemedByID string
+	ID               string
+	Code             string
+	Redeemed         string
+	ExpiryDate       string
+	CreatedAt        string
+	RedeemedAt       string
+	RedeemedByID     string
+	Label            string
+	FactionID        string
+	StartsAt         string
+	MaxUses          string
+	MaxUsesPerPlayer string
+	Uses             string
+	DisabledAt       string
+	CreatedByID      string
 }{
-	ID:           "coupons.id",
-	Code:         "coupons.code",
-	Redeemed:     "coupons.redeemed",
-	ExpiryDate:   "coupons.expiry_date",
-	CreatedAt:    "coupons.created_at",
-	RedeemedAt:   "coupons.redeemed_at",
-	RedeemedByID: "coupons.redeemed_by_id",
+	ID:               "coupons.id",
+	Code:             "coupons.code",
+	Redeemed:         "coupons.redeemed",
+	ExpiryDate:       "coupons.expiry_date",
+	CreatedAt:        "coupons.created_at",
+	RedeemedAt:       "coupons.redeemed_at",
+	RedeemedByID:     "coupons.redeemed_by_id",
+	Label:            "coupons.label",
+	FactionID:        "coupons.faction_id",
+	StartsAt:         "coupons.starts_at",
+	MaxUses:          "coupons.max_uses",
+	MaxUsesPerPlayer: "coupons.max_uses_per_player",
+	Uses:             "coupons.uses",
+	DisabledAt:       "coupons.disabled_at",
+	CreatedByID:      "coupons.created_by_id",
 }
 
 // Generated where
 
 var CouponWhere = struct {
-	ID           whereHelperstring
-	Code         whereHelperstring
-	Redeemed     whereHelperbool
-	ExpiryDate   whereHelpertime_Time
-	CreatedAt    whereHelpertime_Time
-	RedeemedAt   whereHelpernull_Time
-	RedeemedByID whereHelpernull_String
+	ID               whereHelperstring
+	Code             whereHelperstring
+	Redeemed         whereHelperbool
+	ExpiryDate       whereHelpertime_Time
+	CreatedAt        whereHelpertime_Time
+	RedeemedAt       whereHelpernull_Time
+	RedeemedByID     whereHelpernull_String
+	Label            whereHelperstring
+	FactionID        whereHelpernull_String
+	StartsAt         whereHelpernull_Time
+	MaxUses          whereHelpernull_Int
+	MaxUsesPerPlayer whereHelperint
+	Uses             whereHelperint
+	DisabledAt       whereHelpernull_Time
+	CreatedByID      whereHelpernull_String
 }{
-	ID:           whereHelperstring{field: "\"coupons\".\"id\""},
-	Code:         whereHelperstring{field: "\"coupons\".\"code\""},
-	Redeemed:     whereHelperbool{field: "\"coupons\".\"redeemed\""},
-	ExpiryDate:   whereHelpertime_Time{field: "\"coupons\".\"expiry_date\""},
-	CreatedAt:    whereHelpertime_Time{field: "\"coupons\".\"created_at\""},
-	RedeemedAt:   whereHelpernull_Time{field: "\"coupons\".\"redeemed_at\""},
-	RedeemedByID: whereHelpernull_String{field: "\"coupons\".\"redeemed_by_id\""},
+	ID:               whereHelperstring{field: "\"coupons\".\"id\""},
+	Code:             whereHelperstring{field: "\"coupons\".\"code\""},
+	Redeemed:         whereHelperbool{field: "\"coupons\".\"redeemed\""},
+	ExpiryDate:       whereHelpertime_Time{field: "\"coupons\".\"expiry_date\""},
+	CreatedAt:        whereHelpertime_Time{field: "\"coupons\".\"created_at\""},
+	RedeemedAt:       whereHelpernull_Time{field: "\"coupons\".\"redeemed_at\""},
+	RedeemedByID:     whereHelpernull_String{field: "\"coupons\".\"redeemed_by_id\""},
+	Label:            whereHelperstring{field: "\"coupons\".\"label\""},
+	FactionID:        whereHelpernull_String{field: "\"coupons\".\"faction_id\""},
+	StartsAt:         whereHelpernull_Time{field: "\"coupons\".\"starts_at\""},
+	MaxUses:          whereHelpernull_Int{field: "\"coupons\".\"max_uses\""},
+	MaxUsesPerPlayer: whereHelperint{field: "\"coupons\".\"max_uses_per_player\""},
+	Uses:             whereHelperint{field: "\"coupons\".\"uses\""},
+	DisabledAt:       whereHelpernull_Time{field: "\"coupons\".\"disabled_at\""},
+	CreatedByID:      whereHelpernull_String{field: "\"coupons\".\"created_by_id\""},
 }
 
 // CouponRels is where relationship names are stored.
@@ -115,9 +171,9 @@ func (*couponR) NewStruct() *couponR {
 type couponL struct{}
 
 var (
-	couponAllColumns            = []string{"id", "code", "redeemed", "expiry_date", "created_at", "redeemed_at", "redeemed_by_id"}
+	couponAllColumns            = []string{"id", "code", "redeemed", "expiry_date", "created_at", "redeemed_at", "redeemed_by_id", "label", "faction_id", "starts_at", "max_uses", "max_uses_per_player", "uses", "disabled_at", "created_by_id"}
 	couponColumnsWithoutDefault = []string{}
-	couponColumnsWithDefault    = []string{"id", "code", "redeemed", "expiry_date", "created_at", "redeemed_at", "redeemed_by_id"}
+	couponColumnsWithDefault    = []string{"id", "code", "redeemed", "expiry_date", "created_at", "redeemed_at", "redeemed_by_id", "label", "faction_id", "starts_at", "max_uses", "max_uses_per_player", "uses", "disabled_at", "created_by_id"}
 	couponPrimaryKeyColumns     = []string{"id"}
 	couponGeneratedColumns      = []string{}
 )
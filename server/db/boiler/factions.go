@@ -2461,7 +2461,7 @@ func (factionL) LoadChatHistories(e boil.Executor, singular bool, maybeFaction i
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.FactionID {
+			if queries.Equal(local.ID, foreign.FactionID) {
 				local.R.ChatHistories = append(local.R.ChatHistories, foreign)
 				if foreign.R == nil {
 					foreign.R = &chatHistoryR{}
@@ -4635,7 +4635,7 @@ func (o *Faction) AddChatHistories(exec boil.Executor, insert bool, related ...*
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.FactionID = o.ID
+			queries.Assign(&rel.FactionID, o.ID)
 			if err = rel.Insert(exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
@@ -4655,7 +4655,7 @@ func (o *Faction) AddChatHistories(exec boil.Executor, insert bool, related ...*
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.FactionID = o.ID
+			queries.Assign(&rel.FactionID, o.ID)
 		}
 	}
 
@@ -4679,6 +4679,79 @@ func (o *Faction) AddChatHistories(exec boil.Executor, insert bool, related ...*
 	return nil
 }
 
+// SetChatHistories removes all previously related items of the
+// faction replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Faction's ChatHistories accordingly.
+// Replaces o.R.ChatHistories with related.
+// Sets related.R.Faction's ChatHistories accordingly.
+func (o *Faction) SetChatHistories(exec boil.Executor, insert bool, related ...*ChatHistory) error {
+	query := "update \"chat_history\" set \"faction_id\" = null where \"faction_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ChatHistories {
+			queries.SetScanner(&rel.FactionID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Faction = nil
+		}
+
+		o.R.ChatHistories = nil
+	}
+	return o.AddChatHistories(exec, insert, related...)
+}
+
+// RemoveChatHistories relationships from objects passed in.
+// Removes related items from R.ChatHistories (uses pointer comparison, removal does not keep order)
+// Sets related.R.Faction.
+func (o *Faction) RemoveChatHistories(exec boil.Executor, related ...*ChatHistory) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.FactionID, nil)
+		if rel.R != nil {
+			rel.R.Faction = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("faction_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ChatHistories {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ChatHistories)
+			if ln > 1 && i < ln-1 {
+				o.R.ChatHistories[i] = o.R.ChatHistories[ln-1]
+			}
+			o.R.ChatHistories = o.R.ChatHistories[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // AddGameAbilities adds the given related objects to the existing relationships
 // of the faction, optionally inserting them as new records.
 // Appends related to o.R.GameAbilities.
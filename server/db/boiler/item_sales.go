@@ -45,6 +45,10 @@ type ItemSale struct {
 	DeletedAt            null.Time           `boiler:"deleted_at" boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
 	UpdatedAt            time.Time           `boiler:"updated_at" boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 	CreatedAt            time.Time           `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	Bundle               bool                `boiler:"bundle" boil:"bundle" json:"bundle" toml:"bundle" yaml:"bundle"`
+	SoldBlueprintID      null.String         `boiler:"sold_blueprint_id" boil:"sold_blueprint_id" json:"sold_blueprint_id,omitempty" toml:"sold_blueprint_id" yaml:"sold_blueprint_id,omitempty"`
+	SoldSkinBlueprintID  null.String         `boiler:"sold_skin_blueprint_id" boil:"sold_skin_blueprint_id" json:"sold_skin_blueprint_id,omitempty" toml:"sold_skin_blueprint_id" yaml:"sold_skin_blueprint_id,omitempty"`
+	SoldTier             null.String         `boiler:"sold_tier" boil:"sold_tier" json:"sold_tier,omitempty" toml:"sold_tier" yaml:"sold_tier,omitempty"`
 
 	R *itemSaleR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L itemSaleL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -72,6 +76,10 @@ var ItemSaleColumns = struct {
 	DeletedAt            string
 	UpdatedAt            string
 	CreatedAt            string
+	Bundle               string
+	SoldBlueprintID      string
+	SoldSkinBlueprintID  string
+	SoldTier             string
 }{
 	ID:                   "id",
 	FactionID:            "faction_id",
@@ -94,6 +102,10 @@ var ItemSaleColumns = struct {
 	DeletedAt:            "deleted_at",
 	UpdatedAt:            "updated_at",
 	CreatedAt:            "created_at",
+	Bundle:               "bundle",
+	SoldBlueprintID:      "sold_blueprint_id",
+	SoldSkinBlueprintID:  "sold_skin_blueprint_id",
+	SoldTier:             "sold_tier",
 }
 
 var ItemSaleTableColumns = struct {
@@ -118,6 +130,10 @@ var ItemSaleTableColumns = struct {
 	DeletedAt            string
 	UpdatedAt            string
 	CreatedAt            string
+	Bundle               string
+	SoldBlueprintID      string
+	SoldSkinBlueprintID  string
+	SoldTier             string
 }{
 	ID:                   "item_sales.id",
 	FactionID:            "item_sales.faction_id",
@@ -140,6 +156,10 @@ var ItemSaleTableColumns = struct {
 	DeletedAt:            "item_sales.deleted_at",
 	UpdatedAt:            "item_sales.updated_at",
 	CreatedAt:            "item_sales.created_at",
+	Bundle:               "item_sales.bundle",
+	SoldBlueprintID:      "item_sales.sold_blueprint_id",
+	SoldSkinBlueprintID:  "item_sales.sold_skin_blueprint_id",
+	SoldTier:             "item_sales.sold_tier",
 }
 
 // Generated where
@@ -166,6 +186,10 @@ var ItemSaleWhere = struct {
 	DeletedAt            whereHelpernull_Time
 	UpdatedAt            whereHelpertime_Time
 	CreatedAt            whereHelpertime_Time
+	Bundle               whereHelperbool
+	SoldBlueprintID      whereHelpernull_String
+	SoldSkinBlueprintID  whereHelpernull_String
+	SoldTier             whereHelpernull_String
 }{
 	ID:                   whereHelperstring{field: "\"item_sales\".\"id\""},
 	FactionID:            whereHelperstring{field: "\"item_sales\".\"faction_id\""},
@@ -188,6 +212,10 @@ var ItemSaleWhere = struct {
 	DeletedAt:            whereHelpernull_Time{field: "\"item_sales\".\"deleted_at\""},
 	UpdatedAt:            whereHelpertime_Time{field: "\"item_sales\".\"updated_at\""},
 	CreatedAt:            whereHelpertime_Time{field: "\"item_sales\".\"created_at\""},
+	Bundle:               whereHelperbool{field: "\"item_sales\".\"bundle\""},
+	SoldBlueprintID:      whereHelpernull_String{field: "\"item_sales\".\"sold_blueprint_id\""},
+	SoldSkinBlueprintID:  whereHelpernull_String{field: "\"item_sales\".\"sold_skin_blueprint_id\""},
+	SoldTier:             whereHelpernull_String{field: "\"item_sales\".\"sold_tier\""},
 }
 
 // ItemSaleRels is where relationship names are stored.
@@ -226,9 +254,9 @@ func (*itemSaleR) NewStruct() *itemSaleR {
 type itemSaleL struct{}
 
 var (
-	itemSaleAllColumns            = []string{"id", "faction_id", "collection_item_id", "listing_fee_tx_id", "owner_id", "auction", "auction_current_price", "auction_reserved_price", "buyout", "buyout_price", "dutch_auction", "dutch_auction_drop_rate", "end_at", "sold_at", "sold_for", "sold_to", "sold_tx_id", "sold_fee_tx_id", "deleted_at", "updated_at", "created_at"}
-	itemSaleColumnsWithoutDefault = []string{"faction_id", "collection_item_id", "owner_id", "end_at"}
-	itemSaleColumnsWithDefault    = []string{"id", "listing_fee_tx_id", "auction", "auction_current_price", "auction_reserved_price", "buyout", "buyout_price", "dutch_auction", "dutch_auction_drop_rate", "sold_at", "sold_for", "sold_to", "sold_tx_id", "sold_fee_tx_id", "deleted_at", "updated_at", "created_at"}
+	itemSaleAllColumns            = []string{"id", "faction_id", "collection_item_id", "listing_fee_tx_id", "owner_id", "auction", "auction_current_price", "auction_reserved_price", "buyout", "buyout_price", "dutch_auction", "dutch_auction_drop_rate", "end_at", "sold_at", "sold_for", "sold_to", "sold_tx_id", "sold_fee_tx_id", "deleted_at", "updated_at", "created_at", "bundle", "sold_blueprint_id", "sold_skin_blueprint_id", "sold_tier"}
+	itemSaleColumnsWithoutDefault = []string{"faction_id", "collection_item_id", "owner_id", "end_at", "sold_blueprint_id", "sold_skin_blueprint_id", "sold_tier"}
+	itemSaleColumnsWithDefault    = []string{"id", "listing_fee_tx_id", "auction", "auction_current_price", "auction_reserved_price", "buyout", "buyout_price", "dutch_auction", "dutch_auction_drop_rate", "sold_at", "sold_for", "sold_to", "sold_tx_id", "sold_fee_tx_id", "deleted_at", "updated_at", "created_at", "bundle"}
 	itemSalePrimaryKeyColumns     = []string{"id"}
 	itemSaleGeneratedColumns      = []string{}
 )
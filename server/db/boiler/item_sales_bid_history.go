@@ -24,79 +24,93 @@ import (
 
 // ItemSalesBidHistory is an object representing the database table.
 type ItemSalesBidHistory struct {
-	ItemSaleID      string          `boiler:"item_sale_id" boil:"item_sale_id" json:"item_sale_id" toml:"item_sale_id" yaml:"item_sale_id"`
-	BidTXID         string          `boiler:"bid_tx_id" boil:"bid_tx_id" json:"bid_tx_id" toml:"bid_tx_id" yaml:"bid_tx_id"`
-	RefundBidTXID   null.String     `boiler:"refund_bid_tx_id" boil:"refund_bid_tx_id" json:"refund_bid_tx_id,omitempty" toml:"refund_bid_tx_id" yaml:"refund_bid_tx_id,omitempty"`
-	BidderID        string          `boiler:"bidder_id" boil:"bidder_id" json:"bidder_id" toml:"bidder_id" yaml:"bidder_id"`
-	BidAt           time.Time       `boiler:"bid_at" boil:"bid_at" json:"bid_at" toml:"bid_at" yaml:"bid_at"`
-	BidPrice        decimal.Decimal `boiler:"bid_price" boil:"bid_price" json:"bid_price" toml:"bid_price" yaml:"bid_price"`
-	CancelledAt     null.Time       `boiler:"cancelled_at" boil:"cancelled_at" json:"cancelled_at,omitempty" toml:"cancelled_at" yaml:"cancelled_at,omitempty"`
-	CancelledReason null.String     `boiler:"cancelled_reason" boil:"cancelled_reason" json:"cancelled_reason,omitempty" toml:"cancelled_reason" yaml:"cancelled_reason,omitempty"`
+	ItemSaleID       string              `boiler:"item_sale_id" boil:"item_sale_id" json:"item_sale_id" toml:"item_sale_id" yaml:"item_sale_id"`
+	BidTXID          string              `boiler:"bid_tx_id" boil:"bid_tx_id" json:"bid_tx_id" toml:"bid_tx_id" yaml:"bid_tx_id"`
+	RefundBidTXID    null.String         `boiler:"refund_bid_tx_id" boil:"refund_bid_tx_id" json:"refund_bid_tx_id,omitempty" toml:"refund_bid_tx_id" yaml:"refund_bid_tx_id,omitempty"`
+	BidderID         string              `boiler:"bidder_id" boil:"bidder_id" json:"bidder_id" toml:"bidder_id" yaml:"bidder_id"`
+	BidAt            time.Time           `boiler:"bid_at" boil:"bid_at" json:"bid_at" toml:"bid_at" yaml:"bid_at"`
+	BidPrice         decimal.Decimal     `boiler:"bid_price" boil:"bid_price" json:"bid_price" toml:"bid_price" yaml:"bid_price"`
+	CancelledAt      null.Time           `boiler:"cancelled_at" boil:"cancelled_at" json:"cancelled_at,omitempty" toml:"cancelled_at" yaml:"cancelled_at,omitempty"`
+	CancelledReason  null.String         `boiler:"cancelled_reason" boil:"cancelled_reason" json:"cancelled_reason,omitempty" toml:"cancelled_reason" yaml:"cancelled_reason,omitempty"`
+	MaxBidPrice      decimal.NullDecimal `boiler:"max_bid_price" boil:"max_bid_price" json:"max_bid_price,omitempty" toml:"max_bid_price" yaml:"max_bid_price,omitempty"`
+	ExcessRefundTXID null.String         `boiler:"excess_refund_tx_id" boil:"excess_refund_tx_id" json:"excess_refund_tx_id,omitempty" toml:"excess_refund_tx_id" yaml:"excess_refund_tx_id,omitempty"`
 
 	R *itemSalesBidHistoryR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L itemSalesBidHistoryL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ItemSalesBidHistoryColumns = struct {
-	ItemSaleID      string
-	BidTXID         string
-	RefundBidTXID   string
-	BidderID        string
-	BidAt           string
-	BidPrice        string
-	CancelledAt     string
-	CancelledReason string
+	ItemSaleID       string
+	BidTXID          string
+	RefundBidTXID    string
+	BidderID         string
+	BidAt            string
+	BidPrice         string
+	CancelledAt      string
+	CancelledReason  string
+	MaxBidPrice      string
+	ExcessRefundTXID string
 }{
-	ItemSaleID:      "item_sale_id",
-	BidTXID:         "bid_tx_id",
-	RefundBidTXID:   "refund_bid_tx_id",
-	BidderID:        "bidder_id",
-	BidAt:           "bid_at",
-	BidPrice:        "bid_price",
-	CancelledAt:     "cancelled_at",
-	CancelledReason: "cancelled_reason",
+	ItemSaleID:       "item_sale_id",
+	BidTXID:          "bid_tx_id",
+	RefundBidTXID:    "refund_bid_tx_id",
+	BidderID:         "bidder_id",
+	BidAt:            "bid_at",
+	BidPrice:         "bid_price",
+	CancelledAt:      "cancelled_at",
+	CancelledReason:  "cancelled_reason",
+	MaxBidPrice:      "max_bid_price",
+	ExcessRefundTXID: "excess_refund_tx_id",
 }
 
 var ItemSalesBidHistoryTableColumns = struct {
-	ItemSaleID      string
-	BidTXID         string
-	RefundBidTXID   string
-	BidderID        string
-	BidAt           string
-	BidPrice        string
-	CancelledAt     string
-	CancelledReason string
+	ItemSaleID       string
+	BidTXID          string
+	RefundBidTXID    string
+	BidderID         string
+	BidAt            string
+	BidPrice         string
+	CancelledAt      string
+	CancelledReason  string
+	MaxBidPrice      string
+	ExcessRefundTXID string
 }{
-	ItemSaleID:      "item_sales_bid_history.item_sale_id",
-	BidTXID:         "item_sales_bid_history.bid_tx_id",
-	RefundBidTXID:   "item_sales_bid_history.refund_bid_tx_id",
-	BidderID:        "item_sales_bid_history.bidder_id",
-	BidAt:           "item_sales_bid_history.bid_at",
-	BidPrice:        "item_sales_bid_history.bid_price",
-	CancelledAt:     "item_sales_bid_history.cancelled_at",
-	CancelledReason: "item_sales_bid_history.cancelled_reason",
+	ItemSaleID:       "item_sales_bid_history.item_sale_id",
+	BidTXID:          "item_sales_bid_history.bid_tx_id",
+	RefundBidTXID:    "item_sales_bid_history.refund_bid_tx_id",
+	BidderID:         "item_sales_bid_history.bidder_id",
+	BidAt:            "item_sales_bid_history.bid_at",
+	BidPrice:         "item_sales_bid_history.bid_price",
+	CancelledAt:      "item_sales_bid_history.cancelled_at",
+	CancelledReason:  "item_sales_bid_history.cancelled_reason",
+	MaxBidPrice:      "item_sales_bid_history.max_bid_price",
+	ExcessRefundTXID: "item_sales_bid_history.excess_refund_tx_id",
 }
 
 // Generated where
 
 var ItemSalesBidHistoryWhere = struct {
-	ItemSaleID      whereHelperstring
-	BidTXID         whereHelperstring
-	RefundBidTXID   whereHelpernull_String
-	BidderID        whereHelperstring
-	BidAt           whereHelpertime_Time
-	BidPrice        whereHelperdecimal_Decimal
-	CancelledAt     whereHelpernull_Time
-	CancelledReason whereHelpernull_String
+	ItemSaleID       whereHelperstring
+	BidTXID          whereHelperstring
+	RefundBidTXID    whereHelpernull_String
+	BidderID         whereHelperstring
+	BidAt            whereHelpertime_Time
+	BidPrice         whereHelperdecimal_Decimal
+	CancelledAt      whereHelpernull_Time
+	CancelledReason  whereHelpernull_String
+	MaxBidPrice      whereHelperdecimal_NullDecimal
+	ExcessRefundTXID whereHelpernull_String
 }{
-	ItemSaleID:      whereHelperstring{field: "\"item_sales_bid_history\".\"item_sale_id\""},
-	BidTXID:         whereHelperstring{field: "\"item_sales_bid_history\".\"bid_tx_id\""},
-	RefundBidTXID:   whereHelpernull_String{field: "\"item_sales_bid_history\".\"refund_bid_tx_id\""},
-	BidderID:        whereHelperstring{field: "\"item_sales_bid_history\".\"bidder_id\""},
-	BidAt:           whereHelpertime_Time{field: "\"item_sales_bid_history\".\"bid_at\""},
-	BidPrice:        whereHelperdecimal_Decimal{field: "\"item_sales_bid_history\".\"bid_price\""},
-	CancelledAt:     whereHelpernull_Time{field: "\"item_sales_bid_history\".\"cancelled_at\""},
-	CancelledReason: whereHelpernull_String{field: "\"item_sales_bid_history\".\"cancelled_reason\""},
+	ItemSaleID:       whereHelperstring{field: "\"item_sales_bid_history\".\"item_sale_id\""},
+	BidTXID:          whereHelperstring{field: "\"item_sales_bid_history\".\"bid_tx_id\""},
+	RefundBidTXID:    whereHelpernull_String{field: "\"item_sales_bid_history\".\"refund_bid_tx_id\""},
+	BidderID:         whereHelperstring{field: "\"item_sales_bid_history\".\"bidder_id\""},
+	BidAt:            whereHelpertime_Time{field: "\"item_sales_bid_history\".\"bid_at\""},
+	BidPrice:         whereHelperdecimal_Decimal{field: "\"item_sales_bid_history\".\"bid_price\""},
+	CancelledAt:      whereHelpernull_Time{field: "\"item_sales_bid_history\".\"cancelled_at\""},
+	CancelledReason:  whereHelpernull_String{field: "\"item_sales_bid_history\".\"cancelled_reason\""},
+	MaxBidPrice:      whereHelperdecimal_NullDecimal{field: "\"item_sales_bid_history\".\"max_bid_price\""},
+	ExcessRefundTXID: whereHelpernull_String{field: "\"item_sales_bid_history\".\"excess_refund_tx_id\""},
 }
 
 // ItemSalesBidHistoryRels is where relationship names are stored.
@@ -123,9 +137,9 @@ func (*itemSalesBidHistoryR) NewStruct() *itemSalesBidHistoryR {
 type itemSalesBidHistoryL struct{}
 
 var (
-	itemSalesBidHistoryAllColumns            = []string{"item_sale_id", "bid_tx_id", "refund_bid_tx_id", "bidder_id", "bid_at", "bid_price", "cancelled_at", "cancelled_reason"}
+	itemSalesBidHistoryAllColumns            = []string{"item_sale_id", "bid_tx_id", "refund_bid_tx_id", "bidder_id", "bid_at", "bid_price", "cancelled_at", "cancelled_reason", "max_bid_price", "excess_refund_tx_id"}
 	itemSalesBidHistoryColumnsWithoutDefault = []string{"item_sale_id", "bid_tx_id", "bidder_id", "bid_price"}
-	itemSalesBidHistoryColumnsWithDefault    = []string{"refund_bid_tx_id", "bid_at", "cancelled_at", "cancelled_reason"}
+	itemSalesBidHistoryColumnsWithDefault    = []string{"refund_bid_tx_id", "bid_at", "cancelled_at", "cancelled_reason", "max_bid_price", "excess_refund_tx_id"}
 	itemSalesBidHistoryPrimaryKeyColumns     = []string{"item_sale_id", "bidder_id", "bid_at"}
 	itemSalesBidHistoryGeneratedColumns      = []string{}
 )
@@ -30,6 +30,7 @@ type MarketplaceEvent struct {
 	Amount                   decimal.NullDecimal `boiler:"amount" boil:"amount" json:"amount,omitempty" toml:"amount" yaml:"amount,omitempty"`
 	RelatedSaleItemID        null.String         `boiler:"related_sale_item_id" boil:"related_sale_item_id" json:"related_sale_item_id,omitempty" toml:"related_sale_item_id" yaml:"related_sale_item_id,omitempty"`
 	RelatedSaleItemKeycardID null.String         `boiler:"related_sale_item_keycard_id" boil:"related_sale_item_keycard_id" json:"related_sale_item_keycard_id,omitempty" toml:"related_sale_item_keycard_id" yaml:"related_sale_item_keycard_id,omitempty"`
+	RelatedItemOfferID       null.String         `boiler:"related_item_offer_id" boil:"related_item_offer_id" json:"related_item_offer_id,omitempty" toml:"related_item_offer_id" yaml:"related_item_offer_id,omitempty"`
 	CreatedAt                time.Time           `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 
 	R *marketplaceEventR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -43,6 +44,7 @@ var MarketplaceEventColumns = struct {
 	Amount                   string
 	RelatedSaleItemID        string
 	RelatedSaleItemKeycardID string
+	RelatedItemOfferID       string
 	CreatedAt                string
 }{
 	ID:                       "id",
@@ -51,6 +53,7 @@ var MarketplaceEventColumns = struct {
 	Amount:                   "amount",
 	RelatedSaleItemID:        "related_sale_item_id",
 	RelatedSaleItemKeycardID: "related_sale_item_keycard_id",
+	RelatedItemOfferID:       "related_item_offer_id",
 	CreatedAt:                "created_at",
 }
 
@@ -61,6 +64,7 @@ var MarketplaceEventTableColumns = struct {
 	Amount                   string
 	RelatedSaleItemID        string
 	RelatedSaleItemKeycardID string
+	RelatedItemOfferID       string
 	CreatedAt                string
 }{
 	ID:                       "marketplace_events.id",
@@ -69,6 +73,7 @@ var MarketplaceEventTableColumns = struct {
 	Amount:                   "marketplace_events.amount",
 	RelatedSaleItemID:        "marketplace_events.related_sale_item_id",
 	RelatedSaleItemKeycardID: "marketplace_events.related_sale_item_keycard_id",
+	RelatedItemOfferID:       "marketplace_events.related_item_offer_id",
 	CreatedAt:                "marketplace_events.created_at",
 }
 
@@ -81,6 +86,7 @@ var MarketplaceEventWhere = struct {
 	Amount                   whereHelperdecimal_NullDecimal
 	RelatedSaleItemID        whereHelpernull_String
 	RelatedSaleItemKeycardID whereHelpernull_String
+	RelatedItemOfferID       whereHelpernull_String
 	CreatedAt                whereHelpertime_Time
 }{
 	ID:                       whereHelperstring{field: "\"marketplace_events\".\"id\""},
@@ -89,6 +95,7 @@ var MarketplaceEventWhere = struct {
 	Amount:                   whereHelperdecimal_NullDecimal{field: "\"marketplace_events\".\"amount\""},
 	RelatedSaleItemID:        whereHelpernull_String{field: "\"marketplace_events\".\"related_sale_item_id\""},
 	RelatedSaleItemKeycardID: whereHelpernull_String{field: "\"marketplace_events\".\"related_sale_item_keycard_id\""},
+	RelatedItemOfferID:       whereHelpernull_String{field: "\"marketplace_events\".\"related_item_offer_id\""},
 	CreatedAt:                whereHelpertime_Time{field: "\"marketplace_events\".\"created_at\""},
 }
 
@@ -119,9 +126,9 @@ func (*marketplaceEventR) NewStruct() *marketplaceEventR {
 type marketplaceEventL struct{}
 
 var (
-	marketplaceEventAllColumns            = []string{"id", "user_id", "event_type", "amount", "related_sale_item_id", "related_sale_item_keycard_id", "created_at"}
+	marketplaceEventAllColumns            = []string{"id", "user_id", "event_type", "amount", "related_sale_item_id", "related_sale_item_keycard_id", "created_at", "related_item_offer_id"}
 	marketplaceEventColumnsWithoutDefault = []string{"user_id", "event_type"}
-	marketplaceEventColumnsWithDefault    = []string{"id", "amount", "related_sale_item_id", "related_sale_item_keycard_id", "created_at"}
+	marketplaceEventColumnsWithDefault    = []string{"id", "amount", "related_sale_item_id", "related_sale_item_keycard_id", "created_at", "related_item_offer_id"}
 	marketplaceEventPrimaryKeyColumns     = []string{"id"}
 	marketplaceEventGeneratedColumns      = []string{}
 )
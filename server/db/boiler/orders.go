@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -22,86 +23,93 @@ import (
 
 // Order is an object representing the database table.
 type Order struct {
-	ID            string    `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
-	OrderNumber   int64     `boiler:"order_number" boil:"order_number" json:"order_number" toml:"order_number" yaml:"order_number"`
-	UserID        string    `boiler:"user_id" boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	OrderStatus   string    `boiler:"order_status" boil:"order_status" json:"order_status" toml:"order_status" yaml:"order_status"`
-	PaymentMethod string    `boiler:"payment_method" boil:"payment_method" json:"payment_method" toml:"payment_method" yaml:"payment_method"`
-	TXNReference  string    `boiler:"txn_reference" boil:"txn_reference" json:"txn_reference" toml:"txn_reference" yaml:"txn_reference"`
-	Currency      string    `boiler:"currency" boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
-	CreatedAt     time.Time `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt     time.Time `boiler:"updated_at" boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID                    string      `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	OrderNumber           int64       `boiler:"order_number" boil:"order_number" json:"order_number" toml:"order_number" yaml:"order_number"`
+	UserID                string      `boiler:"user_id" boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	OrderStatus           string      `boiler:"order_status" boil:"order_status" json:"order_status" toml:"order_status" yaml:"order_status"`
+	PaymentMethod         string      `boiler:"payment_method" boil:"payment_method" json:"payment_method" toml:"payment_method" yaml:"payment_method"`
+	TXNReference          string      `boiler:"txn_reference" boil:"txn_reference" json:"txn_reference" toml:"txn_reference" yaml:"txn_reference"`
+	Currency              string      `boiler:"currency" boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	CreatedAt             time.Time   `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt             time.Time   `boiler:"updated_at" boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	StripePaymentIntentID null.String `boiler:"stripe_payment_intent_id" boil:"stripe_payment_intent_id" json:"stripe_payment_intent_id,omitempty" toml:"stripe_payment_intent_id" yaml:"stripe_payment_intent_id,omitempty"`
 
 	R *orderR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L orderL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var OrderColumns = struct {
-	ID            string
-	OrderNumber   string
-	UserID        string
-	OrderStatus   string
-	PaymentMethod string
-	TXNReference  string
-	Currency      string
-	CreatedAt     string
-	UpdatedAt     string
+	ID                    string
+	OrderNumber           string
+	UserID                string
+	OrderStatus           string
+	PaymentMethod         string
+	TXNReference          string
+	Currency              string
+	CreatedAt             string
+	UpdatedAt             string
+	StripePaymentIntentID string
 }{
-	ID:            "id",
-	OrderNumber:   "order_number",
-	UserID:        "user_id",
-	OrderStatus:   "order_status",
-	PaymentMethod: "payment_method",
-	TXNReference:  "txn_reference",
-	Currency:      "currency",
-	CreatedAt:     "created_at",
-	UpdatedAt:     "updated_at",
+	ID:                    "id",
+	OrderNumber:           "order_number",
+	UserID:                "user_id",
+	OrderStatus:           "order_status",
+	PaymentMethod:         "payment_method",
+	TXNReference:          "txn_reference",
+	Currency:              "currency",
+	CreatedAt:             "created_at",
+	UpdatedAt:             "updated_at",
+	StripePaymentIntentID: "stripe_payment_intent_id",
 }
 
 var OrderTableColumns = struct {
-	ID            string
-	OrderNumber   string
-	UserID        string
-	OrderStatus   string
-	PaymentMethod string
-	TXNReference  string
-	Currency      string
-	CreatedAt     string
-	UpdatedAt     string
+	ID                    string
+	OrderNumber           string
+	UserID                string
+	OrderStatus           string
+	PaymentMethod         string
+	TXNReference          string
+	Currency              string
+	CreatedAt             string
+	UpdatedAt             string
+	StripePaymentIntentID string
 }{
-	ID:            "orders.id",
-	OrderNumber:   "orders.order_number",
-	UserID:        "orders.user_id",
-	OrderStatus:   "orders.order_status",
-	PaymentMethod: "orders.payment_method",
-	TXNReference:  "orders.txn_reference",
-	Currency:      "orders.currency",
-	CreatedAt:     "orders.created_at",
-	UpdatedAt:     "orders.updated_at",
+	ID:                    "orders.id",
+	OrderNumber:           "orders.order_number",
+	UserID:                "orders.user_id",
+	OrderStatus:           "orders.order_status",
+	PaymentMethod:         "orders.payment_method",
+	TXNReference:          "orders.txn_reference",
+	Currency:              "orders.currency",
+	CreatedAt:             "orders.created_at",
+	UpdatedAt:             "orders.updated_at",
+	StripePaymentIntentID: "orders.stripe_payment_intent_id",
 }
 
 // Generated where
 
 var OrderWhere = struct {
-	ID            whereHelperstring
-	OrderNumber   whereHelperint64
-	UserID        whereHelperstring
-	OrderStatus   whereHelperstring
-	PaymentMethod whereHelperstring
-	TXNReference  whereHelperstring
-	Currency      whereHelperstring
-	CreatedAt     whereHelpertime_Time
-	UpdatedAt     whereHelpertime_Time
+	ID                    whereHelperstring
+	OrderNumber           whereHelperint64
+	UserID                whereHelperstring
+	OrderStatus           whereHelperstring
+	PaymentMethod         whereHelperstring
+	TXNReference          whereHelperstring
+	Currency              whereHelperstring
+	CreatedAt             whereHelpertime_Time
+	UpdatedAt             whereHelpertime_Time
+	StripePaymentIntentID whereHelpernull_String
 }{
-	ID:            whereHelperstring{field: "\"orders\".\"id\""},
-	OrderNumber:   whereHelperint64{field: "\"orders\".\"order_number\""},
-	UserID:        whereHelperstring{field: "\"orders\".\"user_id\""},
-	OrderStatus:   whereHelperstring{field: "\"orders\".\"order_status\""},
-	PaymentMethod: whereHelperstring{field: "\"orders\".\"payment_method\""},
-	TXNReference:  whereHelperstring{field: "\"orders\".\"txn_reference\""},
-	Currency:      whereHelperstring{field: "\"orders\".\"currency\""},
-	CreatedAt:     whereHelpertime_Time{field: "\"orders\".\"created_at\""},
-	UpdatedAt:     whereHelpertime_Time{field: "\"orders\".\"updated_at\""},
+	ID:                    whereHelperstring{field: "\"orders\".\"id\""},
+	OrderNumber:           whereHelperint64{field: "\"orders\".\"order_number\""},
+	UserID:                whereHelperstring{field: "\"orders\".\"user_id\""},
+	OrderStatus:           whereHelperstring{field: "\"orders\".\"order_status\""},
+	PaymentMethod:         whereHelperstring{field: "\"orders\".\"payment_method\""},
+	TXNReference:          whereHelperstring{field: "\"orders\".\"txn_reference\""},
+	Currency:              whereHelperstring{field: "\"orders\".\"currency\""},
+	CreatedAt:             whereHelpertime_Time{field: "\"orders\".\"created_at\""},
+	UpdatedAt:             whereHelpertime_Time{field: "\"orders\".\"updated_at\""},
+	StripePaymentIntentID: whereHelpernull_String{field: "\"orders\".\"stripe_payment_intent_id\""},
 }
 
 // OrderRels is where relationship names are stored.
@@ -128,9 +136,9 @@ func (*orderR) NewStruct() *orderR {
 type orderL struct{}
 
 var (
-	orderAllColumns            = []string{"id", "order_number", "user_id", "order_status", "payment_method", "txn_reference", "currency", "created_at", "updated_at"}
+	orderAllColumns            = []string{"id", "order_number", "user_id", "order_status", "payment_method", "txn_reference", "currency", "created_at", "updated_at", "stripe_payment_intent_id"}
 	orderColumnsWithoutDefault = []string{"user_id", "payment_method", "txn_reference"}
-	orderColumnsWithDefault    = []string{"id", "order_number", "order_status", "currency", "created_at", "updated_at"}
+	orderColumnsWithDefault    = []string{"id", "order_number", "order_status", "currency", "created_at", "updated_at", "stripe_payment_intent_id"}
 	orderPrimaryKeyColumns     = []string{"id"}
 	orderGeneratedColumns      = []string{}
 )
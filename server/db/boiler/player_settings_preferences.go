@@ -23,30 +23,36 @@ import (
 
 // PlayerSettingsPreference is an object representing the database table.
 type PlayerSettingsPreference struct {
-	ID                          string      `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
-	PlayerID                    string      `boiler:"player_id" boil:"player_id" json:"player_id" toml:"player_id" yaml:"player_id"`
-	Shortcode                   string      `boiler:"shortcode" boil:"shortcode" json:"shortcode" toml:"shortcode" yaml:"shortcode"`
-	EnableTelegramNotifications bool        `boiler:"enable_telegram_notifications" boil:"enable_telegram_notifications" json:"enable_telegram_notifications" toml:"enable_telegram_notifications" yaml:"enable_telegram_notifications"`
-	EnableSMSNotifications      bool        `boiler:"enable_sms_notifications" boil:"enable_sms_notifications" json:"enable_sms_notifications" toml:"enable_sms_notifications" yaml:"enable_sms_notifications"`
-	EnablePushNotifications     bool        `boiler:"enable_push_notifications" boil:"enable_push_notifications" json:"enable_push_notifications" toml:"enable_push_notifications" yaml:"enable_push_notifications"`
-	TelegramID                  null.Int64  `boiler:"telegram_id" boil:"telegram_id" json:"telegram_id,omitempty" toml:"telegram_id" yaml:"telegram_id,omitempty"`
-	MobileNumber                null.String `boiler:"mobile_number" boil:"mobile_number" json:"mobile_number,omitempty" toml:"mobile_number" yaml:"mobile_number,omitempty"`
-	CreatedAt                   time.Time   `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ID                                          string      `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	PlayerID                                    string      `boiler:"player_id" boil:"player_id" json:"player_id" toml:"player_id" yaml:"player_id"`
+	Shortcode                                   string      `boiler:"shortcode" boil:"shortcode" json:"shortcode" toml:"shortcode" yaml:"shortcode"`
+	EnableTelegramNotifications                 bool        `boiler:"enable_telegram_notifications" boil:"enable_telegram_notifications" json:"enable_telegram_notifications" toml:"enable_telegram_notifications" yaml:"enable_telegram_notifications"`
+	EnableSMSNotifications                      bool        `boiler:"enable_sms_notifications" boil:"enable_sms_notifications" json:"enable_sms_notifications" toml:"enable_sms_notifications" yaml:"enable_sms_notifications"`
+	EnablePushNotifications                     bool        `boiler:"enable_push_notifications" boil:"enable_push_notifications" json:"enable_push_notifications" toml:"enable_push_notifications" yaml:"enable_push_notifications"`
+	TelegramID                                  null.Int64  `boiler:"telegram_id" boil:"telegram_id" json:"telegram_id,omitempty" toml:"telegram_id" yaml:"telegram_id,omitempty"`
+	MobileNumber                                null.String `boiler:"mobile_number" boil:"mobile_number" json:"mobile_number,omitempty" toml:"mobile_number" yaml:"mobile_number,omitempty"`
+	CreatedAt                                   time.Time   `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	EnableMarketplaceSystemMessageNotifications bool        `boiler:"enable_marketplace_system_message_notifications" boil:"enable_marketplace_system_message_notifications" json:"enable_marketplace_system_message_notifications" toml:"enable_marketplace_system_message_notifications" yaml:"enable_marketplace_system_message_notifications"`
+	EnableMarketplaceTelegramNotifications      bool        `boiler:"enable_marketplace_telegram_notifications" boil:"enable_marketplace_telegram_notifications" json:"enable_marketplace_telegram_notifications" toml:"enable_marketplace_telegram_notifications" yaml:"enable_marketplace_telegram_notifications"`
+	EnableMarketplaceWSNotifications            bool        `boiler:"enable_marketplace_ws_notifications" boil:"enable_marketplace_ws_notifications" json:"enable_marketplace_ws_notifications" toml:"enable_marketplace_ws_notifications" yaml:"enable_marketplace_ws_notifications"`
 
 	R *playerSettingsPreferenceR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L playerSettingsPreferenceL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var PlayerSettingsPreferenceColumns = struct {
-	ID                          string
-	PlayerID                    string
-	Shortcode                   string
-	EnableTelegramNotifications string
-	EnableSMSNotifications      string
-	EnablePushNotifications     string
-	TelegramID                  string
-	MobileNumber                string
-	CreatedAt                   string
+	ID                                          string
+	PlayerID                                    string
+	Shortcode                                   string
+	EnableTelegramNotifications                 string
+	EnableSMSNotifications                      string
+	EnablePushNotifications                     string
+	TelegramID                                  string
+	MobileNumber                                string
+	CreatedAt                                   string
+	EnableMarketplaceSystemMessageNotifications string
+	EnableMarketplaceTelegramNotifications      string
+	EnableMarketplaceWSNotifications            string
 }{
 	ID:                          "id",
 	PlayerID:                    "player_id",
@@ -57,18 +63,24 @@ var PlayerSettingsPreferenceColumns = struct {
 	TelegramID:                  "telegram_id",
 	MobileNumber:                "mobile_number",
 	CreatedAt:                   "created_at",
+	EnableMarketplaceSystemMessageNotifications: "enable_marketplace_system_message_notifications",
+	EnableMarketplaceTelegramNotifications:      "enable_marketplace_telegram_notifications",
+	EnableMarketplaceWSNotifications:            "enable_marketplace_ws_notifications",
 }
 
 var PlayerSettingsPreferenceTableColumns = struct {
-	ID                          string
-	PlayerID                    string
-	Shortcode                   string
-	EnableTelegramNotifications string
-	EnableSMSNotifications      string
-	EnablePushNotifications     string
-	TelegramID                  string
-	MobileNumber                string
-	CreatedAt                   string
+	ID                                          string
+	PlayerID                                    string
+	Shortcode                                   string
+	EnableTelegramNotifications                 string
+	EnableSMSNotifications                      string
+	EnablePushNotifications                     string
+	TelegramID                                  string
+	MobileNumber                                string
+	CreatedAt                                   string
+	EnableMarketplaceSystemMessageNotifications string
+	EnableMarketplaceTelegramNotifications      string
+	EnableMarketplaceWSNotifications            string
 }{
 	ID:                          "player_settings_preferences.id",
 	PlayerID:                    "player_settings_preferences.player_id",
@@ -79,20 +91,26 @@ var PlayerSettingsPreferenceTableColumns = struct {
 	TelegramID:                  "player_settings_preferences.telegram_id",
 	MobileNumber:                "player_settings_preferences.mobile_number",
 	CreatedAt:                   "player_settings_preferences.created_at",
+	EnableMarketplaceSystemMessageNotifications: "player_settings_preferences.enable_marketplace_system_message_notifications",
+	EnableMarketplaceTelegramNotifications:      "player_settings_preferences.enable_marketplace_telegram_notifications",
+	EnableMarketplaceWSNotifications:            "player_settings_preferences.enable_marketplace_ws_notifications",
 }
 
 // Generated where
 
 var PlayerSettingsPreferenceWhere = struct {
-	ID                          whereHelperstring
-	PlayerID                    whereHelperstring
-	Shortcode                   whereHelperstring
-	EnableTelegramNotifications whereHelperbool
-	EnableSMSNotifications      whereHelperbool
-	EnablePushNotifications     whereHelperbool
-	TelegramID                  whereHelpernull_Int64
-	MobileNumber                whereHelpernull_String
-	CreatedAt                   whereHelpertime_Time
+	ID                                          whereHelperstring
+	PlayerID                                    whereHelperstring
+	Shortcode                                   whereHelperstring
+	EnableTelegramNotifications                 whereHelperbool
+	EnableSMSNotifications                      whereHelperbool
+	EnablePushNotifications                     whereHelperbool
+	TelegramID                                  whereHelpernull_Int64
+	MobileNumber                                whereHelpernull_String
+	CreatedAt                                   whereHelpertime_Time
+	EnableMarketplaceSystemMessageNotifications whereHelperbool
+	EnableMarketplaceTelegramNotifications      whereHelperbool
+	EnableMarketplaceWSNotifications            whereHelperbool
 }{
 	ID:                          whereHelperstring{field: "\"player_settings_preferences\".\"id\""},
 	PlayerID:                    whereHelperstring{field: "\"player_settings_preferences\".\"player_id\""},
@@ -103,6 +121,9 @@ var PlayerSettingsPreferenceWhere = struct {
 	TelegramID:                  whereHelpernull_Int64{field: "\"player_settings_preferences\".\"telegram_id\""},
 	MobileNumber:                whereHelpernull_String{field: "\"player_settings_preferences\".\"mobile_number\""},
 	CreatedAt:                   whereHelpertime_Time{field: "\"player_settings_preferences\".\"created_at\""},
+	EnableMarketplaceSystemMessageNotifications: whereHelperbool{field: "\"player_settings_preferences\".\"enable_marketplace_system_message_notifications\""},
+	EnableMarketplaceTelegramNotifications:      whereHelperbool{field: "\"player_settings_preferences\".\"enable_marketplace_telegram_notifications\""},
+	EnableMarketplaceWSNotifications:            whereHelperbool{field: "\"player_settings_preferences\".\"enable_marketplace_ws_notifications\""},
 }
 
 // PlayerSettingsPreferenceRels is where relationship names are stored.
@@ -126,9 +147,9 @@ func (*playerSettingsPreferenceR) NewStruct() *playerSettingsPreferenceR {
 type playerSettingsPreferenceL struct{}
 
 var (
-	playerSettingsPreferenceAllColumns            = []string{"id", "player_id", "shortcode", "enable_telegram_notifications", "enable_sms_notifications", "enable_push_notifications", "telegram_id", "mobile_number", "created_at"}
+	playerSettingsPreferenceAllColumns            = []string{"id", "player_id", "shortcode", "enable_telegram_notifications", "enable_sms_notifications", "enable_push_notifications", "telegram_id", "mobile_number", "created_at", "enable_marketplace_system_message_notifications", "enable_marketplace_telegram_notifications", "enable_marketplace_ws_notifications"}
 	playerSettingsPreferenceColumnsWithoutDefault = []string{"player_id", "shortcode"}
-	playerSettingsPreferenceColumnsWithDefault    = []string{"id", "enable_telegram_notifications", "enable_sms_notifications", "enable_push_notifications", "telegram_id", "mobile_number", "created_at"}
+	playerSettingsPreferenceColumnsWithDefault    = []string{"id", "enable_telegram_notifications", "enable_sms_notifications", "enable_push_notifications", "telegram_id", "mobile_number", "created_at", "enable_marketplace_system_message_notifications", "enable_marketplace_telegram_notifications", "enable_marketplace_ws_notifications"}
 	playerSettingsPreferencePrimaryKeyColumns     = []string{"id"}
 	playerSettingsPreferenceGeneratedColumns      = []string{}
 )
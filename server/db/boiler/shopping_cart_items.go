@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -22,65 +23,79 @@ import (
 
 // ShoppingCartItem is an object representing the database table.
 type ShoppingCartItem struct {
-	ID             string    `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
-	ShoppingCartID string    `boiler:"shopping_cart_id" boil:"shopping_cart_id" json:"shopping_cart_id" toml:"shopping_cart_id" yaml:"shopping_cart_id"`
-	ProductID      string    `boiler:"product_id" boil:"product_id" json:"product_id" toml:"product_id" yaml:"product_id"`
-	Quantity       int       `boiler:"quantity" boil:"quantity" json:"quantity" toml:"quantity" yaml:"quantity"`
-	CreatedAt      time.Time `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt      time.Time `boiler:"updated_at" boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID              string      `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	ShoppingCartID  string      `boiler:"shopping_cart_id" boil:"shopping_cart_id" json:"shopping_cart_id" toml:"shopping_cart_id" yaml:"shopping_cart_id"`
+	ProductID       string      `boiler:"product_id" boil:"product_id" json:"product_id" toml:"product_id" yaml:"product_id"`
+	Quantity        int         `boiler:"quantity" boil:"quantity" json:"quantity" toml:"quantity" yaml:"quantity"`
+	CreatedAt       time.Time   `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       time.Time   `boiler:"updated_at" boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	GiftRecipientID null.String `boiler:"gift_recipient_id" boil:"gift_recipient_id" json:"gift_recipient_id,omitempty" toml:"gift_recipient_id" yaml:"gift_recipient_id,omitempty"`
+	GiftCode        bool        `boiler:"gift_code" boil:"gift_code" json:"gift_code" toml:"gift_code" yaml:"gift_code"`
 
 	R *shoppingCartItemR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L shoppingCartItemL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ShoppingCartItemColumns = struct {
-	ID             string
-	ShoppingCartID string
-	ProductID      string
-	Quantity       string
-	CreatedAt      string
-	UpdatedAt      string
+	ID              string
+	ShoppingCartID  string
+	ProductID       string
+	Quantity        string
+	CreatedAt       string
+	UpdatedAt       string
+	GiftRecipientID string
+	GiftCode        string
 }{
-	ID:             "id",
-	ShoppingCartID: "shopping_cart_id",
-	ProductID:      "product_id",
-	Quantity:       "quantity",
-	CreatedAt:      "created_at",
-	UpdatedAt:      "updated_at",
+	ID:              "id",
+	ShoppingCartID:  "shopping_cart_id",
+	ProductID:       "product_id",
+	Quantity:        "quantity",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+	GiftRecipientID: "gift_recipient_id",
+	GiftCode:        "gift_code",
 }
 
 var ShoppingCartItemTableColumns = struct {
-	ID             string
-	ShoppingCartID string
-	ProductID      string
-	Quantity       string
-	CreatedAt      string
-	UpdatedAt      string
+	ID              string
+	ShoppingCartID  string
+	ProductID       string
+	Quantity        string
+	CreatedAt       string
+	UpdatedAt       string
+	GiftRecipientID string
+	GiftCode        string
 }{
-	ID:             "shopping_cart_items.id",
-	ShoppingCartID: "shopping_cart_items.shopping_cart_id",
-	ProductID:      "shopping_cart_items.product_id",
-	Quantity:       "shopping_cart_items.quantity",
-	CreatedAt:      "shopping_cart_items.created_at",
-	UpdatedAt:      "shopping_cart_items.updated_at",
+	ID:              "shopping_cart_items.id",
+	ShoppingCartID:  "shopping_cart_items.shopping_cart_id",
+	ProductID:       "shopping_cart_items.product_id",
+	Quantity:        "shopping_cart_items.quantity",
+	CreatedAt:       "shopping_cart_items.created_at",
+	UpdatedAt:       "shopping_cart_items.updated_at",
+	GiftRecipientID: "shopping_cart_items.gift_recipient_id",
+	GiftCode:        "shopping_cart_items.gift_code",
 }
 
 // Generated where
 
 var ShoppingCartItemWhere = struct {
-	ID             whereHelperstring
-	ShoppingCartID whereHelperstring
-	ProductID      whereHelperstring
-	Quantity       whereHelperint
-	CreatedAt      whereHelpertime_Time
-	UpdatedAt      whereHelpertime_Time
+	ID              whereHelperstring
+	ShoppingCartID  whereHelperstring
+	ProductID       whereHelperstring
+	Quantity        whereHelperint
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpertime_Time
+	GiftRecipientID whereHelpernull_String
+	GiftCode        whereHelperbool
 }{
-	ID:             whereHelperstring{field: "\"shopping_cart_items\".\"id\""},
-	ShoppingCartID: whereHelperstring{field: "\"shopping_cart_items\".\"shopping_cart_id\""},
-	ProductID:      whereHelperstring{field: "\"shopping_cart_items\".\"product_id\""},
-	Quantity:       whereHelperint{field: "\"shopping_cart_items\".\"quantity\""},
-	CreatedAt:      whereHelpertime_Time{field: "\"shopping_cart_items\".\"created_at\""},
-	UpdatedAt:      whereHelpertime_Time{field: "\"shopping_cart_items\".\"updated_at\""},
+	ID:              whereHelperstring{field: "\"shopping_cart_items\".\"id\""},
+	ShoppingCartID:  whereHelperstring{field: "\"shopping_cart_items\".\"shopping_cart_id\""},
+	ProductID:       whereHelperstring{field: "\"shopping_cart_items\".\"product_id\""},
+	Quantity:        whereHelperint{field: "\"shopping_cart_items\".\"quantity\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"shopping_cart_items\".\"created_at\""},
+	UpdatedAt:       whereHelpertime_Time{field: "\"shopping_cart_items\".\"updated_at\""},
+	GiftRecipientID: whereHelpernull_String{field: "\"shopping_cart_items\".\"gift_recipient_id\""},
+	GiftCode:        whereHelperbool{field: "\"shopping_cart_items\".\"gift_code\""},
 }
 
 // ShoppingCartItemRels is where relationship names are stored.
@@ -107,9 +122,9 @@ func (*shoppingCartItemR) NewStruct() *shoppingCartItemR {
 type shoppingCartItemL struct{}
 
 var (
-	shoppingCartItemAllColumns            = []string{"id", "shopping_cart_id", "product_id", "quantity", "created_at", "updated_at"}
+	shoppingCartItemAllColumns            = []string{"id", "shopping_cart_id", "product_id", "quantity", "created_at", "updated_at", "gift_recipient_id", "gift_code"}
 	shoppingCartItemColumnsWithoutDefault = []string{"shopping_cart_id", "product_id"}
-	shoppingCartItemColumnsWithDefault    = []string{"id", "quantity", "created_at", "updated_at"}
+	shoppingCartItemColumnsWithDefault    = []string{"id", "quantity", "created_at", "updated_at", "gift_recipient_id", "gift_code"}
 	shoppingCartItemPrimaryKeyColumns     = []string{"id"}
 	shoppingCartItemGeneratedColumns      = []string{}
 )
@@ -23,86 +23,93 @@ import (
 
 // SystemMessage is an object representing the database table.
 type SystemMessage struct {
-	ID       string      `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
-	PlayerID string      `boiler:"player_id" boil:"player_id" json:"player_id" toml:"player_id" yaml:"player_id"`
-	Message  string      `boiler:"message" boil:"message" json:"message" toml:"message" yaml:"message"`
-	Data     null.JSON   `boiler:"data" boil:"data" json:"data,omitempty" toml:"data" yaml:"data,omitempty"`
-	SentAt   time.Time   `boiler:"sent_at" boil:"sent_at" json:"sent_at" toml:"sent_at" yaml:"sent_at"`
-	SenderID string      `boiler:"sender_id" boil:"sender_id" json:"sender_id" toml:"sender_id" yaml:"sender_id"`
-	Title    string      `boiler:"title" boil:"title" json:"title" toml:"title" yaml:"title"`
-	DataType null.String `boiler:"data_type" boil:"data_type" json:"data_type,omitempty" toml:"data_type" yaml:"data_type,omitempty"`
-	ReadAt   null.Time   `boiler:"read_at" boil:"read_at" json:"read_at,omitempty" toml:"read_at" yaml:"read_at,omitempty"`
+	ID        string      `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	PlayerID  string      `boiler:"player_id" boil:"player_id" json:"player_id" toml:"player_id" yaml:"player_id"`
+	Message   string      `boiler:"message" boil:"message" json:"message" toml:"message" yaml:"message"`
+	Data      null.JSON   `boiler:"data" boil:"data" json:"data,omitempty" toml:"data" yaml:"data,omitempty"`
+	SentAt    time.Time   `boiler:"sent_at" boil:"sent_at" json:"sent_at" toml:"sent_at" yaml:"sent_at"`
+	SenderID  string      `boiler:"sender_id" boil:"sender_id" json:"sender_id" toml:"sender_id" yaml:"sender_id"`
+	Title     string      `boiler:"title" boil:"title" json:"title" toml:"title" yaml:"title"`
+	DataType  null.String `boiler:"data_type" boil:"data_type" json:"data_type,omitempty" toml:"data_type" yaml:"data_type,omitempty"`
+	ReadAt    null.Time   `boiler:"read_at" boil:"read_at" json:"read_at,omitempty" toml:"read_at" yaml:"read_at,omitempty"`
+	ExpiresAt null.Time   `boiler:"expires_at" boil:"expires_at" json:"expires_at,omitempty" toml:"expires_at" yaml:"expires_at,omitempty"`
 
 	R *systemMessageR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L systemMessageL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var SystemMessageColumns = struct {
-	ID       string
-	PlayerID string
-	Message  string
-	Data     string
-	SentAt   string
-	SenderID string
-	Title    string
-	DataType string
-	ReadAt   string
+	ID        string
+	PlayerID  string
+	Message   string
+	Data      string
+	SentAt    string
+	SenderID  string
+	Title     string
+	DataType  string
+	ReadAt    string
+	ExpiresAt string
 }{
-	ID:       "id",
-	PlayerID: "player_id",
-	Message:  "message",
-	Data:     "data",
-	SentAt:   "sent_at",
-	SenderID: "sender_id",
-	Title:    "title",
-	DataType: "data_type",
-	ReadAt:   "read_at",
+	ID:        "id",
+	PlayerID:  "player_id",
+	Message:   "message",
+	Data:      "data",
+	SentAt:    "sent_at",
+	SenderID:  "sender_id",
+	Title:     "title",
+	DataType:  "data_type",
+	ReadAt:    "read_at",
+	ExpiresAt: "expires_at",
 }
 
 var SystemMessageTableColumns = struct {
-	ID       string
-	PlayerID string
-	Message  string
-	Data     string
-	SentAt   string
-	SenderID string
-	Title    string
-	DataType string
-	ReadAt   string
+	ID        string
+	PlayerID  string
+	Message   string
+	Data      string
+	SentAt    string
+	SenderID  string
+	Title     string
+	DataType  string
+	ReadAt    string
+	ExpiresAt string
 }{
-	ID:       "system_messages.id",
-	PlayerID: "system_messages.player_id",
-	Message:  "system_messages.message",
-	Data:     "system_messages.data",
-	SentAt:   "system_messages.sent_at",
-	SenderID: "system_messages.sender_id",
-	Title:    "system_messages.title",
-	DataType: "system_messages.data_type",
-	ReadAt:   "system_messages.read_at",
+	ID:        "system_messages.id",
+	PlayerID:  "system_messages.player_id",
+	Message:   "system_messages.message",
+	Data:      "system_messages.data",
+	SentAt:    "system_messages.sent_at",
+	SenderID:  "system_messages.sender_id",
+	Title:     "system_messages.title",
+	DataType:  "system_messages.data_type",
+	ReadAt:    "system_messages.read_at",
+	ExpiresAt: "system_messages.expires_at",
 }
 
 // Generated where
 
 var SystemMessageWhere = struct {
-	ID       whereHelperstring
-	PlayerID whereHelperstring
-	Message  whereHelperstring
-	Data     whereHelpernull_JSON
-	SentAt   whereHelpertime_Time
-	SenderID whereHelperstring
-	Title    whereHelperstring
-	DataType whereHelpernull_String
-	ReadAt   whereHelpernull_Time
+	ID        whereHelperstring
+	PlayerID  whereHelperstring
+	Message   whereHelperstring
+	Data      whereHelpernull_JSON
+	SentAt    whereHelpertime_Time
+	SenderID  whereHelperstring
+	Title     whereHelperstring
+	DataType  whereHelpernull_String
+	ReadAt    whereHelpernull_Time
+	ExpiresAt whereHelpernull_Time
 }{
-	ID:       whereHelperstring{field: "\"system_messages\".\"id\""},
-	PlayerID: whereHelperstring{field: "\"system_messages\".\"player_id\""},
-	Message:  whereHelperstring{field: "\"system_messages\".\"message\""},
-	Data:     whereHelpernull_JSON{field: "\"system_messages\".\"data\""},
-	SentAt:   whereHelpertime_Time{field: "\"system_messages\".\"sent_at\""},
-	SenderID: whereHelperstring{field: "\"system_messages\".\"sender_id\""},
-	Title:    whereHelperstring{field: "\"system_messages\".\"title\""},
-	DataType: whereHelpernull_String{field: "\"system_messages\".\"data_type\""},
-	ReadAt:   whereHelpernull_Time{field: "\"system_messages\".\"read_at\""},
+	ID:        whereHelperstring{field: "\"system_messages\".\"id\""},
+	PlayerID:  whereHelperstring{field: "\"system_messages\".\"player_id\""},
+	Message:   whereHelperstring{field: "\"system_messages\".\"message\""},
+	Data:      whereHelpernull_JSON{field: "\"system_messages\".\"data\""},
+	SentAt:    whereHelpertime_Time{field: "\"system_messages\".\"sent_at\""},
+	SenderID:  whereHelperstring{field: "\"system_messages\".\"sender_id\""},
+	Title:     whereHelperstring{field: "\"system_messages\".\"title\""},
+	DataType:  whereHelpernull_String{field: "\"system_messages\".\"data_type\""},
+	ReadAt:    whereHelpernull_Time{field: "\"system_messages\".\"read_at\""},
+	ExpiresAt: whereHelpernull_Time{field: "\"system_messages\".\"expires_at\""},
 }
 
 // SystemMessageRels is where relationship names are stored.
@@ -129,8 +136,8 @@ func (*systemMessageR) NewStruct() *systemMessageR {
 type systemMessageL struct{}
 
 var (
-	systemMessageAllColumns            = []string{"id", "player_id", "message", "data", "sent_at", "sender_id", "title", "data_type", "read_at"}
-	systemMessageColumnsWithoutDefault = []string{"player_id", "message", "sender_id", "title"}
+	systemMessageAllColumns            = []string{"id", "player_id", "message", "data", "sent_at", "sender_id", "title", "data_type", "read_at", "expires_at"}
+	systemMessageColumnsWithoutDefault = []string{"player_id", "message", "sender_id", "title", "expires_at"}
 	systemMessageColumnsWithDefault    = []string{"id", "data", "sent_at", "data_type", "read_at"}
 	systemMessagePrimaryKeyColumns     = []string{"id"}
 	systemMessageGeneratedColumns      = []string{}
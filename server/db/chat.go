@@ -0,0 +1,41 @@
+package db
+
+import (
+	"fmt"
+	"server/db/boiler"
+	"server/gamedb"
+	"time"
+
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// ChatHistoryCursor points at a message of the chat history, messages are ordered by their created time then their id
+type ChatHistoryCursor struct {
+	SentAt time.Time `json:"sent_at"`
+	ID     string    `json:"id"`
+}
+
+// ChatHistoryList returns up to limit messages of the chat stream sent before the cursor, newest first.
+// The latest messages are returned when the cursor is nil.
+func ChatHistoryList(chatStream string, before *ChatHistoryCursor, limit int) ([]*boiler.ChatHistory, error) {
+	queryMods := []qm.QueryMod{
+		boiler.ChatHistoryWhere.ChatStream.EQ(chatStream),
+		qm.OrderBy(fmt.Sprintf("%s DESC, %s DESC", boiler.ChatHistoryTableColumns.CreatedAt, boiler.ChatHistoryTableColumns.ID)),
+		qm.Limit(limit),
+	}
+
+	if before != nil {
+		queryMods = append(queryMods, qm.Where(
+			fmt.Sprintf("(%s, %s) < (?, ?)", boiler.ChatHistoryTableColumns.CreatedAt, boiler.ChatHistoryTableColumns.ID),
+			before.SentAt, before.ID,
+		))
+	}
+
+	msgs, err := boiler.ChatHistories(queryMods...).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load chat history.")
+	}
+
+	return msgs, nil
+}
@@ -0,0 +1,263 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"server/db/boiler"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/ninja-software/terror/v2"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Stores a coupon discount can be used in
+const (
+	DiscountTargetFiat = "fiat"
+	DiscountTargetSups = "sups"
+)
+
+// CouponRedeem counts a use of a coupon code by a player in the given faction.
+// It returns sql.ErrNoRows when the code does not exist or cannot currently be redeemed,
+// and the coupon stays locked until conn is committed or rolled back.
+func CouponRedeem(conn boil.Executor, code string, playerID string, factionID string) (*boiler.Coupon, error) {
+	q := `
+		UPDATE coupons
+		SET uses           = uses + 1,
+			redeemed       = (max_uses IS NOT NULL AND uses + 1 >= max_uses),
+			redeemed_by_id = $2,
+			redeemed_at    = NOW()
+		WHERE code = $1
+			AND redeemed = FALSE
+			AND disabled_at IS NULL
+			AND expiry_date > NOW()
+			AND (starts_at IS NULL OR starts_at <= NOW())
+			AND (faction_id IS NULL OR faction_id = $3)
+		RETURNING *`
+	coupon := &boiler.Coupon{}
+	err := boiler.NewQuery(qm.SQL(q, code, playerID, factionID)).Bind(nil, conn, coupon)
+	if err != nil {
+		return nil, err
+	}
+	return coupon, nil
+}
+
+// CouponIsSingleUse returns true if a coupon can only be redeemed once in total, in which case its items are marked
+// as claimed when it is redeemed.
+func CouponIsSingleUse(coupon *boiler.Coupon) bool {
+	return coupon.MaxUses.Valid && coupon.MaxUses.Int == 1
+}
+
+// CouponPlayerRedemptionCount returns the number of times a player has redeemed a coupon.
+func CouponPlayerRedemptionCount(conn boil.Executor, couponID string, playerID string) (int, error) {
+	count, err := boiler.CouponEvents(
+		boiler.CouponEventWhere.CouponID.EQ(couponID),
+		boiler.CouponEventWhere.Event.EQ(CouponEventRedeemed),
+		boiler.CouponEventWhere.PlayerID.EQ(null.StringFrom(playerID)),
+	).Count(conn)
+	if err != nil {
+		return 0, terror.Error(err)
+	}
+	return int(count), nil
+}
+
+// CouponCreate creates a coupon code with the items it grants.
+func CouponCreate(conn boil.Executor, coupon *boiler.Coupon, items []*boiler.CouponItem) error {
+	// max uses is nullable with a default, so it has to be inserted when it is null to mean unlimited uses
+	err := coupon.Insert(conn, boil.Greylist(boiler.CouponColumns.MaxUses))
+	if err != nil {
+		return terror.Error(err)
+	}
+
+	for _, ci := range items {
+		ci.CouponID = coupon.ID
+		err = ci.Insert(conn, boil.Infer())
+		if err != nil {
+			return terror.Error(err)
+		}
+	}
+	coupon.R = coupon.R.NewStruct()
+	coupon.R.CouponItems = items
+
+	err = CouponEventInsert(conn, coupon.ID, CouponEventCreated, coupon.CreatedByID, "created by admin")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CouponList returns a page of the coupons created by admins, newest first.
+func CouponList(conn boil.Executor, search string, includeDisabled bool, offset int, pageSize int) (int64, []*boiler.Coupon, error) {
+	queryMods := []qm.QueryMod{
+		boiler.CouponWhere.CreatedByID.IsNotNull(),
+	}
+	if search != "" {
+		queryMods = append(queryMods, qm.Where(
+			fmt.Sprintf("(%s ILIKE ? OR %s ILIKE ?)", boiler.CouponTableColumns.Code, boiler.CouponTableColumns.Label),
+			"%"+search+"%",
+			"%"+search+"%",
+		))
+	}
+	if !includeDisabled {
+		queryMods = append(queryMods, boiler.CouponWhere.DisabledAt.IsNull())
+	}
+
+	total, err := boiler.Coupons(queryMods...).Count(conn)
+	if err != nil {
+		return 0, nil, terror.Error(err)
+	}
+
+	queryMods = append(queryMods,
+		qm.Load(boiler.CouponRels.CouponItems),
+		qm.OrderBy(boiler.CouponColumns.CreatedAt+" DESC"),
+		qm.Offset(offset),
+		qm.Limit(pageSize),
+	)
+	coupons, err := boiler.Coupons(queryMods...).All(conn)
+	if err != nil {
+		return 0, nil, terror.Error(err)
+	}
+
+	return total, coupons, nil
+}
+
+// CouponDisable stops a coupon from being redeemed any more.
+func CouponDisable(conn boil.Executor, couponID string, playerID string) (*boiler.Coupon, error) {
+	coupon, err := boiler.FindCoupon(conn, couponID)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to find coupon.")
+	}
+	if coupon.DisabledAt.Valid {
+		return coupon, nil
+	}
+
+	coupon.DisabledAt = null.TimeFrom(time.Now())
+	_, err = coupon.Update(conn, boil.Whitelist(boiler.CouponColumns.DisabledAt))
+	if err != nil {
+		return nil, terror.Error(err, "Failed to disable coupon.")
+	}
+
+	err = CouponEventInsert(conn, coupon.ID, CouponEventRevoked, null.StringFrom(playerID), "disabled by admin")
+	if err != nil {
+		return nil, err
+	}
+
+	return coupon, nil
+}
+
+// PlayerDiscountsAvailable returns the unused and unexpired discounts of a player.
+func PlayerDiscountsAvailable(conn boil.Executor, playerID string) (boiler.PlayerDiscountSlice, error) {
+	discounts, err := boiler.PlayerDiscounts(
+		boiler.PlayerDiscountWhere.PlayerID.EQ(playerID),
+		boiler.PlayerDiscountWhere.UsedAt.IsNull(),
+		qm.Where(fmt.Sprintf("%s > NOW()", boiler.PlayerDiscountColumns.ExpiresAt)),
+		qm.OrderBy(boiler.PlayerDiscountColumns.ExpiresAt),
+	).All(conn)
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	return discounts, nil
+}
+
+// PlayerDiscountAvailable returns an unused and unexpired discount of a player which can be used in the target store.
+func PlayerDiscountAvailable(conn boil.Executor, playerDiscountID string, playerID string, target string) (*boiler.PlayerDiscount, error) {
+	pd, err := boiler.PlayerDiscounts(
+		boiler.PlayerDiscountWhere.ID.EQ(playerDiscountID),
+		boiler.PlayerDiscountWhere.PlayerID.EQ(playerID),
+		boiler.PlayerDiscountWhere.Target.EQ(target),
+		boiler.PlayerDiscountWhere.UsedAt.IsNull(),
+		qm.Where(fmt.Sprintf("%s > NOW()", boiler.PlayerDiscountColumns.ExpiresAt)),
+	).One(conn)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, terror.Error(err, "This discount has been used or has expired.")
+	}
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load discount.")
+	}
+	return pd, nil
+}
+
+// PlayerDiscountValue returns how much a discount takes off a price, which is never more than the price.
+// Prices and amounts are in the smallest unit of the currency, so the value is rounded down to a whole unit.
+func PlayerDiscountValue(pd *boiler.PlayerDiscount, price decimal.Decimal) decimal.Decimal {
+	value := decimal.Zero
+	if pd.Percentage.Valid {
+		value = price.Mul(pd.Percentage.Decimal).Div(decimal.NewFromInt(100)).Floor()
+	} else if pd.Amount.Valid {
+		value = pd.Amount.Decimal
+	}
+	if value.GreaterThan(price) {
+		value = price
+	}
+	return value
+}
+
+// PlayerDiscountUse marks a discount as used, returning false when it had already been used.
+func PlayerDiscountUse(conn boil.Executor, playerDiscountID string, reference string) (bool, error) {
+	updated, err := boiler.PlayerDiscounts(
+		boiler.PlayerDiscountWhere.ID.EQ(playerDiscountID),
+		boiler.PlayerDiscountWhere.UsedAt.IsNull(),
+	).UpdateAll(conn, boiler.M{
+		boiler.PlayerDiscountColumns.UsedAt:        null.TimeFrom(time.Now()),
+		boiler.PlayerDiscountColumns.UsedReference: null.StringFrom(reference),
+	})
+	if err != nil {
+		return false, terror.Error(err)
+	}
+	return updated > 0, nil
+}
+
+// PlayerDiscountUseReserved marks a discount as used by the reference it was reserved for, or by the reference if it
+// was released since. Returns false when the discount has been used by something else.
+func PlayerDiscountUseReserved(conn boil.Executor, playerDiscountID string, reference string) (bool, error) {
+	updated, err := boiler.PlayerDiscounts(
+		boiler.PlayerDiscountWhere.ID.EQ(playerDiscountID),
+		qm.Expr(
+			boiler.PlayerDiscountWhere.UsedAt.IsNull(),
+			qm.Or2(boiler.PlayerDiscountWhere.UsedReference.EQ(null.StringFrom(reference))),
+		),
+	).UpdateAll(conn, boiler.M{
+		boiler.PlayerDiscountColumns.UsedAt:        null.TimeFrom(time.Now()),
+		boiler.PlayerDiscountColumns.UsedReference: null.StringFrom(reference),
+	})
+	if err != nil {
+		return false, terror.Error(err)
+	}
+	return updated > 0, nil
+}
+
+// PlayerDiscountReserved returns the discount of a player which is reserved by a reference, nil if it is not reserved.
+func PlayerDiscountReserved(conn boil.Executor, playerDiscountID string, playerID string, target string) (*boiler.PlayerDiscount, error) {
+	pd, err := boiler.PlayerDiscounts(
+		boiler.PlayerDiscountWhere.ID.EQ(playerDiscountID),
+		boiler.PlayerDiscountWhere.PlayerID.EQ(playerID),
+		boiler.PlayerDiscountWhere.Target.EQ(target),
+		boiler.PlayerDiscountWhere.UsedAt.IsNotNull(),
+		boiler.PlayerDiscountWhere.UsedReference.IsNotNull(),
+	).One(conn)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load discount.")
+	}
+	return pd, nil
+}
+
+// PlayerDiscountRelease makes the discounts reserved by a reference which was never paid available again.
+func PlayerDiscountRelease(conn boil.Executor, reference string) error {
+	_, err := boiler.PlayerDiscounts(
+		boiler.PlayerDiscountWhere.UsedReference.EQ(null.StringFrom(reference)),
+	).UpdateAll(conn, boiler.M{
+		boiler.PlayerDiscountColumns.UsedAt:        null.Time{},
+		boiler.PlayerDiscountColumns.UsedReference: null.String{},
+	})
+	if err != nil {
+		return terror.Error(err, "Failed to release discount.")
+	}
+	return nil
+}
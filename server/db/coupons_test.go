@@ -0,0 +1,42 @@
+package db
+
+import (
+	"server/db/boiler"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestPlayerDiscountValue(t *testing.T) {
+	tests := []struct {
+		name       string
+		percentage decimal.NullDecimal
+		amount     decimal.NullDecimal
+		price      int64
+		expected   int64
+	}{
+		{"percentage", decimal.NewNullDecimal(decimal.NewFromInt(10)), decimal.NullDecimal{}, 2000, 200},
+		{"percentage rounds down", decimal.NewNullDecimal(decimal.NewFromInt(15)), decimal.NullDecimal{}, 999, 149},
+		{"fractional percentage", decimal.NewNullDecimal(decimal.NewFromFloat(12.5)), decimal.NullDecimal{}, 1000, 125},
+		{"full percentage", decimal.NewNullDecimal(decimal.NewFromInt(100)), decimal.NullDecimal{}, 1000, 1000},
+		{"percentage over the price", decimal.NewNullDecimal(decimal.NewFromInt(150)), decimal.NullDecimal{}, 1000, 1000},
+		{"amount", decimal.NullDecimal{}, decimal.NewNullDecimal(decimal.NewFromInt(300)), 1000, 300},
+		{"amount over the price", decimal.NullDecimal{}, decimal.NewNullDecimal(decimal.NewFromInt(1500)), 1000, 1000},
+		{"percentage before amount", decimal.NewNullDecimal(decimal.NewFromInt(10)), decimal.NewNullDecimal(decimal.NewFromInt(300)), 1000, 100},
+		{"no discount", decimal.NullDecimal{}, decimal.NullDecimal{}, 1000, 0},
+		{"zero price", decimal.NewNullDecimal(decimal.NewFromInt(10)), decimal.NullDecimal{}, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pd := &boiler.PlayerDiscount{
+				Percentage: tt.percentage,
+				Amount:     tt.amount,
+			}
+			value := PlayerDiscountValue(pd, decimal.NewFromInt(tt.price))
+			if !value.Equal(decimal.NewFromInt(tt.expected)) {
+				t.Fatalf("unexpected discount value: %s, expected %d", value, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,287 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"server"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type DirectMessageConversationSummary struct {
+	ID            string               `json:"id"`
+	OtherPlayer   *server.PublicPlayer `json:"other_player"`
+	LastMessageAt null.Time            `json:"last_message_at"`
+	LastReadAt    time.Time            `json:"last_read_at"`
+	IsMuted       bool                 `json:"is_muted"`
+	UnreadCount   int                  `json:"unread_count"`
+}
+
+// DirectMessageConversationSummaries returns the conversations of the player, latest conversation first.
+// Only the given conversations are returned if any conversation id is provided.
+func DirectMessageConversationSummaries(playerID string, conversationIDs ...string) ([]*DirectMessageConversationSummary, error) {
+	args := []interface{}{playerID}
+	conversationFilter := ""
+	if len(conversationIDs) > 0 {
+		placeholders := ""
+		for i, id := range conversationIDs {
+			if i > 0 {
+				placeholders += ","
+			}
+			args = append(args, id)
+			placeholders += fmt.Sprintf("$%d", len(args))
+		}
+		conversationFilter = fmt.Sprintf("AND c.id IN (%s)", placeholders)
+	}
+
+	q := fmt.Sprintf(`
+		SELECT
+			c.id,
+			c.last_message_at,
+			m.last_read_at,
+			m.muted_at IS NOT NULL,
+			(
+				SELECT COUNT(*) FROM direct_messages dm
+				WHERE dm.conversation_id = c.id AND dm.sender_id != m.player_id AND dm.created_at > m.last_read_at
+			),
+			p.id,
+			p.username,
+			p.gid,
+			p.faction_id,
+			p.about_me,
+			p.rank,
+			p.created_at
+		FROM direct_message_conversation_members m
+		INNER JOIN direct_message_conversations c ON c.id = m.conversation_id
+		INNER JOIN players p ON p.id = CASE WHEN c.player_a_id = m.player_id THEN c.player_b_id ELSE c.player_a_id END
+		WHERE m.player_id = $1 %s
+		ORDER BY c.last_message_at DESC NULLS LAST
+	`, conversationFilter)
+
+	rows, err := gamedb.StdConn.Query(q, args...)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("player id", playerID).Msg("Failed to load direct message conversations.")
+		return nil, terror.Error(err, "Failed to load conversations.")
+	}
+
+	defer rows.Close()
+
+	resp := []*DirectMessageConversationSummary{}
+	for rows.Next() {
+		summary := &DirectMessageConversationSummary{
+			OtherPlayer: &server.PublicPlayer{},
+		}
+		err = rows.Scan(
+			&summary.ID,
+			&summary.LastMessageAt,
+			&summary.LastReadAt,
+			&summary.IsMuted,
+			&summary.UnreadCount,
+			&summary.OtherPlayer.ID,
+			&summary.OtherPlayer.Username,
+			&summary.OtherPlayer.Gid,
+			&summary.OtherPlayer.FactionID,
+			&summary.OtherPlayer.AboutMe,
+			&summary.OtherPlayer.Rank,
+			&summary.OtherPlayer.CreatedAt,
+		)
+		if err != nil {
+			gamelog.L.Error().Err(err).Str("player id", playerID).Msg("Failed to scan direct message conversation.")
+			return nil, terror.Error(err, "Failed to load conversations.")
+		}
+
+		resp = append(resp, summary)
+	}
+
+	return resp, nil
+}
+
+// DirectMessageUnreadTotal returns the number of unread messages in the player's conversations which are not muted
+func DirectMessageUnreadTotal(playerID string) (int, error) {
+	q := `
+		SELECT COUNT(*)
+		FROM direct_message_conversation_members m
+		INNER JOIN direct_messages dm ON dm.conversation_id = m.conversation_id AND dm.sender_id != m.player_id AND dm.created_at > m.last_read_at
+		WHERE m.player_id = $1 AND m.muted_at ISNULL
+	`
+
+	total := 0
+	err := gamedb.StdConn.QueryRow(q, playerID).Scan(&total)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("player id", playerID).Msg("Failed to count unread direct messages.")
+		return 0, terror.Error(err, "Failed to count unread messages.")
+	}
+
+	return total, nil
+}
+
+// DirectMessageConversationGetOrCreate returns the conversation between the two players, the conversation is created if it does not exist
+func DirectMessageConversationGetOrCreate(playerID string, otherPlayerID string) (*boiler.DirectMessageConversation, error) {
+	// player ids are sorted, so the pair matches the unique constraint
+	playerAID, playerBID := playerID, otherPlayerID
+	if playerBID < playerAID {
+		playerAID, playerBID = playerBID, playerAID
+	}
+
+	conversation, err := boiler.DirectMessageConversations(
+		boiler.DirectMessageConversationWhere.PlayerAID.EQ(playerAID),
+		boiler.DirectMessageConversationWhere.PlayerBID.EQ(playerBID),
+	).One(gamedb.StdConn)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, terror.Error(err, "Failed to load conversation.")
+	}
+	if conversation != nil {
+		return conversation, nil
+	}
+
+	tx, err := gamedb.StdConn.Begin()
+	if err != nil {
+		return nil, terror.Error(err, "Failed to start db transaction.")
+	}
+	defer tx.Rollback()
+
+	conversation = &boiler.DirectMessageConversation{
+		PlayerAID: playerAID,
+		PlayerBID: playerBID,
+	}
+	err = conversation.Insert(tx, boil.Infer())
+	if err != nil {
+		return nil, terror.Error(err, "Failed to create conversation.")
+	}
+
+	for _, id := range []string{playerAID, playerBID} {
+		member := &boiler.DirectMessageConversationMember{
+			ConversationID: conversation.ID,
+			PlayerID:       id,
+		}
+		err = member.Insert(tx, boil.Infer())
+		if err != nil {
+			return nil, terror.Error(err, "Failed to create conversation.")
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, terror.Error(err, "Failed to commit db transaction.")
+	}
+
+	return conversation, nil
+}
+
+// DirectMessageConversationMember returns the player's membership of the conversation
+func DirectMessageConversationMember(conversationID string, playerID string) (*boiler.DirectMessageConversationMember, error) {
+	member, err := boiler.FindDirectMessageConversationMember(gamedb.StdConn, conversationID, playerID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, terror.Error(err, "Conversation not found.")
+	}
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load conversation.")
+	}
+
+	return member, nil
+}
+
+type DirectMessageCursor struct {
+	SentAt time.Time `json:"sent_at"`
+	ID     string    `json:"id"`
+}
+
+// DirectMessageList returns up to limit messages of the conversation sent before the cursor, newest first
+func DirectMessageList(conversationID string, before *DirectMessageCursor, limit int) (boiler.DirectMessageSlice, error) {
+	queryMods := []qm.QueryMod{
+		boiler.DirectMessageWhere.ConversationID.EQ(conversationID),
+		qm.OrderBy(fmt.Sprintf("%s DESC, %s DESC", boiler.DirectMessageTableColumns.CreatedAt, boiler.DirectMessageTableColumns.ID)),
+		qm.Limit(limit),
+	}
+
+	if before != nil {
+		queryMods = append(queryMods, qm.Where(
+			fmt.Sprintf("(%s, %s) < (?, ?)", boiler.DirectMessageTableColumns.CreatedAt, boiler.DirectMessageTableColumns.ID),
+			before.SentAt, before.ID,
+		))
+	}
+
+	msgs, err := boiler.DirectMessages(queryMods...).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load messages.")
+	}
+
+	return msgs, nil
+}
+
+// PlayerBlocked checks whether the player has blocked the other player
+func PlayerBlocked(playerID string, otherPlayerID string) (bool, error) {
+	blocked, err := boiler.PlayerBlockExists(gamedb.StdConn, playerID, otherPlayerID)
+	if err != nil {
+		return false, terror.Error(err, "Failed to check blocked players.")
+	}
+
+	return blocked, nil
+}
+
+// PlayerIDsBlocking returns the ids of the given players who have blocked the player
+func PlayerIDsBlocking(playerID string, playerIDs []string) ([]string, error) {
+	ids := []string{}
+	if len(playerIDs) == 0 {
+		return ids, nil
+	}
+
+	blocks, err := boiler.PlayerBlocks(
+		boiler.PlayerBlockWhere.PlayerID.IN(playerIDs),
+		boiler.PlayerBlockWhere.BlockedPlayerID.EQ(playerID),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to check blocked players.")
+	}
+
+	for _, b := range blocks {
+		ids = append(ids, b.PlayerID)
+	}
+
+	return ids, nil
+}
+
+// PlayerGidsBlocking returns the gids of the given players who have blocked the player
+func PlayerGidsBlocking(playerID string, gids []int) ([]int, error) {
+	result := []int{}
+	if len(gids) == 0 {
+		return result, nil
+	}
+
+	ps, err := boiler.Players(
+		qm.Select(boiler.PlayerColumns.ID, boiler.PlayerColumns.Gid),
+		boiler.PlayerWhere.Gid.IN(gids),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load players.")
+	}
+
+	playerIDs := []string{}
+	for _, p := range ps {
+		playerIDs = append(playerIDs, p.ID)
+	}
+
+	blockingIDs, err := PlayerIDsBlocking(playerID, playerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range ps {
+		for _, id := range blockingIDs {
+			if p.ID == id {
+				result = append(result, p.Gid)
+				break
+			}
+		}
+	}
+
+	return result, nil
+}
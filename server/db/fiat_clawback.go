@@ -0,0 +1,256 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"server/db/boiler"
+
+	"github.com/friendsofgo/errors"
+	"github.com/lib/pq"
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const (
+	OrderItemAssetStatusDelivered = "delivered"
+	OrderItemAssetStatusLocked    = "locked"
+	OrderItemAssetStatusRevoked   = "revoked"
+)
+
+// Reasons set on the hidden assets of a reversed payment
+const (
+	AssetHiddenPaymentDisputed = "Locked: payment disputed"
+	AssetHiddenPaymentReversed = "Revoked: payment reversed"
+)
+
+// Actions recorded in the order state history
+const (
+	OrderStateActionStatusChanged         = "status_changed"
+	OrderStateActionIgnored               = "ignored"
+	OrderStateActionPartialRefund         = "partial_refund"
+	OrderStateActionAssetsLocked          = "assets_locked"
+	OrderStateActionAssetsUnlocked        = "assets_unlocked"
+	OrderStateActionAssetsRevoked         = "assets_revoked"
+	OrderStateActionFactionPassRolledBack = "faction_pass_rolled_back"
+	OrderStateActionFactionPassRestored   = "faction_pass_restored"
+	OrderStateActionModeratorsAlerted     = "moderators_alerted"
+)
+
+// FiatOrderAsset is an asset delivered to the buyer of an order.
+type FiatOrderAsset struct {
+	ItemType string
+	ItemID   string
+}
+
+// FiatOrderItemAssetsInsert records the assets delivered for an order item.
+// Assets which were not delivered for an order item are ignored.
+func FiatOrderItemAssetsInsert(conn boil.Executor, orderItemID string, mysteryCrateID null.String, status string, assets ...*FiatOrderAsset) error {
+	if orderItemID == "" {
+		return nil
+	}
+	for _, asset := range assets {
+		oia := &boiler.OrderItemAsset{
+			OrderItemID:    orderItemID,
+			ItemType:       asset.ItemType,
+			ItemID:         asset.ItemID,
+			MysteryCrateID: mysteryCrateID,
+			Status:         status,
+		}
+		err := oia.Insert(conn, boil.Infer())
+		if err != nil {
+			return terror.Error(err)
+		}
+	}
+	return nil
+}
+
+// FiatOrderCrateContentsInsert records the contents of an opened crate against the order that delivered the crate.
+// Crates which were not bought with fiat are ignored.
+func FiatOrderCrateContentsInsert(conn boil.Executor, crateID string, assets ...*FiatOrderAsset) error {
+	crateAsset, err := boiler.OrderItemAssets(
+		boiler.OrderItemAssetWhere.ItemType.EQ(boiler.ItemTypeMysteryCrate),
+		boiler.OrderItemAssetWhere.ItemID.EQ(crateID),
+	).One(conn)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return terror.Error(err)
+	}
+
+	return FiatOrderItemAssetsInsert(conn, crateAsset.OrderItemID, null.StringFrom(crateID), crateAsset.Status, assets...)
+}
+
+// FiatOrderByStripePayment returns the order paid by the stripe payment intent or invoice, or nil when there is none.
+func FiatOrderByStripePayment(conn boil.Executor, paymentIntentID string, invoiceID string) (*boiler.Order, error) {
+	if paymentIntentID == "" && invoiceID == "" {
+		return nil, nil
+	}
+
+	order := &boiler.Order{}
+	q := fmt.Sprintf(`
+		SELECT %[1]s
+		FROM %[2]s
+		WHERE (%[3]s = $1 AND $1 <> '')
+			OR (%[4]s = $2 AND $2 <> '')
+		LIMIT 1`,
+		boiler.OrderColumns.ID,
+		boiler.TableNames.Orders,
+		boiler.OrderColumns.StripePaymentIntentID,
+		boiler.OrderColumns.TXNReference,
+	)
+	err := conn.QueryRow(q, paymentIntentID, invoiceID).Scan(&order.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+
+	order, err = boiler.FindOrder(conn, order.ID)
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	return order, nil
+}
+
+// FactionPassPurchaseByStripePayment returns the faction pass purchase paid by the stripe payment intent, or nil when there is none.
+func FactionPassPurchaseByStripePayment(conn boil.Executor, paymentIntentID string) (*boiler.FactionPassPurchaseLog, error) {
+	if paymentIntentID == "" {
+		return nil, nil
+	}
+
+	fpp, err := boiler.FactionPassPurchaseLogs(
+		boiler.FactionPassPurchaseLogWhere.StripePaymentIntentID.EQ(null.StringFrom(paymentIntentID)),
+	).One(conn)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	return fpp, nil
+}
+
+// FiatOrderAssetsSetStatus moves the assets delivered by an order from one of the given statuses to a new status.
+// Locked and revoked assets are clawback locked, which stops them being queued, sold or moved to xsyn, and revoked
+// assets are archived. The hidden reason of the assets is only replaced when it was set by a payment reversal, so assets
+// hidden for another reason stay hidden. It returns the current owners of the updated assets.
+func FiatOrderAssetsSetStatus(conn boil.Executor, orderID string, fromStatuses []string, toStatus string, assetHidden null.String) ([]string, error) {
+	q := `
+		WITH _assets AS (
+			UPDATE order_item_assets oia
+			SET status = $3,
+				updated_at = NOW()
+			FROM order_items oi
+			WHERE oi.id = oia.order_item_id
+				AND oi.order_id = $1
+				AND oia.status = ANY($2)
+			RETURNING oia.item_type, oia.item_id
+		)
+		UPDATE collection_items ci
+		SET asset_hidden = CASE
+				WHEN ci.asset_hidden IS NULL OR ci.asset_hidden = ANY($5) THEN $4
+				ELSE ci.asset_hidden
+			END,
+			clawback_locked = $3 IN ($6, $7),
+			deleted_at = CASE
+				WHEN $3 = $7 THEN COALESCE(ci.deleted_at, NOW())
+				ELSE ci.deleted_at
+			END
+		FROM _assets
+		WHERE ci.item_type::TEXT = _assets.item_type
+			AND ci.item_id = _assets.item_id
+		RETURNING ci.owner_id`
+	rows, err := conn.Query(
+		q,
+		orderID,
+		pq.Array(fromStatuses),
+		toStatus,
+		assetHidden,
+		pq.Array([]string{AssetHiddenPaymentDisputed, AssetHiddenPaymentReversed}),
+		OrderItemAssetStatusLocked,
+		OrderItemAssetStatusRevoked,
+	)
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	defer rows.Close()
+
+	ownerIDs := []string{}
+	for rows.Next() {
+		ownerID := ""
+		err := rows.Scan(&ownerID)
+		if err != nil {
+			return nil, terror.Error(err)
+		}
+		ownerIDs = append(ownerIDs, ownerID)
+	}
+
+	return ownerIDs, nil
+}
+
+// StripeEventProcessed checks whether the stripe event has already been recorded in the order state history.
+func StripeEventProcessed(conn boil.Executor, stripeEventID string) (bool, error) {
+	exists, err := boiler.OrderStateHistories(
+		boiler.OrderStateHistoryWhere.StripeEventID.EQ(null.StringFrom(stripeEventID)),
+	).Exists(conn)
+	if err != nil {
+		return false, terror.Error(err)
+	}
+	return exists, nil
+}
+
+// clawbackLockedSQL checks whether the item with the id given by the %[1]s expression, or anything equipped on it, is
+// clawback locked.
+const clawbackLockedSQL = `
+	EXISTS (
+		SELECT 1
+		FROM collection_items cl
+		WHERE cl.clawback_locked
+			AND (
+				cl.item_id = %[1]s
+				OR cl.item_id IN (SELECT id FROM weapons WHERE equipped_on = %[1]s)
+				OR cl.item_id IN (SELECT id FROM utility WHERE equipped_on = %[1]s)
+				OR cl.item_id IN (SELECT id FROM mech_skin WHERE equipped_on = %[1]s)
+				OR cl.item_id IN (SELECT id FROM mech_animation WHERE equipped_on = %[1]s)
+				OR cl.item_id IN (SELECT id FROM power_cores WHERE equipped_on = %[1]s)
+				OR cl.item_id IN (SELECT ws.id FROM weapon_skin ws INNER JOIN weapons w ON w.id = ws.equipped_on WHERE w.id = %[1]s OR w.equipped_on = %[1]s)
+			)
+	)`
+
+// CollectionItemClawbackLocked checks whether an item, or anything equipped on it, is clawback locked.
+func CollectionItemClawbackLocked(conn boil.Executor, itemID string) (bool, error) {
+	locked := false
+	err := conn.QueryRow("SELECT "+fmt.Sprintf(clawbackLockedSQL, "$1::UUID"), itemID).Scan(&locked)
+	if err != nil {
+		return false, terror.Error(err)
+	}
+	return locked, nil
+}
+
+// MarketplaceSaleClawbackLocked checks whether the item of a sale, or any unreleased item of its bundle, is clawback locked.
+func MarketplaceSaleClawbackLocked(conn boil.Executor, itemSaleID string) (bool, error) {
+	q := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM collection_items ci
+			WHERE ci.clawback_locked
+				AND (
+					ci.id = (SELECT s.collection_item_id FROM item_sales s WHERE s.id = $1)
+					OR ci.id IN (
+						SELECT bi.collection_item_id
+						FROM item_sale_bundle_items bi
+						WHERE bi.item_sale_id = $1
+							AND bi.released_at IS NULL
+					)
+				)
+		)`
+	locked := false
+	err := conn.QueryRow(q, itemSaleID).Scan(&locked)
+	if err != nil {
+		return false, terror.Error(err)
+	}
+	return locked, nil
+}
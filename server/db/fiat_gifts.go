@@ -0,0 +1,216 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"server/db/boiler"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Events recorded in the coupon audit trail
+const (
+	CouponEventCreated  = "created"
+	CouponEventRedeemed = "redeemed"
+	CouponEventRejected = "rejected"
+	CouponEventRevoked  = "revoked"
+)
+
+// FiatGiftRecipient finds the player a gift is for by their GID, their username or both as "username#gid".
+func FiatGiftRecipient(conn boil.Executor, recipient string) (*boiler.Player, error) {
+	recipient = strings.TrimSpace(recipient)
+	username := recipient
+	gid := ""
+	if i := strings.LastIndex(recipient, "#"); i >= 0 {
+		username = strings.TrimSpace(recipient[:i])
+		gid = strings.TrimSpace(recipient[i+1:])
+	} else if _, err := strconv.Atoi(recipient); err == nil {
+		username = ""
+		gid = recipient
+	}
+
+	queryMods := []qm.QueryMod{qm.Limit(2)}
+	if gid != "" {
+		g, err := strconv.Atoi(gid)
+		if err != nil {
+			return nil, terror.Error(err, "Invalid player GID.")
+		}
+		queryMods = append(queryMods, boiler.PlayerWhere.Gid.EQ(g))
+	}
+	if username != "" {
+		queryMods = append(queryMods, boiler.PlayerWhere.Username.EQ(null.StringFrom(username)))
+	}
+	if len(queryMods) == 1 {
+		return nil, terror.Error(fmt.Errorf("gift recipient is missing"), "Gift recipient is required.")
+	}
+
+	players, err := boiler.Players(queryMods...).All(conn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load gift recipient.")
+	}
+	if len(players) == 0 {
+		return nil, terror.Error(fmt.Errorf("gift recipient %s not found", recipient), "Player not found.")
+	}
+	if len(players) > 1 {
+		return nil, terror.Error(fmt.Errorf("gift recipient %s is ambiguous", recipient), "More than one player has this username, please use their GID.")
+	}
+
+	return players[0], nil
+}
+
+// CouponEventInsert adds an event to the coupon audit trail.
+func CouponEventInsert(conn boil.Executor, couponID string, event string, playerID null.String, note string) error {
+	ce := &boiler.CouponEvent{
+		CouponID: couponID,
+		Event:    event,
+		PlayerID: playerID,
+		Note:     note,
+	}
+	err := ce.Insert(conn, boil.Infer())
+	if err != nil {
+		return terror.Error(err)
+	}
+	return nil
+}
+
+// FiatGiftCodeCreate creates a single use coupon code redeeming a single item, and records the gift it was bought as.
+func FiatGiftCodeCreate(conn boil.Executor, gift *boiler.FiatGift, itemType string, itemID string, expiresAt time.Time) (*boiler.Coupon, error) {
+	coupon := &boiler.Coupon{}
+	q := fmt.Sprintf(`
+		INSERT INTO %[1]s (%[2]s, %[3]s)
+		VALUES (random_string(12), $1)
+		RETURNING %[4]s, %[2]s, %[5]s, %[3]s, %[6]s`,
+		boiler.TableNames.Coupons,
+		boiler.CouponColumns.Code,
+		boiler.CouponColumns.ExpiryDate,
+		boiler.CouponColumns.ID,
+		boiler.CouponColumns.Redeemed,
+		boiler.CouponColumns.CreatedAt,
+	)
+	err := conn.QueryRow(q, expiresAt).Scan(&coupon.ID, &coupon.Code, &coupon.Redeemed, &coupon.ExpiryDate, &coupon.CreatedAt)
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+
+	ci := &boiler.CouponItem{
+		CouponID: coupon.ID,
+		ItemType: itemType,
+		ItemID:   null.StringFrom(itemID),
+	}
+	err = ci.Insert(conn, boil.Infer())
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+
+	gift.CouponID = null.StringFrom(coupon.ID)
+	err = gift.Insert(conn, boil.Infer())
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+
+	err = CouponEventInsert(conn, coupon.ID, CouponEventCreated, null.StringFrom(gift.PurchasedByID), "gift code bought from the fiat store")
+	if err != nil {
+		return nil, err
+	}
+
+	return coupon, nil
+}
+
+// FiatGiftByCoupon returns the gift a coupon was bought as, or nil when the coupon is not a gift code.
+func FiatGiftByCoupon(conn boil.Executor, couponID string) (*boiler.FiatGift, error) {
+	gift, err := boiler.FiatGifts(
+		boiler.FiatGiftWhere.CouponID.EQ(null.StringFrom(couponID)),
+	).One(conn)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	return gift, nil
+}
+
+// FiatGiftByFactionPassPurchase returns the gift a faction pass was bought as, or nil when it was bought for the buyer.
+func FiatGiftByFactionPassPurchase(conn boil.Executor, factionPassPurchaseLogID string) (*boiler.FiatGift, error) {
+	gift, err := boiler.FiatGifts(
+		boiler.FiatGiftWhere.FactionPassPurchaseLogID.EQ(null.StringFrom(factionPassPurchaseLogID)),
+	).One(conn)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	return gift, nil
+}
+
+// FiatGiftPaymentCleared checks whether the order or faction pass purchase a gift was bought with is paid and not
+// being disputed or reversed.
+func FiatGiftPaymentCleared(conn boil.Executor, gift *boiler.FiatGift, factionPassPaidStatus string) (bool, error) {
+	if gift.OrderItemID.Valid {
+		orderStatus := ""
+		q := fmt.Sprintf(`
+			SELECT o.%[1]s
+			FROM %[2]s oi
+			INNER JOIN %[3]s o ON o.%[4]s = oi.%[5]s
+			WHERE oi.%[6]s = $1`,
+			boiler.OrderColumns.OrderStatus,
+			boiler.TableNames.OrderItems,
+			boiler.TableNames.Orders,
+			boiler.OrderColumns.ID,
+			boiler.OrderItemColumns.OrderID,
+			boiler.OrderItemColumns.ID,
+		)
+		err := conn.QueryRow(q, gift.OrderItemID.String).Scan(&orderStatus)
+		if err != nil {
+			return false, terror.Error(err)
+		}
+		return orderStatus == boiler.OrderStatusesCompleted, nil
+	}
+
+	if gift.FactionPassPurchaseLogID.Valid {
+		fpp, err := boiler.FindFactionPassPurchaseLog(conn, gift.FactionPassPurchaseLogID.String)
+		if err != nil {
+			return false, terror.Error(err)
+		}
+		return fpp.PaymentStatus == factionPassPaidStatus, nil
+	}
+
+	return false, nil
+}
+
+// FiatGiftCodesRevoke expires the unredeemed gift codes bought by an order or faction pass purchase,
+// and returns the number of codes revoked.
+func FiatGiftCodesRevoke(conn boil.Executor, orderID null.String, factionPassPurchaseLogID null.String, note string) (int, error) {
+	q := `
+		WITH _revoked AS (
+			UPDATE coupons c
+			SET expiry_date = NOW()
+			FROM fiat_gifts fg
+				LEFT JOIN order_items oi ON oi.id = fg.order_item_id
+			WHERE fg.coupon_id = c.id
+				AND c.redeemed = FALSE
+				AND c.expiry_date > NOW()
+				AND (oi.order_id = $1 OR fg.faction_pass_purchase_log_id = $2)
+			RETURNING c.id
+		)
+		INSERT INTO coupon_events (coupon_id, event, note)
+		SELECT _revoked.id, $3, $4
+		FROM _revoked`
+	result, err := conn.Exec(q, orderID, factionPassPurchaseLogID, CouponEventRevoked, note)
+	if err != nil {
+		return 0, terror.Error(err)
+	}
+	revoked, err := result.RowsAffected()
+	if err != nil {
+		return 0, terror.Error(err)
+	}
+	return int(revoked), nil
+}
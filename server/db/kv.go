@@ -39,6 +39,11 @@ const KeyMarketplaceListingFee KVKey = "marketplace_listing_fee"
 const KeyMarketplaceListingBuyoutFee KVKey = "marketplace_listing_buyout_fee"
 const KeyMarketplaceListingAuctionReserveFee KVKey = "marketplace_listing_auction_reserve_fee"
 const KeyMarketplaceSaleCutPercentageFee KVKey = "marketplace_sale_cut_percentage_fee"
+const KeyMarketplaceOfferExpiryHours KVKey = "marketplace_offer_expiry_hours"
+const KeyMarketplaceAuctionSoftCloseMinutes KVKey = "marketplace_auction_soft_close_minutes"
+const KeyMarketplaceAuctionSoftCloseExtendMinutes KVKey = "marketplace_auction_soft_close_extend_minutes"
+const KeyMarketplaceAuctionBidIncrement KVKey = "marketplace_auction_bid_increment"
+const KeyMarketplacePriceHistoryDays KVKey = "marketplace_price_history_days"
 
 const KeyBattleAbilityBribeDuration KVKey = "battle_ability_bribe_duration"
 const KeyBattleAbilityLocationSelectDuration KVKey = "battle_ability_location_select_duration"
@@ -72,6 +77,9 @@ const KeyCanDeployDamagedRatio KVKey = "can_deploy_damaged_ratio"
 
 const KeyDecentralisedAutonomousSyndicateTax KVKey = "decentralised_autonomous_syndicate_tax"
 const KeyCorporationSyndicateTax KVKey = "corporation_syndicate_tax"
+const KeySyndicateDuesReminderHours KVKey = "syndicate_dues_reminder_hours"
+const KeySyndicateDuesGracePeriodHours KVKey = "syndicate_dues_grace_period_hours"
+const KeySyndicateDuesRetryIntervalHours KVKey = "syndicate_dues_retry_interval_hours"
 
 const KeyOvenmediaAPIBaseUrl KVKey = "ovenmedia_api_base_url"
 const KeyOvenmediaVoiceStreamURL KVKey = "ovenmedia_stream_voice_base_url"
@@ -92,6 +100,7 @@ const KeyMinimumMechActionCountMild KVKey = "minimum_mech_action_count_mild"
 const KeyMinimumMechActionCountLoose KVKey = "minimum_mech_action_count_loose"
 
 const KeyFiatToSUPCut KVKey = "fiat_to_sup_cut" // TODO: find better name to describe: "20% cheaper than fiat pricing"
+const KeyFiatGiftCodeExpiryDays KVKey = "fiat_gift_code_expiry_days"
 
 const KeyDefaultPublicLobbyCount KVKey = "default_public_lobby_count"
 const KeySystemLobbyDefaultExtraReward KVKey = "system_lobby_extra_reward"
@@ -100,8 +109,19 @@ const KeyOpenNewArenaEveryXAmountOfBattleLobbies KVKey = "open_new_arena_after_x
 
 const KeyDeductBlockCountFromBomb KVKey = "deduct_block_count_from_bomb"
 
+// rating of mechs and pilots, and the rating bands public lobbies are filled by
+const KeyRatingKFactor KVKey = "rating_k_factor"
+const KeyRatingProvisionalKFactor KVKey = "rating_provisional_k_factor"
+const KeyRatingProvisionalBattles KVKey = "rating_provisional_battles"
+const KeyMatchmakingRatingBand KVKey = "matchmaking_rating_band"
+const KeyMatchmakingRatingBandWidenPerMinute KVKey = "matchmaking_rating_band_widen_per_minute"
+const KeyMatchmakingRatingBandMax KVKey = "matchmaking_rating_band_max"
+
+const KeyTournamentMatchLobbyRetryMinutes KVKey = "tournament_match_lobby_retry_minutes"
+
 const KeyDiscordChannelID KVKey = "discord_channel_id"
 const KeyDiscordBattleArenaChannelID KVKey = "discord_battle_arena_channel_id"
+const KeyDiscordTournamentChannelID KVKey = "discord_tournament_channel_id"
 
 const KeyDiscordGuildID KVKey = "discord_guild_id"
 
@@ -113,6 +133,13 @@ const KeyLivestreamURL KVKey = "livestream_url"
 
 const KeyBattleArenaWebURL KVKey = "battle_arena_web_url"
 
+// the number of workers which process quest jobs, and the attempts a quest job gets before it is marked failed
+const KeyQuestWorkerCount KVKey = "quest_worker_count"
+const KeyQuestJobMaxAttempts KVKey = "quest_job_max_attempts"
+
+// KeyWeaponNoAmmoStatMultiplier reduces the stats of a weapon which is deployed without ammo loaded
+const KeyWeaponNoAmmoStatMultiplier KVKey = "weapon_no_ammo_stat_multiplier"
+
 func get(key KVKey) string {
 	kv, err := boiler.KVS(boiler.KVWhere.Key.EQ(string(key))).One(gamedb.StdConn)
 	if err != nil {
@@ -92,6 +92,7 @@ var ItemSaleQueryMods = []qm.QueryMod{
 		fmt.Sprintf(`%s AS "bidder.faction_id"`, qm.Rels(bidderTable, boiler.PlayerColumns.FactionID)),
 		fmt.Sprintf(`%s AS "bidder.public_address"`, qm.Rels(bidderTable, boiler.PlayerColumns.PublicAddress)),
 		fmt.Sprintf(`%s AS "bidder.gid"`, qm.Rels(bidderTable, boiler.PlayerColumns.Gid)),
+		fmt.Sprintf(`%s AS bundle`, qm.Rels(boiler.TableNames.ItemSales, boiler.ItemSaleColumns.Bundle)),
 	),
 	// collection items
 	qm.InnerJoin(
@@ -439,10 +440,20 @@ func MarketplaceItemSale(id uuid.UUID) (*server.MarketplaceSaleItem, error) {
 		&output.LastBid.FactionID,
 		&output.LastBid.PublicAddress,
 		&output.LastBid.Gid,
+		&output.Bundle,
 	)
 	if err != nil {
 		return nil, terror.Error(err)
 	}
+
+	if output.Bundle {
+		bundleItems, err := MarketplaceSaleBundleItems(gamedb.StdConn, output.ID)
+		if err != nil {
+			return nil, err
+		}
+		output.BundleItems = bundleItems[output.ID]
+	}
+
 	return output, nil
 }
 
@@ -509,8 +520,8 @@ type MarketplaceWeaponStatFilter struct {
 	FilterStatSpread              *WeaponStatFilterRange `json:"spread"`
 }
 
-// MarketplaceItemSaleList returns a numeric paginated result of sales list.
-func MarketplaceItemSaleList(
+// marketplaceItemSaleListQueryMods returns the query mods filtering the item sales of the marketplace sales list.
+func marketplaceItemSaleListQueryMods(
 	itemType string,
 	userID string,
 	factionID string,
@@ -523,15 +534,7 @@ func MarketplaceItemSaleList(
 	sold bool,
 	minPrice decimal.NullDecimal,
 	maxPrice decimal.NullDecimal,
-	offset int,
-	pageSize int,
-	sortBy string,
-	sortDir SortByDir,
-) (int64, []*server.MarketplaceSaleItem, error) {
-	if !sortDir.IsValid() {
-		return 0, nil, terror.Error(fmt.Errorf("invalid sort direction"))
-	}
-
+) []qm.QueryMod {
 	queryMods := append(
 		ItemSaleQueryMods,
 		boiler.ItemSaleWhere.DeletedAt.IsNull(),
@@ -539,6 +542,47 @@ func MarketplaceItemSaleList(
 	if factionID != "" {
 		queryMods = append(queryMods, boiler.ItemSaleWhere.FactionID.EQ(factionID))
 	}
+	queryMods = append(queryMods, marketplaceItemSaleFilterQueryMods(
+		itemType,
+		userID,
+		search,
+		rarities,
+		saleTypes,
+		weaponTypes,
+		weaponStats,
+		ownedBy,
+		minPrice,
+		maxPrice,
+	)...)
+
+	if sold {
+		queryMods = append(queryMods, boiler.ItemSaleWhere.SoldAt.IsNotNull())
+	} else {
+		queryMods = append(queryMods,
+			boiler.ItemSaleWhere.SoldAt.IsNull(),
+			boiler.ItemSaleWhere.EndAt.GT(time.Now()),
+			boiler.CollectionItemWhere.XsynLocked.EQ(false),
+			boiler.CollectionItemWhere.MarketLocked.EQ(false),
+		)
+	}
+
+	return queryMods
+}
+
+// marketplaceItemSaleFilterQueryMods returns the where clauses of the filters a player can set on the sales list.
+func marketplaceItemSaleFilterQueryMods(
+	itemType string,
+	userID string,
+	search string,
+	rarities []string,
+	saleTypes []string,
+	weaponTypes []string,
+	weaponStats *MarketplaceWeaponStatFilter,
+	ownedBy []string,
+	minPrice decimal.NullDecimal,
+	maxPrice decimal.NullDecimal,
+) []qm.QueryMod {
+	queryMods := []qm.QueryMod{}
 	if itemType != "" {
 		queryMods = append(queryMods, boiler.CollectionItemWhere.ItemType.EQ(itemType))
 	}
@@ -658,17 +702,6 @@ func MarketplaceItemSaleList(
 			),
 		))
 	}
-	if sold {
-		queryMods = append(queryMods, boiler.ItemSaleWhere.SoldAt.IsNotNull())
-	} else {
-		queryMods = append(queryMods,
-			boiler.ItemSaleWhere.SoldAt.IsNull(),
-			boiler.ItemSaleWhere.EndAt.GT(time.Now()),
-			boiler.CollectionItemWhere.XsynLocked.EQ(false),
-			boiler.CollectionItemWhere.MarketLocked.EQ(false),
-		)
-	}
-
 	// Search
 	if search != "" {
 		xsearch := ParseQueryText(search, true)
@@ -703,6 +736,47 @@ func MarketplaceItemSaleList(
 		}
 	}
 
+	return queryMods
+}
+
+// MarketplaceItemSaleList returns a numeric paginated result of sales list.
+func MarketplaceItemSaleList(
+	itemType string,
+	userID string,
+	factionID string,
+	search string,
+	rarities []string,
+	saleTypes []string,
+	weaponTypes []string,
+	weaponStats *MarketplaceWeaponStatFilter,
+	ownedBy []string,
+	sold bool,
+	minPrice decimal.NullDecimal,
+	maxPrice decimal.NullDecimal,
+	offset int,
+	pageSize int,
+	sortBy string,
+	sortDir SortByDir,
+) (int64, []*server.MarketplaceSaleItem, error) {
+	if !sortDir.IsValid() {
+		return 0, nil, terror.Error(fmt.Errorf("invalid sort direction"))
+	}
+
+	queryMods := marketplaceItemSaleListQueryMods(
+		itemType,
+		userID,
+		factionID,
+		search,
+		rarities,
+		saleTypes,
+		weaponTypes,
+		weaponStats,
+		ownedBy,
+		sold,
+		minPrice,
+		maxPrice,
+	)
+
 	// Get total rows
 	total, err := boiler.ItemSales(queryMods...).Count(gamedb.StdConn)
 	if err != nil {
@@ -759,6 +833,24 @@ func MarketplaceItemSaleList(
 	if err != nil {
 		return 0, nil, terror.Error(err)
 	}
+
+	// Load in bundled items
+	bundleSaleIDs := []string{}
+	for _, r := range records {
+		if r.Bundle {
+			bundleSaleIDs = append(bundleSaleIDs, r.ID)
+		}
+	}
+	if len(bundleSaleIDs) > 0 {
+		bundleItems, err := MarketplaceSaleBundleItems(gamedb.StdConn, bundleSaleIDs...)
+		if err != nil {
+			return 0, nil, err
+		}
+		for _, r := range records {
+			r.BundleItems = bundleItems[r.ID]
+		}
+	}
+
 	return total, records, nil
 }
 
@@ -912,12 +1004,22 @@ func MarketplaceEventList(
 				qm.Rels(boiler.TableNames.MarketplaceEvents, boiler.MarketplaceEventColumns.RelatedSaleItemID),
 			),
 		),
+		// Item Offers
 		qm.LeftOuterJoin(
 			fmt.Sprintf(
 				"%s ON %s = %s",
+				boiler.TableNames.ItemOffers,
+				qm.Rels(boiler.TableNames.ItemOffers, boiler.ItemOfferColumns.ID),
+				qm.Rels(boiler.TableNames.MarketplaceEvents, boiler.MarketplaceEventColumns.RelatedItemOfferID),
+			),
+		),
+		qm.LeftOuterJoin(
+			fmt.Sprintf(
+				"%s ON %s = COALESCE(%s, %s)",
 				boiler.TableNames.CollectionItems,
 				qm.Rels(boiler.TableNames.CollectionItems, boiler.CollectionItemColumns.ID),
 				qm.Rels(boiler.TableNames.ItemSales, boiler.ItemSaleColumns.CollectionItemID),
+				qm.Rels(boiler.TableNames.ItemOffers, boiler.ItemOfferColumns.CollectionItemID),
 			),
 		),
 		qm.LeftOuterJoin(
@@ -978,11 +1080,12 @@ func MarketplaceEventList(
 		// Item Seller owner
 		qm.InnerJoin(
 			fmt.Sprintf(
-				"%s ON %s = COALESCE(%s, %s)",
+				"%s ON %s = COALESCE(%s, %s, %s)",
 				boiler.TableNames.Players,
 				qm.Rels(boiler.TableNames.Players, boiler.PlayerColumns.ID),
 				qm.Rels(boiler.TableNames.ItemSales, boiler.ItemSaleColumns.OwnerID),
 				qm.Rels(boiler.TableNames.ItemKeycardSales, boiler.ItemKeycardSaleColumns.OwnerID),
+				qm.Rels(boiler.TableNames.ItemOffers, boiler.ItemOfferColumns.OwnerID),
 			),
 		),
 
@@ -1114,6 +1217,34 @@ func MarketplaceEventList(
 		return 0, nil, terror.Error(err)
 	}
 
+	// Load in offers, these have no relationship to load them with
+	offerIDs := []string{}
+	for _, r := range records {
+		if r.RelatedItemOfferID.Valid {
+			offerIDs = append(offerIDs, r.RelatedItemOfferID.String)
+		}
+	}
+	offers := map[string]*boiler.ItemOffer{}
+	offerCollectionItems := map[string]*boiler.CollectionItem{}
+	if len(offerIDs) > 0 {
+		offerSlice, err := boiler.ItemOffers(boiler.ItemOfferWhere.ID.IN(offerIDs)).All(gamedb.StdConn)
+		if err != nil {
+			return 0, nil, terror.Error(err)
+		}
+		collectionItemIDs := []string{}
+		for _, o := range offerSlice {
+			offers[o.ID] = o
+			collectionItemIDs = append(collectionItemIDs, o.CollectionItemID)
+		}
+		collectionItems, err := boiler.CollectionItems(boiler.CollectionItemWhere.ID.IN(collectionItemIDs)).All(gamedb.StdConn)
+		if err != nil {
+			return 0, nil, terror.Error(err)
+		}
+		for _, ci := range collectionItems {
+			offerCollectionItems[ci.ID] = ci
+		}
+	}
+
 	// Populate results
 	collectionToMechID := map[string]string{}
 	collectionToMysteryCrateID := map[string]string{}
@@ -1229,6 +1360,43 @@ func MarketplaceEventList(
 				}
 			}
 		}
+
+		if offer, ok := offers[r.RelatedItemOfferID.String]; ok && row.Item == nil {
+			row.Offer = offer
+			if colItem, ok := offerCollectionItems[offer.CollectionItemID]; ok {
+				row.Item = &server.MarketplaceEventItem{
+					ID:                 offer.ID,
+					FactionID:          offer.FactionID,
+					CollectionItemID:   offer.CollectionItemID,
+					CollectionItemType: colItem.ItemType,
+					OwnerID:            offer.OwnerID,
+					EndAt:              offer.ExpiresAt,
+					UpdatedAt:          offer.UpdatedAt,
+					CreatedAt:          offer.CreatedAt,
+					CollectionItem: server.MarketplaceSaleCollectionItem{
+						Hash:         colItem.Hash,
+						Tier:         null.StringFrom(colItem.Tier),
+						XsynLocked:   colItem.XsynLocked,
+						MarketLocked: colItem.MarketLocked,
+					},
+				}
+				if offer.Status == boiler.ItemOfferStatusACCEPTED {
+					row.Item.SoldAt = offer.RespondedAt
+					row.Item.SoldFor = decimal.NewNullDecimal(offer.Amount)
+				}
+				switch colItem.ItemType {
+				case boiler.ItemTypeMech:
+					mechIDs = append(mechIDs, colItem.ItemID)
+					collectionToMechID[colItem.ID] = colItem.ItemID
+				case boiler.ItemTypeMysteryCrate:
+					mysteryCrateIDs = append(mysteryCrateIDs, colItem.ItemID)
+					collectionToMysteryCrateID[colItem.ID] = colItem.ItemID
+				case boiler.ItemTypeWeapon:
+					weaponIDs = append(weaponIDs, colItem.ItemID)
+					collectionToWeaponID[colItem.ID] = colItem.ItemID
+				}
+			}
+		}
 		output = append(output, row)
 	}
 
@@ -1516,7 +1684,7 @@ func MarketplaceSaleCreate(
 	return output, nil
 }
 
-// CancelBidResponse contains the txid and amount on cancelled bids.
+// CancelBidResponse contains the txid and amount held on cancelled bids.
 type CancelBidResponse struct {
 	BidderID  string
 	FactionID null.String
@@ -1534,7 +1702,7 @@ func MarketplaceSaleCancelBids(conn boil.Executor, itemID uuid.UUID, msg string)
 		WHERE b.item_sale_id = $1
 			AND b.cancelled_at IS NULL
 			AND p.id = b.bidder_id
-		RETURNING bidder_id, faction_id, bid_tx_id, bid_price`
+		RETURNING bidder_id, faction_id, bid_tx_id, COALESCE(max_bid_price, bid_price)`
 	rows, err := conn.Query(q, itemID, msg)
 	if err != nil {
 		return nil, terror.Error(err)
@@ -1576,12 +1744,14 @@ func MarketplaceSaleBidHistoryRefund(conn boil.Executor, itemID uuid.UUID, txID,
 }
 
 // MarketplaceSaleBidHistoryCreate inserts a new bid history record.
-func MarketplaceSaleBidHistoryCreate(conn boil.Executor, id uuid.UUID, bidderUserID uuid.UUID, amount decimal.Decimal, txid string) (*boiler.ItemSalesBidHistory, error) {
+// The max bid price is set on proxy bids, where the transaction holds the max bid price rather than the bid price.
+func MarketplaceSaleBidHistoryCreate(conn boil.Executor, id uuid.UUID, bidderUserID uuid.UUID, bidPrice decimal.Decimal, maxBidPrice decimal.NullDecimal, txid string) (*boiler.ItemSalesBidHistory, error) {
 	obj := &boiler.ItemSalesBidHistory{
-		ItemSaleID: id.String(),
-		BidderID:   bidderUserID.String(),
-		BidTXID:    txid,
-		BidPrice:   amount.Mul(decimal.New(1, 18)),
+		ItemSaleID:  id.String(),
+		BidderID:    bidderUserID.String(),
+		BidTXID:     txid,
+		BidPrice:    bidPrice,
+		MaxBidPrice: maxBidPrice,
 	}
 	err := obj.Insert(conn, boil.Infer())
 	if err != nil {
@@ -1726,6 +1896,8 @@ func MarketplaceAddEvent(eventType string, userID string, amount decimal.NullDec
 		obj.RelatedSaleItemKeycardID = null.StringFrom(itemSaleID)
 	} else if table == boiler.TableNames.ItemSales {
 		obj.RelatedSaleItemID = null.StringFrom(itemSaleID)
+	} else if table == boiler.TableNames.ItemOffers {
+		obj.RelatedItemOfferID = null.StringFrom(itemSaleID)
 	} else {
 		return terror.Error(fmt.Errorf("invalid item sale table"))
 	}
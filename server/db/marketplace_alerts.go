@@ -0,0 +1,241 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+
+	"github.com/ninja-software/terror/v2"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// MarketplaceSavedSearchFilter is the part of the marketplace sales list request saved by a player.
+// The json keys match the sales list request so the client can save its current filters as they are.
+type MarketplaceSavedSearchFilter struct {
+	ItemType     string                       `json:"item_type"`
+	Rarities     []string                     `json:"rarities"`
+	ListingTypes []string                     `json:"listing_types"`
+	WeaponTypes  []string                     `json:"weapon_types"`
+	WeaponStats  *MarketplaceWeaponStatFilter `json:"weapon_stats"`
+	MinPrice     decimal.NullDecimal          `json:"min_price"`
+	MaxPrice     decimal.NullDecimal          `json:"max_price"`
+	Search       string                       `json:"search"`
+}
+
+// MarketplaceSavedSearchesMatchingListing returns the ids of the saved searches which the listing shows up in, when the
+// search's filter is applied to its player's sales list. Every search is matched in a single query, and searches whose
+// filter can't be read are left out.
+func MarketplaceSavedSearchesMatchingListing(itemSale *boiler.ItemSale, searches boiler.MarketplaceSavedSearchSlice) (map[string]bool, error) {
+	matched := map[string]bool{}
+
+	searchIDs := []interface{}{}
+	searchConditions := []qm.QueryMod{}
+	for _, search := range searches {
+		filter := &MarketplaceSavedSearchFilter{}
+		err := json.Unmarshal(search.Filter, filter)
+		if err != nil {
+			gamelog.L.Warn().Err(err).Str("saved_search_id", search.ID).Msg("unable to unmarshal saved search filter")
+			continue
+		}
+
+		conditions := []qm.QueryMod{boiler.MarketplaceSavedSearchWhere.ID.EQ(search.ID)}
+		conditions = append(conditions, marketplaceItemSaleFilterQueryMods(
+			filter.ItemType,
+			search.PlayerID,
+			filter.Search,
+			filter.Rarities,
+			filter.ListingTypes,
+			filter.WeaponTypes,
+			filter.WeaponStats,
+			[]string{"others"},
+			filter.MinPrice,
+			filter.MaxPrice,
+		)...)
+
+		searchIDs = append(searchIDs, search.ID)
+		searchConditions = append(searchConditions, qm.Or2(qm.Expr(conditions...)))
+	}
+	if len(searchIDs) == 0 {
+		return matched, nil
+	}
+
+	queryMods := marketplaceItemSaleListQueryMods("", "", itemSale.FactionID, "", nil, nil, nil, nil, nil, false, decimal.NullDecimal{}, decimal.NullDecimal{})
+	queryMods = append(queryMods,
+		boiler.ItemSaleWhere.ID.EQ(itemSale.ID),
+		qm.InnerJoin(fmt.Sprintf("%s ON TRUE", boiler.TableNames.MarketplaceSavedSearches)),
+		qm.WhereIn(boiler.MarketplaceSavedSearchTableColumns.ID+" IN ?", searchIDs...),
+		qm.Expr(searchConditions...),
+	)
+
+	q := boiler.ItemSales(queryMods...)
+	queries.SetSelect(q.Query, []string{boiler.MarketplaceSavedSearchTableColumns.ID})
+	rows, err := q.Query.Query(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		searchID := ""
+		err = rows.Scan(&searchID)
+		if err != nil {
+			return nil, terror.Error(err)
+		}
+		matched[searchID] = true
+	}
+
+	return matched, nil
+}
+
+// MarketplaceSavedSearchesForListing returns the saved searches of the players who can see the listing, other than its owner.
+func MarketplaceSavedSearchesForListing(itemSale *boiler.ItemSale) (boiler.MarketplaceSavedSearchSlice, error) {
+	searches, err := boiler.MarketplaceSavedSearches(
+		qm.InnerJoin(fmt.Sprintf(
+			"%s ON %s = %s",
+			boiler.TableNames.Players,
+			boiler.PlayerTableColumns.ID,
+			boiler.MarketplaceSavedSearchTableColumns.PlayerID,
+		)),
+		boiler.PlayerWhere.FactionID.EQ(null.StringFrom(itemSale.FactionID)),
+		boiler.MarketplaceSavedSearchWhere.PlayerID.NEQ(itemSale.OwnerID),
+		boiler.MarketplaceSavedSearchWhere.DeletedAt.IsNull(),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	return searches, nil
+}
+
+// MarketplaceItemSaleWatchers returns the ids of the players watching a listing, leaving out the given player.
+func MarketplaceItemSaleWatchers(itemSaleID string, excludePlayerID string) ([]string, error) {
+	watches, err := boiler.ItemSaleWatches(
+		qm.Select(boiler.ItemSaleWatchColumns.PlayerID),
+		boiler.ItemSaleWatchWhere.ItemSaleID.EQ(itemSaleID),
+		boiler.ItemSaleWatchWhere.PlayerID.NEQ(excludePlayerID),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+
+	playerIDs := []string{}
+	for _, w := range watches {
+		playerIDs = append(playerIDs, w.PlayerID)
+	}
+	return playerIDs, nil
+}
+
+// MarketplaceItemSaleWatchCount counts the listings a player is watching which are still for sale, leaving out the given listing.
+func MarketplaceItemSaleWatchCount(playerID string, excludeItemSaleID string) (int64, error) {
+	count, err := boiler.ItemSaleWatches(
+		qm.InnerJoin(fmt.Sprintf(
+			"%s ON %s = %s",
+			boiler.TableNames.ItemSales,
+			boiler.ItemSaleTableColumns.ID,
+			boiler.ItemSaleWatchTableColumns.ItemSaleID,
+		)),
+		boiler.ItemSaleWatchWhere.PlayerID.EQ(playerID),
+		boiler.ItemSaleWatchWhere.ItemSaleID.NEQ(excludeItemSaleID),
+		boiler.ItemSaleWhere.SoldAt.IsNull(),
+		boiler.ItemSaleWhere.DeletedAt.IsNull(),
+		qm.Where(boiler.ItemSaleTableColumns.EndAt+" > NOW()"),
+	).Count(gamedb.StdConn)
+	if err != nil {
+		return 0, terror.Error(err)
+	}
+	return count, nil
+}
+
+// MarketplaceItemSaleWatchUpsert adds a listing to a player's watchlist, or updates the dutch auction threshold of an existing watch.
+func MarketplaceItemSaleWatchUpsert(playerID string, itemSaleID string, dutchAuctionThreshold decimal.NullDecimal) (*boiler.ItemSaleWatch, error) {
+	watch := &boiler.ItemSaleWatch{
+		PlayerID:              playerID,
+		ItemSaleID:            itemSaleID,
+		DutchAuctionThreshold: dutchAuctionThreshold,
+	}
+	err := watch.Upsert(
+		gamedb.StdConn,
+		true,
+		[]string{
+			boiler.ItemSaleWatchColumns.PlayerID,
+			boiler.ItemSaleWatchColumns.ItemSaleID,
+		},
+		boil.Whitelist(
+			boiler.ItemSaleWatchColumns.DutchAuctionThreshold,
+			boiler.ItemSaleWatchColumns.DutchAuctionNotifiedAt,
+		),
+		boil.Infer(),
+	)
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	return watch, nil
+}
+
+// MarketplaceWatchedDutchAuctionAlert is a watched dutch auction which has dropped to the watcher's threshold.
+type MarketplaceWatchedDutchAuctionAlert struct {
+	PlayerID   string
+	ItemSaleID string
+	Price      decimal.Decimal
+}
+
+// MarketplaceWatchedDutchAuctionsToAlert returns the watched dutch auctions whose price has dropped to the watcher's threshold,
+// which the watcher has not been alerted about yet.
+func MarketplaceWatchedDutchAuctionsToAlert() ([]*MarketplaceWatchedDutchAuctionAlert, error) {
+	q := `
+		SELECT w.player_id,
+			w.item_sale_id,
+			_s.price
+		FROM item_sale_watches w
+			INNER JOIN LATERAL (
+				SELECT GREATEST(
+					s.buyout_price - s.dutch_auction_drop_rate * FLOOR(EXTRACT(EPOCH FROM NOW() - s.created_at) / 60),
+					COALESCE(s.auction_reserved_price, 1000000000000000000)
+				) AS price
+				FROM item_sales s
+				WHERE s.id = w.item_sale_id
+					AND s.dutch_auction = TRUE
+					AND s.sold_at IS NULL
+					AND s.deleted_at IS NULL
+					AND s.end_at > NOW()
+			) _s ON TRUE
+		WHERE w.dutch_auction_threshold IS NOT NULL
+			AND w.dutch_auction_notified_at IS NULL
+			AND _s.price <= w.dutch_auction_threshold`
+	rows, err := gamedb.StdConn.Query(q)
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	defer rows.Close()
+
+	output := []*MarketplaceWatchedDutchAuctionAlert{}
+	for rows.Next() {
+		alert := &MarketplaceWatchedDutchAuctionAlert{}
+		err := rows.Scan(&alert.PlayerID, &alert.ItemSaleID, &alert.Price)
+		if err != nil {
+			return nil, terror.Error(err)
+		}
+		output = append(output, alert)
+	}
+
+	return output, nil
+}
+
+// MarketplaceWatchDutchAuctionAlerted flags the watcher as alerted about the dutch auction dropping to their threshold.
+func MarketplaceWatchDutchAuctionAlerted(playerID string, itemSaleID string) error {
+	q := `
+		UPDATE item_sale_watches
+		SET dutch_auction_notified_at = NOW()
+		WHERE player_id = $1
+			AND item_sale_id = $2`
+	_, err := gamedb.StdConn.Exec(q, playerID, itemSaleID)
+	if err != nil {
+		return terror.Error(err)
+	}
+	return nil
+}
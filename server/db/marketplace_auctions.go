@@ -0,0 +1,156 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"server"
+	"server/db/boiler"
+	"server/gamedb"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/ninja-software/terror/v2"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// MarketplaceAuctionBidIncrement returns the amount proxy bids are raised by when outbidding another bid.
+func MarketplaceAuctionBidIncrement() decimal.Decimal {
+	return GetDecimalWithDefault(KeyMarketplaceAuctionBidIncrement, decimal.NewFromInt(1)).Mul(decimal.New(1, 18))
+}
+
+// MarketplaceSaleActiveBid returns the bid currently leading an auction, nil if there are no bids.
+func MarketplaceSaleActiveBid(conn boil.Executor, itemSaleID string) (*boiler.ItemSalesBidHistory, error) {
+	bid, err := boiler.ItemSalesBidHistories(
+		boiler.ItemSalesBidHistoryWhere.ItemSaleID.EQ(itemSaleID),
+		boiler.ItemSalesBidHistoryWhere.CancelledAt.IsNull(),
+		boiler.ItemSalesBidHistoryWhere.RefundBidTXID.IsNull(),
+		qm.OrderBy(boiler.ItemSalesBidHistoryColumns.BidAt+" DESC"),
+	).One(conn)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	return bid, nil
+}
+
+// MarketplaceSaleBidRaise raises the price of a proxy bid, up to its max bid price.
+func MarketplaceSaleBidRaise(conn boil.Executor, itemSaleID string, txID string, bidPrice decimal.Decimal) error {
+	q := `
+		UPDATE item_sales_bid_history
+		SET bid_price = LEAST($3, max_bid_price)
+		WHERE item_sale_id = $1
+			AND bid_tx_id = $2
+			AND max_bid_price IS NOT NULL
+			AND cancelled_at IS NULL`
+	_, err := conn.Exec(q, itemSaleID, txID, bidPrice)
+	if err != nil {
+		return terror.Error(err)
+	}
+	return nil
+}
+
+// MarketplaceSaleAuctionSoftClose pushes out the end of an auction when a bid is placed near its end.
+// Returns the new end of the auction, null if it was not extended.
+func MarketplaceSaleAuctionSoftClose(conn boil.Executor, itemSaleID string) (null.Time, error) {
+	window := GetIntWithDefault(KeyMarketplaceAuctionSoftCloseMinutes, 5)
+	extend := GetIntWithDefault(KeyMarketplaceAuctionSoftCloseExtendMinutes, 5)
+
+	endAt := time.Time{}
+	now := time.Time{}
+	q := `SELECT end_at, NOW() FROM item_sales WHERE id = $1 FOR UPDATE`
+	err := conn.QueryRow(q, itemSaleID).Scan(&endAt, &now)
+	if err != nil {
+		return null.Time{}, terror.Error(err)
+	}
+
+	extendedEndAt, ok := server.AuctionSoftCloseEndAt(endAt, now, time.Duration(window)*time.Minute, time.Duration(extend)*time.Minute)
+	if !ok {
+		return null.Time{}, nil
+	}
+
+	q = `
+		UPDATE item_sales
+		SET end_at = $2,
+			updated_at = NOW()
+		WHERE id = $1`
+	_, err = conn.Exec(q, itemSaleID, extendedEndAt)
+	if err != nil {
+		return null.Time{}, terror.Error(err)
+	}
+	return null.TimeFrom(extendedEndAt), nil
+}
+
+// MarketplaceSaleBidExcessRefund adds in the transaction returning what the winning bid held above its final price.
+func MarketplaceSaleBidExcessRefund(conn boil.Executor, itemSaleID string, txID string, refundTxID string) error {
+	q := `
+		UPDATE item_sales_bid_history
+		SET excess_refund_tx_id = $3
+		WHERE item_sale_id = $1
+			AND bid_tx_id = $2`
+	_, err := conn.Exec(q, itemSaleID, txID, refundTxID)
+	if err != nil {
+		return terror.Error(err)
+	}
+	return nil
+}
+
+// MarketplaceWinningBidsWithExcess returns the winning proxy bids that still hold more than the price the auction was won at.
+func MarketplaceWinningBidsWithExcess() (boiler.ItemSalesBidHistorySlice, error) {
+	bids, err := boiler.ItemSalesBidHistories(
+		qm.InnerJoin(fmt.Sprintf(
+			"%[1]s ON %[2]s = %[3]s AND %[4]s = %[5]s AND %[6]s IS NOT NULL",
+			boiler.TableNames.ItemSales,
+			boiler.ItemSaleTableColumns.ID,
+			boiler.ItemSalesBidHistoryTableColumns.ItemSaleID,
+			boiler.ItemSaleTableColumns.SoldTo,
+			boiler.ItemSalesBidHistoryTableColumns.BidderID,
+			boiler.ItemSaleTableColumns.SoldTXID,
+		)),
+		boiler.ItemSalesBidHistoryWhere.CancelledAt.IsNull(),
+		boiler.ItemSalesBidHistoryWhere.RefundBidTXID.IsNull(),
+		boiler.ItemSalesBidHistoryWhere.ExcessRefundTXID.IsNull(),
+		qm.Where(fmt.Sprintf(
+			"%s > %s",
+			boiler.ItemSalesBidHistoryTableColumns.MaxBidPrice,
+			boiler.ItemSalesBidHistoryTableColumns.BidPrice,
+		)),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	return bids, nil
+}
+
+// MarketplaceOpenAuctions returns the auctions which have not ended yet.
+func MarketplaceOpenAuctions() (boiler.ItemSaleSlice, error) {
+	auctions, err := boiler.ItemSales(
+		qm.Select(
+			boiler.ItemSaleColumns.ID,
+			boiler.ItemSaleColumns.EndAt,
+		),
+		boiler.ItemSaleWhere.Auction.EQ(true),
+		boiler.ItemSaleWhere.SoldTo.IsNull(),
+		boiler.ItemSaleWhere.DeletedAt.IsNull(),
+		qm.Where(boiler.ItemSaleColumns.EndAt+" > NOW()"),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	return auctions, nil
+}
+
+// MarketplaceAuctionEndsIn returns how long until an auction end by the database clock, which the auction close
+// query checks the end against.
+func MarketplaceAuctionEndsIn(endAt time.Time) (time.Duration, error) {
+	seconds := 0.0
+	err := gamedb.StdConn.QueryRow(`SELECT EXTRACT(EPOCH FROM $1::TIMESTAMPTZ - NOW())`, endAt).Scan(&seconds)
+	if err != nil {
+		return 0, terror.Error(err)
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
@@ -0,0 +1,223 @@
+package db
+
+import (
+	"fmt"
+	"server"
+	"server/db/boiler"
+	"server/gamedb"
+
+	"github.com/lib/pq"
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// MarketplaceSaleBundleCollectionItemAdd adds a collection item to a bundle listing.
+func MarketplaceSaleBundleCollectionItemAdd(conn boil.Executor, itemSaleID string, collectionItemID string) error {
+	obj := &boiler.ItemSaleBundleItem{
+		ItemSaleID:       itemSaleID,
+		CollectionItemID: null.StringFrom(collectionItemID),
+	}
+	err := obj.Insert(conn, boil.Infer())
+	if err != nil {
+		return terror.Error(err)
+	}
+	return nil
+}
+
+// MarketplaceSaleBundleKeycardAdd takes keycards off the player and adds them to a bundle listing.
+func MarketplaceSaleBundleKeycardAdd(conn boil.Executor, itemSaleID string, playerID string, playerKeycardID string, count int) error {
+	q := `
+		UPDATE player_keycards
+		SET count = count - $3
+		WHERE id = $1
+			AND player_id = $2
+			AND count >= $3`
+	result, err := conn.Exec(q, playerKeycardID, playerID, count)
+	if err != nil {
+		return terror.Error(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return terror.Error(err)
+	}
+	if affected == 0 {
+		return terror.Error(fmt.Errorf("not enough keycards"), "You do not have enough keycards to list.")
+	}
+
+	obj := &boiler.ItemSaleBundleItem{
+		ItemSaleID:      itemSaleID,
+		PlayerKeycardID: null.StringFrom(playerKeycardID),
+		KeycardCount:    count,
+	}
+	err = obj.Insert(conn, boil.Infer())
+	if err != nil {
+		return terror.Error(err)
+	}
+	return nil
+}
+
+// MarketplaceSaleBundleItemsUnreleased returns the items of a bundle listing which are still held by the listing.
+func MarketplaceSaleBundleItemsUnreleased(conn boil.Executor, itemSaleID string) (boiler.ItemSaleBundleItemSlice, error) {
+	items, err := boiler.ItemSaleBundleItems(
+		boiler.ItemSaleBundleItemWhere.ItemSaleID.EQ(itemSaleID),
+		boiler.ItemSaleBundleItemWhere.ReleasedAt.IsNull(),
+		qm.OrderBy(boiler.ItemSaleBundleItemColumns.CreatedAt),
+	).All(conn)
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	return items, nil
+}
+
+// MarketplaceSaleBundleItemsRelease flags all the items of a bundle listing as no longer held by the listing.
+func MarketplaceSaleBundleItemsRelease(conn boil.Executor, itemSaleID string) error {
+	q := `
+		UPDATE item_sale_bundle_items
+		SET released_at = NOW()
+		WHERE item_sale_id = $1
+			AND released_at IS NULL`
+	_, err := conn.Exec(q, itemSaleID)
+	if err != nil {
+		return terror.Error(err)
+	}
+	return nil
+}
+
+// MarketplaceSaleBundleItems returns the other items sold with each of the given bundle listings, keyed by item sale id.
+func MarketplaceSaleBundleItems(conn boil.Executor, itemSaleIDs ...string) (map[string][]*server.MarketplaceSaleBundleItem, error) {
+	q := `
+		SELECT b.item_sale_id,
+			b.id,
+			b.collection_item_id,
+			COALESCE(ci.item_type::TEXT, 'keycard'),
+			COALESCE(ci.item_id, b.player_keycard_id),
+			ci.hash,
+			ci.tier,
+			bk.label,
+			b.keycard_count
+		FROM item_sale_bundle_items b
+			LEFT JOIN collection_items ci ON ci.id = b.collection_item_id
+			LEFT JOIN player_keycards pk ON pk.id = b.player_keycard_id
+			LEFT JOIN blueprint_keycards bk ON bk.id = pk.blueprint_keycard_id
+		WHERE b.item_sale_id = ANY($1)
+		ORDER BY b.created_at`
+	rows, err := conn.Query(q, pq.Array(itemSaleIDs))
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	defer rows.Close()
+
+	output := map[string][]*server.MarketplaceSaleBundleItem{}
+	for rows.Next() {
+		itemSaleID := ""
+		item := &server.MarketplaceSaleBundleItem{}
+		err := rows.Scan(
+			&itemSaleID,
+			&item.ID,
+			&item.CollectionItemID,
+			&item.ItemType,
+			&item.ItemID,
+			&item.Hash,
+			&item.Tier,
+			&item.Label,
+			&item.KeycardCount,
+		)
+		if err != nil {
+			return nil, terror.Error(err)
+		}
+		output[itemSaleID] = append(output[itemSaleID], item)
+	}
+
+	return output, nil
+}
+
+// MarketplaceBundlesToRelease returns the ids of bundle listings which have been archived, or ended without
+// being sold, that still hold items.
+func MarketplaceBundlesToRelease() ([]string, error) {
+	q := `
+		SELECT _s.id
+		FROM item_sales _s
+		WHERE _s.bundle = TRUE
+			AND _s.sold_to IS NULL
+			AND (
+				_s.deleted_at IS NOT NULL
+				OR (
+					_s.end_at <= NOW()
+					AND NOT EXISTS (
+						SELECT 1
+						FROM item_sales_bid_history _b
+						WHERE _b.item_sale_id = _s.id
+							AND _b.cancelled_at IS NULL
+							AND _b.refund_bid_tx_id IS NULL
+					)
+				)
+			)
+			AND EXISTS (
+				SELECT 1
+				FROM item_sale_bundle_items _bi
+				WHERE _bi.item_sale_id = _s.id
+					AND _bi.released_at IS NULL
+			)`
+	rows, err := gamedb.StdConn.Query(q)
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	defer rows.Close()
+
+	output := []string{}
+	for rows.Next() {
+		id := ""
+		err := rows.Scan(&id)
+		if err != nil {
+			return nil, terror.Error(err)
+		}
+		output = append(output, id)
+	}
+
+	return output, nil
+}
+
+// AddPlayerKeycardCount gives the player a number of keycards of the given blueprint.
+func AddPlayerKeycardCount(conn boil.Executor, playerID string, blueprintKeycardID string, count int) error {
+	q := `
+		INSERT INTO player_keycards (player_id, blueprint_keycard_id, count)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (player_id, blueprint_keycard_id)
+		DO UPDATE
+		SET count = player_keycards.count + $3`
+	_, err := conn.Exec(q, playerID, blueprintKeycardID, count)
+	if err != nil {
+		return terror.Error(err)
+	}
+	return nil
+}
+
+// CollectionItemEquippedOn returns the id of the item an asset is equipped on, if any.
+func CollectionItemEquippedOn(conn boil.Executor, itemType string, itemID string) (null.String, error) {
+	table := ""
+	switch itemType {
+	case boiler.ItemTypeWeapon:
+		table = boiler.TableNames.Weapons
+	case boiler.ItemTypeWeaponSkin:
+		table = boiler.TableNames.WeaponSkin
+	case boiler.ItemTypeUtility:
+		table = boiler.TableNames.Utility
+	case boiler.ItemTypeMechSkin:
+		table = boiler.TableNames.MechSkin
+	case boiler.ItemTypeMechAnimation:
+		table = boiler.TableNames.MechAnimation
+	case boiler.ItemTypePowerCore:
+		table = boiler.TableNames.PowerCores
+	default:
+		return null.String{}, nil
+	}
+
+	equippedOn := null.String{}
+	err := conn.QueryRow(fmt.Sprintf(`SELECT equipped_on FROM %s WHERE id = $1`, table), itemID).Scan(&equippedOn)
+	if err != nil {
+		return null.String{}, terror.Error(err)
+	}
+	return equippedOn, nil
+}
@@ -0,0 +1,173 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"server/db/boiler"
+	"server/gamedb"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/ninja-software/terror/v2"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// MarketplaceItemOfferExpiry returns when an offer made now expires
+func MarketplaceItemOfferExpiry() time.Time {
+	hours := GetIntWithDefault(KeyMarketplaceOfferExpiryHours, 72)
+	return time.Now().Add(time.Duration(hours) * time.Hour)
+}
+
+// MarketplaceItemOfferCreate inserts a new pending offer
+func MarketplaceItemOfferCreate(conn boil.Executor, colItem *boiler.CollectionItem, factionID string, offererID string, amount decimal.Decimal, txID null.String, counterOfferOf null.String) (*boiler.ItemOffer, error) {
+	obj := &boiler.ItemOffer{
+		FactionID:        factionID,
+		CollectionItemID: colItem.ID,
+		OwnerID:          colItem.OwnerID,
+		OffererID:        offererID,
+		CounterOfferOf:   counterOfferOf,
+		Amount:           amount,
+		OfferTXID:        txID,
+		Status:           boiler.ItemOfferStatusPENDING,
+		ExpiresAt:        MarketplaceItemOfferExpiry(),
+	}
+	err := obj.Insert(conn, boil.Infer())
+	if err != nil {
+		return nil, terror.Error(err, "Failed to create offer.")
+	}
+	return obj, nil
+}
+
+// MarketplaceItemOffer returns an offer
+func MarketplaceItemOffer(conn boil.Executor, offerID string) (*boiler.ItemOffer, error) {
+	offer, err := boiler.FindItemOffer(conn, offerID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, terror.Error(err, "Offer not found.")
+	}
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load offer.")
+	}
+	return offer, nil
+}
+
+// MarketplaceItemOfferClaim moves an offer from one status to another.
+// It returns false when the offer is no longer in the expected status, so an offer is only ever processed once.
+func MarketplaceItemOfferClaim(conn boil.Executor, offerID string, fromStatus string, toStatus string) (bool, error) {
+	q := fmt.Sprintf(`
+		UPDATE %[1]s
+		SET %[2]s = $3,
+			%[3]s = CASE WHEN $3 = '%[5]s' THEN NULL ELSE NOW() END,
+			%[4]s = NOW()
+		WHERE id = $1
+			AND %[2]s = $2`,
+		boiler.TableNames.ItemOffers,
+		boiler.ItemOfferColumns.Status,
+		boiler.ItemOfferColumns.RespondedAt,
+		boiler.ItemOfferColumns.UpdatedAt,
+		boiler.ItemOfferStatusPENDING,
+	)
+	result, err := conn.Exec(q, offerID, fromStatus, toStatus)
+	if err != nil {
+		return false, terror.Error(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, terror.Error(err)
+	}
+	return affected > 0, nil
+}
+
+// MarketplaceItemOfferRefund adds in refund details to a specific offer.
+func MarketplaceItemOfferRefund(conn boil.Executor, offerID string, txID, refundTxID string) error {
+	q := fmt.Sprintf(`
+		UPDATE %[1]s
+		SET %[2]s = $3, %[4]s = NOW()
+		WHERE id = $1
+			AND %[3]s = $2`,
+		boiler.TableNames.ItemOffers,
+		boiler.ItemOfferColumns.RefundTXID,
+		boiler.ItemOfferColumns.OfferTXID,
+		boiler.ItemOfferColumns.UpdatedAt,
+	)
+	_, err := conn.Exec(q, offerID, txID, refundTxID)
+	if err != nil {
+		return terror.Error(err)
+	}
+	return nil
+}
+
+// MarketplaceItemOfferList returns the pending offers made to and by the player, newest first
+func MarketplaceItemOfferList(playerID string) (boiler.ItemOfferSlice, boiler.ItemOfferSlice, error) {
+	offers, err := boiler.ItemOffers(
+		boiler.ItemOfferWhere.Status.EQ(boiler.ItemOfferStatusPENDING),
+		boiler.ItemOfferWhere.ExpiresAt.GT(time.Now()),
+		qm.Expr(
+			boiler.ItemOfferWhere.OwnerID.EQ(playerID),
+			qm.Or2(boiler.ItemOfferWhere.OffererID.EQ(playerID)),
+		),
+		qm.OrderBy(boiler.ItemOfferColumns.CreatedAt+" DESC"),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, nil, terror.Error(err, "Failed to load offers.")
+	}
+
+	incoming := boiler.ItemOfferSlice{}
+	outgoing := boiler.ItemOfferSlice{}
+	for _, offer := range offers {
+		// a counter offer is made by the owner, so it is incoming for the offerer
+		isIncoming := offer.OwnerID == playerID
+		if offer.CounterOfferOf.Valid {
+			isIncoming = !isIncoming
+		}
+
+		if isIncoming {
+			incoming = append(incoming, offer)
+			continue
+		}
+		outgoing = append(outgoing, offer)
+	}
+
+	return incoming, outgoing, nil
+}
+
+// MarketplaceItemOffersToClose returns the pending offers which have expired, or whose item no longer
+// belongs to the player the offer was made to. Closed offers whose refund previously failed are also returned.
+func MarketplaceItemOffersToClose() (boiler.ItemOfferSlice, error) {
+	offers, err := boiler.ItemOffers(
+		qm.Where(fmt.Sprintf(
+			`(
+				%[1]s = ?
+				AND (
+					%[2]s <= NOW()
+					OR NOT EXISTS (SELECT 1 FROM %[3]s _ci WHERE _ci.id = %[4]s AND _ci.owner_id = %[5]s)
+				)
+			) OR (
+				%[1]s IN (?, ?, ?, ?)
+				AND %[6]s IS NOT NULL
+				AND %[7]s IS NULL
+				AND %[8]s < NOW() - INTERVAL '1 MINUTE'
+			)`,
+			boiler.ItemOfferTableColumns.Status,
+			boiler.ItemOfferTableColumns.ExpiresAt,
+			boiler.TableNames.CollectionItems,
+			boiler.ItemOfferTableColumns.CollectionItemID,
+			boiler.ItemOfferTableColumns.OwnerID,
+			boiler.ItemOfferTableColumns.OfferTXID,
+			boiler.ItemOfferTableColumns.RefundTXID,
+			boiler.ItemOfferTableColumns.UpdatedAt,
+		),
+			boiler.ItemOfferStatusPENDING,
+			boiler.ItemOfferStatusREJECTED,
+			boiler.ItemOfferStatusCOUNTERED,
+			boiler.ItemOfferStatusCANCELLED,
+			boiler.ItemOfferStatusEXPIRED,
+		),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	return offers, nil
+}
@@ -0,0 +1,327 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"server"
+	"server/gamedb"
+	"sort"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/lib/pq"
+	"github.com/ninja-software/terror/v2"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+)
+
+const (
+	PriceHistoryBucketHour = "hour"
+	PriceHistoryBucketDay  = "day"
+	PriceHistoryBucketWeek = "week"
+)
+
+func IsValidPriceHistoryBucket(bucket string) bool {
+	switch bucket {
+	case PriceHistoryBucketHour, PriceHistoryBucketDay, PriceHistoryBucketWeek:
+		return true
+	}
+	return false
+}
+
+// MarketplacePriceFilter picks out the sales of assets of the same kind, by the skin and tier they had when sold.
+// Keycards are filtered by their blueprint only.
+type MarketplacePriceFilter struct {
+	ItemType        string      `json:"item_type"`
+	BlueprintID     string      `json:"blueprint_id"`
+	SkinBlueprintID null.String `json:"skin_blueprint_id"`
+	Tier            null.String `json:"tier"`
+}
+
+func (f *MarketplacePriceFilter) conditions(args []interface{}) (string, []interface{}) {
+	args = append(args, f.ItemType, f.BlueprintID)
+	conditions := fmt.Sprintf("item_type = $%d AND blueprint_id = $%d", len(args)-1, len(args))
+	if f.SkinBlueprintID.Valid {
+		args = append(args, f.SkinBlueprintID.String)
+		conditions += fmt.Sprintf(" AND skin_blueprint_id = $%d", len(args))
+	}
+	if f.Tier.Valid {
+		args = append(args, f.Tier.String)
+		conditions += fmt.Sprintf(" AND tier = $%d", len(args))
+	}
+	return conditions, args
+}
+
+// MarketplacePriceHistory returns the floor, median and volume of the completed sales matching the filter, grouped into time buckets.
+func MarketplacePriceHistory(filter *MarketplacePriceFilter, bucket string, from time.Time) ([]*server.MarketplacePriceHistoryBucket, error) {
+	if !IsValidPriceHistoryBucket(bucket) {
+		return nil, terror.Error(fmt.Errorf("invalid bucket: %s", bucket), "Invalid price history interval.")
+	}
+
+	conditions, args := filter.conditions([]interface{}{bucket, from})
+	q := fmt.Sprintf(`
+		SELECT date_trunc($1, sold_at) AS bucket_at,
+			MIN(sold_for),
+			percentile_disc(0.5) WITHIN GROUP (ORDER BY sold_for),
+			MAX(sold_for),
+			COUNT(*)
+		FROM marketplace_sold_items
+		WHERE sold_at >= $2
+			AND %s
+		GROUP BY bucket_at
+		ORDER BY bucket_at`,
+		conditions,
+	)
+	rows, err := gamedb.StdConn.Query(q, args...)
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	defer rows.Close()
+
+	output := []*server.MarketplacePriceHistoryBucket{}
+	for rows.Next() {
+		b := &server.MarketplacePriceHistoryBucket{}
+		err := rows.Scan(&b.BucketAt, &b.Floor, &b.Median, &b.Ceiling, &b.Volume)
+		if err != nil {
+			return nil, terror.Error(err)
+		}
+		output = append(output, b)
+	}
+
+	return output, nil
+}
+
+// MarketplaceSuggestedPrice returns the median price the assets matching the filter recently sold at, along with the number of sales it is based on.
+// When nothing matching the skin or tier has sold, all the sales of the blueprint are used.
+func MarketplaceSuggestedPrice(filter *MarketplacePriceFilter) (decimal.NullDecimal, int64, error) {
+	conditions, args := filter.conditions([]interface{}{})
+	q := fmt.Sprintf(`
+		SELECT percentile_disc(0.5) WITHIN GROUP (ORDER BY sold_for),
+			COUNT(*)
+		FROM marketplace_sold_items
+		WHERE sold_at >= NOW() - INTERVAL '%d DAY'
+			AND %s`,
+		GetIntWithDefault(KeyMarketplacePriceHistoryDays, 30),
+		conditions,
+	)
+	price := decimal.NullDecimal{}
+	volume := int64(0)
+	err := gamedb.StdConn.QueryRow(q, args...).Scan(&price, &volume)
+	if err != nil {
+		return price, 0, terror.Error(err)
+	}
+
+	if volume == 0 && (filter.SkinBlueprintID.Valid || filter.Tier.Valid) {
+		return MarketplaceSuggestedPrice(&MarketplacePriceFilter{
+			ItemType:    filter.ItemType,
+			BlueprintID: filter.BlueprintID,
+		})
+	}
+
+	return price, volume, nil
+}
+
+// MarketplaceCollectionItemPriceFilter returns the filter matching the sales of assets of the same kind as the collection item.
+func MarketplaceCollectionItemPriceFilter(collectionItemID string) (*MarketplacePriceFilter, error) {
+	q := `
+		SELECT item_type,
+			blueprint_id,
+			skin_blueprint_id,
+			tier
+		FROM collection_item_blueprints
+		WHERE collection_item_id = $1`
+	blueprintID := null.String{}
+	filter := &MarketplacePriceFilter{}
+	err := gamedb.StdConn.QueryRow(q, collectionItemID).Scan(
+		&filter.ItemType,
+		&blueprintID,
+		&filter.SkinBlueprintID,
+		&filter.Tier,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !blueprintID.Valid {
+		return nil, terror.Error(fmt.Errorf("item type %s has no price history", filter.ItemType), "Item is not sold on the marketplace.")
+	}
+	filter.BlueprintID = blueprintID.String
+	return filter, nil
+}
+
+// MarketplaceKeycardPriceFilter returns the filter matching the sales of the same keycard as the player keycard.
+func MarketplaceKeycardPriceFilter(playerKeycardID string) (*MarketplacePriceFilter, error) {
+	filter := &MarketplacePriceFilter{
+		ItemType: "keycard",
+	}
+	err := gamedb.StdConn.QueryRow(`SELECT blueprint_keycard_id FROM player_keycards WHERE id = $1`, playerKeycardID).Scan(&filter.BlueprintID)
+	if err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+type assetValuationKey struct {
+	itemType        string
+	blueprintID     string
+	skinBlueprintID null.String
+	tier            null.String
+}
+
+// MarketplacePlayerValuation estimates the value of the assets a player owns from what they recently sold for on the marketplace.
+func MarketplacePlayerValuation(playerID string) (*server.MarketplacePortfolioValuation, error) {
+	// Owned assets, weapons locked to a mech are part of the mech's value
+	q := `
+		SELECT _cib.item_type,
+			_cib.blueprint_id,
+			_cib.skin_blueprint_id,
+			_cib.tier,
+			COUNT(*)
+		FROM collection_item_blueprints _cib
+			INNER JOIN collection_items _ci ON _ci.id = _cib.collection_item_id
+			LEFT JOIN weapons _w ON _cib.item_type = 'weapon' AND _w.id = _ci.item_id
+			LEFT JOIN mystery_crate _mc ON _cib.item_type = 'mystery_crate' AND _mc.id = _ci.item_id
+		WHERE _ci.owner_id = $1
+			AND _cib.blueprint_id IS NOT NULL
+			AND (_w.id IS NULL OR _w.locked_to_mech = FALSE)
+			AND (_mc.id IS NULL OR _mc.opened = FALSE)
+		GROUP BY _cib.item_type, _cib.blueprint_id, _cib.skin_blueprint_id, _cib.tier
+		UNION ALL
+		SELECT 'keycard',
+			_pk.blueprint_keycard_id,
+			NULL,
+			NULL,
+			SUM(_pk.count)
+		FROM player_keycards _pk
+		WHERE _pk.player_id = $1
+			AND _pk.count > 0
+		GROUP BY _pk.blueprint_keycard_id`
+	rows, err := gamedb.StdConn.Query(q, playerID)
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	defer rows.Close()
+
+	output := &server.MarketplacePortfolioValuation{
+		Total:  decimal.Zero,
+		Assets: []*server.MarketplaceAssetValuation{},
+	}
+	blueprintIDs := []string{}
+	for rows.Next() {
+		asset := &server.MarketplaceAssetValuation{}
+		err := rows.Scan(
+			&asset.ItemType,
+			&asset.BlueprintID,
+			&asset.SkinBlueprintID,
+			&asset.Tier,
+			&asset.Count,
+		)
+		if err != nil {
+			return nil, terror.Error(err)
+		}
+		output.Assets = append(output.Assets, asset)
+		blueprintIDs = append(blueprintIDs, asset.BlueprintID)
+	}
+	if len(output.Assets) == 0 {
+		return output, nil
+	}
+
+	// Prices of the owned assets, along with the price of their blueprint when the exact kind has not sold recently
+	q = fmt.Sprintf(`
+		SELECT item_type,
+			blueprint_id,
+			skin_blueprint_id,
+			tier,
+			GROUPING(skin_blueprint_id, tier) = 0,
+			percentile_disc(0.5) WITHIN GROUP (ORDER BY sold_for),
+			COUNT(*)
+		FROM marketplace_sold_items
+		WHERE sold_at >= NOW() - INTERVAL '%d DAY'
+			AND blueprint_id = ANY($1::UUID[])
+		GROUP BY GROUPING SETS ((item_type, blueprint_id, skin_blueprint_id, tier), (item_type, blueprint_id))`,
+		GetIntWithDefault(KeyMarketplacePriceHistoryDays, 30),
+	)
+	priceRows, err := gamedb.StdConn.Query(q, pq.Array(blueprintIDs))
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	defer priceRows.Close()
+
+	exactPrices := map[assetValuationKey]*server.MarketplaceAssetValuation{}
+	blueprintPrices := map[assetValuationKey]*server.MarketplaceAssetValuation{}
+	for priceRows.Next() {
+		key := assetValuationKey{}
+		exact := false
+		price := &server.MarketplaceAssetValuation{}
+		err := priceRows.Scan(
+			&key.itemType,
+			&key.blueprintID,
+			&key.skinBlueprintID,
+			&key.tier,
+			&exact,
+			&price.Price,
+			&price.Volume,
+		)
+		if err != nil {
+			return nil, terror.Error(err)
+		}
+		if exact {
+			exactPrices[key] = price
+			continue
+		}
+		blueprintPrices[key] = price
+	}
+
+	for _, asset := range output.Assets {
+		key := assetValuationKey{
+			itemType:        asset.ItemType,
+			blueprintID:     asset.BlueprintID,
+			skinBlueprintID: asset.SkinBlueprintID,
+			tier:            asset.Tier,
+		}
+		price, ok := exactPrices[key]
+		if !ok {
+			price, ok = blueprintPrices[assetValuationKey{itemType: asset.ItemType, blueprintID: asset.BlueprintID}]
+		}
+		asset.Value = decimal.Zero
+		if !ok || !price.Price.Valid {
+			continue
+		}
+		asset.Price = price.Price
+		asset.Volume = price.Volume
+		asset.Value = price.Price.Decimal.Mul(decimal.NewFromInt(asset.Count))
+		output.Total = output.Total.Add(asset.Value)
+	}
+
+	sort.SliceStable(output.Assets, func(i, j int) bool {
+		return output.Assets[i].Value.GreaterThan(output.Assets[j].Value)
+	})
+
+	return output, nil
+}
+
+// MarketplaceSuggestedPriceForItem returns the suggested listing price of a collection item or player keycard.
+func MarketplaceSuggestedPriceForItem(itemType string, itemID string) (*MarketplacePriceFilter, decimal.NullDecimal, int64, error) {
+	var filter *MarketplacePriceFilter
+	var err error
+	if itemType == "keycard" {
+		filter, err = MarketplaceKeycardPriceFilter(itemID)
+	} else {
+		collectionItemID := ""
+		err = gamedb.StdConn.QueryRow(`SELECT id FROM collection_items WHERE item_type = $1 AND item_id = $2`, itemType, itemID).Scan(&collectionItemID)
+		if err == nil {
+			filter, err = MarketplaceCollectionItemPriceFilter(collectionItemID)
+		}
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, decimal.NullDecimal{}, 0, terror.Error(err, "Item not found.")
+	}
+	if err != nil {
+		return nil, decimal.NullDecimal{}, 0, terror.Error(err)
+	}
+
+	price, volume, err := MarketplaceSuggestedPrice(filter)
+	if err != nil {
+		return nil, decimal.NullDecimal{}, 0, err
+	}
+	return filter, price, volume, nil
+}
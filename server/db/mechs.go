@@ -635,6 +635,11 @@ func Mech(conn boil.Executor, mechID string) (*server.Mech, error) {
 	}
 	mc.CompatibleBlueprintMechSkinIDs = compatibleSkins
 
+	mc.Rating, err = MechRating(conn, mc.ID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find rating for mech with id %s", mc.ID)
+	}
+
 	if mc.ChassisSkin.Images == nil {
 		mc.ChassisSkin.Images = mc.Images
 	}
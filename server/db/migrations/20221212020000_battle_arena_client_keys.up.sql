@@ -0,0 +1,12 @@
+CREATE TABLE battle_arena_client_keys
+(
+    id              UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    battle_arena_id UUID        NOT NULL REFERENCES battle_arena (id),
+    label           TEXT        NOT NULL DEFAULT '',
+    secret          TEXT        NOT NULL,
+    last_used_at    TIMESTAMPTZ,
+    revoked_at      TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_battle_arena_client_keys_arena_search ON battle_arena_client_keys (battle_arena_id, revoked_at);
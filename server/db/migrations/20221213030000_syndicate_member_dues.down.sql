@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS syndicate_member_dues;
+DROP TABLE IF EXISTS syndicate_dues_cycles;
+DROP TYPE IF EXISTS SYNDICATE_MEMBER_DUES_STATUS;
@@ -0,0 +1,37 @@
+CREATE TYPE SYNDICATE_MEMBER_DUES_STATUS AS ENUM ('PENDING', 'PAID', 'OVERDUE', 'DEFAULTED', 'CANCELLED');
+
+CREATE TABLE syndicate_dues_cycles
+(
+    id             UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    syndicate_id   UUID             NOT NULL REFERENCES syndicates (id),
+    billing_period TIMESTAMPTZ      NOT NULL,
+    charge_at      TIMESTAMPTZ      NOT NULL,
+    amount         NUMERIC(28),
+    reminded_at    TIMESTAMPTZ,
+    charged_at     TIMESTAMPTZ,
+    created_at     TIMESTAMPTZ      NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_syndicate_dues_cycles_period ON syndicate_dues_cycles (syndicate_id, billing_period);
+
+CREATE TABLE syndicate_member_dues
+(
+    id                    UUID PRIMARY KEY             NOT NULL DEFAULT gen_random_uuid(),
+    cycle_id              UUID                         NOT NULL REFERENCES syndicate_dues_cycles (id),
+    syndicate_id          UUID                         NOT NULL REFERENCES syndicates (id),
+    player_id             UUID                         NOT NULL REFERENCES players (id),
+    amount                NUMERIC(28)                  NOT NULL,
+    status                SYNDICATE_MEMBER_DUES_STATUS NOT NULL DEFAULT 'PENDING',
+    attempt_count         INT                          NOT NULL DEFAULT 0,
+    last_attempted_at     TIMESTAMPTZ,
+    grace_ends_at         TIMESTAMPTZ,
+    paid_at               TIMESTAMPTZ,
+    transaction_reference TEXT,
+    note                  TEXT,
+    created_at            TIMESTAMPTZ                  NOT NULL DEFAULT NOW(),
+    updated_at            TIMESTAMPTZ                  NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_syndicate_member_dues_cycle_player ON syndicate_member_dues (cycle_id, player_id);
+CREATE INDEX IF NOT EXISTS idx_syndicate_member_dues_syndicate_status ON syndicate_member_dues (syndicate_id, status);
+CREATE INDEX IF NOT EXISTS idx_syndicate_member_dues_player ON syndicate_member_dues (player_id, created_at DESC);
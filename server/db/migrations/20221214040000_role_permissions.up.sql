@@ -0,0 +1,31 @@
+CREATE TABLE role_permissions
+(
+    role_id    UUID        NOT NULL REFERENCES roles (id),
+    permission TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (role_id, permission)
+);
+
+-- admins hold every permission
+INSERT INTO role_permissions (role_id, permission)
+SELECT r.id, p.permission
+FROM roles r,
+     UNNEST(ARRAY [
+         'RoleList', 'RoleCreate', 'RoleRead', 'RoleUpdate', 'RoleArchive', 'RoleUnarchive', 'RoleAssign',
+         'UserList', 'UserCreate', 'UserRead', 'UserUpdate', 'UserArchive', 'UserUnarchive', 'UserForceDisconnect',
+         'UserBan', 'UserUnban', 'UserAssetRead',
+         'OrganisationList', 'OrganisationCreate', 'OrganisationRead', 'OrganisationUpdate', 'OrganisationArchive', 'OrganisationUnarchive',
+         'ProductList', 'ProductCreate', 'ProductRead', 'ProductUpdate', 'ProductArchive', 'ProductUnarchive',
+         'MechRename', 'ServerRestart',
+         'AdminPortal', 'ImpersonateUser', 'UserActivityList'
+         ]) AS p(permission)
+WHERE r.role_type = 'ADMIN';
+
+-- moderators keep access to the mod tools, except restarting the server
+INSERT INTO role_permissions (role_id, permission)
+SELECT r.id, p.permission
+FROM roles r,
+     UNNEST(ARRAY [
+         'UserRead', 'UserUpdate', 'UserBan', 'UserUnban', 'UserAssetRead', 'UserActivityList', 'MechRename'
+         ]) AS p(permission)
+WHERE r.role_type = 'MODERATOR';
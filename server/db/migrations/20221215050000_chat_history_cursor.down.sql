@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_chat_history_stream_cursor;
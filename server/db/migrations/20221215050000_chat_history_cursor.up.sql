@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS idx_chat_history_stream_cursor ON chat_history (chat_stream, created_at DESC, id DESC);
@@ -0,0 +1 @@
+-- enum values cannot be removed from chat_msg_type_enum
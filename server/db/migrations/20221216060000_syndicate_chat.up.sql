@@ -0,0 +1 @@
+ALTER TYPE chat_msg_type_enum ADD VALUE 'SYNDICATE_NOTICE';
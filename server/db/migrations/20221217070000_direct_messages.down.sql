@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS player_blocks;
+DROP TABLE IF EXISTS direct_messages;
+DROP TABLE IF EXISTS direct_message_conversation_members;
+DROP TABLE IF EXISTS direct_message_conversations;
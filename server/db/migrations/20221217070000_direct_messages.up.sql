@@ -0,0 +1,44 @@
+CREATE TABLE direct_message_conversations
+(
+    id              UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    player_a_id     UUID        NOT NULL REFERENCES players (id),
+    player_b_id     UUID        NOT NULL REFERENCES players (id),
+    last_message_at TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- the player ids are sorted, so a pair of players only has a single conversation
+    CHECK (player_a_id < player_b_id),
+    UNIQUE (player_a_id, player_b_id)
+);
+
+CREATE TABLE direct_message_conversation_members
+(
+    conversation_id UUID        NOT NULL REFERENCES direct_message_conversations (id),
+    player_id       UUID        NOT NULL REFERENCES players (id),
+    last_read_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    muted_at        TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (conversation_id, player_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_direct_message_conversation_members_player ON direct_message_conversation_members (player_id);
+
+CREATE TABLE direct_messages
+(
+    id              UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    conversation_id UUID        NOT NULL REFERENCES direct_message_conversations (id),
+    sender_id       UUID        NOT NULL REFERENCES players (id),
+    message         TEXT        NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_direct_messages_conversation_cursor ON direct_messages (conversation_id, created_at DESC, id DESC);
+
+CREATE TABLE player_blocks
+(
+    player_id         UUID        NOT NULL REFERENCES players (id),
+    blocked_player_id UUID        NOT NULL REFERENCES players (id),
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (player_id, blocked_player_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_player_blocks_blocked_player ON player_blocks (blocked_player_id);
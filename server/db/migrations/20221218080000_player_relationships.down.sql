@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS player_follows;
+DROP TABLE IF EXISTS player_friends;
+DROP TABLE IF EXISTS player_friend_requests;
+DROP TYPE IF EXISTS friend_request_status_enum;
@@ -0,0 +1,36 @@
+DROP TYPE IF EXISTS friend_request_status_enum;
+CREATE TYPE friend_request_status_enum AS ENUM ('PENDING', 'ACCEPTED', 'DECLINED', 'CANCELLED');
+
+CREATE TABLE player_friend_requests
+(
+    id           UUID PRIMARY KEY           NOT NULL DEFAULT gen_random_uuid(),
+    sender_id    UUID                       NOT NULL REFERENCES players (id),
+    receiver_id  UUID                       NOT NULL REFERENCES players (id),
+    status       friend_request_status_enum NOT NULL DEFAULT 'PENDING',
+    responded_at TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ                NOT NULL DEFAULT NOW(),
+    CHECK (sender_id != receiver_id)
+);
+
+-- a player can only have one pending request to another player
+CREATE UNIQUE INDEX IF NOT EXISTS idx_player_friend_requests_pending ON player_friend_requests (sender_id, receiver_id) WHERE status = 'PENDING';
+CREATE INDEX IF NOT EXISTS idx_player_friend_requests_receiver ON player_friend_requests (receiver_id) WHERE status = 'PENDING';
+
+-- friendships are stored in both directions
+CREATE TABLE player_friends
+(
+    player_id  UUID        NOT NULL REFERENCES players (id),
+    friend_id  UUID        NOT NULL REFERENCES players (id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (player_id, friend_id)
+);
+
+CREATE TABLE player_follows
+(
+    follower_id UUID        NOT NULL REFERENCES players (id),
+    followed_id UUID        NOT NULL REFERENCES players (id),
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (follower_id, followed_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_player_follows_followed ON player_follows (followed_id);
@@ -0,0 +1,5 @@
+DELETE FROM role_permissions WHERE permission = 'CrateDropRateRead';
+
+DROP TABLE IF EXISTS mystery_crate_rolls;
+DROP TABLE IF EXISTS mystery_crate_seeds;
+DROP TABLE IF EXISTS mystery_crate_drop_rates;
@@ -0,0 +1,46 @@
+-- published drop tables, entries are never updated so past rolls can always be verified
+CREATE TABLE mystery_crate_drop_rates
+(
+    id                          UUID PRIMARY KEY   NOT NULL DEFAULT gen_random_uuid(),
+    storefront_mystery_crate_id UUID               NOT NULL REFERENCES storefront_mystery_crates (id),
+    slot                        TEXT               NOT NULL,
+    blueprint_type              TEMPLATE_ITEM_TYPE NOT NULL,
+    blueprint_id                UUID               NOT NULL,
+    weight                      INT                NOT NULL CHECK (weight > 0),
+    deleted_at                  TIMESTAMPTZ,
+    created_at                  TIMESTAMPTZ        NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_mystery_crate_drop_rates_storefront_crate ON mystery_crate_drop_rates (storefront_mystery_crate_id, slot);
+
+-- the seed hash is committed at purchase and the seed is revealed on open
+CREATE TABLE mystery_crate_seeds
+(
+    mystery_crate_id            UUID PRIMARY KEY NOT NULL REFERENCES mystery_crate (id),
+    storefront_mystery_crate_id UUID             NOT NULL REFERENCES storefront_mystery_crates (id),
+    server_seed                 TEXT             NOT NULL,
+    server_seed_hash            TEXT             NOT NULL,
+    committed_at                TIMESTAMPTZ      NOT NULL DEFAULT NOW(),
+    revealed_at                 TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_mystery_crate_seeds_storefront_crate ON mystery_crate_seeds (storefront_mystery_crate_id);
+
+CREATE TABLE mystery_crate_rolls
+(
+    mystery_crate_id UUID        NOT NULL REFERENCES mystery_crate (id),
+    slot             TEXT        NOT NULL,
+    roll             BIGINT      NOT NULL, -- roll modulo the total weight
+    total_weight     INT         NOT NULL,
+    drop_rate_id     UUID        NOT NULL REFERENCES mystery_crate_drop_rates (id),
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (mystery_crate_id, slot)
+);
+
+CREATE INDEX IF NOT EXISTS idx_mystery_crate_rolls_drop_rate ON mystery_crate_rolls (drop_rate_id);
+
+INSERT INTO role_permissions (role_id, permission)
+SELECT r.id, 'CrateDropRateRead'
+FROM roles r
+WHERE r.role_type = 'ADMIN'
+ON CONFLICT DO NOTHING;
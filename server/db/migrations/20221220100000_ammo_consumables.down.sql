@@ -0,0 +1,21 @@
+DROP TABLE IF EXISTS battle_weapon_ammo_usages;
+
+DROP INDEX IF EXISTS idx_weapon_ammo_weapon_id;
+
+ALTER TABLE weapon_ammo
+    DROP CONSTRAINT IF EXISTS weapon_ammo_count_check;
+
+ALTER TABLE ammo
+    DROP CONSTRAINT IF EXISTS ammo_count_check;
+
+ALTER TABLE blueprint_ammo
+    DROP COLUMN IF EXISTS sups_cost,
+    ALTER COLUMN damage_multiplier SET DEFAULT 0,
+    ALTER COLUMN damage_falloff_multiplier SET DEFAULT 0,
+    ALTER COLUMN damage_falloff_rate_multiplier SET DEFAULT 0,
+    ALTER COLUMN spread_multiplier SET DEFAULT 0,
+    ALTER COLUMN rate_of_fire_multiplier SET DEFAULT 0,
+    ALTER COLUMN radius_multiplier SET DEFAULT 0,
+    ALTER COLUMN projectile_speed_multiplier SET DEFAULT 0,
+    ALTER COLUMN energy_cost_multiplier SET DEFAULT 0,
+    ALTER COLUMN max_ammo_multiplier SET DEFAULT 0;
@@ -0,0 +1,44 @@
+-- ammo multipliers default to 0, which would zero the stats of the weapon it is loaded into
+UPDATE blueprint_ammo SET damage_multiplier = 1 WHERE damage_multiplier IS NULL OR damage_multiplier = 0;
+UPDATE blueprint_ammo SET damage_falloff_multiplier = 1 WHERE damage_falloff_multiplier IS NULL OR damage_falloff_multiplier = 0;
+UPDATE blueprint_ammo SET damage_falloff_rate_multiplier = 1 WHERE damage_falloff_rate_multiplier IS NULL OR damage_falloff_rate_multiplier = 0;
+UPDATE blueprint_ammo SET spread_multiplier = 1 WHERE spread_multiplier IS NULL OR spread_multiplier = 0;
+UPDATE blueprint_ammo SET rate_of_fire_multiplier = 1 WHERE rate_of_fire_multiplier IS NULL OR rate_of_fire_multiplier = 0;
+UPDATE blueprint_ammo SET radius_multiplier = 1 WHERE radius_multiplier IS NULL OR radius_multiplier = 0;
+UPDATE blueprint_ammo SET projectile_speed_multiplier = 1 WHERE projectile_speed_multiplier IS NULL OR projectile_speed_multiplier = 0;
+UPDATE blueprint_ammo SET energy_cost_multiplier = 1 WHERE energy_cost_multiplier IS NULL OR energy_cost_multiplier = 0;
+UPDATE blueprint_ammo SET max_ammo_multiplier = 1 WHERE max_ammo_multiplier IS NULL OR max_ammo_multiplier = 0;
+
+ALTER TABLE blueprint_ammo
+    ALTER COLUMN damage_multiplier SET DEFAULT 1,
+    ALTER COLUMN damage_falloff_multiplier SET DEFAULT 1,
+    ALTER COLUMN damage_falloff_rate_multiplier SET DEFAULT 1,
+    ALTER COLUMN spread_multiplier SET DEFAULT 1,
+    ALTER COLUMN rate_of_fire_multiplier SET DEFAULT 1,
+    ALTER COLUMN radius_multiplier SET DEFAULT 1,
+    ALTER COLUMN projectile_speed_multiplier SET DEFAULT 1,
+    ALTER COLUMN energy_cost_multiplier SET DEFAULT 1,
+    ALTER COLUMN max_ammo_multiplier SET DEFAULT 1,
+    -- ammo is only sold in the store when it has a price
+    ADD COLUMN sups_cost NUMERIC(28);
+
+ALTER TABLE ammo
+    ADD CONSTRAINT ammo_count_check CHECK ( count >= 0 );
+
+-- a weapon is loaded with a single type of ammo
+ALTER TABLE weapon_ammo
+    ADD CONSTRAINT weapon_ammo_count_check CHECK ( count >= 0 );
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_weapon_ammo_weapon_id ON weapon_ammo (weapon_id);
+
+-- the shots reported by the game client at the end of the battle, and the ammo it consumed
+CREATE TABLE battle_weapon_ammo_usages
+(
+    battle_id         UUID        NOT NULL REFERENCES battles (id),
+    weapon_id         UUID        NOT NULL REFERENCES weapons (id),
+    blueprint_ammo_id UUID        NOT NULL REFERENCES blueprint_ammo (id),
+    shots_fired       INT         NOT NULL,
+    ammo_consumed     INT         NOT NULL,
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (battle_id, weapon_id)
+);
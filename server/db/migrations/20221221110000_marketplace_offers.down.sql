@@ -0,0 +1,5 @@
+ALTER TABLE marketplace_events
+    DROP COLUMN IF EXISTS related_item_offer_id;
+
+DROP TABLE IF EXISTS item_offers;
+DROP TYPE IF EXISTS ITEM_OFFER_STATUS;
@@ -0,0 +1,37 @@
+ALTER TYPE MARKETPLACE_EVENT ADD VALUE IF NOT EXISTS 'offer';
+ALTER TYPE MARKETPLACE_EVENT ADD VALUE IF NOT EXISTS 'offer_received';
+ALTER TYPE MARKETPLACE_EVENT ADD VALUE IF NOT EXISTS 'offer_refund';
+ALTER TYPE MARKETPLACE_EVENT ADD VALUE IF NOT EXISTS 'offer_accepted';
+ALTER TYPE MARKETPLACE_EVENT ADD VALUE IF NOT EXISTS 'offer_rejected';
+ALTER TYPE MARKETPLACE_EVENT ADD VALUE IF NOT EXISTS 'offer_countered';
+
+DROP TYPE IF EXISTS ITEM_OFFER_STATUS;
+CREATE TYPE ITEM_OFFER_STATUS AS ENUM ('PENDING', 'ACCEPTED', 'REJECTED', 'COUNTERED', 'CANCELLED', 'EXPIRED');
+
+-- offers made on collection items, listed or not.
+-- the sups of an offer are held in the faction account until it is accepted or refunded.
+-- a counter offer is made by the owner, and is paid by the offerer when they accept it.
+CREATE TABLE item_offers
+(
+    id                 UUID PRIMARY KEY           DEFAULT gen_random_uuid(),
+    faction_id         UUID              NOT NULL REFERENCES factions (id),
+    collection_item_id UUID              NOT NULL REFERENCES collection_items (id),
+    owner_id           UUID              NOT NULL REFERENCES players (id),
+    offerer_id         UUID              NOT NULL REFERENCES players (id),
+    counter_offer_of   UUID REFERENCES item_offers (id),
+    amount             NUMERIC(28)       NOT NULL CHECK ( amount > 0 ),
+    offer_tx_id        TEXT,
+    refund_tx_id       TEXT,
+    status             ITEM_OFFER_STATUS NOT NULL DEFAULT 'PENDING',
+    item_sale_id       UUID REFERENCES item_sales (id),
+    expires_at         TIMESTAMPTZ       NOT NULL,
+    responded_at       TIMESTAMPTZ,
+    updated_at         TIMESTAMPTZ       NOT NULL DEFAULT NOW(),
+    created_at         TIMESTAMPTZ       NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_item_offers_collection_item_id ON item_offers (collection_item_id);
+CREATE INDEX IF NOT EXISTS idx_item_offers_pending_expires_at ON item_offers (expires_at) WHERE status = 'PENDING';
+
+ALTER TABLE marketplace_events
+    ADD COLUMN related_item_offer_id UUID REFERENCES item_offers (id);
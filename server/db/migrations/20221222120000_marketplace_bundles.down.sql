@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS item_sale_bundle_items;
+
+ALTER TABLE item_sales
+    DROP COLUMN IF EXISTS bundle;
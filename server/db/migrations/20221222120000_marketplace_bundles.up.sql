@@ -0,0 +1,21 @@
+ALTER TABLE item_sales
+    ADD COLUMN bundle BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- the other items sold with a bundle listing, the lead item stays on item_sales.collection_item_id.
+-- items are released when the bundle is sold, cancelled or expires.
+CREATE TABLE item_sale_bundle_items
+(
+    id                 UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    item_sale_id       UUID        NOT NULL REFERENCES item_sales (id),
+    collection_item_id UUID REFERENCES collection_items (id),
+    player_keycard_id  UUID REFERENCES player_keycards (id),
+    keycard_count      INT         NOT NULL DEFAULT 0,
+    released_at        TIMESTAMPTZ,
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (
+        (collection_item_id IS NOT NULL AND player_keycard_id IS NULL)
+        OR (collection_item_id IS NULL AND player_keycard_id IS NOT NULL AND keycard_count > 0)
+    )
+);
+
+CREATE INDEX IF NOT EXISTS idx_item_sale_bundle_items_item_sale_id ON item_sale_bundle_items (item_sale_id);
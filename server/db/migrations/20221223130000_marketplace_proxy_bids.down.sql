@@ -0,0 +1,3 @@
+ALTER TABLE item_sales_bid_history
+    DROP COLUMN IF EXISTS excess_refund_tx_id,
+    DROP COLUMN IF EXISTS max_bid_price;
@@ -0,0 +1,5 @@
+-- max_bid_price is set on proxy bids. The bid transaction escrows the max bid price, while bid_price is the price the
+-- bid currently stands at. Whatever the winning bidder escrowed above the final price is sent back to them.
+ALTER TABLE item_sales_bid_history
+    ADD COLUMN max_bid_price DECIMAL CHECK (max_bid_price IS NULL OR max_bid_price >= bid_price),
+    ADD COLUMN excess_refund_tx_id TEXT;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_item_keycard_sales_sold_at;
+DROP INDEX IF EXISTS idx_item_sales_sold_at;
+
+DROP VIEW IF EXISTS marketplace_sold_items;
+DROP VIEW IF EXISTS collection_item_blueprints;
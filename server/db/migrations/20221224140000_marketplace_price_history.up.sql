@@ -0,0 +1,44 @@
+-- resolves collection items to the blueprint they were made from, along with the skin they currently have equipped
+CREATE OR REPLACE VIEW collection_item_blueprints AS
+SELECT _ci.id                                                       AS collection_item_id,
+       _ci.item_type::TEXT                                          AS item_type,
+       COALESCE(_m.blueprint_id, _w.blueprint_id, _mc.blueprint_id) AS blueprint_id,
+       COALESCE(_ms.blueprint_id, _ws.blueprint_id)                 AS skin_blueprint_id,
+       _ci.tier                                                     AS tier
+FROM collection_items _ci
+         LEFT JOIN mechs _m ON _ci.item_type = 'mech' AND _m.id = _ci.item_id
+         LEFT JOIN mech_skin _ms ON _ms.id = _m.chassis_skin_id
+         LEFT JOIN weapons _w ON _ci.item_type = 'weapon' AND _w.id = _ci.item_id
+         LEFT JOIN weapon_skin _ws ON _ws.id = _w.equipped_weapon_skin_id
+         LEFT JOIN mystery_crate _mc ON _ci.item_type = 'mystery_crate' AND _mc.id = _ci.item_id;
+
+-- completed marketplace sales, used to build price history.
+-- bundles are left out as their price covers several items.
+CREATE OR REPLACE VIEW marketplace_sold_items AS
+SELECT _s.id                  AS item_sale_id,
+       _cib.item_type         AS item_type,
+       _cib.blueprint_id      AS blueprint_id,
+       _cib.skin_blueprint_id AS skin_blueprint_id,
+       _cib.tier              AS tier,
+       _s.sold_for            AS sold_for,
+       _s.sold_at             AS sold_at
+FROM item_sales _s
+         INNER JOIN collection_item_blueprints _cib ON _cib.collection_item_id = _s.collection_item_id
+WHERE _s.sold_at IS NOT NULL
+  AND _s.sold_for IS NOT NULL
+  AND _s.bundle = FALSE
+UNION ALL
+SELECT _ks.id                   AS item_sale_id,
+       'keycard'                AS item_type,
+       _pk.blueprint_keycard_id AS blueprint_id,
+       NULL::UUID               AS skin_blueprint_id,
+       NULL::TEXT               AS tier,
+       _ks.sold_for             AS sold_for,
+       _ks.sold_at              AS sold_at
+FROM item_keycard_sales _ks
+         INNER JOIN player_keycards _pk ON _pk.id = _ks.item_id
+WHERE _ks.sold_at IS NOT NULL
+  AND _ks.sold_for IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_item_sales_sold_at ON item_sales (sold_at) WHERE sold_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_item_keycard_sales_sold_at ON item_keycard_sales (sold_at) WHERE sold_at IS NOT NULL;
@@ -0,0 +1,7 @@
+ALTER TABLE player_settings_preferences
+    DROP COLUMN IF EXISTS enable_marketplace_ws_notifications,
+    DROP COLUMN IF EXISTS enable_marketplace_telegram_notifications,
+    DROP COLUMN IF EXISTS enable_marketplace_system_message_notifications;
+
+DROP TABLE IF EXISTS marketplace_saved_searches;
+DROP TABLE IF EXISTS item_sale_watches;
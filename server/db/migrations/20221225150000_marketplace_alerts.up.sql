@@ -0,0 +1,31 @@
+-- sale items players are watching, dutch auction watchers can be alerted once the price drops to their threshold
+CREATE TABLE item_sale_watches
+(
+    player_id                 UUID        NOT NULL REFERENCES players (id),
+    item_sale_id              UUID        NOT NULL REFERENCES item_sales (id),
+    dutch_auction_threshold   NUMERIC(28) CHECK ( dutch_auction_threshold > 0 ),
+    dutch_auction_notified_at TIMESTAMPTZ,
+    created_at                TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (player_id, item_sale_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_item_sale_watches_item_sale_id ON item_sale_watches (item_sale_id);
+
+-- saved marketplace sales list filters, players are alerted when a new listing matches one
+CREATE TABLE marketplace_saved_searches
+(
+    id         UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    player_id  UUID        NOT NULL REFERENCES players (id),
+    name       TEXT        NOT NULL,
+    filter     JSONB       NOT NULL,
+    deleted_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_marketplace_saved_searches_player_id ON marketplace_saved_searches (player_id) WHERE deleted_at IS NULL;
+
+ALTER TABLE player_settings_preferences
+    ADD COLUMN enable_marketplace_system_message_notifications BOOLEAN NOT NULL DEFAULT TRUE,
+    ADD COLUMN enable_marketplace_telegram_notifications       BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN enable_marketplace_ws_notifications             BOOLEAN NOT NULL DEFAULT TRUE;
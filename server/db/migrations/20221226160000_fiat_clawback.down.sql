@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS order_state_histories;
+DROP TABLE IF EXISTS order_item_assets;
+
+DROP INDEX IF EXISTS idx_faction_pass_purchase_logs_stripe_payment_intent;
+DROP INDEX IF EXISTS idx_orders_stripe_payment_intent;
+DROP INDEX IF EXISTS idx_orders_txn_reference;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS stripe_payment_intent_id;
+
+-- enum values cannot be dropped, orders keep the disputed and chargeback statuses
@@ -0,0 +1,44 @@
+ALTER TYPE ORDER_STATUSES ADD VALUE IF NOT EXISTS 'disputed';
+ALTER TYPE ORDER_STATUSES ADD VALUE IF NOT EXISTS 'chargeback';
+
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS stripe_payment_intent_id TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_orders_txn_reference ON orders (txn_reference);
+CREATE INDEX IF NOT EXISTS idx_orders_stripe_payment_intent ON orders (stripe_payment_intent_id);
+CREATE INDEX IF NOT EXISTS idx_faction_pass_purchase_logs_stripe_payment_intent ON faction_pass_purchase_logs (stripe_payment_intent_id);
+
+-- assets delivered by an order, including the contents of delivered crates once they are opened
+CREATE TABLE order_item_assets
+(
+    id               UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    order_item_id    UUID             NOT NULL REFERENCES order_items (id),
+    item_type        TEXT             NOT NULL,
+    item_id          UUID             NOT NULL,
+    mystery_crate_id UUID REFERENCES mystery_crate (id), -- the delivered crate the asset was opened from
+    status           TEXT             NOT NULL DEFAULT 'delivered' CHECK (status IN ('delivered', 'locked', 'revoked')),
+    updated_at       TIMESTAMPTZ      NOT NULL DEFAULT NOW(),
+    created_at       TIMESTAMPTZ      NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_item_assets_order_item ON order_item_assets (order_item_id);
+CREATE INDEX IF NOT EXISTS idx_order_item_assets_item ON order_item_assets (item_type, item_id);
+
+-- audit trail of every step taken on an order or faction pass purchase after payment
+CREATE TABLE order_state_histories
+(
+    id                           UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    order_id                     UUID REFERENCES orders (id),
+    faction_pass_purchase_log_id UUID REFERENCES faction_pass_purchase_logs (id),
+    action                       TEXT             NOT NULL,
+    from_status                  TEXT,
+    to_status                    TEXT,
+    stripe_event_id              TEXT,
+    note                         TEXT             NOT NULL DEFAULT '',
+    created_at                   TIMESTAMPTZ      NOT NULL DEFAULT NOW(),
+    CHECK (order_id IS NOT NULL OR faction_pass_purchase_log_id IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_state_histories_order ON order_state_histories (order_id);
+CREATE INDEX IF NOT EXISTS idx_order_state_histories_faction_pass_purchase ON order_state_histories (faction_pass_purchase_log_id);
+CREATE INDEX IF NOT EXISTS idx_order_state_histories_stripe_event ON order_state_histories (stripe_event_id);
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS coupon_events;
+DROP TABLE IF EXISTS fiat_gifts;
+
+ALTER TABLE shopping_cart_items
+    DROP COLUMN IF EXISTS gift_recipient_id,
+    DROP COLUMN IF EXISTS gift_code;
+
+-- enum values cannot be dropped, coupon items keep the fiat product and faction pass types
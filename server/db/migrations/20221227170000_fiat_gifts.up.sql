@@ -0,0 +1,38 @@
+ALTER TYPE COUPON_ITEM_TYPE ADD VALUE IF NOT EXISTS 'FIAT_PRODUCT';
+ALTER TYPE COUPON_ITEM_TYPE ADD VALUE IF NOT EXISTS 'FACTION_PASS';
+
+-- cart items bought for another player, or as a redeemable gift code
+ALTER TABLE shopping_cart_items
+    ADD COLUMN IF NOT EXISTS gift_recipient_id UUID REFERENCES players (id),
+    ADD COLUMN IF NOT EXISTS gift_code         BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- store products and faction passes bought for someone else, either delivered to the recipient or as a gift code
+CREATE TABLE fiat_gifts
+(
+    id                           UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    purchased_by_id              UUID             NOT NULL REFERENCES players (id),
+    recipient_id                 UUID REFERENCES players (id),
+    order_item_id                UUID REFERENCES order_items (id),
+    faction_pass_purchase_log_id UUID REFERENCES faction_pass_purchase_logs (id),
+    coupon_id                    UUID REFERENCES coupons (id),
+    created_at                   TIMESTAMPTZ      NOT NULL DEFAULT NOW(),
+    CHECK (recipient_id IS NOT NULL OR coupon_id IS NOT NULL),
+    CHECK (order_item_id IS NOT NULL OR faction_pass_purchase_log_id IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_fiat_gifts_order_item ON fiat_gifts (order_item_id);
+CREATE INDEX IF NOT EXISTS idx_fiat_gifts_faction_pass_purchase_log ON fiat_gifts (faction_pass_purchase_log_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_fiat_gifts_coupon ON fiat_gifts (coupon_id);
+
+-- audit trail of coupon codes being created, redeemed, rejected and revoked
+CREATE TABLE coupon_events
+(
+    id         UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    coupon_id  UUID             NOT NULL REFERENCES coupons (id),
+    event      TEXT             NOT NULL CHECK (event IN ('created', 'redeemed', 'rejected', 'revoked')),
+    player_id  UUID REFERENCES players (id),
+    note       TEXT             NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ      NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_coupon_events_coupon ON coupon_events (coupon_id);
@@ -0,0 +1,20 @@
+DELETE FROM role_permissions WHERE permission IN ('CouponList', 'CouponCreate', 'CouponUpdate');
+
+DROP TABLE IF EXISTS player_discounts;
+
+ALTER TABLE coupon_items
+    DROP COLUMN IF EXISTS blueprint_type,
+    DROP COLUMN IF EXISTS discount_target,
+    DROP COLUMN IF EXISTS discount_percentage;
+
+ALTER TABLE coupons
+    DROP COLUMN IF EXISTS label,
+    DROP COLUMN IF EXISTS faction_id,
+    DROP COLUMN IF EXISTS starts_at,
+    DROP COLUMN IF EXISTS max_uses,
+    DROP COLUMN IF EXISTS max_uses_per_player,
+    DROP COLUMN IF EXISTS uses,
+    DROP COLUMN IF EXISTS disabled_at,
+    DROP COLUMN IF EXISTS created_by_id;
+
+-- enum values cannot be dropped, coupon items keep the blueprint and discount types
@@ -0,0 +1,50 @@
+ALTER TYPE COUPON_ITEM_TYPE ADD VALUE IF NOT EXISTS 'BLUEPRINT';
+ALTER TYPE COUPON_ITEM_TYPE ADD VALUE IF NOT EXISTS 'DISCOUNT';
+
+-- admin defined codes, which can be redeemed more than once and be limited to a faction and a time window
+ALTER TABLE coupons
+    ADD COLUMN IF NOT EXISTS label               TEXT    NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS faction_id          UUID REFERENCES factions (id),
+    ADD COLUMN IF NOT EXISTS starts_at           TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS max_uses            INT DEFAULT 1 CHECK (max_uses > 0),
+    ADD COLUMN IF NOT EXISTS max_uses_per_player INT     NOT NULL DEFAULT 1 CHECK (max_uses_per_player > 0),
+    ADD COLUMN IF NOT EXISTS uses                INT     NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS disabled_at         TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS created_by_id       UUID REFERENCES players (id);
+
+UPDATE coupons
+SET uses = 1
+WHERE redeemed = TRUE;
+
+-- BLUEPRINT items grant "amount" of the blueprint "item_id",
+-- FACTION_PASS items grant the faction pass "item_id", or "amount" days when there is no pass,
+-- DISCOUNT items grant a discount of "discount_percentage" or "amount" cents (fiat) / wei (sups)
+ALTER TABLE coupon_items
+    ADD COLUMN IF NOT EXISTS blueprint_type      TEXT CHECK (blueprint_type IN ('mech', 'weapon', 'mech_skin', 'weapon_skin', 'power_core', 'player_ability', 'keycard')),
+    ADD COLUMN IF NOT EXISTS discount_target     TEXT CHECK (discount_target IN ('fiat', 'sups')),
+    ADD COLUMN IF NOT EXISTS discount_percentage NUMERIC(5, 2) CHECK (discount_percentage > 0 AND discount_percentage <= 100);
+
+-- discounts players have redeemed, used once on a fiat checkout or a sups store purchase
+CREATE TABLE player_discounts
+(
+    id             UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    player_id      UUID             NOT NULL REFERENCES players (id),
+    coupon_id      UUID             NOT NULL REFERENCES coupons (id),
+    target         TEXT             NOT NULL CHECK (target IN ('fiat', 'sups')),
+    percentage     NUMERIC(5, 2),
+    amount         NUMERIC(28),
+    expires_at     TIMESTAMPTZ      NOT NULL,
+    used_at        TIMESTAMPTZ,
+    used_reference TEXT,
+    created_at     TIMESTAMPTZ      NOT NULL DEFAULT NOW(),
+    CHECK (percentage IS NOT NULL OR amount IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_player_discounts_player ON player_discounts (player_id, used_at);
+
+INSERT INTO role_permissions (role_id, permission)
+SELECT r.id, p.permission
+FROM roles r
+         CROSS JOIN (VALUES ('CouponList'), ('CouponCreate'), ('CouponUpdate')) p(permission)
+WHERE r.role_type = 'ADMIN'
+ON CONFLICT DO NOTHING;
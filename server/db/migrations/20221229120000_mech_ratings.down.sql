@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS battle_rating_changes;
+DROP TABLE IF EXISTS player_ratings;
+DROP TABLE IF EXISTS mech_ratings;
+
+ALTER TABLE battle_lobbies
+    DROP COLUMN IF EXISTS is_private;
@@ -0,0 +1,45 @@
+-- the access code of a lobby is cleared once it is ready, so whether it was private is kept for rating the battle
+ALTER TABLE battle_lobbies
+    ADD COLUMN IF NOT EXISTS is_private BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE battle_lobbies
+SET is_private = TRUE
+WHERE access_code IS NOT NULL;
+
+CREATE TABLE mech_ratings
+(
+    mech_id       UUID PRIMARY KEY NOT NULL REFERENCES mechs (id),
+    rating        NUMERIC(10, 2)   NOT NULL DEFAULT 1500,
+    battles_rated INT              NOT NULL DEFAULT 0,
+    updated_at    TIMESTAMPTZ      NOT NULL DEFAULT NOW(),
+    created_at    TIMESTAMPTZ      NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_mech_ratings_rating ON mech_ratings (rating DESC);
+
+CREATE TABLE player_ratings
+(
+    player_id     UUID PRIMARY KEY NOT NULL REFERENCES players (id),
+    rating        NUMERIC(10, 2)   NOT NULL DEFAULT 1500,
+    battles_rated INT              NOT NULL DEFAULT 0,
+    updated_at    TIMESTAMPTZ      NOT NULL DEFAULT NOW(),
+    created_at    TIMESTAMPTZ      NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_player_ratings_rating ON player_ratings (rating DESC);
+
+-- rating changes of each rated battle, which also stops a battle from being rated twice
+CREATE TABLE battle_rating_changes
+(
+    battle_id           UUID           NOT NULL REFERENCES battles (id),
+    mech_id             UUID           NOT NULL REFERENCES mechs (id),
+    piloted_by_id       UUID           NOT NULL REFERENCES players (id),
+    faction_id          UUID           NOT NULL REFERENCES factions (id),
+    placement           INT            NOT NULL,
+    mech_rating_before  NUMERIC(10, 2),
+    mech_rating_after   NUMERIC(10, 2),
+    pilot_rating_before NUMERIC(10, 2),
+    pilot_rating_after  NUMERIC(10, 2),
+    created_at          TIMESTAMPTZ    NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (battle_id, mech_id)
+);
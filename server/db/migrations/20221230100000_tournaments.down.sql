@@ -0,0 +1,7 @@
+DELETE FROM role_permissions WHERE permission IN ('TournamentCreate', 'TournamentUpdate');
+
+DROP TABLE IF EXISTS tournament_matches;
+DROP TABLE IF EXISTS tournament_entrants;
+DROP TABLE IF EXISTS tournament_maps;
+DROP TABLE IF EXISTS tournament_prize_splits;
+DROP TABLE IF EXISTS tournaments;
@@ -0,0 +1,96 @@
+-- tournaments hosted by a player, a syndicate or an admin, played out in private battle lobbies
+CREATE TABLE tournaments
+(
+    id                      UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    name                    TEXT             NOT NULL,
+    host_by_id              UUID             NOT NULL REFERENCES players (id),
+    syndicate_id            UUID REFERENCES syndicates (id),
+    is_admin_hosted         BOOLEAN          NOT NULL DEFAULT FALSE,
+    format                  TEXT             NOT NULL CHECK (format IN ('single_elimination', 'double_elimination', 'round_robin', 'swiss')),
+    status                  TEXT             NOT NULL DEFAULT 'registration' CHECK (status IN ('registration', 'in_progress', 'completed', 'cancelled')),
+    entry_fee               NUMERIC(28)      NOT NULL DEFAULT 0,
+    host_prize              NUMERIC(28)      NOT NULL DEFAULT 0,
+    host_prize_tx_id        TEXT,
+    host_prize_refund_tx_id TEXT,
+    min_entrants            INT              NOT NULL DEFAULT 2 CHECK (min_entrants >= 2),
+    max_entrants            INT              NOT NULL CHECK (max_entrants >= 2),
+    swiss_rounds            INT,
+    current_round           INT              NOT NULL DEFAULT 0,
+    registration_ends_at    TIMESTAMPTZ      NOT NULL,
+    started_at              TIMESTAMPTZ,
+    ended_at                TIMESTAMPTZ,
+    cancelled_at            TIMESTAMPTZ,
+    created_at              TIMESTAMPTZ      NOT NULL DEFAULT NOW(),
+    updated_at              TIMESTAMPTZ      NOT NULL DEFAULT NOW(),
+    CHECK (max_entrants >= min_entrants)
+);
+
+CREATE INDEX IF NOT EXISTS idx_tournaments_status ON tournaments (status, registration_ends_at);
+
+-- share of the prize pool for each final placement, adding up to one
+CREATE TABLE tournament_prize_splits
+(
+    tournament_id UUID          NOT NULL REFERENCES tournaments (id),
+    placement     INT           NOT NULL CHECK (placement > 0),
+    share         NUMERIC(5, 4) NOT NULL CHECK (share > 0 AND share <= 1),
+    PRIMARY KEY (tournament_id, placement)
+);
+
+-- maps the matches of a tournament are played on, picked at random for each match
+CREATE TABLE tournament_maps
+(
+    tournament_id UUID NOT NULL REFERENCES tournaments (id),
+    game_map_id   UUID NOT NULL REFERENCES game_maps (id),
+    PRIMARY KEY (tournament_id, game_map_id)
+);
+
+CREATE TABLE tournament_entrants
+(
+    id            UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    tournament_id UUID             NOT NULL REFERENCES tournaments (id),
+    player_id     UUID             NOT NULL REFERENCES players (id),
+    faction_id    UUID             NOT NULL REFERENCES factions (id),
+    mech_id       UUID             NOT NULL REFERENCES mechs (id),
+    seed          INT,
+    wins          INT              NOT NULL DEFAULT 0,
+    losses        INT              NOT NULL DEFAULT 0,
+    byes          INT              NOT NULL DEFAULT 0,
+    eliminated_at TIMESTAMPTZ,
+    placement     INT,
+    prize         NUMERIC(28),
+    paid_tx_id    TEXT,
+    refund_tx_id  TEXT,
+    prize_tx_id   TEXT,
+    withdrawn_at  TIMESTAMPTZ,
+    created_at    TIMESTAMPTZ      NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tournament_entrants_player ON tournament_entrants (tournament_id, player_id) WHERE withdrawn_at IS NULL;
+
+-- a match between two entrants, or a bye when there is no second entrant
+CREATE TABLE tournament_matches
+(
+    id              UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    tournament_id   UUID             NOT NULL REFERENCES tournaments (id),
+    round           INT              NOT NULL,
+    match_number    INT              NOT NULL,
+    bracket         TEXT             NOT NULL DEFAULT 'main' CHECK (bracket IN ('main', 'winners', 'losers', 'grand_final')),
+    entrant_a_id    UUID             NOT NULL REFERENCES tournament_entrants (id),
+    entrant_b_id    UUID REFERENCES tournament_entrants (id),
+    battle_lobby_id UUID REFERENCES battle_lobbies (id),
+    winner_id       UUID REFERENCES tournament_entrants (id),
+    loser_id        UUID REFERENCES tournament_entrants (id),
+    result_note     TEXT,
+    completed_at    TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ      NOT NULL DEFAULT NOW(),
+    UNIQUE (tournament_id, round, match_number)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tournament_matches_battle_lobby ON tournament_matches (battle_lobby_id);
+
+INSERT INTO role_permissions (role_id, permission)
+SELECT r.id, p.permission
+FROM roles r
+         CROSS JOIN (VALUES ('TournamentCreate'), ('TournamentUpdate')) p(permission)
+WHERE r.role_type = 'ADMIN'
+ON CONFLICT DO NOTHING;
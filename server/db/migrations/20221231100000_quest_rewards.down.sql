@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS player_quest_rewards;
+DROP TABLE IF EXISTS blueprint_quest_rewards;
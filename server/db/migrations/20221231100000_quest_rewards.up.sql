@@ -0,0 +1,35 @@
+-- rewards of a quest, synced from the static data. Every reward outside a pool is given, and one reward of each pool is picked by weight
+CREATE TABLE blueprint_quest_rewards
+(
+    blueprint_quest_id UUID        NOT NULL REFERENCES blueprint_quests (id),
+    position           INT         NOT NULL,
+    reward_type        TEXT        NOT NULL CHECK (reward_type IN ('SUPS', 'PLAYER_ABILITY', 'MECH_SKIN', 'WEAPON_SKIN', 'MYSTERY_CRATE', 'FACTION_PASS_DAYS')),
+    item_id            UUID,
+    amount             NUMERIC(28) NOT NULL CHECK (amount > 0),
+    pool               TEXT,
+    weight             INT         NOT NULL DEFAULT 1 CHECK (weight > 0),
+    PRIMARY KEY (blueprint_quest_id, position)
+);
+
+-- rewards given to players for completing quests
+CREATE TABLE player_quest_rewards
+(
+    id             UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    player_id      UUID             NOT NULL REFERENCES players (id),
+    quest_id       UUID             NOT NULL REFERENCES quests (id),
+    reward_type    TEXT             NOT NULL,
+    item_id        UUID,
+    amount         NUMERIC(28)      NOT NULL,
+    transaction_id TEXT,
+    created_at     TIMESTAMPTZ      NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_player_quest_rewards_player ON player_quest_rewards (player_id, quest_id);
+
+-- quests rewarded a mini mech before their rewards were configurable
+INSERT INTO blueprint_quest_rewards (blueprint_quest_id, position, reward_type, item_id, amount)
+SELECT bq.id, 0, 'PLAYER_ABILITY', bpa.id, 1
+FROM blueprint_quests bq
+         CROSS JOIN blueprint_player_abilities bpa
+WHERE bpa.game_client_ability_id = 18
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS player_quest_progress;
+
+DROP INDEX IF EXISTS idx_blueprint_quests_objective_event;
+
+ALTER TABLE blueprint_quests
+    DROP COLUMN IF EXISTS prerequisite_id,
+    DROP COLUMN IF EXISTS objective_event,
+    DROP COLUMN IF EXISTS objective_filter;
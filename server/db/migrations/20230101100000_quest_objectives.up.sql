@@ -0,0 +1,21 @@
+ALTER TYPE QUEST_EVENT_TYPE ADD VALUE IF NOT EXISTS 'seasonal_quest';
+ALTER TYPE QUEST_KEY ADD VALUE IF NOT EXISTS 'objective';
+
+-- a quest with a prerequisite is only available once the player completes the prerequisite in the same quest event.
+-- objective quests count the events of objective_event whose fields match every field of objective_filter
+ALTER TABLE blueprint_quests
+    ADD COLUMN IF NOT EXISTS prerequisite_id  UUID REFERENCES blueprint_quests (id),
+    ADD COLUMN IF NOT EXISTS objective_event  TEXT,
+    ADD COLUMN IF NOT EXISTS objective_filter JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_blueprint_quests_objective_event ON blueprint_quests (objective_event) WHERE objective_event IS NOT NULL;
+
+-- progress of players on objective quests, counted up as events happen
+CREATE TABLE player_quest_progress
+(
+    player_id  UUID        NOT NULL REFERENCES players (id),
+    quest_id   UUID        NOT NULL REFERENCES quests (id),
+    progress   INT         NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (player_id, quest_id)
+);
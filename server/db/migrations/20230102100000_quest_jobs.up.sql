@@ -0,0 +1,20 @@
+-- durable queue of quest work, pulled by the quest workers. jobs are leased by pushing available_at forward when claimed,
+-- so a job whose worker died is picked up again once its lease runs out. failed_at is set once a job runs out of attempts
+CREATE TABLE quest_jobs
+(
+    id           UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    job_type     TEXT        NOT NULL CHECK (job_type IN ('CHECK', 'EVENT', 'GRANT')),
+    player_id    UUID        NOT NULL REFERENCES players (id),
+    quest_key    TEXT,
+    quest_id     UUID REFERENCES quests (id),
+    event_type   TEXT,
+    amount       INT         NOT NULL DEFAULT 0,
+    fields       JSONB       NOT NULL DEFAULT '{}',
+    attempts     INT         NOT NULL DEFAULT 0,
+    last_error   TEXT,
+    available_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    failed_at    TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_quest_jobs_available_at ON quest_jobs (available_at) WHERE failed_at IS NULL;
@@ -0,0 +1,5 @@
+ALTER TABLE system_messages
+    DROP COLUMN IF EXISTS expires_at;
+
+DROP TABLE IF EXISTS broadcast_system_message_receipts;
+DROP TABLE IF EXISTS broadcast_system_messages;
@@ -0,0 +1,33 @@
+-- broadcast system messages are stored once and read by every player in their audience, instead of a row per player.
+-- audience_id is the faction, syndicate, role or feature name of the audience, and is null for global messages.
+-- messages are shown from send_at until expires_at, notified_at is set once the online players have been told about it
+CREATE TABLE broadcast_system_messages
+(
+    id          UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    sender_id   UUID        NOT NULL REFERENCES players (id),
+    audience    TEXT        NOT NULL CHECK (audience IN ('GLOBAL', 'FACTION', 'SYNDICATE', 'ROLE', 'FEATURE')),
+    audience_id TEXT,
+    title       TEXT        NOT NULL,
+    message     TEXT        NOT NULL,
+    data_type   TEXT,
+    data        JSONB,
+    send_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at  TIMESTAMPTZ,
+    notified_at TIMESTAMPTZ,
+    deleted_at  TIMESTAMPTZ,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_broadcast_system_messages_send_at ON broadcast_system_messages (send_at DESC) WHERE deleted_at IS NULL;
+
+-- read receipts of broadcast system messages, a player without a receipt has not read the message
+CREATE TABLE broadcast_system_message_receipts
+(
+    broadcast_system_message_id UUID        NOT NULL REFERENCES broadcast_system_messages (id),
+    player_id                   UUID        NOT NULL REFERENCES players (id),
+    read_at                     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (broadcast_system_message_id, player_id)
+);
+
+ALTER TABLE system_messages
+    ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ;
@@ -0,0 +1,6 @@
+UPDATE chat_history
+SET faction_id = '98bf7bb3-1a7c-4f21-8843-458d62884060'
+WHERE faction_id ISNULL;
+
+ALTER TABLE chat_history
+    ALTER COLUMN faction_id SET NOT NULL;
@@ -0,0 +1,3 @@
+-- messages of the global chat which do not belong to a faction, such as global bans, are stored without one
+ALTER TABLE chat_history
+    ALTER COLUMN faction_id DROP NOT NULL;
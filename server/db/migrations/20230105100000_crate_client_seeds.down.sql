@@ -0,0 +1,4 @@
+DELETE FROM role_permissions WHERE permission = 'CrateDropRateUpdate';
+
+ALTER TABLE mystery_crate_seeds
+    DROP COLUMN IF EXISTS client_seed;
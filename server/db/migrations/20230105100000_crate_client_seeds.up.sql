@@ -0,0 +1,9 @@
+-- the player picks a client seed when opening the crate, after the server seed is committed
+ALTER TABLE mystery_crate_seeds
+    ADD COLUMN IF NOT EXISTS client_seed TEXT NOT NULL DEFAULT '';
+
+INSERT INTO role_permissions (role_id, permission)
+SELECT r.id, 'CrateDropRateUpdate'
+FROM roles r
+WHERE r.role_type = 'ADMIN'
+ON CONFLICT DO NOTHING;
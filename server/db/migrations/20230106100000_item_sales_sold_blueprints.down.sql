@@ -0,0 +1,33 @@
+CREATE OR REPLACE VIEW marketplace_sold_items AS
+SELECT _s.id                  AS item_sale_id,
+       _cib.item_type         AS item_type,
+       _cib.blueprint_id      AS blueprint_id,
+       _cib.skin_blueprint_id AS skin_blueprint_id,
+       _cib.tier              AS tier,
+       _s.sold_for            AS sold_for,
+       _s.sold_at             AS sold_at
+FROM item_sales _s
+         INNER JOIN collection_item_blueprints _cib ON _cib.collection_item_id = _s.collection_item_id
+WHERE _s.sold_at IS NOT NULL
+  AND _s.sold_for IS NOT NULL
+  AND _s.bundle = FALSE
+UNION ALL
+SELECT _ks.id                   AS item_sale_id,
+       'keycard'                AS item_type,
+       _pk.blueprint_keycard_id AS blueprint_id,
+       NULL::UUID               AS skin_blueprint_id,
+       NULL::TEXT               AS tier,
+       _ks.sold_for             AS sold_for,
+       _ks.sold_at              AS sold_at
+FROM item_keycard_sales _ks
+         INNER JOIN player_keycards _pk ON _pk.id = _ks.item_id
+WHERE _ks.sold_at IS NOT NULL
+  AND _ks.sold_for IS NOT NULL;
+
+DROP TRIGGER IF EXISTS trigger_item_sale_sold_blueprint ON item_sales;
+DROP FUNCTION IF EXISTS item_sale_sold_blueprint();
+
+ALTER TABLE item_sales
+    DROP COLUMN IF EXISTS sold_blueprint_id,
+    DROP COLUMN IF EXISTS sold_skin_blueprint_id,
+    DROP COLUMN IF EXISTS sold_tier;
@@ -0,0 +1,65 @@
+-- the kind of asset an item sold as, so price history is not changed by skins equipped after the sale
+ALTER TABLE item_sales
+    ADD COLUMN IF NOT EXISTS sold_blueprint_id      UUID,
+    ADD COLUMN IF NOT EXISTS sold_skin_blueprint_id UUID,
+    ADD COLUMN IF NOT EXISTS sold_tier              TEXT;
+
+-- past sales only have what the items are now
+UPDATE item_sales _s
+SET sold_blueprint_id      = _cib.blueprint_id,
+    sold_skin_blueprint_id = _cib.skin_blueprint_id,
+    sold_tier              = _cib.tier
+FROM collection_item_blueprints _cib
+WHERE _cib.collection_item_id = _s.collection_item_id
+  AND _s.sold_at IS NOT NULL;
+
+CREATE OR REPLACE FUNCTION item_sale_sold_blueprint() RETURNS TRIGGER AS
+$item_sale_sold_blueprint$
+BEGIN
+    IF new.sold_at IS NOT NULL AND (tg_op = 'INSERT' OR old.sold_at IS NULL) THEN
+        SELECT _cib.blueprint_id, _cib.skin_blueprint_id, _cib.tier
+        INTO new.sold_blueprint_id, new.sold_skin_blueprint_id, new.sold_tier
+        FROM collection_item_blueprints _cib
+        WHERE _cib.collection_item_id = new.collection_item_id;
+    END IF;
+    RETURN new;
+END
+$item_sale_sold_blueprint$
+    LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trigger_item_sale_sold_blueprint ON item_sales;
+
+CREATE TRIGGER trigger_item_sale_sold_blueprint
+    BEFORE INSERT OR UPDATE OF sold_at
+    ON item_sales
+    FOR EACH ROW
+EXECUTE PROCEDURE item_sale_sold_blueprint();
+
+-- completed marketplace sales, used to build price history.
+-- bundles are left out as their price covers several items.
+CREATE OR REPLACE VIEW marketplace_sold_items AS
+SELECT _s.id                     AS item_sale_id,
+       _ci.item_type::TEXT       AS item_type,
+       _s.sold_blueprint_id      AS blueprint_id,
+       _s.sold_skin_blueprint_id AS skin_blueprint_id,
+       _s.sold_tier              AS tier,
+       _s.sold_for               AS sold_for,
+       _s.sold_at                AS sold_at
+FROM item_sales _s
+         INNER JOIN collection_items _ci ON _ci.id = _s.collection_item_id
+WHERE _s.sold_at IS NOT NULL
+  AND _s.sold_for IS NOT NULL
+  AND _s.sold_blueprint_id IS NOT NULL
+  AND _s.bundle = FALSE
+UNION ALL
+SELECT _ks.id                   AS item_sale_id,
+       'keycard'                AS item_type,
+       _pk.blueprint_keycard_id AS blueprint_id,
+       NULL::UUID               AS skin_blueprint_id,
+       NULL::TEXT               AS tier,
+       _ks.sold_for             AS sold_for,
+       _ks.sold_at              AS sold_at
+FROM item_keycard_sales _ks
+         INNER JOIN player_keycards _pk ON _pk.id = _ks.item_id
+WHERE _ks.sold_at IS NOT NULL
+  AND _ks.sold_for IS NOT NULL;
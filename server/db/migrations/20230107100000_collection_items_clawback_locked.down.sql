@@ -0,0 +1,2 @@
+ALTER TABLE collection_items
+    DROP COLUMN IF EXISTS clawback_locked;
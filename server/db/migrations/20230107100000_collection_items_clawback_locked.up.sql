@@ -0,0 +1,19 @@
+-- items locked or revoked by a fiat payment reversal, which can not be queued, sold or moved to xsyn
+ALTER TABLE collection_items
+    ADD COLUMN IF NOT EXISTS clawback_locked BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE collection_items ci
+SET clawback_locked = TRUE
+FROM order_item_assets oia
+WHERE oia.status IN ('locked', 'revoked')
+  AND ci.item_type::TEXT = oia.item_type
+  AND ci.item_id = oia.item_id;
+
+-- revoked items are archived
+UPDATE collection_items ci
+SET deleted_at = NOW()
+FROM order_item_assets oia
+WHERE oia.status = 'revoked'
+  AND ci.item_type::TEXT = oia.item_type
+  AND ci.item_id = oia.item_id
+  AND ci.deleted_at IS NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE battle_arena_client_keys
+    DROP COLUMN IF EXISTS last_handshake_timestamp;
@@ -0,0 +1,2 @@
+ALTER TABLE battle_arena_client_keys
+    ADD COLUMN IF NOT EXISTS last_handshake_timestamp BIGINT;
@@ -0,0 +1,459 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"server"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/ninja-software/terror/v2"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// MysteryCrateDropRatesAt returns the drop table of the storefront crate which was published at the given time
+func MysteryCrateDropRatesAt(exec boil.Executor, storefrontMysteryCrateID string, at time.Time) (boiler.MysteryCrateDropRateSlice, error) {
+	dropRates, err := boiler.MysteryCrateDropRates(
+		boiler.MysteryCrateDropRateWhere.StorefrontMysteryCrateID.EQ(storefrontMysteryCrateID),
+		boiler.MysteryCrateDropRateWhere.CreatedAt.LTE(at),
+		qm.Expr(
+			boiler.MysteryCrateDropRateWhere.DeletedAt.IsNull(),
+			qm.Or2(boiler.MysteryCrateDropRateWhere.DeletedAt.GT(null.TimeFrom(at))),
+		),
+		qm.WithDeleted(),
+	).All(exec)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load crate drop rates.")
+	}
+
+	return dropRates, nil
+}
+
+// MysteryCrateSeedCommit commits a new server seed to the purchased crate.
+// Crates without a published drop table keep their pre-assigned contents, and no seed is committed.
+func MysteryCrateSeedCommit(tx boil.Executor, crateID string, storefrontMysteryCrateID string) (*boiler.MysteryCrateSeed, error) {
+	now := time.Now()
+
+	dropRates, err := MysteryCrateDropRatesAt(tx, storefrontMysteryCrateID, now)
+	if err != nil {
+		return nil, err
+	}
+	if len(dropRates) == 0 {
+		return nil, nil
+	}
+
+	serverSeed, err := server.NewMysteryCrateServerSeed()
+	if err != nil {
+		return nil, terror.Error(err, "Failed to generate crate seed.")
+	}
+
+	seed := &boiler.MysteryCrateSeed{
+		MysteryCrateID:           crateID,
+		StorefrontMysteryCrateID: storefrontMysteryCrateID,
+		ServerSeed:               serverSeed,
+		ServerSeedHash:           server.MysteryCrateSeedHash(serverSeed, crateID),
+		CommittedAt:              now,
+	}
+	err = seed.Insert(tx, boil.Infer())
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("crate id", crateID).Msg("Failed to insert crate seed.")
+		return nil, terror.Error(err, "Failed to commit crate seed.")
+	}
+
+	return seed, nil
+}
+
+// MysteryCrateContents returns the blueprints inside the crate which is being opened.
+// Crates with a committed seed have their contents rolled from the drop table with the client seed of the player,
+// and the seed is revealed. Other crates return their pre-assigned blueprints.
+func MysteryCrateContents(tx boil.Executor, crateID string, clientSeed string) (boiler.MysteryCrateBlueprintSlice, error) {
+	seed, err := boiler.FindMysteryCrateSeed(tx, crateID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, terror.Error(err, "Failed to load crate seed.")
+	}
+
+	if seed == nil {
+		blueprints, err := boiler.MysteryCrateBlueprints(
+			boiler.MysteryCrateBlueprintWhere.MysteryCrateID.EQ(crateID),
+		).All(tx)
+		if err != nil {
+			return nil, terror.Error(err, "Failed to load crate contents.")
+		}
+
+		return blueprints, nil
+	}
+
+	if seed.RevealedAt.Valid {
+		return nil, terror.Error(fmt.Errorf("crate %s is already revealed", crateID), "This crate has already been opened.")
+	}
+
+	dropRates, err := MysteryCrateDropRatesAt(tx, seed.StorefrontMysteryCrateID, seed.CommittedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	result := boiler.MysteryCrateBlueprintSlice{}
+	for _, sr := range server.MysteryCrateRollDropTable(seed.ServerSeed, clientSeed, crateID, dropRates) {
+		roll := &boiler.MysteryCrateRoll{
+			MysteryCrateID: crateID,
+			Slot:           sr.Slot,
+			Roll:           int64(sr.Target),
+			TotalWeight:    sr.TotalWeight,
+			DropRateID:     sr.DropRate.ID,
+		}
+		err = roll.Insert(tx, boil.Infer())
+		if err != nil {
+			gamelog.L.Error().Err(err).Interface("roll", roll).Msg("Failed to insert crate roll.")
+			return nil, terror.Error(err, "Failed to roll crate contents.")
+		}
+
+		result = append(result, &boiler.MysteryCrateBlueprint{
+			MysteryCrateID: crateID,
+			BlueprintType:  sr.DropRate.BlueprintType,
+			BlueprintID:    sr.DropRate.BlueprintID,
+		})
+	}
+
+	seed.ClientSeed = clientSeed
+	seed.RevealedAt = null.TimeFrom(time.Now())
+	_, err = seed.Update(tx, boil.Whitelist(boiler.MysteryCrateSeedColumns.ClientSeed, boiler.MysteryCrateSeedColumns.RevealedAt))
+	if err != nil {
+		return nil, terror.Error(err, "Failed to reveal crate seed.")
+	}
+
+	return result, nil
+}
+
+type MysteryCrateVerification struct {
+	MysteryCrateID           string                           `json:"mystery_crate_id"`
+	StorefrontMysteryCrateID string                           `json:"storefront_mystery_crate_id"`
+	ServerSeedHash           string                           `json:"server_seed_hash"`
+	ServerSeed               null.String                      `json:"server_seed"`
+	ClientSeed               string                           `json:"client_seed"`
+	CommittedAt              time.Time                        `json:"committed_at"`
+	RevealedAt               null.Time                        `json:"revealed_at"`
+	IsHashValid              bool                             `json:"is_hash_valid"`
+	IsRollValid              bool                             `json:"is_roll_valid"`
+	DropTable                boiler.MysteryCrateDropRateSlice `json:"drop_table"`
+	Rolls                    []*server.MysteryCrateSlotRoll   `json:"rolls"`
+	RecordedRolls            boiler.MysteryCrateRollSlice     `json:"recorded_rolls"`
+}
+
+// MysteryCrateVerify re-derives the rolls of the crate, the seed is only included once the crate is opened
+func MysteryCrateVerify(crateID string) (*MysteryCrateVerification, error) {
+	seed, err := boiler.FindMysteryCrateSeed(gamedb.StdConn, crateID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, terror.Error(err, "This crate was not rolled from a published drop table.")
+	}
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load crate seed.")
+	}
+
+	dropRates, err := MysteryCrateDropRatesAt(gamedb.StdConn, seed.StorefrontMysteryCrateID, seed.CommittedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &MysteryCrateVerification{
+		MysteryCrateID:           seed.MysteryCrateID,
+		StorefrontMysteryCrateID: seed.StorefrontMysteryCrateID,
+		ServerSeedHash:           seed.ServerSeedHash,
+		CommittedAt:              seed.CommittedAt,
+		RevealedAt:               seed.RevealedAt,
+		DropTable:                dropRates,
+		Rolls:                    []*server.MysteryCrateSlotRoll{},
+		RecordedRolls:            boiler.MysteryCrateRollSlice{},
+	}
+
+	// keep the seed secret until the crate is opened
+	if !seed.RevealedAt.Valid {
+		return resp, nil
+	}
+
+	resp.ServerSeed = null.StringFrom(seed.ServerSeed)
+	resp.ClientSeed = seed.ClientSeed
+	resp.IsHashValid = server.MysteryCrateSeedHash(seed.ServerSeed, seed.MysteryCrateID) == seed.ServerSeedHash
+	resp.Rolls = server.MysteryCrateRollDropTable(seed.ServerSeed, seed.ClientSeed, seed.MysteryCrateID, dropRates)
+
+	resp.RecordedRolls, err = boiler.MysteryCrateRolls(
+		boiler.MysteryCrateRollWhere.MysteryCrateID.EQ(crateID),
+		qm.OrderBy(boiler.MysteryCrateRollColumns.Slot),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load crate rolls.")
+	}
+
+	// check the recorded rolls match the re-derived rolls
+	resp.IsRollValid = len(resp.Rolls) == len(resp.RecordedRolls)
+	for _, sr := range resp.Rolls {
+		matched := false
+		for _, rr := range resp.RecordedRolls {
+			if rr.Slot == sr.Slot && rr.DropRateID == sr.DropRate.ID && rr.Roll == int64(sr.Target) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			resp.IsRollValid = false
+			break
+		}
+	}
+
+	return resp, nil
+}
+
+// MysteryCrateDropTable returns the current drop table of the storefront crate
+func MysteryCrateDropTable(storefrontMysteryCrateID string) (boiler.MysteryCrateDropRateSlice, error) {
+	dropRates, err := boiler.MysteryCrateDropRates(
+		boiler.MysteryCrateDropRateWhere.StorefrontMysteryCrateID.EQ(storefrontMysteryCrateID),
+		qm.OrderBy(fmt.Sprintf("%s, %s, %s", boiler.MysteryCrateDropRateColumns.Slot, boiler.MysteryCrateDropRateColumns.BlueprintType, boiler.MysteryCrateDropRateColumns.BlueprintID)),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load crate drop rates.")
+	}
+
+	return dropRates, nil
+}
+
+// MysteryCrateDropRateEntry is an entry of the drop table of a storefront crate
+type MysteryCrateDropRateEntry struct {
+	Slot          string `json:"slot"`
+	BlueprintType string `json:"blueprint_type"`
+	BlueprintID   string `json:"blueprint_id"`
+	Weight        int    `json:"weight"`
+}
+
+// MysteryCrateDropTableSet publishes a new drop table for the storefront crate.
+// The entries of the current drop table are archived instead of updated, so the crates which were purchased before
+// can still be verified against the drop table of their purchase time.
+func MysteryCrateDropTableSet(storefrontMysteryCrateID string, entries []*MysteryCrateDropRateEntry) (boiler.MysteryCrateDropRateSlice, error) {
+	exists, err := boiler.StorefrontMysteryCrateExists(gamedb.StdConn, storefrontMysteryCrateID)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load storefront crate.")
+	}
+	if !exists {
+		return nil, terror.Error(fmt.Errorf("storefront crate %s does not exist", storefrontMysteryCrateID), "Storefront crate does not exist.")
+	}
+
+	for _, entry := range entries {
+		if entry.Slot == "" {
+			return nil, terror.Error(fmt.Errorf("missing drop rate slot"), "Every drop rate needs a slot.")
+		}
+		if entry.Weight <= 0 {
+			return nil, terror.Error(fmt.Errorf("invalid drop rate weight: %d", entry.Weight), "Drop rate weights must be greater than zero.")
+		}
+
+		exists, err := mysteryCrateBlueprintExists(entry.BlueprintType, entry.BlueprintID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, terror.Error(fmt.Errorf("%s blueprint %s does not exist", entry.BlueprintType, entry.BlueprintID), fmt.Sprintf("%s blueprint %s does not exist.", entry.BlueprintType, entry.BlueprintID))
+		}
+	}
+
+	tx, err := gamedb.StdConn.Begin()
+	if err != nil {
+		return nil, terror.Error(err, "Failed to start db transaction.")
+	}
+	defer tx.Rollback()
+
+	_, err = boiler.MysteryCrateDropRates(
+		boiler.MysteryCrateDropRateWhere.StorefrontMysteryCrateID.EQ(storefrontMysteryCrateID),
+	).UpdateAll(tx, boiler.M{
+		boiler.MysteryCrateDropRateColumns.DeletedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, terror.Error(err, "Failed to archive crate drop rates.")
+	}
+
+	result := boiler.MysteryCrateDropRateSlice{}
+	for _, entry := range entries {
+		dr := &boiler.MysteryCrateDropRate{
+			StorefrontMysteryCrateID: storefrontMysteryCrateID,
+			Slot:                     entry.Slot,
+			BlueprintType:            entry.BlueprintType,
+			BlueprintID:              entry.BlueprintID,
+			Weight:                   entry.Weight,
+		}
+		err = dr.Insert(tx, boil.Infer())
+		if err != nil {
+			gamelog.L.Error().Err(err).Interface("drop rate", dr).Msg("Failed to insert crate drop rate.")
+			return nil, terror.Error(err, "Failed to publish crate drop rates.")
+		}
+		result = append(result, dr)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, terror.Error(err, "Failed to commit db transaction.")
+	}
+
+	return result, nil
+}
+
+// mysteryCrateBlueprintExists checks the blueprint of a drop rate exists, only the types a crate can hold are accepted
+func mysteryCrateBlueprintExists(blueprintType string, blueprintID string) (bool, error) {
+	var exists bool
+	var err error
+	switch blueprintType {
+	case boiler.TemplateItemTypeMECH:
+		exists, err = boiler.BlueprintMechExists(gamedb.StdConn, blueprintID)
+	case boiler.TemplateItemTypeMECH_SKIN:
+		exists, err = boiler.BlueprintMechSkinExists(gamedb.StdConn, blueprintID)
+	case boiler.TemplateItemTypeWEAPON:
+		exists, err = boiler.BlueprintWeaponExists(gamedb.StdConn, blueprintID)
+	case boiler.TemplateItemTypeWEAPON_SKIN:
+		exists, err = boiler.BlueprintWeaponSkinExists(gamedb.StdConn, blueprintID)
+	case boiler.TemplateItemTypePOWER_CORE:
+		exists, err = boiler.BlueprintPowerCoreExists(gamedb.StdConn, blueprintID)
+	default:
+		return false, terror.Error(fmt.Errorf("invalid blueprint type: %s", blueprintType), fmt.Sprintf("Crates can not hold %s blueprints.", blueprintType))
+	}
+	if err != nil {
+		return false, terror.Error(err, "Failed to load blueprint.")
+	}
+
+	return exists, nil
+}
+
+type MysteryCrateDropRateStat struct {
+	DropRateID         string          `json:"drop_rate_id"`
+	Slot               string          `json:"slot"`
+	BlueprintType      string          `json:"blueprint_type"`
+	BlueprintID        string          `json:"blueprint_id"`
+	AdvertisedRate     decimal.Decimal `json:"advertised_rate"`
+	RealisedRate       decimal.Decimal `json:"realised_rate"`
+	RealisedCount      int             `json:"realised_count"`
+	IsCurrentDropTable bool            `json:"is_current_drop_table"`
+}
+
+type MysteryCrateDropRateStats struct {
+	StorefrontMysteryCrateID string                      `json:"storefront_mystery_crate_id"`
+	Label                    string                      `json:"label"`
+	FactionID                string                      `json:"faction_id"`
+	OpenedCount              int                         `json:"opened_count"`
+	DropRates                []*MysteryCrateDropRateStat `json:"drop_rates"`
+}
+
+// MysteryCrateDropRateStatList compares the advertised drop rates with the realised drop rates of the opened crates.
+// The advertised rate is based on the current drop table, entries which have been removed are still listed with their realised rate.
+func MysteryCrateDropRateStatList() ([]*MysteryCrateDropRateStats, error) {
+	storefrontCrates, err := boiler.StorefrontMysteryCrates(
+		qm.OrderBy(fmt.Sprintf("%s, %s", boiler.StorefrontMysteryCrateColumns.FactionID, boiler.StorefrontMysteryCrateColumns.Label)),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load storefront crates.")
+	}
+
+	dropRates, err := boiler.MysteryCrateDropRates(
+		qm.OrderBy(fmt.Sprintf("%s, %s, %s", boiler.MysteryCrateDropRateColumns.Slot, boiler.MysteryCrateDropRateColumns.BlueprintType, boiler.MysteryCrateDropRateColumns.BlueprintID)),
+		qm.WithDeleted(),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load crate drop rates.")
+	}
+
+	// realised counts of each drop rate
+	realisedCounts := make(map[string]int)
+	rows, err := boiler.NewQuery(
+		qm.Select(boiler.MysteryCrateRollColumns.DropRateID, "COUNT(*)"),
+		qm.From(boiler.TableNames.MysteryCrateRolls),
+		qm.GroupBy(boiler.MysteryCrateRollColumns.DropRateID),
+	).Query(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to count crate rolls.")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		dropRateID := ""
+		count := 0
+		err = rows.Scan(&dropRateID, &count)
+		if err != nil {
+			return nil, terror.Error(err, "Failed to count crate rolls.")
+		}
+		realisedCounts[dropRateID] = count
+	}
+
+	// opened counts of each storefront crate
+	openedCounts := make(map[string]int)
+	seedRows, err := boiler.NewQuery(
+		qm.Select(boiler.MysteryCrateSeedColumns.StorefrontMysteryCrateID, "COUNT(*)"),
+		qm.From(boiler.TableNames.MysteryCrateSeeds),
+		boiler.MysteryCrateSeedWhere.RevealedAt.IsNotNull(),
+		qm.GroupBy(boiler.MysteryCrateSeedColumns.StorefrontMysteryCrateID),
+	).Query(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to count opened crates.")
+	}
+	defer seedRows.Close()
+
+	for seedRows.Next() {
+		storefrontCrateID := ""
+		count := 0
+		err = seedRows.Scan(&storefrontCrateID, &count)
+		if err != nil {
+			return nil, terror.Error(err, "Failed to count opened crates.")
+		}
+		openedCounts[storefrontCrateID] = count
+	}
+
+	resp := []*MysteryCrateDropRateStats{}
+	for _, sc := range storefrontCrates {
+		stats := &MysteryCrateDropRateStats{
+			StorefrontMysteryCrateID: sc.ID,
+			Label:                    sc.Label,
+			FactionID:                sc.FactionID,
+			OpenedCount:              openedCounts[sc.ID],
+			DropRates:                []*MysteryCrateDropRateStat{},
+		}
+
+		// total weight of each slot in the current drop table
+		slotWeights := make(map[string]int)
+		for _, dr := range dropRates {
+			if dr.StorefrontMysteryCrateID == sc.ID && !dr.DeletedAt.Valid {
+				slotWeights[dr.Slot] += dr.Weight
+			}
+		}
+
+		for _, dr := range dropRates {
+			if dr.StorefrontMysteryCrateID != sc.ID {
+				continue
+			}
+
+			stat := &MysteryCrateDropRateStat{
+				DropRateID:         dr.ID,
+				Slot:               dr.Slot,
+				BlueprintType:      dr.BlueprintType,
+				BlueprintID:        dr.BlueprintID,
+				AdvertisedRate:     decimal.Zero,
+				RealisedRate:       decimal.Zero,
+				RealisedCount:      realisedCounts[dr.ID],
+				IsCurrentDropTable: !dr.DeletedAt.Valid,
+			}
+
+			if stat.IsCurrentDropTable && slotWeights[dr.Slot] > 0 {
+				stat.AdvertisedRate = decimal.NewFromInt(int64(dr.Weight)).Div(decimal.NewFromInt(int64(slotWeights[dr.Slot])))
+			}
+
+			if stats.OpenedCount > 0 {
+				stat.RealisedRate = decimal.NewFromInt(int64(stat.RealisedCount)).Div(decimal.NewFromInt(int64(stats.OpenedCount)))
+			}
+
+			stats.DropRates = append(stats.DropRates, stat)
+		}
+
+		if len(stats.DropRates) > 0 {
+			resp = append(resp, stats)
+		}
+	}
+
+	return resp, nil
+}
@@ -6,6 +6,7 @@ import (
 	"server/gamedb"
 
 	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
@@ -107,6 +108,21 @@ func PlayerAbilityAssign(playerID string, blueprintID string) error {
 	return nil
 }
 
+// PlayerAbilityCountAdd gives a player a number of player abilities of the given blueprint.
+func PlayerAbilityCountAdd(conn boil.Executor, playerID string, blueprintID string, count int) error {
+	q := `
+		INSERT INTO player_abilities (owner_id, blueprint_id, count)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (owner_id, blueprint_id)
+		DO UPDATE
+		SET count = player_abilities.count + $3`
+	_, err := conn.Exec(q, playerID, blueprintID, count)
+	if err != nil {
+		return terror.Error(err)
+	}
+	return nil
+}
+
 type AbilityLabel struct {
 	Label               string `db:"label"`
 	GameClientAbilityID int    `db:"game_client_ability_id"`
@@ -0,0 +1,553 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"server"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type PlayerFriendRequestDetail struct {
+	ID          string               `json:"id"`
+	OtherPlayer *server.PublicPlayer `json:"other_player"`
+	CreatedAt   time.Time            `json:"created_at"`
+}
+
+// playersByIDs returns the public info of the given players, keyed by player id
+func playersByIDs(playerIDs []string) (map[string]*server.PublicPlayer, error) {
+	result := make(map[string]*server.PublicPlayer)
+	if len(playerIDs) == 0 {
+		return result, nil
+	}
+
+	ps, err := boiler.Players(boiler.PlayerWhere.ID.IN(playerIDs)).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load players.")
+	}
+
+	for _, p := range ps {
+		result[p.ID] = server.PublicPlayerFromBoiler(p)
+	}
+
+	return result, nil
+}
+
+// PlayerFriendIDs returns the ids of the player's friends
+func PlayerFriendIDs(playerID string) ([]string, error) {
+	pfs, err := boiler.PlayerFriends(
+		boiler.PlayerFriendWhere.PlayerID.EQ(playerID),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load friends.")
+	}
+
+	ids := []string{}
+	for _, pf := range pfs {
+		ids = append(ids, pf.FriendID)
+	}
+
+	return ids, nil
+}
+
+// PlayerFriendList returns the player's friends, ordered by username
+func PlayerFriendList(playerID string) ([]*server.PublicPlayer, error) {
+	ps, err := boiler.Players(
+		qm.InnerJoin(fmt.Sprintf(
+			"%s ON %s = %s AND %s = ?",
+			boiler.TableNames.PlayerFriends,
+			boiler.PlayerFriendTableColumns.FriendID,
+			boiler.PlayerTableColumns.ID,
+			boiler.PlayerFriendTableColumns.PlayerID,
+		), playerID),
+		qm.OrderBy(boiler.PlayerTableColumns.Username),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load friends.")
+	}
+
+	resp := []*server.PublicPlayer{}
+	for _, p := range ps {
+		resp = append(resp, server.PublicPlayerFromBoiler(p))
+	}
+
+	return resp, nil
+}
+
+// PlayerFollowingList returns the players followed by the player
+func PlayerFollowingList(playerID string) ([]*server.PublicPlayer, error) {
+	ps, err := boiler.Players(
+		qm.InnerJoin(fmt.Sprintf(
+			"%s ON %s = %s AND %s = ?",
+			boiler.TableNames.PlayerFollows,
+			boiler.PlayerFollowTableColumns.FollowedID,
+			boiler.PlayerTableColumns.ID,
+			boiler.PlayerFollowTableColumns.FollowerID,
+		), playerID),
+		qm.OrderBy(boiler.PlayerTableColumns.Username),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load followed players.")
+	}
+
+	resp := []*server.PublicPlayer{}
+	for _, p := range ps {
+		resp = append(resp, server.PublicPlayerFromBoiler(p))
+	}
+
+	return resp, nil
+}
+
+// PlayerFollowerIDs returns the ids of the players following the player
+func PlayerFollowerIDs(playerID string) ([]string, error) {
+	pfs, err := boiler.PlayerFollows(
+		boiler.PlayerFollowWhere.FollowedID.EQ(playerID),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load followers.")
+	}
+
+	ids := []string{}
+	for _, pf := range pfs {
+		ids = append(ids, pf.FollowerID)
+	}
+
+	return ids, nil
+}
+
+// PlayerFriendRequestList returns the pending friend requests received and sent by the player
+func PlayerFriendRequestList(playerID string) ([]*PlayerFriendRequestDetail, []*PlayerFriendRequestDetail, error) {
+	pfrs, err := boiler.PlayerFriendRequests(
+		boiler.PlayerFriendRequestWhere.Status.EQ(boiler.FriendRequestStatusEnumPENDING),
+		qm.Expr(
+			boiler.PlayerFriendRequestWhere.SenderID.EQ(playerID),
+			qm.Or2(boiler.PlayerFriendRequestWhere.ReceiverID.EQ(playerID)),
+		),
+		qm.OrderBy(boiler.PlayerFriendRequestColumns.CreatedAt+" DESC"),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, nil, terror.Error(err, "Failed to load friend requests.")
+	}
+
+	otherPlayerIDs := []string{}
+	for _, pfr := range pfrs {
+		otherPlayerIDs = append(otherPlayerIDs, pfr.SenderID, pfr.ReceiverID)
+	}
+
+	players, err := playersByIDs(otherPlayerIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	incoming := []*PlayerFriendRequestDetail{}
+	outgoing := []*PlayerFriendRequestDetail{}
+	for _, pfr := range pfrs {
+		if pfr.ReceiverID == playerID {
+			incoming = append(incoming, &PlayerFriendRequestDetail{
+				ID:          pfr.ID,
+				OtherPlayer: players[pfr.SenderID],
+				CreatedAt:   pfr.CreatedAt,
+			})
+			continue
+		}
+
+		outgoing = append(outgoing, &PlayerFriendRequestDetail{
+			ID:          pfr.ID,
+			OtherPlayer: players[pfr.ReceiverID],
+			CreatedAt:   pfr.CreatedAt,
+		})
+	}
+
+	return incoming, outgoing, nil
+}
+
+// playerFriendAdd stores the friendship in both directions
+func playerFriendAdd(tx boil.Executor, playerID string, friendID string) error {
+	for _, pair := range [][2]string{{playerID, friendID}, {friendID, playerID}} {
+		pf := &boiler.PlayerFriend{
+			PlayerID: pair[0],
+			FriendID: pair[1],
+		}
+		err := pf.Upsert(tx, false, []string{boiler.PlayerFriendColumns.PlayerID, boiler.PlayerFriendColumns.FriendID}, boil.None(), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PlayerFriendRequestSend sends a friend request to the receiver.
+// If the receiver has already sent a pending request to the sender, the request is accepted instead.
+// Returns true if the players became friends.
+func PlayerFriendRequestSend(senderID string, receiverID string) (bool, error) {
+	if senderID == receiverID {
+		return false, terror.Error(fmt.Errorf("player cannot befriend themselves"), "You cannot send a friend request to yourself.")
+	}
+
+	receiver, err := boiler.FindPlayer(gamedb.StdConn, receiverID)
+	if errors.Is(err, sql.ErrNoRows) || (receiver != nil && receiver.IsAi) {
+		return false, terror.Error(fmt.Errorf("receiver not found"), "Player not found.")
+	}
+	if err != nil {
+		return false, terror.Error(err, "Failed to load player.")
+	}
+
+	isFriend, err := boiler.PlayerFriendExists(gamedb.StdConn, senderID, receiverID)
+	if err != nil {
+		return false, terror.Error(err, "Failed to check friends.")
+	}
+	if isFriend {
+		return false, terror.Error(fmt.Errorf("players are already friends"), "You are already friends with this player.")
+	}
+
+	blocked, err := boiler.PlayerBlocks(
+		qm.Expr(
+			boiler.PlayerBlockWhere.PlayerID.EQ(senderID),
+			boiler.PlayerBlockWhere.BlockedPlayerID.EQ(receiverID),
+		),
+		qm.Or2(qm.Expr(
+			boiler.PlayerBlockWhere.PlayerID.EQ(receiverID),
+			boiler.PlayerBlockWhere.BlockedPlayerID.EQ(senderID),
+		)),
+	).Exists(gamedb.StdConn)
+	if err != nil {
+		return false, terror.Error(err, "Failed to check blocked players.")
+	}
+	if blocked {
+		return false, terror.Error(fmt.Errorf("players have blocked each other"), "You cannot send a friend request to this player.")
+	}
+
+	tx, err := gamedb.StdConn.Begin()
+	if err != nil {
+		return false, terror.Error(err, "Failed to start db transaction.")
+	}
+	defer tx.Rollback()
+
+	// accept the receiver's pending request, if there is one
+	pfr, err := boiler.PlayerFriendRequests(
+		boiler.PlayerFriendRequestWhere.SenderID.EQ(receiverID),
+		boiler.PlayerFriendRequestWhere.ReceiverID.EQ(senderID),
+		boiler.PlayerFriendRequestWhere.Status.EQ(boiler.FriendRequestStatusEnumPENDING),
+		qm.For("UPDATE"),
+	).One(tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return false, terror.Error(err, "Failed to load friend request.")
+	}
+
+	if pfr != nil {
+		pfr.Status = boiler.FriendRequestStatusEnumACCEPTED
+		pfr.RespondedAt = null.TimeFrom(time.Now())
+		_, err = pfr.Update(tx, boil.Whitelist(boiler.PlayerFriendRequestColumns.Status, boiler.PlayerFriendRequestColumns.RespondedAt))
+		if err != nil {
+			return false, terror.Error(err, "Failed to accept friend request.")
+		}
+
+		err = playerFriendAdd(tx, senderID, receiverID)
+		if err != nil {
+			return false, terror.Error(err, "Failed to add friend.")
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			return false, terror.Error(err, "Failed to commit db transaction.")
+		}
+
+		return true, nil
+	}
+
+	pending, err := boiler.PlayerFriendRequests(
+		boiler.PlayerFriendRequestWhere.SenderID.EQ(senderID),
+		boiler.PlayerFriendRequestWhere.ReceiverID.EQ(receiverID),
+		boiler.PlayerFriendRequestWhere.Status.EQ(boiler.FriendRequestStatusEnumPENDING),
+	).Exists(tx)
+	if err != nil {
+		return false, terror.Error(err, "Failed to load friend request.")
+	}
+	if pending {
+		return false, terror.Error(fmt.Errorf("friend request already sent"), "You have already sent a friend request to this player.")
+	}
+
+	pfr = &boiler.PlayerFriendRequest{
+		SenderID:   senderID,
+		ReceiverID: receiverID,
+		Status:     boiler.FriendRequestStatusEnumPENDING,
+	}
+	err = pfr.Insert(tx, boil.Infer())
+	if err != nil {
+		gamelog.L.Error().Err(err).Interface("friend request", pfr).Msg("Failed to insert friend request.")
+		return false, terror.Error(err, "Failed to send friend request.")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, terror.Error(err, "Failed to commit db transaction.")
+	}
+
+	return false, nil
+}
+
+// PlayerFriendRequestRespond accepts or declines a pending friend request received by the player
+func PlayerFriendRequestRespond(requestID string, playerID string, accept bool) (*boiler.PlayerFriendRequest, error) {
+	tx, err := gamedb.StdConn.Begin()
+	if err != nil {
+		return nil, terror.Error(err, "Failed to start db transaction.")
+	}
+	defer tx.Rollback()
+
+	pfr, err := boiler.PlayerFriendRequests(
+		boiler.PlayerFriendRequestWhere.ID.EQ(requestID),
+		boiler.PlayerFriendRequestWhere.ReceiverID.EQ(playerID),
+		boiler.PlayerFriendRequestWhere.Status.EQ(boiler.FriendRequestStatusEnumPENDING),
+		qm.For("UPDATE"),
+	).One(tx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, terror.Error(err, "Friend request not found.")
+	}
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load friend request.")
+	}
+
+	pfr.Status = boiler.FriendRequestStatusEnumDECLINED
+	if accept {
+		pfr.Status = boiler.FriendRequestStatusEnumACCEPTED
+	}
+	pfr.RespondedAt = null.TimeFrom(time.Now())
+	_, err = pfr.Update(tx, boil.Whitelist(boiler.PlayerFriendRequestColumns.Status, boiler.PlayerFriendRequestColumns.RespondedAt))
+	if err != nil {
+		return nil, terror.Error(err, "Failed to respond to friend request.")
+	}
+
+	if accept {
+		err = playerFriendAdd(tx, pfr.SenderID, pfr.ReceiverID)
+		if err != nil {
+			return nil, terror.Error(err, "Failed to add friend.")
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, terror.Error(err, "Failed to commit db transaction.")
+	}
+
+	return pfr, nil
+}
+
+// PlayerFriendRequestCancel cancels a pending friend request sent by the player
+func PlayerFriendRequestCancel(requestID string, playerID string) (*boiler.PlayerFriendRequest, error) {
+	pfr, err := boiler.PlayerFriendRequests(
+		boiler.PlayerFriendRequestWhere.ID.EQ(requestID),
+		boiler.PlayerFriendRequestWhere.SenderID.EQ(playerID),
+		boiler.PlayerFriendRequestWhere.Status.EQ(boiler.FriendRequestStatusEnumPENDING),
+	).One(gamedb.StdConn)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, terror.Error(err, "Friend request not found.")
+	}
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load friend request.")
+	}
+
+	pfr.Status = boiler.FriendRequestStatusEnumCANCELLED
+	pfr.RespondedAt = null.TimeFrom(time.Now())
+	_, err = pfr.Update(gamedb.StdConn, boil.Whitelist(boiler.PlayerFriendRequestColumns.Status, boiler.PlayerFriendRequestColumns.RespondedAt))
+	if err != nil {
+		return nil, terror.Error(err, "Failed to cancel friend request.")
+	}
+
+	return pfr, nil
+}
+
+// playerRelationshipsRemove removes the friendship, follows and pending friend requests between the two players
+func playerRelationshipsRemove(tx boil.Executor, playerID string, otherPlayerID string) error {
+	for _, pair := range [][2]string{{playerID, otherPlayerID}, {otherPlayerID, playerID}} {
+		_, err := boiler.PlayerFriends(
+			boiler.PlayerFriendWhere.PlayerID.EQ(pair[0]),
+			boiler.PlayerFriendWhere.FriendID.EQ(pair[1]),
+		).DeleteAll(tx)
+		if err != nil {
+			return err
+		}
+
+		_, err = boiler.PlayerFollows(
+			boiler.PlayerFollowWhere.FollowerID.EQ(pair[0]),
+			boiler.PlayerFollowWhere.FollowedID.EQ(pair[1]),
+		).DeleteAll(tx)
+		if err != nil {
+			return err
+		}
+
+		_, err = boiler.PlayerFriendRequests(
+			boiler.PlayerFriendRequestWhere.SenderID.EQ(pair[0]),
+			boiler.PlayerFriendRequestWhere.ReceiverID.EQ(pair[1]),
+			boiler.PlayerFriendRequestWhere.Status.EQ(boiler.FriendRequestStatusEnumPENDING),
+		).UpdateAll(tx, boiler.M{
+			boiler.PlayerFriendRequestColumns.Status:      boiler.FriendRequestStatusEnumCANCELLED,
+			boiler.PlayerFriendRequestColumns.RespondedAt: null.TimeFrom(time.Now()),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PlayerFriendRemove removes the friendship between the two players
+func PlayerFriendRemove(playerID string, friendID string) error {
+	tx, err := gamedb.StdConn.Begin()
+	if err != nil {
+		return terror.Error(err, "Failed to start db transaction.")
+	}
+	defer tx.Rollback()
+
+	for _, pair := range [][2]string{{playerID, friendID}, {friendID, playerID}} {
+		_, err = boiler.PlayerFriends(
+			boiler.PlayerFriendWhere.PlayerID.EQ(pair[0]),
+			boiler.PlayerFriendWhere.FriendID.EQ(pair[1]),
+		).DeleteAll(tx)
+		if err != nil {
+			return terror.Error(err, "Failed to remove friend.")
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return terror.Error(err, "Failed to commit db transaction.")
+	}
+
+	return nil
+}
+
+// PlayerFollowSet follows or unfollows the other player
+func PlayerFollowSet(playerID string, otherPlayerID string, isFollowing bool) error {
+	if !isFollowing {
+		_, err := boiler.PlayerFollows(
+			boiler.PlayerFollowWhere.FollowerID.EQ(playerID),
+			boiler.PlayerFollowWhere.FollowedID.EQ(otherPlayerID),
+		).DeleteAll(gamedb.StdConn)
+		if err != nil {
+			return terror.Error(err, "Failed to unfollow player.")
+		}
+
+		return nil
+	}
+
+	if playerID == otherPlayerID {
+		return terror.Error(fmt.Errorf("player cannot follow themselves"), "You cannot follow yourself.")
+	}
+
+	other, err := boiler.FindPlayer(gamedb.StdConn, otherPlayerID)
+	if errors.Is(err, sql.ErrNoRows) || (other != nil && other.IsAi) {
+		return terror.Error(fmt.Errorf("player not found"), "Player not found.")
+	}
+	if err != nil {
+		return terror.Error(err, "Failed to load player.")
+	}
+
+	// blocked players cannot follow the blocker
+	blocked, err := PlayerBlocked(otherPlayerID, playerID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return terror.Error(fmt.Errorf("player is blocked"), "You cannot follow this player.")
+	}
+
+	pf := &boiler.PlayerFollow{
+		FollowerID: playerID,
+		FollowedID: otherPlayerID,
+	}
+	err = pf.Upsert(gamedb.StdConn, false, []string{boiler.PlayerFollowColumns.FollowerID, boiler.PlayerFollowColumns.FollowedID}, boil.None(), boil.Infer())
+	if err != nil {
+		return terror.Error(err, "Failed to follow player.")
+	}
+
+	return nil
+}
+
+// PlayerBlockSet blocks or unblocks the other player.
+// Blocking also removes the friendship, follows and pending friend requests between the players.
+func PlayerBlockSet(playerID string, otherPlayerID string, isBlocked bool) error {
+	if !isBlocked {
+		_, err := boiler.PlayerBlocks(
+			boiler.PlayerBlockWhere.PlayerID.EQ(playerID),
+			boiler.PlayerBlockWhere.BlockedPlayerID.EQ(otherPlayerID),
+		).DeleteAll(gamedb.StdConn)
+		if err != nil {
+			return terror.Error(err, "Failed to unblock player.")
+		}
+
+		return nil
+	}
+
+	if playerID == otherPlayerID {
+		return terror.Error(fmt.Errorf("player cannot block themselves"), "You cannot block yourself.")
+	}
+
+	exists, err := boiler.PlayerExists(gamedb.StdConn, otherPlayerID)
+	if err != nil {
+		return terror.Error(err, "Failed to load player.")
+	}
+	if !exists {
+		return terror.Error(fmt.Errorf("player not found"), "Player not found.")
+	}
+
+	tx, err := gamedb.StdConn.Begin()
+	if err != nil {
+		return terror.Error(err, "Failed to start db transaction.")
+	}
+	defer tx.Rollback()
+
+	pb := &boiler.PlayerBlock{
+		PlayerID:        playerID,
+		BlockedPlayerID: otherPlayerID,
+	}
+	err = pb.Upsert(tx, false, []string{boiler.PlayerBlockColumns.PlayerID, boiler.PlayerBlockColumns.BlockedPlayerID}, boil.None(), boil.Infer())
+	if err != nil {
+		return terror.Error(err, "Failed to block player.")
+	}
+
+	err = playerRelationshipsRemove(tx, playerID, otherPlayerID)
+	if err != nil {
+		return terror.Error(err, "Failed to block player.")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return terror.Error(err, "Failed to commit db transaction.")
+	}
+
+	return nil
+}
+
+// PlayerBlockedList returns the players blocked by the player
+func PlayerBlockedList(playerID string) ([]*server.PublicPlayer, error) {
+	ps, err := boiler.Players(
+		qm.InnerJoin(fmt.Sprintf(
+			"%s ON %s = %s AND %s = ?",
+			boiler.TableNames.PlayerBlocks,
+			boiler.PlayerBlockTableColumns.BlockedPlayerID,
+			boiler.PlayerTableColumns.ID,
+			boiler.PlayerBlockTableColumns.PlayerID,
+		), playerID),
+		qm.OrderBy(boiler.PlayerTableColumns.Username),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load blocked players.")
+	}
+
+	resp := []*server.PublicPlayer{}
+	for _, p := range ps {
+		resp = append(resp, server.PublicPlayerFromBoiler(p))
+	}
+
+	return resp, nil
+}
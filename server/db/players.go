@@ -414,6 +414,37 @@ func PlayerQuestStatGet(playerID string) ([]*server.QuestStat, error) {
 				qm.Rels(boiler.TableNames.PlayersObtainedQuests, boiler.PlayersObtainedQuestColumns.PlayerID),
 				playerID,
 			),
+			fmt.Sprintf(
+				`(
+					SELECT pq.%[1]s FROM %[2]s pq
+					WHERE pq.%[3]s = %[4]s AND pq.%[5]s = %[6]s AND pq.%[7]s ISNULL
+					LIMIT 1
+				) AS prerequisite_quest_id`,
+				boiler.QuestColumns.ID,
+				boiler.TableNames.Quests,
+				boiler.QuestColumns.BlueprintID,
+				qm.Rels(boiler.TableNames.BlueprintQuests, boiler.BlueprintQuestColumns.PrerequisiteID),
+				boiler.QuestColumns.QuestEventID,
+				qm.Rels(boiler.TableNames.Quests, boiler.QuestColumns.QuestEventID),
+				boiler.QuestColumns.DeletedAt,
+			),
+			fmt.Sprintf(
+				`(%[1]s NOTNULL AND NOT EXISTS (
+					SELECT 1 FROM %[2]s poq
+					INNER JOIN %[3]s pq ON pq.%[4]s = poq.%[5]s
+					WHERE poq.%[6]s = '%[7]s' AND pq.%[8]s = %[1]s AND pq.%[9]s = %[10]s
+				)) AS locked`,
+				qm.Rels(boiler.TableNames.BlueprintQuests, boiler.BlueprintQuestColumns.PrerequisiteID),
+				boiler.TableNames.PlayersObtainedQuests,
+				boiler.TableNames.Quests,
+				boiler.QuestColumns.ID,
+				boiler.PlayersObtainedQuestColumns.ObtainedQuestID,
+				boiler.PlayersObtainedQuestColumns.PlayerID,
+				playerID,
+				boiler.QuestColumns.BlueprintID,
+				boiler.QuestColumns.QuestEventID,
+				qm.Rels(boiler.TableNames.Quests, boiler.QuestColumns.QuestEventID),
+			),
 		),
 		qm.From(
 			fmt.Sprintf(
@@ -475,9 +506,10 @@ func PlayerQuestStatGet(playerID string) ([]*server.QuestStat, error) {
 }
 
 type PlayerQuestProgression struct {
-	QuestID string `json:"quest_id"`
-	Current int    `json:"current"`
-	Goal    int    `json:"goal"`
+	QuestID string         `json:"quest_id"`
+	Current int            `json:"current"`
+	Goal    int            `json:"goal"`
+	Rewards []*QuestReward `json:"rewards"`
 }
 
 func PlayerQuestProgressions(playerID string) ([]*PlayerQuestProgression, error) {
@@ -496,6 +528,16 @@ func PlayerQuestProgressions(playerID string) ([]*PlayerQuestProgression, error)
 		return nil, terror.Error(err, "Failed to get available quests")
 	}
 
+	blueprintQuestIDs := []string{}
+	for _, q := range quests {
+		blueprintQuestIDs = append(blueprintQuestIDs, q.BlueprintID)
+	}
+	rewards, err := QuestRewards(gamedb.StdConn, blueprintQuestIDs...)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to load quest rewards")
+		return nil, err
+	}
+
 	result := []*PlayerQuestProgression{}
 
 	// cache data to speed up the process
@@ -512,6 +554,7 @@ func PlayerQuestProgressions(playerID string) ([]*PlayerQuestProgression, error)
 			QuestID: q.ID,
 			Current: 0,
 			Goal:    q.R.Blueprint.RequestAmount,
+			Rewards: rewards[q.BlueprintID],
 		}
 
 		// if player already obtained the quest
@@ -527,6 +570,18 @@ func PlayerQuestProgressions(playerID string) ([]*PlayerQuestProgression, error)
 
 		// otherwise, load current progression
 		switch q.R.Blueprint.Key {
+		case boiler.QuestKeyObjective:
+			pqp.Current, err = PlayerQuestProgressGet(gamedb.StdConn, playerID, q.ID)
+			if err != nil {
+				l.Error().Err(err).Msg("Failed to get player quest progress")
+				return nil, err
+			}
+
+			// cap current score with the quest goal
+			if pqp.Current > pqp.Goal {
+				pqp.Current = pqp.Goal
+			}
+
 		case boiler.QuestKeyAbilityKill:
 			// fill data, if already query once
 			if abilityKillCount.Valid {
@@ -0,0 +1,199 @@
+package db
+
+import (
+	"fmt"
+	"server/db/boiler"
+	"server/gamelog"
+
+	"github.com/ninja-software/terror/v2"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Quest reward types. Sups and faction pass days have no item, the amount is the number of whole sups or days.
+// Mystery crate rewards are the crate of the player's faction with the same type as the storefront crate of the item.
+const (
+	QuestRewardTypeSups            = "SUPS"
+	QuestRewardTypePlayerAbility   = "PLAYER_ABILITY"
+	QuestRewardTypeMechSkin        = "MECH_SKIN"
+	QuestRewardTypeWeaponSkin      = "WEAPON_SKIN"
+	QuestRewardTypeMysteryCrate    = "MYSTERY_CRATE"
+	QuestRewardTypeFactionPassDays = "FACTION_PASS_DAYS"
+)
+
+// QuestRewardTypeIsValid returns whether the reward type exists, and whether it needs an item.
+func QuestRewardTypeIsValid(rewardType string) (valid bool, needsItem bool) {
+	switch rewardType {
+	case QuestRewardTypeSups, QuestRewardTypeFactionPassDays:
+		return true, false
+	case QuestRewardTypePlayerAbility, QuestRewardTypeMechSkin, QuestRewardTypeWeaponSkin, QuestRewardTypeMysteryCrate:
+		return true, true
+	}
+	return false, false
+}
+
+// QuestReward is a reward of a quest shown to the player before they complete it.
+type QuestReward struct {
+	Type     string          `json:"type"`
+	Label    string          `json:"label"`
+	ImageURL string          `json:"image_url,omitempty"`
+	Amount   decimal.Decimal `json:"amount"`
+	// Pool is set when the reward is one of a pool, of which only one is given with the chance of the reward
+	Pool   string          `json:"pool,omitempty"`
+	Chance decimal.Decimal `json:"chance"`
+}
+
+// BlueprintQuestRewards returns the rewards of each blueprint quest, in the order they are configured.
+func BlueprintQuestRewards(conn boil.Executor, blueprintQuestIDs ...string) (map[string][]*boiler.BlueprintQuestReward, error) {
+	bqrs, err := boiler.BlueprintQuestRewards(
+		boiler.BlueprintQuestRewardWhere.BlueprintQuestID.IN(blueprintQuestIDs),
+		qm.OrderBy(boiler.BlueprintQuestRewardColumns.Position),
+	).All(conn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load quest rewards.")
+	}
+
+	result := make(map[string][]*boiler.BlueprintQuestReward)
+	for _, bqr := range bqrs {
+		result[bqr.BlueprintQuestID] = append(result[bqr.BlueprintQuestID], bqr)
+	}
+
+	return result, nil
+}
+
+// QuestRewards returns the rewards of each blueprint quest with their labels and chances, for showing to players.
+func QuestRewards(conn boil.Executor, blueprintQuestIDs ...string) (map[string][]*QuestReward, error) {
+	bqrsByQuest, err := BlueprintQuestRewards(conn, blueprintQuestIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	bqrs := boiler.BlueprintQuestRewardSlice{}
+	for _, questBqrs := range bqrsByQuest {
+		bqrs = append(bqrs, questBqrs...)
+	}
+
+	items, err := questRewardItems(conn, bqrs)
+	if err != nil {
+		gamelog.L.Error().Err(err).Strs("blueprint quest ids", blueprintQuestIDs).Msg("Failed to load quest reward items.")
+		return nil, err
+	}
+
+	result := make(map[string][]*QuestReward)
+	for blueprintQuestID, bqrs := range bqrsByQuest {
+		poolWeights := make(map[string]int)
+		for _, bqr := range bqrs {
+			if bqr.Pool.Valid {
+				poolWeights[bqr.Pool.String] += bqr.Weight
+			}
+		}
+
+		for _, bqr := range bqrs {
+			qr := &QuestReward{
+				Type:   bqr.RewardType,
+				Amount: bqr.Amount,
+				Chance: decimal.NewFromInt(1),
+			}
+			if bqr.Pool.Valid {
+				qr.Pool = bqr.Pool.String
+				qr.Chance = decimal.NewFromInt(int64(bqr.Weight)).Div(decimal.NewFromInt(int64(poolWeights[bqr.Pool.String])))
+			}
+
+			item, ok := items[questRewardItemKey{bqr.RewardType, bqr.ItemID.String}]
+			if !ok {
+				err = fmt.Errorf("quest reward item not found: %s %s", bqr.RewardType, bqr.ItemID.String)
+				gamelog.L.Error().Err(err).Str("blueprint quest id", blueprintQuestID).Int("position", bqr.Position).Msg("Failed to load quest reward item.")
+				return nil, terror.Error(err, "Failed to load quest reward item.")
+			}
+			qr.Label = item.label
+			qr.ImageURL = item.imageURL
+
+			result[blueprintQuestID] = append(result[blueprintQuestID], qr)
+		}
+	}
+
+	return result, nil
+}
+
+// questRewardItemKey is the reward type and item id of a quest reward, the item id is empty for rewards without an item.
+type questRewardItemKey struct {
+	rewardType string
+	itemID     string
+}
+
+// questRewardItem is the label and image of the item of a quest reward.
+type questRewardItem struct {
+	label    string
+	imageURL string
+}
+
+// questRewardItems loads the label and image of the items of the quest rewards, with a query per reward type.
+func questRewardItems(conn boil.Executor, bqrs boiler.BlueprintQuestRewardSlice) (map[questRewardItemKey]*questRewardItem, error) {
+	itemIDs := make(map[string][]string)
+	for _, bqr := range bqrs {
+		if bqr.ItemID.Valid {
+			itemIDs[bqr.RewardType] = append(itemIDs[bqr.RewardType], bqr.ItemID.String)
+		}
+	}
+
+	result := map[questRewardItemKey]*questRewardItem{
+		{QuestRewardTypeSups, ""}:            {label: "SUPS"},
+		{QuestRewardTypeFactionPassDays, ""}: {label: "Faction Pass Days"},
+	}
+	for rewardType, ids := range itemIDs {
+		switch rewardType {
+		case QuestRewardTypePlayerAbility:
+			bps, err := boiler.BlueprintPlayerAbilities(boiler.BlueprintPlayerAbilityWhere.ID.IN(ids)).All(conn)
+			if err != nil {
+				return nil, terror.Error(err, "Failed to load player ability blueprints.")
+			}
+			for _, bp := range bps {
+				result[questRewardItemKey{rewardType, bp.ID}] = &questRewardItem{bp.Label, bp.ImageURL}
+			}
+		case QuestRewardTypeMechSkin:
+			bps, err := boiler.BlueprintMechSkins(boiler.BlueprintMechSkinWhere.ID.IN(ids)).All(conn)
+			if err != nil {
+				return nil, terror.Error(err, "Failed to load mech skin blueprints.")
+			}
+			for _, bp := range bps {
+				result[questRewardItemKey{rewardType, bp.ID}] = &questRewardItem{bp.Label, bp.ImageURL.String}
+			}
+		case QuestRewardTypeWeaponSkin:
+			bps, err := boiler.BlueprintWeaponSkins(boiler.BlueprintWeaponSkinWhere.ID.IN(ids)).All(conn)
+			if err != nil {
+				return nil, terror.Error(err, "Failed to load weapon skin blueprints.")
+			}
+			for _, bp := range bps {
+				result[questRewardItemKey{rewardType, bp.ID}] = &questRewardItem{bp.Label, bp.ImageURL.String}
+			}
+		case QuestRewardTypeMysteryCrate:
+			crates, err := boiler.StorefrontMysteryCrates(boiler.StorefrontMysteryCrateWhere.ID.IN(ids)).All(conn)
+			if err != nil {
+				return nil, terror.Error(err, "Failed to load mystery crates.")
+			}
+			for _, crate := range crates {
+				result[questRewardItemKey{rewardType, crate.ID}] = &questRewardItem{crate.Label, crate.ImageURL.String}
+			}
+		default:
+			return nil, terror.Error(fmt.Errorf("invalid quest reward type: %s", rewardType), "Invalid quest reward type.")
+		}
+	}
+
+	return result, nil
+}
+
+// QuestRewardItemExists returns whether the item of a quest reward exists, rewards without an item always exist.
+func QuestRewardItemExists(conn boil.Executor, rewardType string, itemID string) (bool, error) {
+	bqr := &boiler.BlueprintQuestReward{
+		RewardType: rewardType,
+		ItemID:     null.NewString(itemID, itemID != ""),
+	}
+	items, err := questRewardItems(conn, boiler.BlueprintQuestRewardSlice{bqr})
+	if err != nil {
+		return false, err
+	}
+	_, ok := items[questRewardItemKey{rewardType, itemID}]
+	return ok, nil
+}
@@ -0,0 +1,237 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"server/db/boiler"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Quest objective events, which objective quests progress on. The fields of each event can be matched by the objective
+// filter of a blueprint quest, a quest with the filter {"mech_model_id": "..."} only progresses on events of that mech model.
+const (
+	// QuestObjectiveEventBattleWin is a mech winning a battle for its pilot, with the fields mech_model_id and faction_id
+	QuestObjectiveEventBattleWin = "battle_win"
+	// QuestObjectiveEventBattleSurvive is a mech surviving to the end of a battle, with the fields mech_model_id and faction_id
+	QuestObjectiveEventBattleSurvive = "battle_survive"
+	// QuestObjectiveEventWeaponDamage is the damage a mech deals with a weapon, with the fields weapon_type and mech_model_id
+	QuestObjectiveEventWeaponDamage = "weapon_damage"
+	// QuestObjectiveEventRepairGameFlawless is a repair agent completed without failing a block, with the field for_other
+	QuestObjectiveEventRepairGameFlawless = "repair_game_flawless"
+	// QuestObjectiveEventMarketplaceSale is an item sold on the marketplace, with the field item_type which is the
+	// collection item type, or QuestObjectiveItemTypeKeycard
+	QuestObjectiveEventMarketplaceSale = "marketplace_sale"
+)
+
+// QuestObjectiveItemTypeKeycard is the item type of keycards sold on the marketplace, which are not collection items.
+const QuestObjectiveItemTypeKeycard = "keycard"
+
+// QuestObjectiveEventIsValid returns whether an objective quest can target the event.
+func QuestObjectiveEventIsValid(event string) bool {
+	switch event {
+	case QuestObjectiveEventBattleWin,
+		QuestObjectiveEventBattleSurvive,
+		QuestObjectiveEventWeaponDamage,
+		QuestObjectiveEventRepairGameFlawless,
+		QuestObjectiveEventMarketplaceSale:
+		return true
+	}
+	return false
+}
+
+// QuestPrerequisiteMet returns whether the player has completed the prerequisite of a quest in the same quest event.
+func QuestPrerequisiteMet(conn boil.Executor, playerID string, quest *boiler.Quest, bq *boiler.BlueprintQuest) (bool, error) {
+	if !bq.PrerequisiteID.Valid {
+		return true, nil
+	}
+
+	q := fmt.Sprintf(`
+		SELECT EXISTS (
+			SELECT 1 FROM %[1]s poq
+			INNER JOIN %[2]s q ON q.%[3]s = poq.%[4]s
+			WHERE poq.%[5]s = $1 AND q.%[6]s = $2 AND q.%[7]s = $3
+		)`,
+		boiler.TableNames.PlayersObtainedQuests,
+		boiler.TableNames.Quests,
+		boiler.QuestColumns.ID,
+		boiler.PlayersObtainedQuestColumns.ObtainedQuestID,
+		boiler.PlayersObtainedQuestColumns.PlayerID,
+		boiler.QuestColumns.BlueprintID,
+		boiler.QuestColumns.QuestEventID,
+	)
+
+	met := false
+	err := conn.QueryRow(q, playerID, bq.PrerequisiteID.String, quest.QuestEventID).Scan(&met)
+	if err != nil {
+		return false, terror.Error(err, "Failed to check quest prerequisite.")
+	}
+
+	return met, nil
+}
+
+// PlayerQuestProgressGet returns the progress of a player on an objective quest.
+func PlayerQuestProgressGet(conn boil.Executor, playerID string, questID string) (int, error) {
+	pqp, err := boiler.FindPlayerQuestProgress(conn, playerID, questID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, terror.Error(err, "Failed to get player quest progress.")
+	}
+
+	return pqp.Progress, nil
+}
+
+// PlayerQuestProgressAdd adds to the progress of a player on an objective quest, and returns the new progress.
+func PlayerQuestProgressAdd(conn boil.Executor, playerID string, questID string, amount int) (int, error) {
+	q := fmt.Sprintf(`
+		INSERT INTO %[1]s (%[2]s, %[3]s, %[4]s) VALUES ($1, $2, $3)
+		ON CONFLICT (%[2]s, %[3]s) DO UPDATE SET %[4]s = %[1]s.%[4]s + EXCLUDED.%[4]s, %[5]s = NOW()
+		RETURNING %[4]s`,
+		boiler.TableNames.PlayerQuestProgress,
+		boiler.PlayerQuestProgressColumns.PlayerID,
+		boiler.PlayerQuestProgressColumns.QuestID,
+		boiler.PlayerQuestProgressColumns.Progress,
+		boiler.PlayerQuestProgressColumns.UpdatedAt,
+	)
+
+	progress := 0
+	err := conn.QueryRow(q, playerID, questID, amount).Scan(&progress)
+	if err != nil {
+		return 0, terror.Error(err, "Failed to update player quest progress.")
+	}
+
+	return progress, nil
+}
+
+// Quest job types. Check jobs recount a legacy quest key from the db, event jobs progress objective quests, and grant jobs
+// give a player a quest they have reached the goal of.
+const (
+	QuestJobTypeCheck = "CHECK"
+	QuestJobTypeEvent = "EVENT"
+	QuestJobTypeGrant = "GRANT"
+)
+
+// QuestJobClaim leases the next available quest job, or returns nil when there is none. The lease grows with each attempt,
+// and the job is picked up again once it runs out, unless the worker completes or fails the job first. Jobs whose last
+// lease ran out without the worker completing or failing them, and which have no attempts left, are marked failed.
+func QuestJobClaim(conn boil.Executor, maxAttempts int) (*boiler.QuestJob, error) {
+	// leases are timed by the database clock, the same as the claim below
+	q := fmt.Sprintf(`
+		UPDATE %[1]s
+		SET %[2]s = NOW(), %[3]s = 'lease ran out on the last attempt'
+		WHERE %[2]s ISNULL AND %[4]s <= NOW() AND %[5]s >= $1`,
+		boiler.TableNames.QuestJobs,
+		boiler.QuestJobColumns.FailedAt,
+		boiler.QuestJobColumns.LastError,
+		boiler.QuestJobColumns.AvailableAt,
+		boiler.QuestJobColumns.Attempts,
+	)
+	_, err := conn.Exec(q, maxAttempts)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to fail expired quest jobs.")
+	}
+
+	q = fmt.Sprintf(`
+		UPDATE %[1]s
+		SET %[2]s = %[2]s + 1, %[3]s = NOW() + MAKE_INTERVAL(secs => 30 * (%[2]s + 1) * (%[2]s + 1))
+		WHERE %[4]s = (
+			SELECT %[4]s FROM %[1]s
+			WHERE %[5]s ISNULL AND %[3]s <= NOW() AND %[2]s < $1
+			ORDER BY %[3]s
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		boiler.TableNames.QuestJobs,
+		boiler.QuestJobColumns.Attempts,
+		boiler.QuestJobColumns.AvailableAt,
+		boiler.QuestJobColumns.ID,
+		boiler.QuestJobColumns.FailedAt,
+	)
+
+	jobs := boiler.QuestJobSlice{}
+	err = boiler.NewQuery(qm.SQL(q, maxAttempts)).Bind(nil, conn, &jobs)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to claim quest job.")
+	}
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+
+	return jobs[0], nil
+}
+
+// QuestJobComplete removes a finished quest job. It returns false when the lease of the job has been taken by another
+// worker since it was claimed, in which case the work done under the job should be rolled back.
+func QuestJobComplete(conn boil.Executor, job *boiler.QuestJob) (bool, error) {
+	deleted, err := boiler.QuestJobs(
+		boiler.QuestJobWhere.ID.EQ(job.ID),
+		boiler.QuestJobWhere.Attempts.EQ(job.Attempts),
+	).DeleteAll(conn)
+	if err != nil {
+		return false, terror.Error(err, "Failed to complete quest job.")
+	}
+
+	return deleted > 0, nil
+}
+
+// QuestJobFieldsSet stores the fields of a quest job. It returns false when the lease of the job has been taken by another
+// worker since it was claimed.
+func QuestJobFieldsSet(conn boil.Executor, job *boiler.QuestJob) (bool, error) {
+	updated, err := boiler.QuestJobs(
+		boiler.QuestJobWhere.ID.EQ(job.ID),
+		boiler.QuestJobWhere.Attempts.EQ(job.Attempts),
+	).UpdateAll(conn, boiler.M{boiler.QuestJobColumns.Fields: job.Fields})
+	if err != nil {
+		return false, terror.Error(err, "Failed to update quest job.")
+	}
+
+	return updated > 0, nil
+}
+
+// QuestJobFail records the error of a quest job, which is retried when its lease runs out, or marked failed once it has no attempts left.
+func QuestJobFail(conn boil.Executor, job *boiler.QuestJob, jobErr error, maxAttempts int) error {
+	cols := boiler.M{boiler.QuestJobColumns.LastError: jobErr.Error()}
+	if job.Attempts >= maxAttempts {
+		cols[boiler.QuestJobColumns.FailedAt] = time.Now()
+	}
+
+	_, err := boiler.QuestJobs(
+		boiler.QuestJobWhere.ID.EQ(job.ID),
+		boiler.QuestJobWhere.Attempts.EQ(job.Attempts),
+	).UpdateAll(conn, cols)
+	if err != nil {
+		return terror.Error(err, "Failed to record quest job failure.")
+	}
+
+	return nil
+}
+
+// PlayerObtainedQuestInsert records a player obtaining a quest. It returns false when the player has already obtained it,
+// which the grant uses so a quest is only ever rewarded once.
+func PlayerObtainedQuestInsert(conn boil.Executor, playerID string, questID string) (bool, error) {
+	q := fmt.Sprintf(
+		`INSERT INTO %s (%s, %s) VALUES ($1, $2) ON CONFLICT DO NOTHING`,
+		boiler.TableNames.PlayersObtainedQuests,
+		boiler.PlayersObtainedQuestColumns.PlayerID,
+		boiler.PlayersObtainedQuestColumns.ObtainedQuestID,
+	)
+
+	result, err := conn.Exec(q, playerID, questID)
+	if err != nil {
+		return false, terror.Error(err, "Failed to insert player obtained quest.")
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, terror.Error(err, "Failed to insert player obtained quest.")
+	}
+
+	return inserted > 0, nil
+}
@@ -224,6 +224,7 @@ type MechQueueAuthorisationData struct {
 	LockedToMarketplace bool        `db:"locked_to_marketplace"`
 	MarketLocked        bool        `db:"market_locked"`
 	XsynLocked          bool        `db:"xsyn_locked"`
+	ClawbackLocked      bool        `db:"clawback_locked"`
 	PowerCoreID         null.String `db:"power_core_id"`
 	HasWeapon           bool        `db:"has_weapon"`
 	IsAvailable         bool        `db:"is_available"`
@@ -259,6 +260,7 @@ func MechsQueueAuthorisationDataGet(mechIDs []string) ([]*MechQueueAuthorisation
 			boiler.CollectionItemTableColumns.LockedToMarketplace,
 			boiler.CollectionItemTableColumns.MarketLocked,
 			boiler.CollectionItemTableColumns.XsynLocked,
+			fmt.Sprintf(clawbackLockedSQL, boiler.MechTableColumns.ID)+" AS clawback_locked",
 			boiler.MechTableColumns.PowerCoreID,
 			fmt.Sprintf(
 				"COALESCE((SELECT COUNT(*) > 0 FROM %s WHERE %s = %s), FALSE) AS has_weapon",
@@ -320,6 +322,7 @@ func MechsQueueAuthorisationDataGet(mechIDs []string) ([]*MechQueueAuthorisation
 			&mqa.LockedToMarketplace,
 			&mqa.MarketLocked,
 			&mqa.XsynLocked,
+			&mqa.ClawbackLocked,
 			&mqa.PowerCoreID,
 			&mqa.HasWeapon,
 			&mqa.IsAvailable,
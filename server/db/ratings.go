@@ -0,0 +1,360 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"server"
+	"server/db/boiler"
+	"server/gamedb"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/lib/pq"
+	"github.com/ninja-software/terror/v2"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// RatingDefault is the rating of mechs and pilots which have not been rated yet
+const RatingDefault = 1500
+
+// RatingProvisionalBattles returns how many rated battles a rating stays provisional for.
+func RatingProvisionalBattles() int {
+	return GetIntWithDefault(KeyRatingProvisionalBattles, 10)
+}
+
+func ratingFromBoiler(rating decimal.Decimal, battlesRated int) *server.Rating {
+	return &server.Rating{
+		Rating:       rating.Round(0),
+		BattlesRated: battlesRated,
+		Provisional:  battlesRated < RatingProvisionalBattles(),
+	}
+}
+
+// MechRatingsGet returns the ratings of mechs, with the default rating for the mechs which have not been rated yet.
+func MechRatingsGet(conn boil.Executor, mechIDs []string) (map[string]*boiler.MechRating, error) {
+	mrs, err := boiler.MechRatings(boiler.MechRatingWhere.MechID.IN(mechIDs)).All(conn)
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+
+	ratings := make(map[string]*boiler.MechRating)
+	for _, mr := range mrs {
+		ratings[mr.MechID] = mr
+	}
+	for _, mechID := range mechIDs {
+		if _, ok := ratings[mechID]; !ok {
+			ratings[mechID] = &boiler.MechRating{
+				MechID: mechID,
+				Rating: decimal.NewFromInt(RatingDefault),
+			}
+		}
+	}
+
+	return ratings, nil
+}
+
+// PlayerRatingsGet returns the pilot ratings of players, with the default rating for the players which have not been rated yet.
+func PlayerRatingsGet(conn boil.Executor, playerIDs []string) (map[string]*boiler.PlayerRating, error) {
+	prs, err := boiler.PlayerRatings(boiler.PlayerRatingWhere.PlayerID.IN(playerIDs)).All(conn)
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+
+	ratings := make(map[string]*boiler.PlayerRating)
+	for _, pr := range prs {
+		ratings[pr.PlayerID] = pr
+	}
+	for _, playerID := range playerIDs {
+		if _, ok := ratings[playerID]; !ok {
+			ratings[playerID] = &boiler.PlayerRating{
+				PlayerID: playerID,
+				Rating:   decimal.NewFromInt(RatingDefault),
+			}
+		}
+	}
+
+	return ratings, nil
+}
+
+// MechRating returns the rating of a mech.
+func MechRating(conn boil.Executor, mechID string) (*server.Rating, error) {
+	mr, err := boiler.FindMechRating(conn, mechID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ratingFromBoiler(decimal.NewFromInt(RatingDefault), 0), nil
+	}
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	return ratingFromBoiler(mr.Rating, mr.BattlesRated), nil
+}
+
+// PlayerRating returns the pilot rating of a player.
+func PlayerRating(conn boil.Executor, playerID string) (*server.Rating, error) {
+	pr, err := boiler.FindPlayerRating(conn, playerID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ratingFromBoiler(decimal.NewFromInt(RatingDefault), 0), nil
+	}
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	return ratingFromBoiler(pr.Rating, pr.BattlesRated), nil
+}
+
+// BattleIsRated returns true if the rating changes of a battle have already been recorded.
+func BattleIsRated(conn boil.Executor, battleID string) (bool, error) {
+	rated, err := boiler.BattleRatingChanges(boiler.BattleRatingChangeWhere.BattleID.EQ(battleID)).Exists(conn)
+	if err != nil {
+		return false, terror.Error(err)
+	}
+	return rated, nil
+}
+
+// MechRatingSave inserts or updates the rating of a mech.
+func MechRatingSave(conn boil.Executor, mr *boiler.MechRating) error {
+	mr.UpdatedAt = time.Now()
+	err := mr.Upsert(
+		conn,
+		true,
+		[]string{boiler.MechRatingColumns.MechID},
+		boil.Whitelist(boiler.MechRatingColumns.Rating, boiler.MechRatingColumns.BattlesRated, boiler.MechRatingColumns.UpdatedAt),
+		boil.Infer(),
+	)
+	if err != nil {
+		return terror.Error(err)
+	}
+	return nil
+}
+
+// PlayerRatingSave inserts or updates the pilot rating of a player.
+func PlayerRatingSave(conn boil.Executor, pr *boiler.PlayerRating) error {
+	pr.UpdatedAt = time.Now()
+	err := pr.Upsert(
+		conn,
+		true,
+		[]string{boiler.PlayerRatingColumns.PlayerID},
+		boil.Whitelist(boiler.PlayerRatingColumns.Rating, boiler.PlayerRatingColumns.BattlesRated, boiler.PlayerRatingColumns.UpdatedAt),
+		boil.Infer(),
+	)
+	if err != nil {
+		return terror.Error(err)
+	}
+	return nil
+}
+
+// BattleLobbyFilledByRating returns true if a battle lobby is only joined by mechs of a similar rating,
+// which are the public lobbies generated by the system.
+func BattleLobbyFilledByRating(bl *boiler.BattleLobby) bool {
+	return bl.GeneratedBySystem && !bl.AccessCode.Valid && !bl.IsPrivate && !bl.IsAiDrivenMatch
+}
+
+// LobbyRating is the average rating of the mechs queued in a battle lobby.
+type LobbyRating struct {
+	BattleLobbyID string
+	Rating        decimal.Decimal
+	MechCount     int
+	FirstQueuedAt time.Time
+}
+
+// BattleLobbyRatings returns the ratings of battle lobbies which have mechs queued in them.
+func BattleLobbyRatings(conn boil.Executor, battleLobbyIDs []string) (map[string]*LobbyRating, error) {
+	q := fmt.Sprintf(`
+		SELECT blm.%[1]s, AVG(COALESCE(mr.%[2]s, $2)), COUNT(blm.%[3]s), MIN(blm.%[4]s)
+		FROM %[5]s blm
+		LEFT JOIN %[6]s mr ON mr.%[7]s = blm.%[3]s
+		WHERE blm.%[1]s = ANY($1)
+			AND blm.%[8]s ISNULL
+			AND blm.%[9]s ISNULL
+			AND blm.%[10]s ISNULL
+		GROUP BY blm.%[1]s`,
+		boiler.BattleLobbiesMechColumns.BattleLobbyID, // 1
+		boiler.MechRatingColumns.Rating,               // 2
+		boiler.BattleLobbiesMechColumns.MechID,        // 3
+		boiler.BattleLobbiesMechColumns.CreatedAt,     // 4
+		boiler.TableNames.BattleLobbiesMechs,          // 5
+		boiler.TableNames.MechRatings,                 // 6
+		boiler.MechRatingColumns.MechID,               // 7
+		boiler.BattleLobbiesMechColumns.RefundTXID,    // 8
+		boiler.BattleLobbiesMechColumns.DeletedAt,     // 9
+		boiler.BattleLobbiesMechColumns.EndedAt,       // 10
+	)
+	rows, err := conn.Query(q, pq.Array(battleLobbyIDs), RatingDefault)
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	defer rows.Close()
+
+	ratings := make(map[string]*LobbyRating)
+	for rows.Next() {
+		lr := &LobbyRating{}
+		err = rows.Scan(&lr.BattleLobbyID, &lr.Rating, &lr.MechCount, &lr.FirstQueuedAt)
+		if err != nil {
+			return nil, terror.Error(err)
+		}
+		ratings[lr.BattleLobbyID] = lr
+	}
+
+	return ratings, nil
+}
+
+// MatchmakingRatingBand returns how far the rating of a mech can be from the rating of a public lobby to join it.
+// The band widens the longer the lobby has been waiting for mechs, so lobbies still fill up when few players are online.
+func MatchmakingRatingBand(waiting time.Duration) decimal.Decimal {
+	band := GetDecimalWithDefault(KeyMatchmakingRatingBand, decimal.NewFromInt(200))
+	widenPerMinute := GetDecimalWithDefault(KeyMatchmakingRatingBandWidenPerMinute, decimal.NewFromInt(50))
+	maxBand := GetDecimalWithDefault(KeyMatchmakingRatingBandMax, decimal.NewFromInt(800))
+
+	return ratingBandWidened(band, widenPerMinute, maxBand, waiting)
+}
+
+// ratingBandWidened returns the band widened by how long the lobby has been waiting, up to the max band.
+func ratingBandWidened(band decimal.Decimal, widenPerMinute decimal.Decimal, maxBand decimal.Decimal, waiting time.Duration) decimal.Decimal {
+	band = band.Add(widenPerMinute.Mul(decimal.NewFromFloat(waiting.Minutes())))
+	if band.GreaterThan(maxBand) {
+		band = maxBand
+	}
+	return band
+}
+
+// MechRatingInBand returns true if a mech rating is close enough to the rating of a lobby to join it.
+// Lobbies without any mechs queued accept any rating.
+func MechRatingInBand(lr *LobbyRating, rating decimal.Decimal) bool {
+	if lr == nil || lr.MechCount == 0 {
+		return true
+	}
+	return ratingInBand(lr.Rating, rating, MatchmakingRatingBand(time.Since(lr.FirstQueuedAt)))
+}
+
+// ratingInBand returns true if a rating is within the band either side of the lobby rating.
+func ratingInBand(lobbyRating decimal.Decimal, rating decimal.Decimal, band decimal.Decimal) bool {
+	return rating.Sub(lobbyRating).Abs().LessThanOrEqual(band)
+}
+
+// MechRatingLeaderboard is a ranked mech on the rating leaderboard.
+type MechRatingLeaderboard struct {
+	MechID       string          `json:"mech_id"`
+	Name         string          `json:"name"`
+	Label        string          `json:"label"`
+	Owner        *server.Player  `json:"owner"`
+	Rating       decimal.Decimal `json:"rating"`
+	BattlesRated int             `json:"battles_rated"`
+}
+
+// TopRatedMechs returns the highest rated mechs which are no longer provisional, optionally in a faction.
+func TopRatedMechs(factionID null.String) ([]*MechRatingLeaderboard, error) {
+	args := []interface{}{RatingProvisionalBattles()}
+	factionClause := ""
+	if factionID.Valid {
+		factionClause = fmt.Sprintf("AND p.%s = $2", boiler.PlayerColumns.FactionID)
+		args = append(args, factionID.String)
+	}
+
+	q := fmt.Sprintf(`
+		SELECT mr.%[1]s, m.%[2]s, bm.%[3]s, TO_JSON(p.*), ROUND(mr.%[4]s), mr.%[5]s
+		FROM %[6]s mr
+		INNER JOIN %[7]s m ON m.%[8]s = mr.%[1]s
+		INNER JOIN %[9]s bm ON bm.%[10]s = m.%[11]s
+		INNER JOIN %[12]s ci ON ci.%[13]s = mr.%[1]s
+		INNER JOIN (
+			SELECT %[14]s, %[15]s, %[16]s, %[17]s, %[18]s FROM %[19]s
+		) p ON p.%[14]s = ci.%[20]s
+		WHERE mr.%[5]s >= $1 %[21]s
+		ORDER BY mr.%[4]s DESC
+		LIMIT 100`,
+		boiler.MechRatingColumns.MechID,       // 1
+		boiler.MechColumns.Name,               // 2
+		boiler.BlueprintMechColumns.Label,     // 3
+		boiler.MechRatingColumns.Rating,       // 4
+		boiler.MechRatingColumns.BattlesRated, // 5
+		boiler.TableNames.MechRatings,         // 6
+		boiler.TableNames.Mechs,               // 7
+		boiler.MechColumns.ID,                 // 8
+		boiler.TableNames.BlueprintMechs,      // 9
+		boiler.BlueprintMechColumns.ID,        // 10
+		boiler.MechColumns.BlueprintID,        // 11
+		boiler.TableNames.CollectionItems,     // 12
+		boiler.CollectionItemColumns.ItemID,   // 13
+		boiler.PlayerColumns.ID,               // 14
+		boiler.PlayerColumns.Username,         // 15
+		boiler.PlayerColumns.FactionID,        // 16
+		boiler.PlayerColumns.Gid,              // 17
+		boiler.PlayerColumns.Rank,             // 18
+		boiler.TableNames.Players,             // 19
+		boiler.CollectionItemColumns.OwnerID,  // 20
+		factionClause,                         // 21
+	)
+	rows, err := gamedb.StdConn.Query(q, args...)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to get mech rating leaderboard.")
+	}
+	defer rows.Close()
+
+	resp := []*MechRatingLeaderboard{}
+	for rows.Next() {
+		mrl := &MechRatingLeaderboard{}
+		err = rows.Scan(&mrl.MechID, &mrl.Name, &mrl.Label, &mrl.Owner, &mrl.Rating, &mrl.BattlesRated)
+		if err != nil {
+			return nil, terror.Error(err, "Failed to load mech rating leaderboard.")
+		}
+		resp = append(resp, mrl)
+	}
+
+	return resp, nil
+}
+
+// PlayerRatingLeaderboard is a ranked pilot on the rating leaderboard.
+type PlayerRatingLeaderboard struct {
+	Player       *server.Player  `json:"player"`
+	Rating       decimal.Decimal `json:"rating"`
+	BattlesRated int             `json:"battles_rated"`
+}
+
+// TopRatedPilots returns the highest rated pilots which are no longer provisional, optionally in a faction.
+func TopRatedPilots(factionID null.String) ([]*PlayerRatingLeaderboard, error) {
+	args := []interface{}{RatingProvisionalBattles()}
+	factionClause := ""
+	if factionID.Valid {
+		factionClause = fmt.Sprintf("AND p.%s = $2", boiler.PlayerColumns.FactionID)
+		args = append(args, factionID.String)
+	}
+
+	q := fmt.Sprintf(`
+		SELECT TO_JSON(p.*), ROUND(pr.%[1]s), pr.%[2]s
+		FROM %[3]s pr
+		INNER JOIN (
+			SELECT %[4]s, %[5]s, %[6]s, %[7]s, %[8]s FROM %[9]s
+		) p ON p.%[4]s = pr.%[10]s
+		WHERE pr.%[2]s >= $1 %[11]s
+		ORDER BY pr.%[1]s DESC
+		LIMIT 100`,
+		boiler.PlayerRatingColumns.Rating,       // 1
+		boiler.PlayerRatingColumns.BattlesRated, // 2
+		boiler.TableNames.PlayerRatings,         // 3
+		boiler.PlayerColumns.ID,                 // 4
+		boiler.PlayerColumns.Username,           // 5
+		boiler.PlayerColumns.FactionID,          // 6
+		boiler.PlayerColumns.Gid,                // 7
+		boiler.PlayerColumns.Rank,               // 8
+		boiler.TableNames.Players,               // 9
+		boiler.PlayerRatingColumns.PlayerID,     // 10
+		factionClause,                           // 11
+	)
+	rows, err := gamedb.StdConn.Query(q, args...)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to get pilot rating leaderboard.")
+	}
+	defer rows.Close()
+
+	resp := []*PlayerRatingLeaderboard{}
+	for rows.Next() {
+		prl := &PlayerRatingLeaderboard{}
+		err = rows.Scan(&prl.Player, &prl.Rating, &prl.BattlesRated)
+		if err != nil {
+			return nil, terror.Error(err, "Failed to load pilot rating leaderboard.")
+		}
+		resp = append(resp, prl)
+	}
+
+	return resp, nil
+}
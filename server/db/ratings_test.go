@@ -0,0 +1,72 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestRatingBandWidened(t *testing.T) {
+	band := decimal.NewFromInt(200)
+	widenPerMinute := decimal.NewFromInt(50)
+	maxBand := decimal.NewFromInt(800)
+
+	tests := []struct {
+		name     string
+		waiting  time.Duration
+		expected int64
+	}{
+		{"not waiting", 0, 200},
+		{"half a minute", 30 * time.Second, 225},
+		{"one minute", time.Minute, 250},
+		{"ten minutes", 10 * time.Minute, 700},
+		{"at the max", 12 * time.Minute, 800},
+		{"past the max", time.Hour, 800},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			widened := ratingBandWidened(band, widenPerMinute, maxBand, tt.waiting)
+			if !widened.Equal(decimal.NewFromInt(tt.expected)) {
+				t.Fatalf("unexpected band: %s, expected %d", widened, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRatingInBand(t *testing.T) {
+	lobbyRating := decimal.NewFromInt(1000)
+	band := decimal.NewFromInt(200)
+
+	tests := []struct {
+		name     string
+		rating   int64
+		expected bool
+	}{
+		{"same rating", 1000, true},
+		{"above", 1150, true},
+		{"below", 850, true},
+		{"top of the band", 1200, true},
+		{"bottom of the band", 800, true},
+		{"over the band", 1201, false},
+		{"under the band", 799, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if inBand := ratingInBand(lobbyRating, decimal.NewFromInt(tt.rating), band); inBand != tt.expected {
+				t.Fatalf("unexpected in band: %t, expected %t", inBand, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMechRatingInBand_EmptyLobby(t *testing.T) {
+	if !MechRatingInBand(nil, decimal.NewFromInt(3000)) {
+		t.Fatalf("lobbies without a rating should accept any rating")
+	}
+	if !MechRatingInBand(&LobbyRating{Rating: decimal.NewFromInt(1000)}, decimal.NewFromInt(3000)) {
+		t.Fatalf("lobbies without any mechs queued should accept any rating")
+	}
+}
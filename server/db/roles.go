@@ -0,0 +1,176 @@
+package db
+
+import (
+	"fmt"
+	"server"
+	"server/db/boiler"
+	"server/gamedb"
+
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type RoleWithPermissions struct {
+	*boiler.Role
+	Permissions []string `json:"permissions"`
+}
+
+// RoleHasPermission checks whether the role has been granted the given permission
+func RoleHasPermission(roleID string, perm server.Perm) (bool, error) {
+	granted, err := boiler.RolePermissions(
+		boiler.RolePermissionWhere.RoleID.EQ(roleID),
+		boiler.RolePermissionWhere.Permission.EQ(perm.String()),
+	).Exists(gamedb.StdConn)
+	if err != nil {
+		return false, terror.Error(err, "Failed to check role permission.")
+	}
+
+	return granted, nil
+}
+
+// RolePermissions returns the permissions granted to the role
+func RolePermissions(roleID string) ([]string, error) {
+	rolePermissions, err := boiler.RolePermissions(
+		boiler.RolePermissionWhere.RoleID.EQ(roleID),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load role permissions.")
+	}
+
+	perms := []string{}
+	for _, rp := range rolePermissions {
+		perms = append(perms, rp.Permission)
+	}
+
+	return perms, nil
+}
+
+// RolePermissionsNotHeld returns the permissions of the list which have not been granted to the role
+func RolePermissionsNotHeld(roleID string, perms []string) ([]string, error) {
+	held, err := RolePermissions(roleID)
+	if err != nil {
+		return nil, err
+	}
+
+	notHeld := []string{}
+	for _, perm := range perms {
+		found := false
+		for _, h := range held {
+			if h == perm {
+				found = true
+				break
+			}
+		}
+		if !found {
+			notHeld = append(notHeld, perm)
+		}
+	}
+
+	return notHeld, nil
+}
+
+// RoleList returns all the roles and the permissions granted to them
+func RoleList() ([]*RoleWithPermissions, error) {
+	roles, err := boiler.Roles(
+		qm.OrderBy(boiler.RoleColumns.RoleType),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load roles.")
+	}
+
+	rolePermissions, err := boiler.RolePermissions(
+		qm.OrderBy(boiler.RolePermissionColumns.Permission),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load role permissions.")
+	}
+
+	resp := []*RoleWithPermissions{}
+	for _, role := range roles {
+		rwp := &RoleWithPermissions{
+			Role:        role,
+			Permissions: []string{},
+		}
+		for _, rp := range rolePermissions {
+			if rp.RoleID == role.ID {
+				rwp.Permissions = append(rwp.Permissions, rp.Permission)
+			}
+		}
+		resp = append(resp, rwp)
+	}
+
+	return resp, nil
+}
+
+// RolePermissionsSet replaces the permissions granted to the role
+func RolePermissionsSet(roleID string, perms []server.Perm) error {
+	for _, perm := range perms {
+		if !perm.IsValid() {
+			return terror.Error(fmt.Errorf("invalid permission: %s", perm), fmt.Sprintf("Permission %s does not exist.", perm))
+		}
+	}
+
+	exists, err := boiler.RoleExists(gamedb.StdConn, roleID)
+	if err != nil {
+		return terror.Error(err, "Failed to load role.")
+	}
+	if !exists {
+		return terror.Error(fmt.Errorf("role %s does not exist", roleID), "Role does not exist.")
+	}
+
+	tx, err := gamedb.StdConn.Begin()
+	if err != nil {
+		return terror.Error(err, "Failed to start db transaction.")
+	}
+	defer tx.Rollback()
+
+	_, err = boiler.RolePermissions(
+		boiler.RolePermissionWhere.RoleID.EQ(roleID),
+	).DeleteAll(tx)
+	if err != nil {
+		return terror.Error(err, "Failed to clear role permissions.")
+	}
+
+	for _, perm := range perms {
+		rp := &boiler.RolePermission{
+			RoleID:     roleID,
+			Permission: perm.String(),
+		}
+		err = rp.Upsert(tx, false, []string{boiler.RolePermissionColumns.RoleID, boiler.RolePermissionColumns.Permission}, boil.None(), boil.Infer())
+		if err != nil {
+			return terror.Error(err, "Failed to grant role permission.")
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return terror.Error(err, "Failed to commit db transaction.")
+	}
+
+	return nil
+}
+
+// PlayerRoleAssign assigns the role to the player
+func PlayerRoleAssign(playerID string, roleID string) (*boiler.Player, error) {
+	exists, err := boiler.RoleExists(gamedb.StdConn, roleID)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load role.")
+	}
+	if !exists {
+		return nil, terror.Error(fmt.Errorf("role %s does not exist", roleID), "Role does not exist.")
+	}
+
+	player, err := boiler.FindPlayer(gamedb.StdConn, playerID)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load player.")
+	}
+
+	player.RoleID = roleID
+	_, err = player.Update(gamedb.StdConn, boil.Whitelist(boiler.PlayerColumns.RoleID))
+	if err != nil {
+		return nil, terror.Error(err, "Failed to update player role.")
+	}
+
+	return player, nil
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
@@ -115,11 +116,14 @@ func ShoppingCartUpdateExpiry(conn boil.Executor, id string, expiresAt time.Time
 }
 
 // ShoppingCartItemAdd adds a single cart item into the shopping cart.
-func ShoppingCartItemAdd(conn boil.Executor, shoppingCartID string, productID string, quantity int) error {
+// Gifts are either delivered to the gift recipient or bought as a gift code.
+func ShoppingCartItemAdd(conn boil.Executor, shoppingCartID string, productID string, quantity int, giftRecipientID null.String, giftCode bool) error {
 	item := &boiler.ShoppingCartItem{
-		ShoppingCartID: shoppingCartID,
-		ProductID:      productID,
-		Quantity:       quantity,
+		ShoppingCartID:  shoppingCartID,
+		ProductID:       productID,
+		Quantity:        quantity,
+		GiftRecipientID: giftRecipientID,
+		GiftCode:        giftCode,
 	}
 	err := item.Insert(conn, boil.Infer())
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/friendsofgo/errors"
 	"github.com/ninja-software/terror/v2"
+	"github.com/shopspring/decimal"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"server"
@@ -181,3 +182,52 @@ func GetSyndicateTotalAvailableMotionVoters(syndicateID string) (int64, error) {
 		return 0, terror.Error(fmt.Errorf("invalid syndicate type"), "Invalid syndicate type")
 	}
 }
+
+// SyndicateMemberArrears returns the total amount of dues the member has not paid
+func SyndicateMemberArrears(syndicateID string, playerID string) (decimal.Decimal, error) {
+	arrears := decimal.Zero
+
+	q := fmt.Sprintf(
+		`SELECT COALESCE(SUM(%s), 0) FROM %s WHERE %s = $1 AND %s = $2 AND %s = $3`,
+		boiler.SyndicateMemberDueColumns.Amount,
+		boiler.TableNames.SyndicateMemberDues,
+		boiler.SyndicateMemberDueColumns.SyndicateID,
+		boiler.SyndicateMemberDueColumns.PlayerID,
+		boiler.SyndicateMemberDueColumns.Status,
+	)
+	err := gamedb.StdConn.QueryRow(q, syndicateID, playerID, boiler.SyndicateMemberDuesStatusOVERDUE).Scan(&arrears)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("syndicate id", syndicateID).Str("player id", playerID).Msg("Failed to get syndicate member arrears from db.")
+		return decimal.Zero, terror.Error(err, "Failed to load syndicate member arrears.")
+	}
+
+	return arrears, nil
+}
+
+// SyndicateMemberDuesList returns the dues history of the member, latest first
+func SyndicateMemberDuesList(syndicateID string, playerID string, limit, offset int) ([]*boiler.SyndicateMemberDue, int64, error) {
+	queries := []qm.QueryMod{
+		boiler.SyndicateMemberDueWhere.SyndicateID.EQ(syndicateID),
+		boiler.SyndicateMemberDueWhere.PlayerID.EQ(playerID),
+	}
+
+	count, err := boiler.SyndicateMemberDues(queries...).Count(gamedb.StdConn)
+	if err != nil {
+		gamelog.L.Error().Err(err).Interface("queries", queries).Msg("Failed to get the total count of syndicate member dues from db")
+		return nil, 0, terror.Error(err, "Failed to get syndicate dues list")
+	}
+
+	queries = append(queries,
+		qm.OrderBy(fmt.Sprintf("%s DESC", boiler.SyndicateMemberDueColumns.CreatedAt)),
+		qm.Limit(limit),
+		qm.Offset(offset),
+	)
+
+	dues, err := boiler.SyndicateMemberDues(queries...).All(gamedb.StdConn)
+	if err != nil {
+		gamelog.L.Error().Err(err).Interface("queries", queries).Msg("Failed to get syndicate member dues list from db")
+		return nil, 0, terror.Error(err, "Failed to get syndicate dues list")
+	}
+
+	return dues, count, nil
+}
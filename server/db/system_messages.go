@@ -0,0 +1,229 @@
+package db
+
+import (
+	"fmt"
+	"server/db/boiler"
+	"server/gamedb"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// System message audiences of broadcast system messages. The audience id of a faction, syndicate or role audience is
+// its id, and the audience id of a feature audience is the feature name.
+const (
+	SystemMessageAudienceGlobal    = "GLOBAL"
+	SystemMessageAudienceFaction   = "FACTION"
+	SystemMessageAudienceSyndicate = "SYNDICATE"
+	SystemMessageAudienceRole      = "ROLE"
+	SystemMessageAudienceFeature   = "FEATURE"
+)
+
+// SystemMessageAudienceIsValid returns whether the audience exists, and whether it needs an audience id.
+func SystemMessageAudienceIsValid(audience string) (valid bool, needsID bool) {
+	switch audience {
+	case SystemMessageAudienceGlobal:
+		return true, false
+	case SystemMessageAudienceFaction, SystemMessageAudienceSyndicate, SystemMessageAudienceRole, SystemMessageAudienceFeature:
+		return true, true
+	}
+	return false, false
+}
+
+// broadcastSystemMessageVisible is the condition of the broadcast system messages (b) a player (p) can see. Players only
+// see the broadcasts sent since they signed up, the same as when a broadcast was a message per player.
+var broadcastSystemMessageVisible = fmt.Sprintf(`
+	b.%[1]s ISNULL AND b.%[2]s <= NOW() AND (b.%[3]s ISNULL OR b.%[3]s > NOW()) AND b.%[2]s >= p.%[4]s AND (
+		b.%[5]s = '%[7]s'
+		OR (b.%[5]s = '%[8]s' AND b.%[6]s = p.%[12]s::TEXT)
+		OR (b.%[5]s = '%[9]s' AND b.%[6]s = p.%[13]s::TEXT)
+		OR (b.%[5]s = '%[10]s' AND b.%[6]s = p.%[14]s::TEXT)
+		OR (b.%[5]s = '%[11]s' AND EXISTS (
+			SELECT 1 FROM %[15]s pf WHERE pf.%[16]s = p.%[18]s AND pf.%[17]s::TEXT = b.%[6]s AND pf.%[19]s ISNULL
+		))
+	)`,
+	boiler.BroadcastSystemMessageColumns.DeletedAt,
+	boiler.BroadcastSystemMessageColumns.SendAt,
+	boiler.BroadcastSystemMessageColumns.ExpiresAt,
+	boiler.PlayerColumns.CreatedAt,
+	boiler.BroadcastSystemMessageColumns.Audience,
+	boiler.BroadcastSystemMessageColumns.AudienceID,
+	SystemMessageAudienceGlobal,
+	SystemMessageAudienceFaction,
+	SystemMessageAudienceSyndicate,
+	SystemMessageAudienceRole,
+	SystemMessageAudienceFeature,
+	boiler.PlayerColumns.FactionID,
+	boiler.PlayerColumns.SyndicateID,
+	boiler.PlayerColumns.RoleID,
+	boiler.TableNames.PlayersFeatures,
+	boiler.PlayersFeatureColumns.PlayerID,
+	boiler.PlayersFeatureColumns.FeatureName,
+	boiler.PlayerColumns.ID,
+	boiler.PlayersFeatureColumns.DeletedAt,
+)
+
+// playerSystemMessages is the personal and broadcast system messages of the player $1, which have not expired.
+var playerSystemMessages = fmt.Sprintf(`
+	WITH messages AS (
+		SELECT sm.%[1]s, sm.%[2]s, sm.%[3]s, sm.%[4]s, sm.%[5]s, sm.%[6]s, sm.%[7]s, sm.%[8]s, FALSE AS is_broadcast
+		FROM %[9]s sm
+		WHERE sm.%[10]s = $1 AND (sm.%[11]s ISNULL OR sm.%[11]s > NOW())
+		UNION ALL
+		SELECT b.%[12]s, b.%[13]s, b.%[14]s, b.%[15]s, b.%[16]s, b.%[17]s, b.%[18]s, r.%[19]s, TRUE AS is_broadcast
+		FROM %[20]s b
+		INNER JOIN %[21]s p ON p.%[22]s = $1
+		LEFT JOIN %[23]s r ON r.%[24]s = b.%[12]s AND r.%[25]s = p.%[22]s
+		WHERE %[26]s
+	)`,
+	boiler.SystemMessageColumns.ID,
+	boiler.SystemMessageColumns.SenderID,
+	boiler.SystemMessageColumns.Title,
+	boiler.SystemMessageColumns.Message,
+	boiler.SystemMessageColumns.Data,
+	boiler.SystemMessageColumns.DataType,
+	boiler.SystemMessageColumns.SentAt,
+	boiler.SystemMessageColumns.ReadAt,
+	boiler.TableNames.SystemMessages,
+	boiler.SystemMessageColumns.PlayerID,
+	boiler.SystemMessageColumns.ExpiresAt,
+	boiler.BroadcastSystemMessageColumns.ID,
+	boiler.BroadcastSystemMessageColumns.SenderID,
+	boiler.BroadcastSystemMessageColumns.Title,
+	boiler.BroadcastSystemMessageColumns.Message,
+	boiler.BroadcastSystemMessageColumns.Data,
+	boiler.BroadcastSystemMessageColumns.DataType,
+	boiler.BroadcastSystemMessageColumns.SendAt,
+	boiler.BroadcastSystemMessageReceiptColumns.ReadAt,
+	boiler.TableNames.BroadcastSystemMessages,
+	boiler.TableNames.Players,
+	boiler.PlayerColumns.ID,
+	boiler.TableNames.BroadcastSystemMessageReceipts,
+	boiler.BroadcastSystemMessageReceiptColumns.BroadcastSystemMessageID,
+	boiler.BroadcastSystemMessageReceiptColumns.PlayerID,
+	broadcastSystemMessageVisible,
+)
+
+// PlayerSystemMessage is a personal or broadcast system message of a player.
+type PlayerSystemMessage struct {
+	ID          string      `boil:"id"`
+	SenderID    string      `boil:"sender_id"`
+	Title       string      `boil:"title"`
+	Message     string      `boil:"message"`
+	Data        null.JSON   `boil:"data"`
+	DataType    null.String `boil:"data_type"`
+	SentAt      time.Time   `boil:"sent_at"`
+	ReadAt      null.Time   `boil:"read_at"`
+	IsBroadcast bool        `boil:"is_broadcast"`
+}
+
+// PlayerSystemMessageList returns a page of the personal and broadcast system messages of a player, newest first,
+// with the total of the messages in the list and the total of the unread messages.
+func PlayerSystemMessageList(playerID string, hideRead bool, limit int, offset int) ([]*PlayerSystemMessage, int, int, error) {
+	counts := &struct {
+		Total       int `boil:"total"`
+		TotalUnread int `boil:"total_unread"`
+	}{}
+	q := playerSystemMessages + `
+		SELECT
+			COUNT(*) FILTER (WHERE $2 = FALSE OR read_at ISNULL) AS total,
+			COUNT(*) FILTER (WHERE read_at ISNULL) AS total_unread
+		FROM messages`
+	err := boiler.NewQuery(qm.SQL(q, playerID, hideRead)).Bind(nil, gamedb.StdConn, counts)
+	if err != nil {
+		return nil, 0, 0, terror.Error(err, "Failed to count system messages.")
+	}
+
+	result := []*PlayerSystemMessage{}
+	q = playerSystemMessages + `
+		SELECT * FROM messages
+		WHERE $2 = FALSE OR read_at ISNULL
+		ORDER BY sent_at DESC
+		LIMIT $3 OFFSET $4`
+	err = boiler.NewQuery(qm.SQL(q, playerID, hideRead, limit, offset)).Bind(nil, gamedb.StdConn, &result)
+	if err != nil {
+		return nil, 0, 0, terror.Error(err, "Failed to load system messages.")
+	}
+
+	return result, counts.Total, counts.TotalUnread, nil
+}
+
+// BroadcastSystemMessageVisible returns whether the player can see the broadcast system message.
+func BroadcastSystemMessageVisible(conn boil.Executor, playerID string, broadcastID string) (bool, error) {
+	q := fmt.Sprintf(`
+		SELECT EXISTS (
+			SELECT 1 FROM %s b
+			INNER JOIN %s p ON p.%s = $1
+			WHERE b.%s = $2 AND %s
+		)`,
+		boiler.TableNames.BroadcastSystemMessages,
+		boiler.TableNames.Players,
+		boiler.PlayerColumns.ID,
+		boiler.BroadcastSystemMessageColumns.ID,
+		broadcastSystemMessageVisible,
+	)
+
+	visible := false
+	err := conn.QueryRow(q, playerID, broadcastID).Scan(&visible)
+	if err != nil {
+		return false, terror.Error(err, "Failed to find system message.")
+	}
+
+	return visible, nil
+}
+
+// BroadcastSystemMessageAudienceFilter returns the players of the list which can see the broadcast system message.
+func BroadcastSystemMessageAudienceFilter(conn boil.Executor, broadcastID string, playerIDs []string) ([]string, error) {
+	result := []string{}
+	if len(playerIDs) == 0 {
+		return result, nil
+	}
+
+	q := fmt.Sprintf(`
+		SELECT p.%s FROM %s p
+		INNER JOIN %s b ON b.%s = $1
+		WHERE p.%s = ANY($2::UUID[]) AND %s`,
+		boiler.PlayerColumns.ID,
+		boiler.TableNames.Players,
+		boiler.TableNames.BroadcastSystemMessages,
+		boiler.BroadcastSystemMessageColumns.ID,
+		boiler.PlayerColumns.ID,
+		broadcastSystemMessageVisible,
+	)
+
+	rows, err := conn.Query(q, broadcastID, pq.Array(playerIDs))
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load system message audience.")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		playerID := ""
+		err = rows.Scan(&playerID)
+		if err != nil {
+			return nil, terror.Error(err, "Failed to load system message audience.")
+		}
+		result = append(result, playerID)
+	}
+
+	return result, rows.Err()
+}
+
+// BroadcastSystemMessageRead records the player reading a broadcast system message.
+func BroadcastSystemMessageRead(conn boil.Executor, playerID string, broadcastID string) error {
+	receipt := &boiler.BroadcastSystemMessageReceipt{
+		BroadcastSystemMessageID: broadcastID,
+		PlayerID:                 playerID,
+		ReadAt:                   time.Now(),
+	}
+	err := receipt.Upsert(conn, false, nil, boil.None(), boil.Infer())
+	if err != nil {
+		return terror.Error(err, "Failed to mark system message as read.")
+	}
+
+	return nil
+}
@@ -0,0 +1,262 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"server/db/boiler"
+
+	"github.com/friendsofgo/errors"
+	"github.com/ninja-software/terror/v2"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Bracket formats of a tournament
+const (
+	TournamentFormatSingleElimination = "single_elimination"
+	TournamentFormatDoubleElimination = "double_elimination"
+	TournamentFormatRoundRobin        = "round_robin"
+	TournamentFormatSwiss             = "swiss"
+)
+
+// Statuses of a tournament
+const (
+	TournamentStatusRegistration = "registration"
+	TournamentStatusInProgress   = "in_progress"
+	TournamentStatusCompleted    = "completed"
+	TournamentStatusCancelled    = "cancelled"
+)
+
+// Brackets a tournament match is played in, only double elimination uses more than the main bracket
+const (
+	TournamentBracketMain       = "main"
+	TournamentBracketWinners    = "winners"
+	TournamentBracketLosers     = "losers"
+	TournamentBracketGrandFinal = "grand_final"
+)
+
+// TournamentFormatIsValid returns true if a format is one of the supported bracket formats.
+func TournamentFormatIsValid(format string) bool {
+	switch format {
+	case TournamentFormatSingleElimination, TournamentFormatDoubleElimination, TournamentFormatRoundRobin, TournamentFormatSwiss:
+		return true
+	}
+	return false
+}
+
+// TournamentEntrant is an entrant of a tournament with the name of the player and their mech.
+type TournamentEntrant struct {
+	*boiler.TournamentEntrant
+	Username   string `json:"username"`
+	Gid        int    `json:"gid"`
+	MechName   string `json:"mech_name"`
+	Eliminated bool   `json:"eliminated"`
+}
+
+// TournamentState is the bracket state of a tournament, which is sent to the players watching it.
+type TournamentState struct {
+	*boiler.Tournament
+	HostUsername string                         `json:"host_username"`
+	PrizePool    decimal.Decimal                `json:"prize_pool"`
+	PrizeSplits  []*boiler.TournamentPrizeSplit `json:"prize_splits"`
+	GameMapIDs   []string                       `json:"game_map_ids"`
+	Entrants     []*TournamentEntrant           `json:"entrants"`
+	Matches      []*boiler.TournamentMatch      `json:"matches"`
+}
+
+// TournamentGet returns the bracket state of a tournament.
+func TournamentGet(conn boil.Executor, tournamentID string) (*TournamentState, error) {
+	t, err := boiler.FindTournament(conn, tournamentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, terror.Error(err, "Tournament does not exist.")
+	}
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load tournament.")
+	}
+
+	state := &TournamentState{
+		Tournament:  t,
+		PrizeSplits: []*boiler.TournamentPrizeSplit{},
+		GameMapIDs:  []string{},
+		Entrants:    []*TournamentEntrant{},
+		Matches:     []*boiler.TournamentMatch{},
+	}
+
+	host, err := boiler.FindPlayer(conn, t.HostByID)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load tournament host.")
+	}
+	state.HostUsername = host.Username.String
+
+	splits, err := boiler.TournamentPrizeSplits(
+		boiler.TournamentPrizeSplitWhere.TournamentID.EQ(t.ID),
+		qm.OrderBy(boiler.TournamentPrizeSplitColumns.Placement),
+	).All(conn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load tournament prize splits.")
+	}
+	state.PrizeSplits = append(state.PrizeSplits, splits...)
+
+	maps, err := boiler.TournamentMaps(
+		boiler.TournamentMapWhere.TournamentID.EQ(t.ID),
+	).All(conn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load tournament maps.")
+	}
+	for _, m := range maps {
+		state.GameMapIDs = append(state.GameMapIDs, m.GameMapID)
+	}
+
+	entrants, err := TournamentEntrants(conn, t.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	playerIDs := []string{}
+	mechIDs := []string{}
+	for _, te := range entrants {
+		playerIDs = append(playerIDs, te.PlayerID)
+		mechIDs = append(mechIDs, te.MechID)
+	}
+
+	players, err := boiler.Players(boiler.PlayerWhere.ID.IN(playerIDs)).All(conn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load tournament entrants.")
+	}
+	mechs, err := boiler.Mechs(boiler.MechWhere.ID.IN(mechIDs)).All(conn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load tournament mechs.")
+	}
+
+	for _, te := range entrants {
+		entrant := &TournamentEntrant{
+			TournamentEntrant: te,
+			Eliminated:        te.EliminatedAt.Valid,
+		}
+		for _, p := range players {
+			if p.ID == te.PlayerID {
+				entrant.Username = p.Username.String
+				entrant.Gid = p.Gid
+			}
+		}
+		for _, m := range mechs {
+			if m.ID == te.MechID {
+				entrant.MechName = m.Name
+			}
+		}
+		state.Entrants = append(state.Entrants, entrant)
+	}
+
+	matches, err := TournamentMatches(conn, t.ID)
+	if err != nil {
+		return nil, err
+	}
+	state.Matches = append(state.Matches, matches...)
+
+	state.PrizePool = TournamentPrizePool(t, len(entrants))
+
+	return state, nil
+}
+
+// TournamentList returns the tournaments in the given statuses, the ones closing registration first.
+func TournamentList(conn boil.Executor, statuses ...string) (boiler.TournamentSlice, error) {
+	ts, err := boiler.Tournaments(
+		boiler.TournamentWhere.Status.IN(statuses),
+		qm.OrderBy(boiler.TournamentColumns.RegistrationEndsAt),
+	).All(conn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load tournaments.")
+	}
+	return ts, nil
+}
+
+// TournamentEntrants returns the entrants of a tournament who have not withdrawn, in the order they joined.
+func TournamentEntrants(conn boil.Executor, tournamentID string) (boiler.TournamentEntrantSlice, error) {
+	entrants, err := boiler.TournamentEntrants(
+		boiler.TournamentEntrantWhere.TournamentID.EQ(tournamentID),
+		boiler.TournamentEntrantWhere.WithdrawnAt.IsNull(),
+		qm.OrderBy(boiler.TournamentEntrantColumns.CreatedAt),
+	).All(conn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load tournament entrants.")
+	}
+	return entrants, nil
+}
+
+// TournamentMechEntered returns true if a mech is entered into a tournament which has not finished, and it has not been knocked out of.
+func TournamentMechEntered(conn boil.Executor, mechID string) (bool, error) {
+	entered, err := boiler.TournamentEntrants(
+		qm.InnerJoin(fmt.Sprintf(
+			"%s ON %s = %s",
+			boiler.TableNames.Tournaments,
+			boiler.TournamentTableColumns.ID,
+			boiler.TournamentEntrantTableColumns.TournamentID,
+		)),
+		boiler.TournamentEntrantWhere.MechID.EQ(mechID),
+		boiler.TournamentEntrantWhere.WithdrawnAt.IsNull(),
+		boiler.TournamentEntrantWhere.EliminatedAt.IsNull(),
+		boiler.TournamentWhere.Status.IN([]string{TournamentStatusRegistration, TournamentStatusInProgress}),
+	).Exists(conn)
+	if err != nil {
+		return false, terror.Error(err, "Failed to check tournament entries.")
+	}
+	return entered, nil
+}
+
+// TournamentMatches returns the matches of a tournament in the order they were played.
+func TournamentMatches(conn boil.Executor, tournamentID string) (boiler.TournamentMatchSlice, error) {
+	matches, err := boiler.TournamentMatches(
+		boiler.TournamentMatchWhere.TournamentID.EQ(tournamentID),
+		qm.OrderBy(boiler.TournamentMatchColumns.Round+", "+boiler.TournamentMatchColumns.MatchNumber),
+	).All(conn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load tournament matches.")
+	}
+	return matches, nil
+}
+
+// TournamentMatchByBattleLobbyID returns the tournament match played in a battle lobby, or nil when the lobby is not a tournament match.
+func TournamentMatchByBattleLobbyID(conn boil.Executor, battleLobbyID string) (*boiler.TournamentMatch, error) {
+	tm, err := boiler.TournamentMatches(
+		boiler.TournamentMatchWhere.BattleLobbyID.EQ(null.StringFrom(battleLobbyID)),
+	).One(conn)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, terror.Error(err, "Failed to load tournament match.")
+	}
+	return tm, nil
+}
+
+// TournamentPrizePool returns the sups paid out to the entrants, which is the entry fees plus the prize put up by the host.
+func TournamentPrizePool(t *boiler.Tournament, entrantCount int) decimal.Decimal {
+	return t.EntryFee.Mul(decimal.NewFromInt(int64(entrantCount))).Add(t.HostPrize)
+}
+
+// TournamentPrizes returns the prize of each entrant from their final placement. Entrants tied on a placement
+// share the prize splits of all the placements they take up, so a tie never pays out more than the pool.
+func TournamentPrizes(splits []*boiler.TournamentPrizeSplit, placements map[string]int, pool decimal.Decimal) map[string]decimal.Decimal {
+	tied := make(map[int]int)
+	for _, placement := range placements {
+		tied[placement] += 1
+	}
+
+	prizes := make(map[string]decimal.Decimal)
+	for entrantID, placement := range placements {
+		share := decimal.Zero
+		for _, split := range splits {
+			if split.Placement >= placement && split.Placement < placement+tied[placement] {
+				share = share.Add(split.Share)
+			}
+		}
+		if share.IsZero() {
+			continue
+		}
+		prizes[entrantID] = pool.Mul(share).Div(decimal.NewFromInt(int64(tied[placement]))).Floor()
+	}
+
+	return prizes
+}
@@ -0,0 +1,81 @@
+package db
+
+import (
+	"server/db/boiler"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestTournamentPrizes(t *testing.T) {
+	splits := []*boiler.TournamentPrizeSplit{
+		{Placement: 1, Share: decimal.NewFromFloat(0.5)},
+		{Placement: 2, Share: decimal.NewFromFloat(0.3)},
+		{Placement: 3, Share: decimal.NewFromFloat(0.2)},
+	}
+	pool := decimal.NewFromInt(1000)
+
+	tests := []struct {
+		name       string
+		placements map[string]int
+		expected   map[string]int64
+	}{
+		{
+			name:       "no ties",
+			placements: map[string]int{"a": 1, "b": 2, "c": 3, "d": 4},
+			expected:   map[string]int64{"a": 500, "b": 300, "c": 200},
+		},
+		{
+			name:       "tied second share second and third",
+			placements: map[string]int{"a": 1, "b": 2, "c": 2, "d": 4},
+			expected:   map[string]int64{"a": 500, "b": 250, "c": 250},
+		},
+		{
+			name:       "tied third share third",
+			placements: map[string]int{"a": 1, "b": 2, "c": 3, "d": 3},
+			expected:   map[string]int64{"a": 500, "b": 300, "c": 100, "d": 100},
+		},
+		{
+			name:       "three tied first round down",
+			placements: map[string]int{"a": 1, "b": 1, "c": 1},
+			expected:   map[string]int64{"a": 333, "b": 333, "c": 333},
+		},
+		{
+			name:       "tied past the prize splits",
+			placements: map[string]int{"a": 1, "b": 2, "c": 3, "d": 4, "e": 4},
+			expected:   map[string]int64{"a": 500, "b": 300, "c": 200},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prizes := TournamentPrizes(splits, tt.placements, pool)
+			if len(prizes) != len(tt.expected) {
+				t.Fatalf("unexpected prizes: %v, expected %v", prizes, tt.expected)
+			}
+
+			total := decimal.Zero
+			for entrantID, expected := range tt.expected {
+				prize, ok := prizes[entrantID]
+				if !ok || !prize.Equal(decimal.NewFromInt(expected)) {
+					t.Fatalf("unexpected prize of %s: %s, expected %d", entrantID, prize, expected)
+				}
+				total = total.Add(prize)
+			}
+			if total.GreaterThan(pool) {
+				t.Fatalf("prizes add up to %s, more than the pool of %s", total, pool)
+			}
+		})
+	}
+}
+
+func TestTournamentPrizePool(t *testing.T) {
+	tournament := &boiler.Tournament{
+		EntryFee:  decimal.NewFromInt(100),
+		HostPrize: decimal.NewFromInt(250),
+	}
+	pool := TournamentPrizePool(tournament, 8)
+	if !pool.Equal(decimal.NewFromInt(1050)) {
+		t.Fatalf("unexpected prize pool: %s, expected 1050", pool)
+	}
+}
@@ -38,6 +38,8 @@ func main() {
 					&cli.StringFlag{Name: "database_name", Value: "gameserver", EnvVars: []string{envPrefix + "_DATABASE_NAME", "DATABASE_NAME"}, Usage: "The database name"},
 					&cli.StringFlag{Name: "database_application_name", Value: "API Sync", EnvVars: []string{envPrefix + "_DATABASE_APPLICATION_NAME"}, Usage: "Postgres database name"},
 					&cli.StringFlag{Name: "static_path", Value: "./synctool/temp-sync/supremacy-static-data/", EnvVars: []string{envPrefix + "_STATIC_PATH"}, Usage: "Static path to file"},
+					&cli.BoolFlag{Name: "dry_run", Value: false, Usage: "Roll the sync back once the report of what would change is printed"},
+					&cli.StringFlag{Name: "report_path", Value: "", Usage: "Path to write the json report of the sync to"},
 					&cli.IntFlag{Name: "database_max_idle_conns", Value: 40, EnvVars: []string{envPrefix + "_DATABASE_MAX_IDLE_CONNS"}, Usage: "Database max idle conns"},
 					&cli.IntFlag{Name: "database_max_open_conns", Value: 50, EnvVars: []string{envPrefix + "_DATABASE_MAX_OPEN_CONNS"}, Usage: "Database max open conns"},
 				},
@@ -76,8 +78,10 @@ func main() {
 					}
 
 					dt := &synctool.StaticSyncTool{
-						DB:       sqlconn,
-						FilePath: filePath,
+						DB:         sqlconn,
+						FilePath:   filePath,
+						DryRun:     c.Bool("dry_run"),
+						ReportPath: c.String("report_path"),
 					}
 
 					err = synctool.SyncTool(dt)
@@ -114,9 +114,11 @@ type ShoppingCart struct {
 
 // ShoppingCartItem holds a single product on shopping cart.
 type ShoppingCartItem struct {
-	ID       string       `json:"id"`
-	Quantity int          `json:"quantity"`
-	Product  *FiatProduct `json:"product"`
+	ID              string       `json:"id"`
+	Quantity        int          `json:"quantity"`
+	Product         *FiatProduct `json:"product"`
+	GiftRecipientID null.String  `json:"gift_recipient_id"`
+	GiftCode        bool         `json:"gift_code"`
 }
 
 func ShoppingCartFromBoiler(sc *boiler.ShoppingCart, items []*boiler.ShoppingCartItem) *ShoppingCart {
@@ -129,8 +131,10 @@ func ShoppingCartFromBoiler(sc *boiler.ShoppingCart, items []*boiler.ShoppingCar
 	}
 	for _, sci := range items {
 		outputItem := &ShoppingCartItem{
-			ID:       sci.ID,
-			Quantity: sci.Quantity,
+			ID:              sci.ID,
+			Quantity:        sci.Quantity,
+			GiftRecipientID: sci.GiftRecipientID,
+			GiftCode:        sci.GiftCode,
 		}
 		if sci.R != nil && sci.R.Product != nil {
 			outputItem.Product = FiatProductFromBoiler(sci.R.Product)
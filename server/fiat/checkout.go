@@ -28,8 +28,29 @@ type ProductItemPackageContents struct {
 	PowerCore   *server.PowerCore    `json:"power_core,omitempty"`
 }
 
-// SendMysteryCrateToUser sends out the mystery crate to user.
-func SendMysteryCrateToUser(conn *sql.Tx, pp *xsyn_rpcclient.XsynXrpcClient, userID string, productID string, quantity int) error {
+// OrderAssets lists the package contents to record against the order delivering them.
+func (p *ProductItemPackageContents) OrderAssets() []*db.FiatOrderAsset {
+	assets := []*db.FiatOrderAsset{}
+	if p.Mech != nil {
+		assets = append(assets, &db.FiatOrderAsset{ItemType: boiler.ItemTypeMech, ItemID: p.Mech.ID})
+	}
+	for _, skin := range p.MechSkins {
+		assets = append(assets, &db.FiatOrderAsset{ItemType: boiler.ItemTypeMechSkin, ItemID: skin.ID})
+	}
+	for _, weapon := range p.Weapons {
+		assets = append(assets, &db.FiatOrderAsset{ItemType: boiler.ItemTypeWeapon, ItemID: weapon.ID})
+	}
+	for _, skin := range p.WeaponSkins {
+		assets = append(assets, &db.FiatOrderAsset{ItemType: boiler.ItemTypeWeaponSkin, ItemID: skin.ID})
+	}
+	if p.PowerCore != nil {
+		assets = append(assets, &db.FiatOrderAsset{ItemType: boiler.ItemTypePowerCore, ItemID: p.PowerCore.ID})
+	}
+	return assets
+}
+
+// SendMysteryCrateToUser sends out the mystery crate to user, recording the crates against the order item.
+func SendMysteryCrateToUser(conn *sql.Tx, pp *xsyn_rpcclient.XsynXrpcClient, userID string, productID string, orderItemID string, quantity int) error {
 	pl := gamelog.L.With().Str("fiat_product_id", productID).Int("quantity", quantity).Logger()
 	errMsg := "Could not give package item, try again or contact support."
 
@@ -52,7 +73,7 @@ func SendMysteryCrateToUser(conn *sql.Tx, pp *xsyn_rpcclient.XsynXrpcClient, use
 	// Assign multiple crate purchases
 	var xsynAssets []*rpctypes.XsynAsset
 	for i := 0; i < quantity; i++ {
-		xsynAsset, err := assignAndRegisterPurchasedCrate(userID, storeCrate, conn)
+		xsynAsset, err := assignAndRegisterPurchasedCrate(userID, storeCrate, orderItemID, conn)
 		if err != nil {
 			return terror.Error(err, "Failed to purchase mystery crate, please try again or contact support.")
 		}
@@ -95,7 +116,7 @@ func SendMysteryCrateToUser(conn *sql.Tx, pp *xsyn_rpcclient.XsynXrpcClient, use
 }
 
 // TODO: This is basically duplicated code from ws_store.go
-func assignAndRegisterPurchasedCrate(userID string, storeCrate *boiler.StorefrontMysteryCrate, tx *sql.Tx) (*rpctypes.XsynAsset, error) {
+func assignAndRegisterPurchasedCrate(userID string, storeCrate *boiler.StorefrontMysteryCrate, orderItemID string, tx *sql.Tx) (*rpctypes.XsynAsset, error) {
 	availableCrates, err := boiler.MysteryCrates(
 		boiler.MysteryCrateWhere.FactionID.EQ(storeCrate.FactionID),
 		boiler.MysteryCrateWhere.Type.EQ(storeCrate.MysteryCrateType),
@@ -148,6 +169,21 @@ func assignAndRegisterPurchasedCrate(userID string, storeCrate *boiler.Storefron
 		return nil, terror.Error(err, "Failed to get mystery crate, please try again or contact support.")
 	}
 
+	// commit the seed of the crate contents
+	_, err = db.MysteryCrateSeedCommit(tx, assignedCrate.ID, storeCrate.ID)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to get mystery crate, please try again or contact support.")
+	}
+
+	err = db.FiatOrderItemAssetsInsert(tx, orderItemID, null.String{}, db.OrderItemAssetStatusDelivered, &db.FiatOrderAsset{
+		ItemType: boiler.ItemTypeMysteryCrate,
+		ItemID:   assignedCrate.ID,
+	})
+	if err != nil {
+		gamelog.L.Error().Err(err).Interface("mystery crate", assignedCrate).Msg("failed to record delivered crate")
+		return nil, terror.Error(err, "Failed to get mystery crate, please try again or contact support.")
+	}
+
 	//register
 	assignedCrateServer := server.MysteryCrateFromBoiler(assignedCrate, collectionItem, null.String{})
 	xsynAsset := rpctypes.ServerMysteryCrateToXsynAsset(assignedCrateServer, faction.Label)
@@ -155,8 +191,8 @@ func assignAndRegisterPurchasedCrate(userID string, storeCrate *boiler.Storefron
 	return xsynAsset, nil
 }
 
-// SendStarterPackageContentsToUser sends out the package contents to user.
-func SendStarterPackageContentsToUser(conn *sql.Tx, pp *xsyn_rpcclient.XsynXrpcClient, userID string, productID string) error {
+// SendStarterPackageContentsToUser sends out the package contents to user, recording the contents against the order item.
+func SendStarterPackageContentsToUser(conn *sql.Tx, pp *xsyn_rpcclient.XsynXrpcClient, userID string, productID string, orderItemID string) error {
 	errMsg := "Could not give package item, try again or contact support."
 
 	productItems, err := boiler.FiatProductItems(
@@ -329,6 +365,13 @@ func SendStarterPackageContentsToUser(conn *sql.Tx, pp *xsyn_rpcclient.XsynXrpcC
 			items.PowerCore = powerCore
 		}
 
+		err = db.FiatOrderItemAssetsInsert(conn, orderItemID, null.String{}, db.OrderItemAssetStatusDelivered, items.OrderAssets()...)
+		if err != nil {
+			pl.Error().Err(err).
+				Msg(fmt.Sprintf("failed to record delivered contents of product item: %s, for user: %s", item.ID, user.ID))
+			return terror.Error(err, errMsg)
+		}
+
 		// Attach parts to items
 		if item.ItemType == boiler.FiatProductItemTypesMechPackage {
 			eod, err := db.MechEquippedOnDetails(conn, items.Mech.ID)
@@ -0,0 +1,330 @@
+package game_client_sim
+
+import (
+	"context"
+	"encoding/binary"
+	"math/rand"
+	"server"
+	"server/battle"
+	"server/helpers"
+	"time"
+)
+
+// the map the simulator reports back to the server, matches the dimensions of the arctic bay map
+var simulatorGameMap = server.GameMap{
+	Name:      "ArcticBay",
+	Width:     2750,
+	Height:    2750,
+	CellsX:    55,
+	CellsY:    55,
+	PixelTop:  -40000,
+	PixelLeft: -39000,
+}
+
+type battleInitPayload struct {
+	BattleID     string                         `json:"battle_id"`
+	MapName      string                         `json:"map_name"`
+	BattleNumber int                            `json:"battle_number"`
+	WarMachines  []*battle.WarMachineGameClient `json:"war_machines"`
+}
+
+type abilityEvent struct {
+	EventID             string  `json:"event_id"`
+	GameClientAbilityID byte    `json:"game_client_ability_id"`
+	WarMachineHash      *string `json:"war_machine_hash,omitempty"`
+}
+
+func (e *abilityEvent) warMachineHash() string {
+	if e.WarMachineHash == nil {
+		return ""
+	}
+	return *e.WarMachineHash
+}
+
+type simWarMachine struct {
+	Hash          string
+	ParticipantID byte
+	FactionID     string
+
+	HealthMax uint32
+	Health    uint32
+	ShieldMax uint32
+	Shield    uint32
+	X         int32
+	Y         int32
+	Rotation  int32
+
+	destroyAt    time.Duration // zero if the war machine survives the battle
+	damage       uint32        // damage taken by the end of the battle, if the war machine survives
+	killedByHash string
+	destroyed    bool
+}
+
+type simBattle struct {
+	ID      string
+	Number  int
+	MapName string
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	rand             *rand.Rand
+	script           *ScriptedBattle
+	duration         time.Duration
+	winningFactionID string
+	winCondition     string
+	warMachines      []*simWarMachine
+}
+
+// newSimBattle plans the outcome of the battle, either from the script or randomly
+func newSimBattle(ctx context.Context, payload *battleInitPayload, script *ScriptedBattle, duration time.Duration, seed int64) *simBattle {
+	btl := &simBattle{
+		ID:           payload.BattleID,
+		Number:       payload.BattleNumber,
+		MapName:      payload.MapName,
+		rand:         rand.New(rand.NewSource(seed)),
+		script:       script,
+		duration:     script.duration(duration),
+		winCondition: defaultWinCondition,
+	}
+	btl.ctx, btl.cancel = context.WithCancel(ctx)
+
+	if script != nil && script.WinCondition != "" {
+		btl.winCondition = script.WinCondition
+	}
+
+	var factionIDs []string
+	for i, wm := range payload.WarMachines {
+		swm := &simWarMachine{
+			Hash:          wm.Hash,
+			ParticipantID: byte(i + 1), // participant id 0 is treated as not ready by the server
+			FactionID:     wm.FactionID,
+			HealthMax:     wm.HealthMax,
+			Health:        wm.HealthMax,
+			ShieldMax:     wm.ShieldMax,
+			Shield:        wm.ShieldMax,
+			X:             int32(simulatorGameMap.PixelLeft) + btl.rand.Int31n(int32(int64(simulatorGameMap.CellsX)*server.GameClientTileSize)),
+			Y:             int32(simulatorGameMap.PixelTop) + btl.rand.Int31n(int32(int64(simulatorGameMap.CellsY)*server.GameClientTileSize)),
+			Rotation:      btl.rand.Int31n(360),
+		}
+		btl.warMachines = append(btl.warMachines, swm)
+
+		found := false
+		for _, fid := range factionIDs {
+			if fid == wm.FactionID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			factionIDs = append(factionIDs, wm.FactionID)
+		}
+	}
+
+	if script != nil && script.WinningFactionID != "" {
+		btl.winningFactionID = script.WinningFactionID
+	} else if len(factionIDs) > 0 {
+		btl.winningFactionID = factionIDs[btl.rand.Intn(len(factionIDs))]
+	}
+
+	if script != nil && len(script.Kills) > 0 {
+		for _, kill := range script.Kills {
+			wm := btl.warMachine(kill.DestroyedHash)
+			if wm == nil {
+				continue
+			}
+			wm.destroyAt = time.Duration(kill.AtSeconds) * time.Second
+			wm.killedByHash = kill.KilledByHash
+		}
+	} else {
+		var winners []*simWarMachine
+		for _, wm := range btl.warMachines {
+			if wm.FactionID == btl.winningFactionID {
+				winners = append(winners, wm)
+			}
+		}
+
+		// every war machine outside the winning faction is destroyed between 10% and 90% of the battle
+		for _, wm := range btl.warMachines {
+			if wm.FactionID == btl.winningFactionID {
+				continue
+			}
+			wm.destroyAt = time.Duration(float64(btl.duration) * (0.1 + 0.8*btl.rand.Float64()))
+			if len(winners) > 0 {
+				wm.killedByHash = winners[btl.rand.Intn(len(winners))].Hash
+			}
+		}
+	}
+
+	// survivors lose up to half of their health
+	for _, wm := range btl.warMachines {
+		if wm.destroyAt == 0 && wm.HealthMax > 0 {
+			wm.damage = uint32(btl.rand.Int63n(int64(wm.HealthMax)/2 + 1))
+		}
+	}
+
+	return btl
+}
+
+func (btl *simBattle) warMachine(hash string) *simWarMachine {
+	for _, wm := range btl.warMachines {
+		if wm.Hash == hash {
+			return wm
+		}
+	}
+	return nil
+}
+
+// ended returns true once every war machine outside the winning faction is destroyed
+func (btl *simBattle) ended() bool {
+	for _, wm := range btl.warMachines {
+		if wm.FactionID != btl.winningFactionID && !wm.destroyed {
+			return false
+		}
+	}
+	return true
+}
+
+// update moves the war machines and applies damage, returns the war machines destroyed in this update
+func (btl *simBattle) update(elapsed time.Duration) []*simWarMachine {
+	var destroyed []*simWarMachine
+
+	step := int32(server.GameClientTileSize / 2)
+	minX, minY := int32(simulatorGameMap.PixelLeft), int32(simulatorGameMap.PixelTop)
+	maxX := minX + int32(int64(simulatorGameMap.CellsX)*server.GameClientTileSize)
+	maxY := minY + int32(int64(simulatorGameMap.CellsY)*server.GameClientTileSize)
+
+	for _, wm := range btl.warMachines {
+		if wm.destroyed {
+			continue
+		}
+
+		wm.X = clamp(wm.X+btl.rand.Int31n(step*2+1)-step, minX, maxX)
+		wm.Y = clamp(wm.Y+btl.rand.Int31n(step*2+1)-step, minY, maxY)
+		wm.Rotation = (wm.Rotation + btl.rand.Int31n(31) - 15 + 360) % 360
+
+		if wm.destroyAt > 0 && elapsed >= wm.destroyAt {
+			wm.destroyed = true
+			wm.Health = 0
+			wm.Shield = 0
+			destroyed = append(destroyed, wm)
+			continue
+		}
+
+		// drain the shield first, then the health
+		var progress float64
+		if wm.destroyAt > 0 {
+			progress = float64(elapsed) / float64(wm.destroyAt)
+		} else {
+			progress = float64(elapsed) / float64(btl.duration)
+		}
+		if progress > 1 {
+			progress = 1
+		}
+
+		wm.Shield = uint32(float64(wm.ShieldMax) * (1 - progress))
+		if wm.destroyAt > 0 {
+			// keep at least 1 health until the war machine is destroyed
+			wm.Health = uint32(float64(wm.HealthMax-1)*(1-progress)) + 1
+		} else {
+			wm.Health = wm.HealthMax - uint32(float64(wm.damage)*progress)
+		}
+	}
+
+	return destroyed
+}
+
+// tickFrame encodes the war machine state in the binary format read by battle.Tick
+func (btl *simBattle) tickFrame() []byte {
+	// position, health and shield are updated
+	syncByte := helpers.PackBooleansIntoByte([]bool{true, true, true})
+
+	frame := []byte{byte(battle.Tick), byte(len(btl.warMachines))}
+	for _, wm := range btl.warMachines {
+		frame = append(frame, wm.ParticipantID, syncByte)
+		frame = append(frame, helpers.IntToBytes(wm.X)...)
+		frame = append(frame, helpers.IntToBytes(wm.Y)...)
+		frame = append(frame, helpers.IntToBytes(wm.Rotation)...)
+		frame = append(frame, uint32ToBytes(wm.Health)...)
+		frame = append(frame, uint32ToBytes(wm.Shield)...)
+	}
+
+	return frame
+}
+
+func (btl *simBattle) mapDetails() *battle.MapDetailsPayload {
+	gameMap := simulatorGameMap
+	if btl.MapName != "" {
+		gameMap.Name = btl.MapName
+	}
+
+	return &battle.MapDetailsPayload{
+		Details:     gameMap,
+		BattleZones: []server.BattleZone{},
+		BattleID:    btl.ID,
+	}
+}
+
+func (btl *simBattle) startPayload(buildNo string) *battle.BattleStartPayload {
+	payload := &battle.BattleStartPayload{
+		BattleID:      btl.ID,
+		ClientBuildNo: buildNo,
+		MapName:       btl.MapName,
+	}
+	for _, wm := range btl.warMachines {
+		payload.WarMachines = append(payload.WarMachines, struct {
+			Hash          string `json:"hash"`
+			ParticipantID byte   `json:"participant_id"`
+		}{wm.Hash, wm.ParticipantID})
+	}
+
+	return payload
+}
+
+func (btl *simBattle) destroyedPayload(wm *simWarMachine) *battle.BattleWMDestroyedPayload {
+	payload := &battle.BattleWMDestroyedPayload{
+		BattleID:                btl.ID,
+		DestroyedWarMachineHash: wm.Hash,
+		KilledByWarMachineHash:  wm.killedByHash,
+		ParticipantID:           int(wm.ParticipantID),
+	}
+	if killer := btl.warMachine(wm.killedByHash); killer == nil {
+		payload.KilledBy = "simulated ability"
+	}
+
+	return payload
+}
+
+func (btl *simBattle) endPayload() *battle.BattleEndPayload {
+	payload := &battle.BattleEndPayload{
+		BattleID:     btl.ID,
+		WinCondition: btl.winCondition,
+	}
+	for _, wm := range btl.warMachines {
+		if wm.FactionID != btl.winningFactionID || wm.destroyed {
+			continue
+		}
+		payload.WinningWarMachines = append(payload.WinningWarMachines, struct {
+			Hash   string `json:"hash"`
+			Health int    `json:"health"`
+		}{wm.Hash, int(wm.Health)})
+	}
+
+	return payload
+}
+
+func uint32ToBytes(v uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, v)
+	return b
+}
+
+func clamp(v, min, max int32) int32 {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
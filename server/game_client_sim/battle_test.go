@@ -0,0 +1,174 @@
+package game_client_sim
+
+import (
+	"context"
+	"encoding/binary"
+	"server/battle"
+	"server/helpers"
+	"testing"
+	"time"
+)
+
+func testBattleInitPayload() *battleInitPayload {
+	return &battleInitPayload{
+		BattleID:     "battle-1",
+		MapName:      "ArcticBay",
+		BattleNumber: 1,
+		WarMachines: []*battle.WarMachineGameClient{
+			{Hash: "red-1", FactionID: "red", HealthMax: 1000, ShieldMax: 500},
+			{Hash: "red-2", FactionID: "red", HealthMax: 1000, ShieldMax: 500},
+			{Hash: "blue-1", FactionID: "blue", HealthMax: 1000, ShieldMax: 500},
+			{Hash: "blue-2", FactionID: "blue", HealthMax: 1000, ShieldMax: 500},
+		},
+	}
+}
+
+func TestSimBattle_TickFrame(t *testing.T) {
+	btl := newSimBattle(context.Background(), testBattleInitPayload(), nil, time.Minute, 1)
+	btl.update(10 * time.Second)
+
+	frame := btl.tickFrame()
+
+	// message type, war machine count, then participant id, sync byte and five 4 byte values per war machine
+	if len(frame) != 2+len(btl.warMachines)*22 {
+		t.Fatalf("unexpected frame length: %d", len(frame))
+	}
+	if battle.MessageType(frame[0]) != battle.Tick {
+		t.Fatalf("unexpected message type: %d", frame[0])
+	}
+	if int(frame[1]) != len(btl.warMachines) {
+		t.Fatalf("unexpected war machine count: %d", frame[1])
+	}
+
+	offset := 2
+	for _, wm := range btl.warMachines {
+		if frame[offset] != wm.ParticipantID {
+			t.Fatalf("unexpected participant id: %d, expected %d", frame[offset], wm.ParticipantID)
+		}
+		if wm.ParticipantID == 0 {
+			t.Fatalf("participant id 0 is treated as not ready")
+		}
+
+		synced := helpers.UnpackBooleansFromByte(frame[offset+1])
+		if !synced[0] || !synced[1] || !synced[2] {
+			t.Fatalf("position, health and shield should be synced")
+		}
+
+		if x := helpers.BytesToInt(frame[offset+2 : offset+6]); x != wm.X {
+			t.Fatalf("unexpected x: %d, expected %d", x, wm.X)
+		}
+		if y := helpers.BytesToInt(frame[offset+6 : offset+10]); y != wm.Y {
+			t.Fatalf("unexpected y: %d, expected %d", y, wm.Y)
+		}
+		if rotation := helpers.BytesToInt(frame[offset+10 : offset+14]); rotation != wm.Rotation {
+			t.Fatalf("unexpected rotation: %d, expected %d", rotation, wm.Rotation)
+		}
+		if health := binary.BigEndian.Uint32(frame[offset+14 : offset+18]); health != wm.Health {
+			t.Fatalf("unexpected health: %d, expected %d", health, wm.Health)
+		}
+		if shield := binary.BigEndian.Uint32(frame[offset+18 : offset+22]); shield != wm.Shield {
+			t.Fatalf("unexpected shield: %d, expected %d", shield, wm.Shield)
+		}
+
+		offset += 22
+	}
+}
+
+func TestSimBattle_Script(t *testing.T) {
+	script := &ScriptedBattle{
+		DurationSeconds:  60,
+		WinningFactionID: "blue",
+		Kills: []*ScriptedKill{
+			{DestroyedHash: "red-1", KilledByHash: "blue-1", AtSeconds: 20},
+			{DestroyedHash: "red-2", AtSeconds: 40},
+		},
+	}
+	btl := newSimBattle(context.Background(), testBattleInitPayload(), script, 5*time.Minute, 1)
+
+	if btl.duration != time.Minute {
+		t.Fatalf("unexpected duration: %s", btl.duration)
+	}
+
+	tests := []struct {
+		elapsed   time.Duration
+		destroyed []string
+		ended     bool
+	}{
+		{10 * time.Second, nil, false},
+		{20 * time.Second, []string{"red-1"}, false},
+		{30 * time.Second, nil, false},
+		{40 * time.Second, []string{"red-2"}, true},
+	}
+
+	for _, tt := range tests {
+		destroyed := btl.update(tt.elapsed)
+		if len(destroyed) != len(tt.destroyed) {
+			t.Fatalf("at %s: unexpected destroyed count: %d, expected %d", tt.elapsed, len(destroyed), len(tt.destroyed))
+		}
+		for i, wm := range destroyed {
+			if wm.Hash != tt.destroyed[i] {
+				t.Fatalf("at %s: unexpected destroyed war machine: %s, expected %s", tt.elapsed, wm.Hash, tt.destroyed[i])
+			}
+			if wm.Health != 0 || wm.Shield != 0 {
+				t.Fatalf("at %s: destroyed war machine %s still has health or shield", tt.elapsed, wm.Hash)
+			}
+		}
+		for _, wm := range btl.warMachines {
+			if !wm.destroyed && wm.Health == 0 {
+				t.Fatalf("at %s: war machine %s has no health before it is destroyed", tt.elapsed, wm.Hash)
+			}
+		}
+		if btl.ended() != tt.ended {
+			t.Fatalf("at %s: unexpected ended: %t", tt.elapsed, btl.ended())
+		}
+	}
+
+	destroyed := btl.destroyedPayload(btl.warMachine("red-1"))
+	if destroyed.KilledByWarMachineHash != "blue-1" || destroyed.KilledBy != "" {
+		t.Fatalf("red-1 should be killed by blue-1")
+	}
+	destroyed = btl.destroyedPayload(btl.warMachine("red-2"))
+	if destroyed.KilledBy == "" {
+		t.Fatalf("red-2 should be killed by an ability")
+	}
+
+	end := btl.endPayload()
+	if end.WinCondition != defaultWinCondition {
+		t.Fatalf("unexpected win condition: %s", end.WinCondition)
+	}
+	if len(end.WinningWarMachines) != 2 {
+		t.Fatalf("unexpected winning war machine count: %d", len(end.WinningWarMachines))
+	}
+	for _, wm := range end.WinningWarMachines {
+		if wm.Hash != "blue-1" && wm.Hash != "blue-2" {
+			t.Fatalf("unexpected winning war machine: %s", wm.Hash)
+		}
+	}
+}
+
+func TestSimBattle_Random(t *testing.T) {
+	btl := newSimBattle(context.Background(), testBattleInitPayload(), nil, time.Minute, 42)
+
+	if btl.winningFactionID != "red" && btl.winningFactionID != "blue" {
+		t.Fatalf("unexpected winning faction: %s", btl.winningFactionID)
+	}
+
+	for _, wm := range btl.warMachines {
+		if wm.FactionID == btl.winningFactionID {
+			if wm.destroyAt != 0 {
+				t.Fatalf("winning war machine %s should survive", wm.Hash)
+			}
+			continue
+		}
+		if wm.destroyAt < 6*time.Second || wm.destroyAt > 54*time.Second {
+			t.Fatalf("war machine %s destroyed outside of 10%% to 90%% of the battle: %s", wm.Hash, wm.destroyAt)
+		}
+	}
+
+	for elapsed := time.Second; elapsed <= time.Minute; elapsed += time.Second {
+		btl.update(elapsed)
+	}
+	if !btl.ended() {
+		t.Fatalf("battle should end within its duration")
+	}
+}
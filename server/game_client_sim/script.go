@@ -0,0 +1,69 @@
+package game_client_sim
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/ninja-software/terror/v2"
+)
+
+// Script describes the outcome of the battles played by the simulator.
+// Battles are played in order, once the script runs out the remaining battles are randomised.
+type Script struct {
+	Battles []*ScriptedBattle `json:"battles"`
+}
+
+type ScriptedBattle struct {
+	DurationSeconds    int             `json:"duration_seconds"`     // optional, falls back to the simulator battle duration
+	WinningFactionID   string          `json:"winning_faction_id"`   // optional, a random faction wins if empty
+	WinCondition       string          `json:"win_condition"`        // optional, defaults to LAST_ALIVE
+	Kills              []*ScriptedKill `json:"kills"`                // optional, every mech outside the winning faction is killed randomly if empty
+	RejectMoveCommands bool            `json:"reject_move_commands"` // respond to mech move commands with an invalid location
+	IgnoreAbilities    bool            `json:"ignore_abilities"`     // never send the ability complete messages
+}
+
+type ScriptedKill struct {
+	DestroyedHash string `json:"destroyed_hash"`
+	KilledByHash  string `json:"killed_by_hash"` // optional, an empty hash means the mech is killed by an ability
+	AtSeconds     int    `json:"at_seconds"`
+}
+
+// LoadScript reads a battle script from a json file
+func LoadScript(path string) (*Script, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to read simulator script.")
+	}
+
+	script := &Script{}
+	err = json.Unmarshal(b, script)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to parse simulator script.")
+	}
+
+	return script, nil
+}
+
+// battleScript returns the scripted battle of the given battle index, nil if the battle should be randomised
+func (s *Script) battleScript(index int) *ScriptedBattle {
+	if s == nil || index >= len(s.Battles) {
+		return nil
+	}
+	return s.Battles[index]
+}
+
+func (sb *ScriptedBattle) duration(fallback time.Duration) time.Duration {
+	if sb == nil || sb.DurationSeconds <= 0 {
+		return fallback
+	}
+	return time.Duration(sb.DurationSeconds) * time.Second
+}
+
+func (sb *ScriptedBattle) rejectMoveCommands() bool {
+	return sb != nil && sb.RejectMoveCommands
+}
+
+func (sb *ScriptedBattle) ignoreAbilities() bool {
+	return sb != nil && sb.IgnoreAbilities
+}
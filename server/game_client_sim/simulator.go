@@ -0,0 +1,382 @@
+package game_client_sim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"server/battle"
+	"server/gamelog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ninja-software/terror/v2"
+	"go.uber.org/atomic"
+	"nhooyr.io/websocket"
+)
+
+const (
+	// the battle init message carries the full loadout of every mech, so it easily exceeds the default read limit
+	simulatorReadLimit = 16 << 20
+
+	defaultWinCondition = "LAST_ALIVE"
+)
+
+type Config struct {
+	ArenaAddr            string // websocket address of the battle arena server, e.g. ws://localhost:8083
+	ArenaID              string
+	ClientKey            string // secret of the arena client key, used to sign the handshake
+	BuildNo              string
+	Battles              int // number of battles to play before disconnecting, 0 plays until the context is cancelled
+	Seed                 int64
+	Script               *Script
+	TickInterval         time.Duration
+	IntroDuration        time.Duration
+	BattleDuration       time.Duration
+	OutroDuration        time.Duration
+	AbilityCompleteDelay time.Duration
+}
+
+// Simulator is a headless game client, it connects to the battle arena and plays battles with scripted or randomised outcomes
+type Simulator struct {
+	Config
+
+	conn *websocket.Conn
+	stop context.CancelFunc
+
+	battleCount   int
+	battlesPlayed atomic.Int32
+	finished      atomic.Bool
+
+	sync.RWMutex
+	battle *simBattle
+}
+
+func New(config Config) *Simulator {
+	if config.TickInterval <= 0 {
+		config.TickInterval = 250 * time.Millisecond
+	}
+	if config.BattleDuration <= 0 {
+		config.BattleDuration = 60 * time.Second
+	}
+	if config.IntroDuration < 0 {
+		config.IntroDuration = 0
+	}
+	if config.OutroDuration < 0 {
+		config.OutroDuration = 0
+	}
+	if config.AbilityCompleteDelay < 0 {
+		config.AbilityCompleteDelay = 0
+	}
+
+	return &Simulator{Config: config}
+}
+
+// BattlesPlayed returns the number of battles which have been played to the end
+func (s *Simulator) BattlesPlayed() int {
+	return int(s.battlesPlayed.Load())
+}
+
+// Run connects to the battle arena and blocks until the configured number of battles is played,
+// the context is cancelled or the connection is closed by the server.
+func (s *Simulator) Run(ctx context.Context) error {
+	ctx, s.stop = context.WithCancel(ctx)
+	defer s.stop()
+
+	u, err := url.Parse(s.ArenaAddr)
+	if err != nil {
+		return terror.Error(err, "Invalid battle arena address.")
+	}
+	q := u.Query()
+	q.Set("id", s.ArenaID)
+	u.RawQuery = q.Encode()
+
+	conn, _, err := websocket.Dial(ctx, u.String(), &websocket.DialOptions{
+		Subprotocols: []string{"gameserver-v1"},
+	})
+	if err != nil {
+		return terror.Error(err, "Failed to connect to battle arena.")
+	}
+	defer conn.Close(websocket.StatusNormalClosure, "simulator stopped")
+
+	conn.SetReadLimit(simulatorReadLimit)
+	s.conn = conn
+
+	timestamp := time.Now().Unix()
+	err = s.send(ctx, battle.GameClientCommandHandshake, &battle.GameClientHandshakePayload{
+		ArenaID:   s.ArenaID,
+		BuildNo:   s.BuildNo,
+		Timestamp: timestamp,
+		Signature: battle.GameClientHandshakeSignature(s.ClientKey, s.ArenaID, s.BuildNo, timestamp),
+	})
+	if err != nil {
+		return err
+	}
+
+	gamelog.L.Info().Str("arena id", s.ArenaID).Str("build no", s.BuildNo).Msg("Game client simulator connected.")
+
+	for {
+		_, data, err := conn.Read(ctx)
+		if err != nil {
+			if s.finished.Load() {
+				return nil
+			}
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+			if code := websocket.CloseStatus(err); code != -1 {
+				return terror.Error(fmt.Errorf("connection closed by battle arena with code %d: %w", code, err), "Battle arena closed the connection.")
+			}
+			return terror.Error(err, "Failed to read from battle arena.")
+		}
+
+		err = s.handleMessage(ctx, data)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// handleMessage handles the battle commands sent by the battle arena.
+// NOTE: messages from the server are plain json without the message type byte.
+func (s *Simulator) handleMessage(ctx context.Context, data []byte) error {
+	msg := &battle.BattleMsg{}
+	err := json.Unmarshal(data, msg)
+	if err != nil {
+		gamelog.L.Warn().Str("msg", string(data)).Err(err).Msg("Simulator unable to unmarshal battle message.")
+		return nil
+	}
+
+	L := gamelog.L.With().Str("battle_command", msg.BattleCommand).Logger()
+
+	switch strings.TrimSpace(msg.BattleCommand) {
+	case battle.BATTLEINIT:
+		payload := &battleInitPayload{}
+		err = json.Unmarshal(msg.Payload, payload)
+		if err != nil {
+			L.Warn().Err(err).Msg("Unable to unmarshal battle init payload.")
+			return nil
+		}
+
+		s.startBattle(ctx, payload)
+
+	case "BATTLE:ABILITY":
+		event := &abilityEvent{}
+		err = json.Unmarshal(msg.Payload, event)
+		if err != nil {
+			L.Warn().Err(err).Msg("Unable to unmarshal ability payload.")
+			return nil
+		}
+
+		go s.respondAbility(ctx, event)
+
+	case battle.GameClientCommandShutdown:
+		payload := &battle.GameClientShutdownPayload{}
+		_ = json.Unmarshal(msg.Payload, payload)
+		return terror.Error(fmt.Errorf("shutdown requested: %s", payload.Reason), "Battle arena requested the game client to shut down.")
+
+	default:
+		L.Debug().Msg("Simulator ignored battle command.")
+	}
+
+	return nil
+}
+
+// startBattle plays the battle in the background, a battle which is still running is dropped
+func (s *Simulator) startBattle(ctx context.Context, payload *battleInitPayload) {
+	s.Lock()
+	if s.battle != nil {
+		gamelog.L.Warn().Str("battle id", s.battle.ID).Msg("Battle arena started a new battle before the previous one ended.")
+		s.battle.cancel()
+	}
+
+	index := s.battleCount
+	s.battleCount++
+
+	btl := newSimBattle(ctx, payload, s.Script.battleScript(index), s.BattleDuration, s.Seed+int64(index))
+	s.battle = btl
+	s.Unlock()
+
+	go s.playBattle(btl, index)
+}
+
+func (s *Simulator) currentBattle() *simBattle {
+	s.RLock()
+	defer s.RUnlock()
+	return s.battle
+}
+
+// playBattle drives a battle through the same lifecycle as the game client
+func (s *Simulator) playBattle(btl *simBattle, index int) {
+	defer btl.cancel()
+
+	L := gamelog.L.With().Str("battle id", btl.ID).Int("battle number", btl.Number).Logger()
+	L.Info().Str("winning faction id", btl.winningFactionID).Msg("Simulating battle.")
+
+	err := s.send(btl.ctx, "BATTLE:MAP_DETAILS", btl.mapDetails())
+	if err != nil {
+		L.Error().Err(err).Msg("Failed to send map details.")
+		return
+	}
+
+	err = s.send(btl.ctx, "BATTLE:START", btl.startPayload(s.BuildNo))
+	if err != nil {
+		L.Error().Err(err).Msg("Failed to send battle start.")
+		return
+	}
+
+	if !sleep(btl.ctx, s.IntroDuration) {
+		return
+	}
+
+	err = s.send(btl.ctx, "BATTLE:INTRO_FINISHED", nil)
+	if err != nil {
+		L.Error().Err(err).Msg("Failed to send intro finished.")
+		return
+	}
+
+	startedAt := time.Now()
+	ticker := time.NewTicker(s.TickInterval)
+	defer ticker.Stop()
+
+	for !btl.ended() {
+		select {
+		case <-btl.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		elapsed := time.Since(startedAt)
+		for _, wm := range btl.update(elapsed) {
+			err = s.send(btl.ctx, "BATTLE:WAR_MACHINE_DESTROYED", btl.destroyedPayload(wm))
+			if err != nil {
+				L.Error().Err(err).Str("war machine hash", wm.Hash).Msg("Failed to send war machine destroyed.")
+				return
+			}
+		}
+
+		err = s.conn.Write(btl.ctx, websocket.MessageBinary, btl.tickFrame())
+		if err != nil {
+			L.Error().Err(err).Msg("Failed to send tick.")
+			return
+		}
+
+		if elapsed >= btl.duration {
+			break
+		}
+	}
+
+	err = s.send(btl.ctx, "BATTLE:END", btl.endPayload())
+	if err != nil {
+		L.Error().Err(err).Msg("Failed to send battle end.")
+		return
+	}
+
+	if !sleep(btl.ctx, s.OutroDuration) {
+		return
+	}
+
+	err = s.send(btl.ctx, "BATTLE:OUTRO_FINISHED", nil)
+	if err != nil {
+		L.Error().Err(err).Msg("Failed to send outro finished.")
+		return
+	}
+
+	played := s.battlesPlayed.Inc()
+	L.Info().Int32("battles played", played).Msg("Simulated battle finished.")
+
+	if s.Battles > 0 && index+1 >= s.Battles {
+		s.finished.Store(true)
+		s.stop()
+	}
+}
+
+// respondAbility sends the messages the game client would send once an ability is executed
+func (s *Simulator) respondAbility(ctx context.Context, event *abilityEvent) {
+	btl := s.currentBattle()
+	if btl == nil {
+		return
+	}
+
+	L := gamelog.L.With().Str("battle id", btl.ID).Str("event id", event.EventID).Int("game client ability id", int(event.GameClientAbilityID)).Logger()
+
+	switch event.GameClientAbilityID {
+	case battle.MechMoveCommandCreateGameAbilityID:
+		// the server waits for the location check before it accepts the move command
+		err := s.send(ctx, "BATTLE:ABILITY_MOVE_COMMAND_RESPONSE", &battle.MechMoveCommandResponsePayload{
+			BattleID:       btl.ID,
+			WarMachineHash: event.warMachineHash(),
+			EventID:        event.EventID,
+			IsValid:        !btl.script.rejectMoveCommands(),
+		})
+		if err != nil {
+			L.Error().Err(err).Msg("Failed to send move command response.")
+			return
+		}
+
+		if btl.script.rejectMoveCommands() || !sleep(btl.ctx, s.AbilityCompleteDelay) {
+			return
+		}
+
+		err = s.send(ctx, "BATTLE:ABILITY_MOVE_COMMAND_COMPLETE", &battle.AbilityMoveCommandCompletePayload{
+			BattleID:       btl.ID,
+			WarMachineHash: event.warMachineHash(),
+		})
+		if err != nil {
+			L.Error().Err(err).Msg("Failed to send move command complete.")
+		}
+
+	case battle.MechMoveCommandCancelGameAbilityID:
+		// nothing to report back
+
+	default:
+		if btl.script.ignoreAbilities() || !sleep(btl.ctx, s.AbilityCompleteDelay) {
+			return
+		}
+
+		err := s.send(ctx, "BATTLE:ABILITY_COMPLETE", &battle.AbilityCompletePayload{
+			BattleID: btl.ID,
+			EventID:  event.EventID,
+		})
+		if err != nil {
+			L.Error().Err(err).Msg("Failed to send ability complete.")
+		}
+	}
+}
+
+// send writes a json battle command to the battle arena
+func (s *Simulator) send(ctx context.Context, cmd string, payload interface{}) error {
+	b, err := json.Marshal(struct {
+		Command string      `json:"battle_command"`
+		Payload interface{} `json:"payload"`
+	}{Payload: payload, Command: cmd})
+	if err != nil {
+		return terror.Error(err, "Failed to marshal battle command.")
+	}
+
+	err = s.conn.Write(ctx, websocket.MessageBinary, append([]byte{byte(battle.JSON)}, b...))
+	if err != nil {
+		return terror.Error(err, "Failed to send battle command.")
+	}
+
+	return nil
+}
+
+// sleep waits for the given duration, returns false if the context is cancelled in the meantime
+func sleep(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
@@ -3,6 +3,7 @@ package server
 // player
 
 const HubKeyPlayerMarketingPreferencesUpdate = "PLAYER:MARKETING:UPDATE"
+const HubKeyPlayerFactionPassExpiryDate = "PLAYER:FACTION:PASS:EXPIRY:DATE"
 
 // player_abilities
 
@@ -24,11 +25,14 @@ const HubKeySyndicateCommitteesSubscribe = "SYNDICATE:COMMITTEES:SUBSCRIBE"
 const HubKeySyndicateRulesSubscribe = "SYNDICATE:RULES:SUBSCRIBE"
 const HubKeySyndicateOngoingMotionSubscribe = "SYNDICATE:ONGOING:MOTION:SUBSCRIBE"
 const HubKeySyndicateOngoingElectionSubscribe = "SYNDICATE:ONGOING:ELECTION:SUBSCRIBE"
+const HubKeySyndicateChatSubscribe = "SYNDICATE:CHAT:SUBSCRIBE"
 
 const HubKeyPlayerRankGet = "PLAYER:RANK:GET"
 const HubKeyUserStatSubscribe = "USER:STAT:SUBSCRIBE"
 const HubKeyUserSubscribe = "USER:SUBSCRIBE"
 const HubKeySyndicateJoinApplicationUpdate = "SYNDICATE:JOIN:APPLICATION:UPDATE"
+const HubKeyDirectMessageSubscribe = "DIRECT:MESSAGE:SUBSCRIBE"
+const HubKeyPlayerFriendsSubscribe = "PLAYER:FRIENDS:SUBSCRIBE"
 
 const HubKeySystemMessageList = "SYSTEM:MESSAGE:LIST"
 const HubKeySystemMessageDismiss = "SYSTEM:MESSAGE:DISMISS"
@@ -58,6 +62,8 @@ const HubKeyNextRepairGameBlock = "NEXT:REPAIR:GAME:BLOCK"
 const HubKeyTelegramShortcodeRegistered = "USER:TELEGRAM_SHORTCODE_REGISTERED"
 const HubKeySystemMessageSend = "SYSTEM:MESSAGE:SEND"
 
+const HubKeyMarketplaceAlert = "MARKETPLACE:ALERT"
+
 const HubKeyPlayerQuestStats = "PLAYER:QUEST:STAT"
 const HubKeyPlayerQuestProgressions = "PLAYER:QUEST:PROGRESSIONS"
 
@@ -129,6 +135,11 @@ const HubKeyPrivateBattleLobbyUpdate = "PRIVATE:BATTLE:LOBBY:UPDATE"
 const HubKeyPlayerAssetMechQueueSubscribe = "PLAYER:ASSET:MECH:QUEUE:SUBSCRIBE"
 const HubKeyBattleETAUpdate = "BATTLE:ETA:UPDATE"
 
+// tournaments
+
+const HubKeyTournamentListUpdate = "TOURNAMENT:LIST:UPDATE"
+const HubKeyTournamentUpdate = "TOURNAMENT:UPDATE"
+
 // faction pass
 
 const HubKeyFactionMostPopularStakedMech = "FACTION:MOST:POPULAR:STAKED:MECH"
@@ -2,6 +2,7 @@ package server
 
 import (
 	"encoding/json"
+	"server/db/boiler"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -38,6 +39,20 @@ type MarketplaceSaleItem struct {
 	Weapon               MarketplaceSaleItemWeapon       `json:"weapon,omitempty" boil:",bind"`
 	CollectionItem       MarketplaceSaleCollectionItem   `json:"collection_item,omitempty" boil:",bind"`
 	LastBid              MarketplaceBidder               `json:"last_bid,omitempty" boil:",bind"`
+	Bundle               bool                            `json:"bundle" boil:"bundle"`
+	BundleItems          []*MarketplaceSaleBundleItem    `json:"bundle_items,omitempty" boil:"-"`
+}
+
+// MarketplaceSaleBundleItem is one of the other items sold with a bundle listing
+type MarketplaceSaleBundleItem struct {
+	ID               string      `json:"id"`
+	CollectionItemID null.String `json:"collection_item_id,omitempty"`
+	ItemType         string      `json:"item_type"`
+	ItemID           string      `json:"item_id"`
+	Hash             null.String `json:"hash,omitempty"`
+	Tier             null.String `json:"tier,omitempty"`
+	Label            null.String `json:"label,omitempty"`
+	KeycardCount     int         `json:"keycard_count,omitempty"`
 }
 
 type MarketplaceBidder struct {
@@ -178,6 +193,7 @@ type MarketplaceEvent struct {
 	Amount    decimal.NullDecimal   `json:"amount" boil:"amount"`
 	CreatedAt time.Time             `json:"created_at" boil:"created_at"`
 	Item      *MarketplaceEventItem `json:"item"`
+	Offer     *boiler.ItemOffer     `json:"offer,omitempty"`
 }
 
 type MarketplaceEventItem struct {
@@ -212,3 +228,29 @@ type MarketplaceEventItem struct {
 	CollectionItem       MarketplaceSaleCollectionItem   `json:"collection_item,omitempty" boil:",bind"`
 	LastBid              MarketplaceBidder               `json:"last_bid,omitempty" boil:",bind"`
 }
+
+// MarketplacePriceHistoryBucket holds the completed sales of a time bucket.
+type MarketplacePriceHistoryBucket struct {
+	BucketAt time.Time       `json:"bucket_at" boil:"bucket_at"`
+	Floor    decimal.Decimal `json:"floor" boil:"floor"`
+	Median   decimal.Decimal `json:"median" boil:"median"`
+	Ceiling  decimal.Decimal `json:"ceiling" boil:"ceiling"`
+	Volume   int64           `json:"volume" boil:"volume"`
+}
+
+// MarketplaceAssetValuation is the estimated value of the assets a player owns of the same kind.
+type MarketplaceAssetValuation struct {
+	ItemType        string              `json:"item_type"`
+	BlueprintID     string              `json:"blueprint_id"`
+	SkinBlueprintID null.String         `json:"skin_blueprint_id"`
+	Tier            null.String         `json:"tier"`
+	Count           int64               `json:"count"`
+	Price           decimal.NullDecimal `json:"price"`  // median sale price of one asset, null when it has not sold recently
+	Volume          int64               `json:"volume"` // number of sales the price is based on
+	Value           decimal.Decimal     `json:"value"`
+}
+
+type MarketplacePortfolioValuation struct {
+	Total  decimal.Decimal              `json:"total"`
+	Assets []*MarketplaceAssetValuation `json:"assets"`
+}
@@ -0,0 +1,248 @@
+package marketplace
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"server"
+	"server/db"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+	"server/system_messages"
+
+	"github.com/friendsofgo/errors"
+	"github.com/gofrs/uuid"
+	"github.com/ninja-syndicate/ws"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type MarketplaceAlertType string
+
+const (
+	MarketplaceAlertTypeNewListing        MarketplaceAlertType = "NEW_LISTING"
+	MarketplaceAlertTypeOutbid            MarketplaceAlertType = "OUTBID"
+	MarketplaceAlertTypeDutchAuctionPrice MarketplaceAlertType = "DUTCH_AUCTION_PRICE"
+)
+
+// MarketplaceAlert is sent to the player's marketplace alerts ws channel, and is the data of the system message.
+type MarketplaceAlert struct {
+	Type          MarketplaceAlertType `json:"type"`
+	ItemSaleID    string               `json:"item_sale_id"`
+	SavedSearchID null.String          `json:"saved_search_id,omitempty"`
+	Price         decimal.NullDecimal  `json:"price,omitempty"`
+	Title         string               `json:"title"`
+	Message       string               `json:"message"`
+}
+
+// sendAlert delivers a marketplace alert through the channels the player has enabled.
+func (m *MarketplaceController) sendAlert(playerID string, alert *MarketplaceAlert) {
+	l := gamelog.L.With().Str("func", "sendAlert").Str("player_id", playerID).Interface("alert", alert).Logger()
+
+	prefs, err := boiler.PlayerSettingsPreferences(boiler.PlayerSettingsPreferenceWhere.PlayerID.EQ(playerID)).One(gamedb.StdConn)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		l.Error().Err(err).Msg("failed to get player preferences")
+		return
+	}
+	if prefs == nil {
+		// default preferences
+		prefs = &boiler.PlayerSettingsPreference{
+			PlayerID: playerID,
+			EnableMarketplaceSystemMessageNotifications: true,
+			EnableMarketplaceWSNotifications:            true,
+		}
+	}
+
+	if prefs.EnableMarketplaceSystemMessageNotifications {
+		data, err := json.Marshal(alert)
+		if err != nil {
+			l.Error().Err(err).Msg("failed to marshal marketplace alert")
+			return
+		}
+		msg := &boiler.SystemMessage{
+			PlayerID: playerID,
+			SenderID: server.SupremacySystemAdminUserID,
+			DataType: null.StringFrom(string(system_messages.SystemMessageDataTypeMarketplaceAlert)),
+			Title:    alert.Title,
+			Message:  alert.Message,
+			Data:     null.JSONFrom(data),
+		}
+		err = msg.Insert(gamedb.StdConn, boil.Infer())
+		if err != nil {
+			l.Error().Err(err).Msg("failed to insert marketplace alert system message")
+		} else {
+			ws.PublishMessage(fmt.Sprintf("/secure/user/%s/system_messages", playerID), server.HubKeySystemMessageListUpdatedSubscribe, true)
+		}
+	}
+
+	if prefs.EnableMarketplaceTelegramNotifications && prefs.EnableTelegramNotifications && prefs.TelegramID.Valid && m.Telegram != nil {
+		err = m.Telegram.Notify(prefs.TelegramID.Int64, fmt.Sprintf("%s: %s", alert.Title, alert.Message))
+		if err != nil {
+			l.Error().Err(err).Str("telegramID", fmt.Sprintf("%v", prefs.TelegramID)).Msg("failed to send marketplace alert telegram notification")
+		}
+	}
+
+	if prefs.EnableMarketplaceWSNotifications {
+		ws.PublishMessage(fmt.Sprintf("/secure/user/%s/marketplace_alerts", playerID), server.HubKeyMarketplaceAlert, alert)
+	}
+}
+
+// listingLabel returns the name of what is being sold in a listing.
+func listingLabel(saleItem *server.MarketplaceSaleItem) string {
+	label := ""
+	switch saleItem.CollectionItemType {
+	case boiler.ItemTypeMech:
+		label = saleItem.Mech.Name.String
+		if label == "" {
+			label = saleItem.Mech.Label.String
+		}
+	case boiler.ItemTypeWeapon:
+		label = saleItem.Weapon.Label.String
+	case boiler.ItemTypeMysteryCrate:
+		label = saleItem.MysteryCrate.Label.String
+	}
+	if label == "" {
+		label = "An item"
+	}
+	if saleItem.Bundle {
+		label = fmt.Sprintf("%s bundle", label)
+	}
+	return label
+}
+
+// AlertNewListing alerts the players with a saved search matching a new listing.
+func (m *MarketplaceController) AlertNewListing(itemSaleID string) {
+	defer func() {
+		if r := recover(); r != nil {
+			gamelog.LogPanicRecovery("panic! panic! panic! Panic at the marketplace new listing alert!", r)
+		}
+	}()
+
+	l := gamelog.L.With().Str("func", "AlertNewListing").Str("item_sale_id", itemSaleID).Logger()
+
+	itemSale, err := boiler.FindItemSale(gamedb.StdConn, itemSaleID)
+	if err != nil {
+		l.Error().Err(err).Msg("unable to find item sale")
+		return
+	}
+
+	searches, err := db.MarketplaceSavedSearchesForListing(itemSale)
+	if err != nil {
+		l.Error().Err(err).Msg("unable to get saved searches")
+		return
+	}
+	if len(searches) == 0 {
+		return
+	}
+
+	matched, err := db.MarketplaceSavedSearchesMatchingListing(itemSale, searches)
+	if err != nil {
+		l.Error().Err(err).Msg("unable to match listing to saved searches")
+		return
+	}
+	if len(matched) == 0 {
+		return
+	}
+
+	saleItem, err := db.MarketplaceItemSale(uuid.Must(uuid.FromString(itemSaleID)))
+	if err != nil {
+		l.Error().Err(err).Msg("unable to get sale item")
+		return
+	}
+	label := listingLabel(saleItem)
+
+	// only one alert per player when several of their searches match
+	alerted := map[string]bool{}
+	for _, search := range searches {
+		if alerted[search.PlayerID] || !matched[search.ID] {
+			continue
+		}
+
+		alerted[search.PlayerID] = true
+		m.sendAlert(search.PlayerID, &MarketplaceAlert{
+			Type:          MarketplaceAlertTypeNewListing,
+			ItemSaleID:    itemSaleID,
+			SavedSearchID: null.StringFrom(search.ID),
+			Title:         "New Marketplace Listing",
+			Message:       fmt.Sprintf("%s matching your saved search \"%s\" has been listed on the marketplace.", label, search.Name),
+		})
+	}
+}
+
+// AlertOutbid alerts the players watching an auction that a new bid has been placed on it.
+func (m *MarketplaceController) AlertOutbid(itemSaleID string, bidderID string, bidPrice decimal.Decimal) {
+	defer func() {
+		if r := recover(); r != nil {
+			gamelog.LogPanicRecovery("panic! panic! panic! Panic at the marketplace outbid alert!", r)
+		}
+	}()
+
+	l := gamelog.L.With().Str("func", "AlertOutbid").Str("item_sale_id", itemSaleID).Logger()
+
+	watchers, err := db.MarketplaceItemSaleWatchers(itemSaleID, bidderID)
+	if err != nil {
+		l.Error().Err(err).Msg("unable to get watchers")
+		return
+	}
+	if len(watchers) == 0 {
+		return
+	}
+
+	saleItem, err := db.MarketplaceItemSale(uuid.Must(uuid.FromString(itemSaleID)))
+	if err != nil {
+		l.Error().Err(err).Msg("unable to get sale item")
+		return
+	}
+	label := listingLabel(saleItem)
+
+	for _, playerID := range watchers {
+		m.sendAlert(playerID, &MarketplaceAlert{
+			Type:       MarketplaceAlertTypeOutbid,
+			ItemSaleID: itemSaleID,
+			Price:      decimal.NewNullDecimal(bidPrice),
+			Title:      "Watched Auction Outbid",
+			Message:    fmt.Sprintf("%s has a new highest bid of %s SUPS.", label, bidPrice.Shift(-18).StringFixed(2)),
+		})
+	}
+}
+
+// Alerts watchers of dutch auctions which have dropped to their threshold.
+func (m *MarketplaceController) processWatchedDutchAuctions() {
+	gamelog.L.Trace().Msg("processing watched dutch auctions started")
+
+	alerts, err := db.MarketplaceWatchedDutchAuctionsToAlert()
+	if err != nil {
+		gamelog.L.Error().
+			Str("db func", "MarketplaceWatchedDutchAuctionsToAlert").
+			Err(err).Msg("unable to retrieve watched dutch auctions to alert")
+		return
+	}
+
+	for _, alert := range alerts {
+		l := gamelog.L.With().Str("player_id", alert.PlayerID).Str("item_sale_id", alert.ItemSaleID).Logger()
+
+		err := db.MarketplaceWatchDutchAuctionAlerted(alert.PlayerID, alert.ItemSaleID)
+		if err != nil {
+			l.Error().Err(err).Msg("unable to flag watched dutch auction as alerted")
+			continue
+		}
+
+		saleItem, err := db.MarketplaceItemSale(uuid.Must(uuid.FromString(alert.ItemSaleID)))
+		if err != nil {
+			l.Error().Err(err).Msg("unable to get sale item")
+			continue
+		}
+
+		m.sendAlert(alert.PlayerID, &MarketplaceAlert{
+			Type:       MarketplaceAlertTypeDutchAuctionPrice,
+			ItemSaleID: alert.ItemSaleID,
+			Price:      decimal.NewNullDecimal(alert.Price),
+			Title:      "Watched Dutch Auction Price Drop",
+			Message:    fmt.Sprintf("%s has dropped to %s SUPS.", listingLabel(saleItem), alert.Price.Shift(-18).StringFixed(2)),
+		})
+	}
+
+	gamelog.L.Trace().Int("num_alerted", len(alerts)).Msg("processing watched dutch auctions finished")
+}
@@ -0,0 +1,180 @@
+package marketplace
+
+import (
+	"fmt"
+	"server"
+	"server/db"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+	"server/xsyn_rpcclient"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+)
+
+// itemSaleIDArgs returns the query args filtering auctions by item sale id.
+func itemSaleIDArgs(itemSaleIDs []string) []interface{} {
+	if len(itemSaleIDs) == 0 {
+		return nil
+	}
+	return []interface{}{pq.Array(itemSaleIDs)}
+}
+
+// ScheduleAuctionClose closes an auction as soon as it ends, replacing any close already scheduled for the auction.
+// The end is timed by the database clock, which the close query checks it against.
+func (m *MarketplaceController) ScheduleAuctionClose(itemSaleID string, endAt time.Time) {
+	endsIn, err := db.MarketplaceAuctionEndsIn(endAt)
+	if err != nil {
+		// the ticker closes the auction instead
+		gamelog.L.Error().Err(err).Str("item_sale_id", itemSaleID).Msg("unable to schedule auction close")
+		return
+	}
+
+	m.auctionTimersMx.Lock()
+	defer m.auctionTimersMx.Unlock()
+
+	if timer, ok := m.auctionTimers[itemSaleID]; ok {
+		timer.Stop()
+	}
+
+	m.auctionTimers[itemSaleID] = time.AfterFunc(endsIn, func() {
+		defer func() {
+			if r := recover(); r != nil {
+				gamelog.LogPanicRecovery("panic! panic! panic! Panic at the auction scheduler!", r)
+			}
+		}()
+
+		m.auctionTimersMx.Lock()
+		delete(m.auctionTimers, itemSaleID)
+		m.auctionTimersMx.Unlock()
+
+		m.processFinishedAuctions(itemSaleID)
+	})
+}
+
+// CancelAuctionClose stops the scheduled close of an auction, for auctions that are archived or bought out.
+func (m *MarketplaceController) CancelAuctionClose(itemSaleID string) {
+	m.auctionTimersMx.Lock()
+	defer m.auctionTimersMx.Unlock()
+
+	if timer, ok := m.auctionTimers[itemSaleID]; ok {
+		timer.Stop()
+		delete(m.auctionTimers, itemSaleID)
+	}
+}
+
+// LockAuctions stops auctions closing while a bid changes their price or end, until UnlockAuctions is called.
+func (m *MarketplaceController) LockAuctions() {
+	m.auctionsMx.Lock()
+}
+
+// UnlockAuctions lets auctions close again after LockAuctions.
+func (m *MarketplaceController) UnlockAuctions() {
+	m.auctionsMx.Unlock()
+}
+
+// scheduleOpenAuctions schedules the close of every auction that has not ended yet.
+func (m *MarketplaceController) scheduleOpenAuctions() {
+	auctions, err := db.MarketplaceOpenAuctions()
+	if err != nil {
+		gamelog.L.Error().
+			Str("db func", "MarketplaceOpenAuctions").
+			Err(err).Msg("unable to retrieve open auctions to schedule")
+		return
+	}
+
+	for _, auction := range auctions {
+		m.ScheduleAuctionClose(auction.ID, auction.EndAt)
+	}
+}
+
+// refundExcessBid sends back what a winning proxy bid held above the price it won the auction at.
+func (m *MarketplaceController) refundExcessBid(itemSaleID string, factionID string, bidderID string, bidTxID string, amount decimal.Decimal) error {
+	l := gamelog.L.With().Str("func", "refundExcessBid").Str("item_sale_id", itemSaleID).Str("bid_tx_id", bidTxID).Str("amount", amount.String()).Logger()
+
+	factionAccountID, ok := server.FactionUsers[factionID]
+	if !ok {
+		err := fmt.Errorf("failed to get hard coded syndicate player id")
+		l.Error().Err(err).Msg("unable to get find faction account")
+		return err
+	}
+	factionAccountUUID := uuid.Must(uuid.FromString(factionAccountID))
+
+	err := fundFactionAccount(
+		m.Passport,
+		factionAccountUUID,
+		amount,
+		fmt.Sprintf("bid_refunds|%s", bidderID),
+		fmt.Sprintf("Bid Excess Refund for Player: %s (item sale: %s)", bidderID, itemSaleID),
+	)
+	if err != nil {
+		return err
+	}
+
+	refundTxID, err := m.Passport.SpendSupMessage(xsyn_rpcclient.SpendSupsReq{
+		FromUserID:           factionAccountUUID,
+		ToUserID:             uuid.Must(uuid.FromString(bidderID)),
+		Amount:               amount.String(),
+		TransactionReference: server.TransactionReference(fmt.Sprintf("bid_refunds|excess|%s|%d", itemSaleID, time.Now().UnixNano())),
+		Group:                string(server.TransactionGroupSupremacy),
+		SubGroup:             string(server.TransactionGroupMarketplace),
+		Description:          fmt.Sprintf("Bid Excess Refund for Player: %s (item sale: %s)", bidderID, itemSaleID),
+	})
+	if err != nil {
+		l.Error().Err(err).Msg("unable to refund bid excess")
+		return err
+	}
+
+	err = db.MarketplaceSaleBidExcessRefund(gamedb.StdConn, itemSaleID, bidTxID, refundTxID)
+	if err != nil {
+		l.Error().Err(err).Str("refund_tx_id", refundTxID).Msg("unable to update excess refund tx id on bid record")
+		return err
+	}
+
+	err = db.MarketplaceAddEvent(boiler.MarketplaceEventBidRefund, bidderID, decimal.NewNullDecimal(amount), itemSaleID, boiler.TableNames.ItemSales)
+	if err != nil {
+		l.Error().Err(err).Str("refund_tx_id", refundTxID).Msg("Failed to log bid excess refund event.")
+	}
+
+	return nil
+}
+
+// Retries returning the excess held by winning proxy bids that failed when the auction closed.
+func (m *MarketplaceController) processExcessBidRefunds() {
+	m.auctionsMx.Lock()
+	defer m.auctionsMx.Unlock()
+
+	gamelog.L.Trace().Msg("processing excess bid refunds started")
+
+	bids, err := db.MarketplaceWinningBidsWithExcess()
+	if err != nil {
+		gamelog.L.Error().
+			Str("db func", "MarketplaceWinningBidsWithExcess").
+			Err(err).Msg("unable to retrieve winning bids with excess")
+		return
+	}
+
+	numProcessed := 0
+	for _, bid := range bids {
+		itemSale, err := boiler.FindItemSale(gamedb.StdConn, bid.ItemSaleID)
+		if err != nil {
+			gamelog.L.Error().Str("item_sale_id", bid.ItemSaleID).Err(err).Msg("unable to find item sale")
+			continue
+		}
+
+		err = m.refundExcessBid(bid.ItemSaleID, itemSale.FactionID, bid.BidderID, bid.BidTXID, bid.MaxBidPrice.Decimal.Sub(bid.BidPrice))
+		if err != nil {
+			continue
+		}
+		numProcessed++
+	}
+
+	gamelog.L.Trace().
+		Int("num_processed", numProcessed).
+		Int("num_failed", len(bids)-numProcessed).
+		Int("num_pending", len(bids)).
+		Msg("processing excess bid refunds finished")
+}
@@ -0,0 +1,382 @@
+package marketplace
+
+import (
+	"fmt"
+	"server/asset"
+	"server/db"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+	"server/xsyn_rpcclient"
+
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+// BundleAssetCheck checks a collection item can be added to a bundle listing by the owner.
+// Items equipped on something are sold along with what they are equipped on, so they cannot be added on their own.
+func BundleAssetCheck(conn boil.Executor, colItem *boiler.CollectionItem, ownerID string) error {
+	if colItem.OwnerID != ownerID {
+		return terror.Error(terror.ErrUnauthorised, "Item does not belong to user.")
+	}
+	if colItem.MarketLocked {
+		return terror.Error(fmt.Errorf("unable to list assets staked with old staking contract"), "Unable to list assets staked with old staking contract.")
+	}
+	if colItem.XsynLocked {
+		return terror.Error(fmt.Errorf("asset does not live on supremacy"), "Asset does not live on Supremacy.")
+	}
+	if colItem.ClawbackLocked {
+		return terror.Error(fmt.Errorf("asset is clawback locked"), "Item is locked while its payment is under review.")
+	}
+	if colItem.LockedToMarketplace {
+		return terror.Error(fmt.Errorf("item is already for sale on marketplace"), "Item is already for sale on Marketplace.")
+	}
+
+	equippedOn, err := db.CollectionItemEquippedOn(conn, colItem.ItemType, colItem.ItemID)
+	if err != nil {
+		return terror.Error(err, "Failed to check item.")
+	}
+	if equippedOn.Valid {
+		return terror.Error(fmt.Errorf("item is equipped"), "Equipped items are sold with what they are equipped on, unequip the item to list it separately.")
+	}
+
+	if colItem.ItemType == boiler.ItemTypeMech {
+		inLobby, err := boiler.BattleLobbiesMechs(
+			boiler.BattleLobbiesMechWhere.MechID.EQ(colItem.ItemID),
+			boiler.BattleLobbiesMechWhere.EndedAt.IsNull(),
+			boiler.BattleLobbiesMechWhere.RefundTXID.IsNull(),
+		).Exists(conn)
+		if err != nil {
+			return terror.Error(err, "Failed to check mech queue.")
+		}
+		if inLobby {
+			return terror.Error(fmt.Errorf("mech is in battle lobby"), "Cannot sell war machine which is already in battle lobby.")
+		}
+	}
+
+	switch colItem.ItemType {
+	case boiler.ItemTypeMysteryCrate:
+		crate, err := boiler.FindMysteryCrate(conn, colItem.ItemID)
+		if err != nil {
+			return terror.Error(err, "Failed to load mystery crate.")
+		}
+		if crate.Opened {
+			return terror.Error(fmt.Errorf("crate is opened"), "Unable to list opened crates.")
+		}
+	case boiler.ItemTypeWeaponSkin:
+	default:
+		canMove, reason, err := db.CanAssetBeModifiedOrMoved(conn, colItem.ItemID, colItem.ItemType, ownerID)
+		if err != nil {
+			return terror.Error(err, "Failed to check item.")
+		}
+		if !canMove {
+			return terror.Error(fmt.Errorf("item cannot be moved"), reason.String())
+		}
+	}
+
+	return nil
+}
+
+// UpdateKeycardCountXSYN adds or removes a number of keycards of a blueprint from a player's xsyn account.
+func UpdateKeycardCountXSYN(passport *xsyn_rpcclient.XsynXrpcClient, keycardBlueprint *boiler.BlueprintKeycard, publicAddress string, amount int, isAdd bool) error {
+	syndicate := "N/A"
+	if keycardBlueprint.Syndicate.Valid {
+		syndicate = keycardBlueprint.Syndicate.String
+	}
+
+	var assetJson types.JSON
+	err := assetJson.Marshal(&AttributeInner{
+		TraitType: "Syndicate",
+		Value:     syndicate,
+	})
+	if err != nil {
+		return terror.Error(err, "Failed to get marshal keycard attribute data")
+	}
+
+	_, err = passport.UpdateKeycardCountXSYN(&xsyn_rpcclient.Asset1155CountUpdateSupremacyReq{
+		ApiKey:         passport.ApiKey,
+		TokenID:        keycardBlueprint.KeycardTokenID,
+		Address:        publicAddress,
+		CollectionSlug: keycardBlueprint.Collection,
+		Amount:         amount,
+		ImageURL:       keycardBlueprint.ImageURL,
+		AnimationURL:   keycardBlueprint.AnimationURL,
+		KeycardGroup:   keycardBlueprint.KeycardGroup,
+		Attributes:     assetJson,
+		IsAdd:          isAdd,
+	})
+	if err != nil {
+		return terror.Error(err, "Failed to update XSYN asset count")
+	}
+
+	return nil
+}
+
+// TransferBundleItems transfers the other items of a bundle listing to the buyer.
+// The returned rollback func undoes the changes made on xsyn, the db changes are undone by rolling back conn.
+func TransferBundleItems(
+	conn boil.Executor,
+	passport *xsyn_rpcclient.XsynXrpcClient,
+	itemSaleID string,
+	fromUserID string,
+	toUserID string,
+	relatedTransactionID string,
+) (TransferAssetToXsynRollbackFunc, error) {
+	l := gamelog.L.With().Str("func", "TransferBundleItems").Str("item_sale_id", itemSaleID).Str("to_user_id", toUserID).Logger()
+
+	rollbackFuncs := []TransferAssetToXsynRollbackFunc{}
+	rollbackFunc := func() {
+		for _, fn := range rollbackFuncs {
+			fn()
+		}
+	}
+
+	bundleItems, err := db.MarketplaceSaleBundleItemsUnreleased(conn, itemSaleID)
+	if err != nil {
+		return nil, err
+	}
+	if len(bundleItems) == 0 {
+		return rollbackFunc, nil
+	}
+
+	buyer, err := boiler.FindPlayer(conn, toUserID)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to find buyer")
+		return nil, terror.Error(err)
+	}
+
+	for _, bundleItem := range bundleItems {
+		if bundleItem.PlayerKeycardID.Valid {
+			playerKeycard, err := boiler.FindPlayerKeycard(conn, bundleItem.PlayerKeycardID.String)
+			if err != nil {
+				rollbackFunc()
+				l.Error().Err(err).Str("player_keycard_id", bundleItem.PlayerKeycardID.String).Msg("failed to find player keycard")
+				return nil, terror.Error(err)
+			}
+			keycardBlueprint, err := boiler.FindBlueprintKeycard(conn, playerKeycard.BlueprintKeycardID)
+			if err != nil {
+				rollbackFunc()
+				l.Error().Err(err).Str("player_keycard_id", playerKeycard.ID).Msg("failed to find blueprint keycard")
+				return nil, terror.Error(err)
+			}
+
+			err = db.AddPlayerKeycardCount(conn, toUserID, playerKeycard.BlueprintKeycardID, bundleItem.KeycardCount)
+			if err != nil {
+				rollbackFunc()
+				l.Error().Err(err).Str("player_keycard_id", playerKeycard.ID).Msg("failed to add keycards to buyer")
+				return nil, err
+			}
+
+			// The keycards were taken off the seller on xsyn when listed
+			err = UpdateKeycardCountXSYN(passport, keycardBlueprint, buyer.PublicAddress.String, bundleItem.KeycardCount, true)
+			if err != nil {
+				rollbackFunc()
+				l.Error().Err(err).Str("player_keycard_id", playerKeycard.ID).Msg("failed to add keycards to buyer on xsyn")
+				return nil, err
+			}
+			count := bundleItem.KeycardCount
+			rollbackFuncs = append(rollbackFuncs, func() {
+				err := UpdateKeycardCountXSYN(passport, keycardBlueprint, buyer.PublicAddress.String, count, false)
+				if err != nil {
+					l.Error().Err(err).Str("player_keycard_id", playerKeycard.ID).Msg("Failed to rollback keycards added to buyer on xsyn.")
+				}
+			})
+			continue
+		}
+
+		colItem, err := boiler.FindCollectionItem(conn, bundleItem.CollectionItemID.String)
+		if err != nil {
+			rollbackFunc()
+			l.Error().Err(err).Str("collection_item_id", bundleItem.CollectionItemID.String).Msg("failed to find collection item")
+			return nil, terror.Error(err)
+		}
+
+		err = passport.TransferAsset(
+			fromUserID,
+			toUserID,
+			colItem.Hash,
+			null.StringFrom(relatedTransactionID),
+			func(rpcClient *xsyn_rpcclient.XsynXrpcClient, eventID int64) {
+				asset.UpdateLatestHandledTransferEvent(rpcClient, eventID)
+			},
+		)
+		if err != nil {
+			rollbackFunc()
+			l.Error().Err(err).Str("collection_item_id", colItem.ID).Msg("failed to transfer bundled item on xsyn")
+			return nil, terror.Error(err)
+		}
+		hash := colItem.Hash
+		rollbackFuncs = append(rollbackFuncs, func() {
+			err := passport.TransferAsset(
+				toUserID,
+				fromUserID,
+				hash,
+				null.StringFrom(relatedTransactionID),
+				func(rpcClient *xsyn_rpcclient.XsynXrpcClient, eventID int64) {
+					asset.UpdateLatestHandledTransferEvent(rpcClient, eventID)
+				},
+			)
+			if err != nil {
+				l.Error().Err(err).Str("hash", hash).Msg("Failed to rollback bundled item rpc TransferAsset.")
+			}
+		})
+
+		err = transferCollectionItemToNewOwner(conn, passport, colItem, toUserID, null.StringFrom(relatedTransactionID))
+		if err != nil {
+			rollbackFunc()
+			return nil, err
+		}
+
+		colItem.LockedToMarketplace = false
+		_, err = colItem.Update(conn, boil.Whitelist(boiler.CollectionItemColumns.LockedToMarketplace))
+		if err != nil {
+			rollbackFunc()
+			l.Error().Err(err).Str("collection_item_id", colItem.ID).Msg("failed to unlock bundled item")
+			return nil, terror.Error(err)
+		}
+	}
+
+	err = db.MarketplaceSaleBundleItemsRelease(conn, itemSaleID)
+	if err != nil {
+		rollbackFunc()
+		return nil, err
+	}
+
+	return rollbackFunc, nil
+}
+
+// ReleaseBundleItems returns the other items of a bundle listing which did not sell back to the seller.
+func ReleaseBundleItems(passport *xsyn_rpcclient.XsynXrpcClient, itemSaleID string) error {
+	l := gamelog.L.With().Str("func", "ReleaseBundleItems").Str("item_sale_id", itemSaleID).Logger()
+
+	tx, err := gamedb.StdConn.Begin()
+	if err != nil {
+		l.Error().Err(err).Msg("unable to start db transaction")
+		return terror.Error(err)
+	}
+	defer tx.Rollback()
+
+	bundleItems, err := db.MarketplaceSaleBundleItemsUnreleased(tx, itemSaleID)
+	if err != nil {
+		return err
+	}
+	if len(bundleItems) == 0 {
+		return nil
+	}
+
+	itemSale, err := boiler.FindItemSale(tx, itemSaleID)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to find item sale")
+		return terror.Error(err)
+	}
+	if itemSale.SoldTo.Valid {
+		return terror.Error(fmt.Errorf("item sale is sold"))
+	}
+	owner, err := boiler.FindPlayer(tx, itemSale.OwnerID)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to find seller")
+		return terror.Error(err)
+	}
+
+	type keycardReturn struct {
+		blueprint *boiler.BlueprintKeycard
+		count     int
+	}
+	keycardReturns := []keycardReturn{}
+
+	for _, bundleItem := range bundleItems {
+		if bundleItem.PlayerKeycardID.Valid {
+			playerKeycard, err := boiler.FindPlayerKeycard(tx, bundleItem.PlayerKeycardID.String)
+			if err != nil {
+				l.Error().Err(err).Str("player_keycard_id", bundleItem.PlayerKeycardID.String).Msg("failed to find player keycard")
+				return terror.Error(err)
+			}
+			keycardBlueprint, err := boiler.FindBlueprintKeycard(tx, playerKeycard.BlueprintKeycardID)
+			if err != nil {
+				l.Error().Err(err).Str("player_keycard_id", playerKeycard.ID).Msg("failed to find blueprint keycard")
+				return terror.Error(err)
+			}
+			err = db.AddPlayerKeycardCount(tx, playerKeycard.PlayerID, playerKeycard.BlueprintKeycardID, bundleItem.KeycardCount)
+			if err != nil {
+				l.Error().Err(err).Str("player_keycard_id", playerKeycard.ID).Msg("failed to return keycards to seller")
+				return err
+			}
+			keycardReturns = append(keycardReturns, keycardReturn{keycardBlueprint, bundleItem.KeycardCount})
+			continue
+		}
+
+		colItem := &boiler.CollectionItem{
+			ID:                  bundleItem.CollectionItemID.String,
+			LockedToMarketplace: false,
+		}
+		_, err = colItem.Update(tx, boil.Whitelist(boiler.CollectionItemColumns.LockedToMarketplace))
+		if err != nil {
+			l.Error().Err(err).Str("collection_item_id", colItem.ID).Msg("failed to unlock bundled item")
+			return terror.Error(err)
+		}
+	}
+
+	err = db.MarketplaceSaleBundleItemsRelease(tx, itemSaleID)
+	if err != nil {
+		return err
+	}
+
+	// Give the keycards back on xsyn, undoing the ones already given back if one fails
+	for i, kr := range keycardReturns {
+		err = UpdateKeycardCountXSYN(passport, kr.blueprint, owner.PublicAddress.String, kr.count, true)
+		if err != nil {
+			l.Error().Err(err).Str("blueprint_keycard_id", kr.blueprint.ID).Msg("failed to return keycards to seller on xsyn")
+			for _, done := range keycardReturns[:i] {
+				err := UpdateKeycardCountXSYN(passport, done.blueprint, owner.PublicAddress.String, done.count, false)
+				if err != nil {
+					l.Error().Err(err).Str("blueprint_keycard_id", done.blueprint.ID).Msg("Failed to rollback keycards returned to seller on xsyn.")
+				}
+			}
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		l.Error().Err(err).Msg("unable to commit db transaction")
+		for _, done := range keycardReturns {
+			err := UpdateKeycardCountXSYN(passport, done.blueprint, owner.PublicAddress.String, done.count, false)
+			if err != nil {
+				l.Error().Err(err).Str("blueprint_keycard_id", done.blueprint.ID).Msg("Failed to rollback keycards returned to seller on xsyn.")
+			}
+		}
+		return terror.Error(err)
+	}
+
+	return nil
+}
+
+// Returns the bundled items of listings that were cancelled, or ended without selling, back to the seller.
+func (m *MarketplaceController) processExpiredBundleListings() {
+	gamelog.L.Trace().Msg("processing expired bundle listings started")
+
+	itemSaleIDs, err := db.MarketplaceBundlesToRelease()
+	if err != nil {
+		gamelog.L.Error().
+			Str("db func", "MarketplaceBundlesToRelease").
+			Err(err).Msg("unable to retrieve expired bundle listings")
+		return
+	}
+
+	numProcessed := 0
+	for _, itemSaleID := range itemSaleIDs {
+		err := ReleaseBundleItems(m.Passport, itemSaleID)
+		if err != nil {
+			continue
+		}
+		numProcessed++
+	}
+
+	gamelog.L.Trace().
+		Int("num_processed", numProcessed).
+		Int("num_failed", len(itemSaleIDs)-numProcessed).
+		Int("num_pending", len(itemSaleIDs)).
+		Msg("processing expired bundle listings finished")
+}
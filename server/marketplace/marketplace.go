@@ -10,8 +10,10 @@ import (
 	"server/db/boiler"
 	"server/gamedb"
 	"server/gamelog"
+	"server/quest"
 	"server/xsyn_rpcclient"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -23,7 +25,14 @@ import (
 )
 
 type MarketplaceController struct {
-	Passport *xsyn_rpcclient.XsynXrpcClient
+	Passport     *xsyn_rpcclient.XsynXrpcClient
+	Telegram     server.Telegram
+	QuestManager *quest.System
+
+	// auctionsMx stops the same auction being closed by the scheduler and the ticker at once, or while a bid is placed
+	auctionsMx      sync.Mutex
+	auctionTimers   map[string]*time.Timer
+	auctionTimersMx sync.Mutex
 }
 
 type ItemSaleAuction struct {
@@ -41,6 +50,7 @@ type ItemSaleAuction struct {
 	AuctionBidPrice      decimal.Decimal     `boil:"auction_bid_price"`
 	AuctionBidUserID     uuid.UUID           `boil:"auction_bid_user_id"`
 	AuctionBidTXID       string              `boil:"auction_bid_tx_id"`
+	AuctionBidMaxPrice   decimal.NullDecimal `boil:"auction_bid_max_price"`
 	FactionID            uuid.UUID           `boil:"faction_id"`
 	CreatedAt            time.Time           `boil:"created_at"`
 }
@@ -50,12 +60,34 @@ type AttributeInner struct {
 	Value     string `json:"value"`
 }
 
-func NewMarketplaceController(pp *xsyn_rpcclient.XsynXrpcClient) *MarketplaceController {
-	m := &MarketplaceController{pp}
+func NewMarketplaceController(pp *xsyn_rpcclient.XsynXrpcClient, telegram server.Telegram, questManager *quest.System) *MarketplaceController {
+	m := &MarketplaceController{
+		Passport:      pp,
+		Telegram:      telegram,
+		QuestManager:  questManager,
+		auctionTimers: map[string]*time.Timer{},
+	}
+	m.scheduleOpenAuctions()
 	go m.Run()
 	return m
 }
 
+// PublishSaleQuestEvent progresses the marketplace sale objective quests of the seller.
+func (m *MarketplaceController) PublishSaleQuestEvent(sellerID string, itemType string) {
+	if m.QuestManager == nil {
+		return
+	}
+
+	m.QuestManager.Publish(&quest.Event{
+		Type:     db.QuestObjectiveEventMarketplaceSale,
+		PlayerID: sellerID,
+		Amount:   1,
+		Fields: map[string]string{
+			"item_type": itemType,
+		},
+	})
+}
+
 func (m *MarketplaceController) Run() {
 	defer func() {
 		if r := recover(); r != nil {
@@ -70,15 +102,29 @@ func (m *MarketplaceController) Run() {
 		case <-mainTicker.C:
 			bm := benchmark.New()
 
+			// auctions are closed on time by the auction scheduler, this picks up dutch auctions and locked items
+			// as well as anything missed while the server was down
 			bm.Start("finished_auctions")
 			m.processFinishedAuctions()
 			bm.End("finished_auctions")
+			bm.Start("excess_bid_refunds")
+			m.processExcessBidRefunds()
+			bm.End("excess_bid_refunds")
 			bm.Start("expired_keycards")
 			m.processExpiredKeycardItemListings()
 			bm.End("expired_keycards")
 			bm.Start("unlock_in_marketplace_items")
 			m.unlockCollectionItems()
 			bm.End("unlock_in_marketplace_items")
+			bm.Start("expired_item_offers")
+			m.processExpiredItemOffers()
+			bm.End("expired_item_offers")
+			bm.Start("expired_bundle_listings")
+			m.processExpiredBundleListings()
+			bm.End("expired_bundle_listings")
+			bm.Start("watched_dutch_auctions")
+			m.processWatchedDutchAuctions()
+			bm.End("watched_dutch_auctions")
 
 			bm.Alert(60000)
 		}
@@ -250,12 +296,21 @@ func (m *MarketplaceController) processExpiredKeycardItemListings() {
 
 // Scan all completed auctions that haven't went through payment process.
 // This also processes and bids that exceed the dutch auction drop rate.
-func (m *MarketplaceController) processFinishedAuctions() {
+// When item sale ids are given only those auctions are processed.
+func (m *MarketplaceController) processFinishedAuctions(itemSaleIDs ...string) {
+	m.auctionsMx.Lock()
+	defer m.auctionsMx.Unlock()
+
 	gamelog.L.Trace().Msg("processing completed auction items started")
 
+	itemSaleFilter := ""
+	if len(itemSaleIDs) > 0 {
+		itemSaleFilter = "AND item_sales.id = ANY($1)"
+	}
+
 	auctions := []*ItemSaleAuction{}
 	err := boiler.NewQuery(
-		qm.SQL(`
+		qm.SQL(fmt.Sprintf(`
 			SELECT item_sales.id AS id,
 				item_sales.collection_item_id,
 				collection_items.item_type,
@@ -267,10 +322,23 @@ func (m *MarketplaceController) processFinishedAuctions() {
 				item_sales.dutch_auction,
 				item_sales.dutch_auction_drop_rate,
 				item_sales.created_at,
-				(collection_items.xsyn_locked OR collection_items.market_locked) AS item_locked,
+				(
+					collection_items.xsyn_locked
+					OR collection_items.market_locked
+					OR collection_items.clawback_locked
+					OR EXISTS (
+						SELECT 1
+						FROM item_sale_bundle_items
+							INNER JOIN collection_items bundle_items ON bundle_items.id = item_sale_bundle_items.collection_item_id
+						WHERE item_sale_bundle_items.item_sale_id = item_sales.id
+							AND item_sale_bundle_items.released_at IS NULL
+							AND bundle_items.clawback_locked = true
+					)
+				) AS item_locked,
 				item_sales_bid_history.bid_price AS auction_bid_price,
 				item_sales_bid_history.bidder_id AS auction_bid_user_id,
 				item_sales_bid_history.bid_tx_id AS auction_bid_tx_id,
+				item_sales_bid_history.max_bid_price AS auction_bid_max_price,
 				players.faction_id
 			FROM item_sales 
 				INNER JOIN item_sales_bid_history ON item_sales_bid_history.item_sale_id = item_sales.id
@@ -291,7 +359,17 @@ func (m *MarketplaceController) processFinishedAuctions() {
 					)
 					OR collection_items.xsyn_locked = true
 					OR collection_items.market_locked = true
-				)`),
+					OR collection_items.clawback_locked = true
+					OR EXISTS (
+						SELECT 1
+						FROM item_sale_bundle_items
+							INNER JOIN collection_items bundle_items ON bundle_items.id = item_sale_bundle_items.collection_item_id
+						WHERE item_sale_bundle_items.item_sale_id = item_sales.id
+							AND item_sale_bundle_items.released_at IS NULL
+							AND bundle_items.clawback_locked = true
+					)
+				)
+				%s`, itemSaleFilter), itemSaleIDArgs(itemSaleIDs)...),
 	).Bind(nil, gamedb.StdConn, &auctions)
 	if err != nil {
 		gamelog.L.Error().
@@ -316,12 +394,18 @@ func (m *MarketplaceController) processFinishedAuctions() {
 					return
 				}
 				factID := uuid.Must(uuid.FromString(factionAccountID))
+
+				// proxy bids hold their max bid price
+				heldAmount := auctionItem.AuctionBidPrice
+				if auctionItem.AuctionBidMaxPrice.Valid {
+					heldAmount = auctionItem.AuctionBidMaxPrice.Decimal
+				}
 				syndicateBalance := m.Passport.UserBalanceGet(factID)
-				if syndicateBalance.LessThanOrEqual(auctionItem.AuctionBidPrice) {
+				if syndicateBalance.LessThanOrEqual(heldAmount) {
 					txid, err := m.Passport.SpendSupMessage(xsyn_rpcclient.SpendSupsReq{
 						FromUserID:           uuid.UUID(server.XsynTreasuryUserID),
 						ToUserID:             factID,
-						Amount:               auctionItem.AuctionBidPrice.StringFixed(0),
+						Amount:               heldAmount.StringFixed(0),
 						TransactionReference: server.TransactionReference(fmt.Sprintf("bid_refunds|%s|%d", auctionItem.AuctionBidUserID, time.Now().UnixNano())),
 						Group:                string(server.TransactionGroupSupremacy),
 						SubGroup:             string(server.TransactionGroupMarketplace),
@@ -330,14 +414,14 @@ func (m *MarketplaceController) processFinishedAuctions() {
 					if err != nil {
 						l.Error().
 							Str("Faction ID", factionAccountID).
-							Str("Amount", auctionItem.AuctionBidPrice.StringFixed(0)).
+							Str("Amount", heldAmount.StringFixed(0)).
 							Err(err).
 							Msg("Could not transfer money from treasury into syndicate account!!")
 						return
 					}
 					l.Warn().
 						Str("Faction ID", factionAccountID).
-						Str("Amount", auctionItem.AuctionBidPrice.StringFixed(0)).
+						Str("Amount", heldAmount.StringFixed(0)).
 						Str("TXID", txid).
 						Err(err).
 						Msg("Had to transfer funds to the syndicate account")
@@ -360,7 +444,7 @@ func (m *MarketplaceController) processFinishedAuctions() {
 						Msg("unable to update refund tx id on bid record")
 					return
 				}
-				err = db.MarketplaceAddEvent(boiler.MarketplaceEventBidRefund, auctionItem.AuctionBidUserID.String(), decimal.NewNullDecimal(auctionItem.AuctionBidPrice), auctionItem.ID.String(), boiler.TableNames.ItemSales)
+				err = db.MarketplaceAddEvent(boiler.MarketplaceEventBidRefund, auctionItem.AuctionBidUserID.String(), decimal.NewNullDecimal(heldAmount), auctionItem.ID.String(), boiler.TableNames.ItemSales)
 				if err != nil {
 					l.Error().
 						Str("bid_tx_id", auctionItem.AuctionBidTXID).
@@ -500,6 +584,15 @@ func (m *MarketplaceController) processFinishedAuctions() {
 				return
 			}
 
+			// Transfer the other items of a bundle
+			bundleTransferRollback, err := TransferBundleItems(tx, m.Passport, auctionItem.ID.String(), auctionItem.OwnerID.String(), auctionItem.AuctionBidUserID.String(), txid)
+			if err != nil {
+				m.Passport.RefundSupsMessage(txid)
+				rpcAssetTransferRollback()
+				l.Error().Err(err).Msg("Failed to transfer bundled items to new owner")
+				return
+			}
+
 			// Unlock Listed Item
 			collectionItem := boiler.CollectionItem{
 				ID:                  auctionItem.CollectionItemID.String(),
@@ -513,6 +606,7 @@ func (m *MarketplaceController) processFinishedAuctions() {
 			if err != nil {
 				m.Passport.RefundSupsMessage(txid)
 				rpcAssetTransferRollback()
+				bundleTransferRollback()
 				l.Error().Err(err).Msg("Failed to unlock marketplace listed collection item.")
 				return
 			}
@@ -522,6 +616,7 @@ func (m *MarketplaceController) processFinishedAuctions() {
 			if err != nil {
 				m.Passport.RefundSupsMessage(txid)
 				rpcAssetTransferRollback()
+				bundleTransferRollback()
 				l.Error().Err(err).Msg("Failed to commit db transaction")
 				return
 			}
@@ -535,6 +630,18 @@ func (m *MarketplaceController) processFinishedAuctions() {
 			if err != nil {
 				l.Error().Err(err).Msg("Failed to log sold event.")
 			}
+			m.PublishSaleQuestEvent(auctionItem.OwnerID.String(), auctionItem.ItemType)
+
+			// Return what a winning proxy bid held above the price it won at, failures are retried on the ticker
+			if auctionItem.AuctionBidMaxPrice.Valid && auctionItem.AuctionBidMaxPrice.Decimal.GreaterThan(auctionItem.AuctionBidPrice) {
+				_ = m.refundExcessBid(
+					auctionItem.ID.String(),
+					auctionItem.FactionID.String(),
+					auctionItem.AuctionBidUserID.String(),
+					auctionItem.AuctionBidTXID,
+					auctionItem.AuctionBidMaxPrice.Decimal.Sub(auctionItem.AuctionBidPrice),
+				)
+			}
 
 			numProcessed++
 		}()
@@ -549,7 +656,6 @@ func (m *MarketplaceController) processFinishedAuctions() {
 
 func HandleMarketplaceAssetTransfer(conn boil.Executor, rpcClient *xsyn_rpcclient.XsynXrpcClient, itemSaleID string) error {
 	l := gamelog.L.With().Interface("itemSaleID", itemSaleID).Str("func", "HandleMarketplaceAuctionAssetTransfer").Logger()
-	attachedHashes := []string{}
 
 	itemSale, err := boiler.FindItemSale(conn, itemSaleID)
 	if err != nil {
@@ -562,9 +668,19 @@ func HandleMarketplaceAssetTransfer(conn boil.Executor, rpcClient *xsyn_rpcclien
 		return err
 	}
 
+	return transferCollectionItemToNewOwner(conn, rpcClient, colItem, itemSale.SoldTo.String, itemSale.SoldTXID)
+}
+
+// transferCollectionItemToNewOwner moves a collection item, and anything attached to it, to the new owner.
+// Attached items are also transferred on xsyn, the collection item itself is expected to already be transferred there.
+func transferCollectionItemToNewOwner(conn boil.Executor, rpcClient *xsyn_rpcclient.XsynXrpcClient, colItem *boiler.CollectionItem, toUserID string, relatedTransactionID null.String) error {
+	l := gamelog.L.With().Str("collection_item_id", colItem.ID).Str("to_user_id", toUserID).Str("func", "transferCollectionItemToNewOwner").Logger()
+	attachedHashes := []string{}
+	fromUserID := colItem.OwnerID
+
 	switch colItem.ItemType {
 	case boiler.ItemTypeWeapon:
-		attachedColItems, err := asset.TransferWeaponToNewOwner(conn, colItem.ItemID, itemSale.SoldTo.String, colItem.XsynLocked, null.NewString("", false))
+		attachedColItems, err := asset.TransferWeaponToNewOwner(conn, colItem.ItemID, toUserID, colItem.XsynLocked, null.NewString("", false))
 		if err != nil {
 			l.Error().Err(err).Msg("failed to transfer mech to new owner")
 			return err
@@ -573,7 +689,7 @@ func HandleMarketplaceAssetTransfer(conn boil.Executor, rpcClient *xsyn_rpcclien
 			attachedHashes = append(attachedHashes, colItem.Hash)
 		}
 	case boiler.ItemTypeMech:
-		attachedColItems, err := asset.TransferMechToNewOwner(conn, colItem.ItemID, itemSale.SoldTo.String, colItem.XsynLocked, null.NewString("", false))
+		attachedColItems, err := asset.TransferMechToNewOwner(conn, colItem.ItemID, toUserID, colItem.XsynLocked, null.NewString("", false))
 		if err != nil {
 			l.Error().Err(err).Msg("failed to transfer mech to new owner")
 			return err
@@ -587,8 +703,8 @@ func HandleMarketplaceAssetTransfer(conn boil.Executor, rpcClient *xsyn_rpcclien
 		boiler.ItemTypePowerCore,
 		boiler.ItemTypeMysteryCrate,
 		boiler.ItemTypeWeaponSkin:
-		colItem.OwnerID = itemSale.SoldTo.String
-		_, err = colItem.Update(conn, boil.Infer())
+		colItem.OwnerID = toUserID
+		_, err := colItem.Update(conn, boil.Infer())
 		if err != nil {
 			l.Error().Err(err).Msg("failed to transfer mech to new owner")
 			return err
@@ -598,10 +714,10 @@ func HandleMarketplaceAssetTransfer(conn boil.Executor, rpcClient *xsyn_rpcclien
 	}
 	for _, hash := range attachedHashes {
 		err := rpcClient.TransferAsset(
-			colItem.OwnerID,
-			itemSale.SoldTo.String,
+			fromUserID,
+			toUserID,
 			hash,
-			itemSale.SoldTXID,
+			relatedTransactionID,
 			func(rpcClient *xsyn_rpcclient.XsynXrpcClient, eventID int64) {
 				asset.UpdateLatestHandledTransferEvent(rpcClient, eventID)
 			},
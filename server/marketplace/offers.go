@@ -0,0 +1,337 @@
+package marketplace
+
+import (
+	"database/sql"
+	"fmt"
+	"server"
+	"server/db"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+	"server/xsyn_rpcclient"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/gofrs/uuid"
+	"github.com/ninja-software/terror/v2"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// ItemOfferAssetCheck checks the collection item can currently be bought off its owner with an offer.
+func ItemOfferAssetCheck(conn boil.Executor, colItem *boiler.CollectionItem, ownerID string) error {
+	if colItem.ItemType != boiler.ItemTypeMech && colItem.ItemType != boiler.ItemTypeMysteryCrate && colItem.ItemType != boiler.ItemTypeWeapon {
+		return terror.Error(fmt.Errorf("invalid item type"), "Offers cannot be made on this item.")
+	}
+	if colItem.OwnerID != ownerID {
+		return terror.Error(fmt.Errorf("item owner has changed"), "Item no longer belongs to the same owner.")
+	}
+	if colItem.LockedToMarketplace {
+		return terror.Error(fmt.Errorf("item is listed"), "Item is listed on the marketplace, buy or bid on the listing instead.")
+	}
+	if colItem.MarketLocked || colItem.XsynLocked || colItem.ClawbackLocked {
+		return terror.Error(fmt.Errorf("item is locked"), "Item is locked.")
+	}
+
+	switch colItem.ItemType {
+	case boiler.ItemTypeMysteryCrate:
+		crate, err := boiler.FindMysteryCrate(conn, colItem.ItemID)
+		if err != nil {
+			return terror.Error(err, "Failed to load mystery crate.")
+		}
+		if crate.Opened {
+			return terror.Error(fmt.Errorf("crate is opened"), "Mystery crate has already been opened.")
+		}
+	case boiler.ItemTypeWeapon:
+		equipped, err := db.CheckWeaponAttached(colItem.ItemID)
+		if err != nil {
+			return terror.Error(err, "Failed to check weapon.")
+		}
+		if equipped {
+			return terror.Error(fmt.Errorf("weapon is attached"), "Weapon is attached to a war machine.")
+		}
+		fallthrough
+	case boiler.ItemTypeMech:
+		canMove, reason, err := db.CanAssetBeModifiedOrMoved(conn, colItem.ItemID, colItem.ItemType, ownerID)
+		if err != nil {
+			return terror.Error(err, "Failed to check item.")
+		}
+		if !canMove {
+			return terror.Error(fmt.Errorf("item cannot be moved"), reason.String())
+		}
+	}
+
+	return nil
+}
+
+// fundFactionAccount tops up the syndicate account from the treasury when it cannot cover the amount.
+func fundFactionAccount(passport *xsyn_rpcclient.XsynXrpcClient, factionAccountID uuid.UUID, amount decimal.Decimal, reference string, description string) error {
+	syndicateBalance := passport.UserBalanceGet(factionAccountID)
+	if syndicateBalance.GreaterThan(amount) {
+		return nil
+	}
+
+	txid, err := passport.SpendSupMessage(xsyn_rpcclient.SpendSupsReq{
+		FromUserID:           uuid.UUID(server.XsynTreasuryUserID),
+		ToUserID:             factionAccountID,
+		Amount:               amount.StringFixed(0),
+		TransactionReference: server.TransactionReference(fmt.Sprintf("%s|%d", reference, time.Now().UnixNano())),
+		Group:                string(server.TransactionGroupSupremacy),
+		SubGroup:             string(server.TransactionGroupMarketplace),
+		Description:          description,
+	})
+	if err != nil {
+		gamelog.L.Error().
+			Str("Faction Account ID", factionAccountID.String()).
+			Str("Amount", amount.StringFixed(0)).
+			Err(err).
+			Msg("Could not transfer money from treasury into syndicate account!!")
+		return err
+	}
+	gamelog.L.Warn().
+		Str("Faction Account ID", factionAccountID.String()).
+		Str("Amount", amount.StringFixed(0)).
+		Str("TXID", txid).
+		Msg("Had to transfer funds to the syndicate account")
+
+	return nil
+}
+
+// ItemOfferRefund returns the escrowed amount of an offer back to the offerer.
+// The offer should already be moved out of pending before being refunded.
+func ItemOfferRefund(passport *xsyn_rpcclient.XsynXrpcClient, offer *boiler.ItemOffer) error {
+	if !offer.OfferTXID.Valid || offer.RefundTXID.Valid {
+		// nothing held
+		return nil
+	}
+
+	l := gamelog.L.With().Str("func", "ItemOfferRefund").Str("item_offer_id", offer.ID).Str("offer_tx_id", offer.OfferTXID.String).Logger()
+
+	factionAccountID, ok := server.FactionUsers[offer.FactionID]
+	if !ok {
+		err := fmt.Errorf("failed to get hard coded syndicate player id")
+		l.Error().Err(err).Msg("unable to get find faction account")
+		return err
+	}
+
+	err := fundFactionAccount(
+		passport,
+		uuid.Must(uuid.FromString(factionAccountID)),
+		offer.Amount,
+		fmt.Sprintf("offer_refunds|%s", offer.OffererID),
+		fmt.Sprintf("Offer Refund for Player: %s (item offer: %s)", offer.OffererID, offer.ID),
+	)
+	if err != nil {
+		return err
+	}
+
+	refundTxID, err := passport.RefundSupsMessage(offer.OfferTXID.String)
+	if err != nil {
+		l.Error().Err(err).Msg("unable to refund offer transaction")
+		return err
+	}
+	offer.RefundTXID = null.StringFrom(refundTxID)
+
+	err = db.MarketplaceItemOfferRefund(gamedb.StdConn, offer.ID, offer.OfferTXID.String, refundTxID)
+	if err != nil {
+		l.Error().Err(err).Str("refund_tx_id", refundTxID).Msg("unable to update refund tx id on offer record")
+		return err
+	}
+
+	err = db.MarketplaceAddEvent(boiler.MarketplaceEventOfferRefund, offer.OffererID, decimal.NewNullDecimal(offer.Amount), offer.ID, boiler.TableNames.ItemOffers)
+	if err != nil {
+		l.Error().Err(err).Str("refund_tx_id", refundTxID).Msg("Failed to log offer refund event.")
+	}
+
+	return nil
+}
+
+// ItemOfferComplete pays the owner the escrowed amount of an accepted offer and transfers the item to the offerer.
+// The escrow transaction of the offer must already be made.
+func ItemOfferComplete(passport *xsyn_rpcclient.XsynXrpcClient, offer *boiler.ItemOffer) error {
+	l := gamelog.L.With().Str("func", "ItemOfferComplete").Str("item_offer_id", offer.ID).Str("cost", offer.Amount.String()).Logger()
+
+	if !offer.OfferTXID.Valid {
+		return terror.Error(fmt.Errorf("offer has not been paid"), "Offer has not been paid.")
+	}
+
+	colItem, err := boiler.FindCollectionItem(gamedb.StdConn, offer.CollectionItemID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return terror.Error(err, "Item not found.")
+	}
+	if err != nil {
+		l.Error().Err(err).Msg("unable to load collection item")
+		return terror.Error(err, "Failed to load item.")
+	}
+
+	err = ItemOfferAssetCheck(gamedb.StdConn, colItem, offer.OwnerID)
+	if err != nil {
+		return err
+	}
+
+	// Get Faction Account sending offer amount from
+	factionAccountID, ok := server.FactionUsers[offer.FactionID]
+	if !ok {
+		err = fmt.Errorf("failed to get hard coded syndicate player id")
+		l.Error().Err(err).Msg("unable to get hard coded syndicate player ID from faction ID")
+		return terror.Error(err, "Failed to process offer.")
+	}
+	factionAccountUUID := uuid.Must(uuid.FromString(factionAccountID))
+
+	// Transfer Sups to Owner
+	salesCutPercentageFee := db.GetDecimalWithDefault(db.KeyMarketplaceSaleCutPercentageFee, decimal.NewFromFloat(0.1))
+	salesCutAmount := offer.Amount.Mul(decimal.NewFromInt(1).Sub(salesCutPercentageFee))
+
+	err = fundFactionAccount(
+		passport,
+		factionAccountUUID,
+		salesCutAmount,
+		fmt.Sprintf("marketplace_buy_item|offer|%s", offer.ID),
+		fmt.Sprintf("Marketplace Offer Payment (%d%% cut): %s", salesCutPercentageFee.Mul(decimal.NewFromInt(100)).IntPart(), offer.ID),
+	)
+	if err != nil {
+		return terror.Error(err, "Failed to process offer.")
+	}
+
+	txid, err := passport.SpendSupMessage(xsyn_rpcclient.SpendSupsReq{
+		FromUserID:           factionAccountUUID,
+		ToUserID:             uuid.Must(uuid.FromString(offer.OwnerID)),
+		Amount:               salesCutAmount.String(),
+		TransactionReference: server.TransactionReference(fmt.Sprintf("marketplace_buy_item|offer|%s|%d", offer.ID, time.Now().UnixNano())),
+		Group:                string(server.TransactionGroupSupremacy),
+		SubGroup:             string(server.TransactionGroupMarketplace),
+		Description:          fmt.Sprintf("Marketplace Offer Payment (%d%% cut): %s", salesCutPercentageFee.Mul(decimal.NewFromInt(100)).IntPart(), offer.ID),
+	})
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to send sups to item owner.")
+		return terror.Error(err, "Failed to process offer.")
+	}
+
+	// Begin Transaction
+	tx, err := gamedb.StdConn.Begin()
+	if err != nil {
+		passport.RefundSupsMessage(txid)
+		l.Error().Err(err).Msg("Failed to start db transaction.")
+		return terror.Error(err, "Failed to process offer.")
+	}
+	defer tx.Rollback()
+
+	// Record the sale, the asset transfer works off the item sale
+	now := time.Now()
+	saleItemRecord := &boiler.ItemSale{
+		FactionID:        offer.FactionID,
+		CollectionItemID: offer.CollectionItemID,
+		OwnerID:          offer.OwnerID,
+		Buyout:           true,
+		BuyoutPrice:      decimal.NewNullDecimal(offer.Amount),
+		EndAt:            now,
+		SoldAt:           null.TimeFrom(now),
+		SoldFor:          decimal.NewNullDecimal(offer.Amount),
+		SoldTXID:         null.StringFrom(txid),
+		SoldTo:           null.StringFrom(offer.OffererID),
+	}
+	err = saleItemRecord.Insert(tx, boil.Infer())
+	if err != nil {
+		passport.RefundSupsMessage(txid)
+		l.Error().Err(err).Msg("Failed to insert item sale for offer.")
+		return terror.Error(err, "Failed to process offer.")
+	}
+
+	rpcAssetTransferRollback, err := TransferAssetsToXsyn(tx, passport, offer.OwnerID, offer.OffererID, txid, colItem.Hash, saleItemRecord.ID)
+	if err != nil {
+		passport.RefundSupsMessage(txid)
+		l.Error().Err(err).Msg("Failed to process transaction for offer passport.TransferAsset.")
+		return terror.Error(err, "Failed to process offer.")
+	}
+
+	err = HandleMarketplaceAssetTransfer(tx, passport, saleItemRecord.ID)
+	if err != nil {
+		passport.RefundSupsMessage(txid)
+		rpcAssetTransferRollback()
+		l.Error().Err(err).Msg("Failed to transfer item to new owner")
+		return terror.Error(err, "Failed to process offer.")
+	}
+
+	offer.ItemSaleID = null.StringFrom(saleItemRecord.ID)
+	offer.UpdatedAt = now
+	_, err = offer.Update(tx, boil.Whitelist(
+		boiler.ItemOfferColumns.ItemSaleID,
+		boiler.ItemOfferColumns.OfferTXID,
+		boiler.ItemOfferColumns.UpdatedAt,
+	))
+	if err != nil {
+		passport.RefundSupsMessage(txid)
+		rpcAssetTransferRollback()
+		l.Error().Err(err).Msg("Failed to update offer.")
+		return terror.Error(err, "Failed to process offer.")
+	}
+
+	// Commit Transaction
+	err = tx.Commit()
+	if err != nil {
+		passport.RefundSupsMessage(txid)
+		rpcAssetTransferRollback()
+		l.Error().Err(err).Msg("Failed to commit db transaction")
+		return terror.Error(err, "Failed to process offer.")
+	}
+
+	// Log Event
+	err = db.MarketplaceAddEvent(boiler.MarketplaceEventOfferAccepted, offer.OffererID, decimal.NewNullDecimal(offer.Amount), offer.ID, boiler.TableNames.ItemOffers)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to log offer accepted event.")
+	}
+	err = db.MarketplaceAddEvent(boiler.MarketplaceEventPurchase, offer.OffererID, decimal.NewNullDecimal(offer.Amount), saleItemRecord.ID, boiler.TableNames.ItemSales)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to log purchase event.")
+	}
+	err = db.MarketplaceAddEvent(boiler.MarketplaceEventSold, offer.OwnerID, decimal.NewNullDecimal(offer.Amount), saleItemRecord.ID, boiler.TableNames.ItemSales)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to log sold event.")
+	}
+
+	return nil
+}
+
+// Expires and refunds pending offers that have expired, or whose item has changed owner since the offer was made.
+// This also retries refunds of closed offers that previously failed.
+func (m *MarketplaceController) processExpiredItemOffers() {
+	gamelog.L.Trace().Msg("processing expired item offers started")
+
+	offers, err := db.MarketplaceItemOffersToClose()
+	if err != nil {
+		gamelog.L.Error().
+			Str("db func", "MarketplaceItemOffersToClose").
+			Err(err).Msg("unable to retrieve expired item offers")
+		return
+	}
+
+	numProcessed := 0
+	for _, offer := range offers {
+		l := gamelog.L.With().Str("item_offer_id", offer.ID).Logger()
+
+		if offer.Status == boiler.ItemOfferStatusPENDING {
+			claimed, err := db.MarketplaceItemOfferClaim(gamedb.StdConn, offer.ID, boiler.ItemOfferStatusPENDING, boiler.ItemOfferStatusEXPIRED)
+			if err != nil {
+				l.Error().Err(err).Msg("unable to expire item offer")
+				continue
+			}
+			if !claimed {
+				continue
+			}
+		}
+
+		err := ItemOfferRefund(m.Passport, offer)
+		if err != nil {
+			continue
+		}
+
+		numProcessed++
+	}
+
+	gamelog.L.Trace().
+		Int("num_processed", numProcessed).
+		Int("num_failed", len(offers)-numProcessed).
+		Int("num_pending", len(offers)).
+		Msg("processing expired item offers finished")
+}
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// AuctionBid is the outcome of a bid placed against the leading bid of an auction.
+type AuctionBid struct {
+	// Price is what the new bid is placed at.
+	Price decimal.Decimal
+	// LeadingBidWins is set when the leading proxy bid still beats the new bid, the new bid is then not placed.
+	LeadingBidWins bool
+	// LeadingBidPrice is what the leading proxy bid is raised to when it still wins.
+	LeadingBidPrice decimal.Decimal
+}
+
+// AuctionBidResolve works out the price of a bid with an optional max amount (proxy bid). The leading max bid is only
+// set when the leading bid is a proxy bid of another player.
+func AuctionBidResolve(bidAmount decimal.Decimal, maxBidAmount decimal.NullDecimal, leadingMaxBid decimal.NullDecimal, reservedPrice decimal.NullDecimal, bidIncrement decimal.Decimal) *AuctionBid {
+	bidTopAmount := bidAmount
+	if maxBidAmount.Valid {
+		bidTopAmount = maxBidAmount.Decimal
+	}
+
+	if leadingMaxBid.Valid {
+		if bidTopAmount.LessThanOrEqual(leadingMaxBid.Decimal) {
+			// Leading proxy bid still wins, raise it just above this bid
+			return &AuctionBid{
+				Price:           bidAmount,
+				LeadingBidWins:  true,
+				LeadingBidPrice: decimal.Min(leadingMaxBid.Decimal, bidTopAmount.Add(bidIncrement)),
+			}
+		}
+		// This bid wins, only go as high as needed to beat the leading proxy bid
+		bidAmount = decimal.Max(bidAmount, decimal.Min(bidTopAmount, leadingMaxBid.Decimal.Add(bidIncrement)))
+	}
+
+	if maxBidAmount.Valid && reservedPrice.Valid && bidAmount.LessThan(reservedPrice.Decimal) {
+		// Proxy bids go straight to the reserve price when they can cover it
+		bidAmount = decimal.Min(maxBidAmount.Decimal, reservedPrice.Decimal)
+	}
+
+	return &AuctionBid{Price: bidAmount}
+}
+
+// AuctionSoftCloseEndAt returns the new end of an auction when a bid placed at now is within the soft close window of
+// its end, the end is pushed out to the extend duration from now. The end is never brought forward.
+func AuctionSoftCloseEndAt(endAt time.Time, now time.Time, window time.Duration, extend time.Duration) (time.Time, bool) {
+	if window <= 0 || extend <= 0 {
+		return endAt, false
+	}
+	if !endAt.After(now) || !endAt.Before(now.Add(window)) || !endAt.Before(now.Add(extend)) {
+		return endAt, false
+	}
+	return now.Add(extend), true
+}
@@ -0,0 +1,160 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestAuctionBidResolve(t *testing.T) {
+	sups := func(amount int64) decimal.Decimal {
+		return decimal.NewFromInt(amount)
+	}
+	nullSups := func(amount int64) decimal.NullDecimal {
+		return decimal.NewNullDecimal(sups(amount))
+	}
+	increment := sups(1)
+
+	tests := []struct {
+		name            string
+		bidAmount       decimal.Decimal
+		maxBidAmount    decimal.NullDecimal
+		leadingMaxBid   decimal.NullDecimal
+		reservedPrice   decimal.NullDecimal
+		price           decimal.Decimal
+		leadingBidWins  bool
+		leadingBidPrice decimal.Decimal
+	}{
+		{
+			name:      "plain bid",
+			bidAmount: sups(10),
+			price:     sups(10),
+		},
+		{
+			name:         "proxy bid without a leading proxy bid",
+			bidAmount:    sups(10),
+			maxBidAmount: nullSups(50),
+			price:        sups(10),
+		},
+		{
+			name:            "leading proxy bid beats plain bid",
+			bidAmount:       sups(20),
+			leadingMaxBid:   nullSups(50),
+			price:           sups(20),
+			leadingBidWins:  true,
+			leadingBidPrice: sups(21),
+		},
+		{
+			name:            "leading proxy bid raised no higher than its max",
+			bidAmount:       sups(50),
+			leadingMaxBid:   nullSups(50),
+			price:           sups(50),
+			leadingBidWins:  true,
+			leadingBidPrice: sups(50),
+		},
+		{
+			name:            "leading proxy bid beats lower proxy bid",
+			bidAmount:       sups(20),
+			maxBidAmount:    nullSups(40),
+			leadingMaxBid:   nullSups(50),
+			price:           sups(20),
+			leadingBidWins:  true,
+			leadingBidPrice: sups(41),
+		},
+		{
+			name:          "proxy bid beats leading proxy bid by the increment",
+			bidAmount:     sups(20),
+			maxBidAmount:  nullSups(80),
+			leadingMaxBid: nullSups(50),
+			price:         sups(51),
+		},
+		{
+			name:          "plain bid above leading proxy bid keeps its amount",
+			bidAmount:     sups(60),
+			leadingMaxBid: nullSups(50),
+			price:         sups(60),
+		},
+		{
+			name:          "proxy bid one above leading proxy bid",
+			bidAmount:     sups(20),
+			maxBidAmount:  nullSups(51),
+			leadingMaxBid: nullSups(50),
+			price:         sups(51),
+		},
+		{
+			name:          "proxy bid goes to the reserve price",
+			bidAmount:     sups(10),
+			maxBidAmount:  nullSups(80),
+			reservedPrice: nullSups(60),
+			price:         sups(60),
+		},
+		{
+			name:          "proxy bid below the reserve price goes to its max",
+			bidAmount:     sups(10),
+			maxBidAmount:  nullSups(40),
+			reservedPrice: nullSups(60),
+			price:         sups(40),
+		},
+		{
+			name:          "plain bid ignores the reserve price",
+			bidAmount:     sups(10),
+			reservedPrice: nullSups(60),
+			price:         sups(10),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bid := AuctionBidResolve(tt.bidAmount, tt.maxBidAmount, tt.leadingMaxBid, tt.reservedPrice, increment)
+			if bid.LeadingBidWins != tt.leadingBidWins {
+				t.Fatalf("unexpected leading bid wins: %t, expected %t", bid.LeadingBidWins, tt.leadingBidWins)
+			}
+			if tt.leadingBidWins {
+				if !bid.LeadingBidPrice.Equal(tt.leadingBidPrice) {
+					t.Fatalf("unexpected leading bid price: %s, expected %s", bid.LeadingBidPrice, tt.leadingBidPrice)
+				}
+				return
+			}
+			if !bid.Price.Equal(tt.price) {
+				t.Fatalf("unexpected price: %s, expected %s", bid.Price, tt.price)
+			}
+		})
+	}
+}
+
+func TestAuctionSoftCloseEndAt(t *testing.T) {
+	now := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		endAt    time.Time
+		window   time.Duration
+		extend   time.Duration
+		expected time.Time
+		extended bool
+	}{
+		{"outside the window", now.Add(10 * time.Minute), 5 * time.Minute, 5 * time.Minute, now.Add(10 * time.Minute), false},
+		{"at the window", now.Add(5 * time.Minute), 5 * time.Minute, 5 * time.Minute, now.Add(5 * time.Minute), false},
+		{"inside the window", now.Add(2 * time.Minute), 5 * time.Minute, 5 * time.Minute, now.Add(5 * time.Minute), true},
+		{"last second", now.Add(time.Second), 5 * time.Minute, 5 * time.Minute, now.Add(5 * time.Minute), true},
+		{"longer extend", now.Add(2 * time.Minute), 5 * time.Minute, 10 * time.Minute, now.Add(10 * time.Minute), true},
+		{"extend would bring the end forward", now.Add(4 * time.Minute), 5 * time.Minute, 3 * time.Minute, now.Add(4 * time.Minute), false},
+		{"ended", now, 5 * time.Minute, 5 * time.Minute, now, false},
+		{"ended in the past", now.Add(-time.Minute), 5 * time.Minute, 5 * time.Minute, now.Add(-time.Minute), false},
+		{"soft close disabled", now.Add(2 * time.Minute), 0, 5 * time.Minute, now.Add(2 * time.Minute), false},
+		{"extend disabled", now.Add(2 * time.Minute), 5 * time.Minute, 0, now.Add(2 * time.Minute), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endAt, extended := AuctionSoftCloseEndAt(tt.endAt, now, tt.window, tt.extend)
+			if extended != tt.extended {
+				t.Fatalf("unexpected extended: %t, expected %t", extended, tt.extended)
+			}
+			if !endAt.Equal(tt.expected) {
+				t.Fatalf("unexpected end: %s, expected %s", endAt, tt.expected)
+			}
+		})
+	}
+}
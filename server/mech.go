@@ -30,6 +30,13 @@ type Stats struct {
 	TotalLosses     int `json:"total_losses"`
 }
 
+// Rating is the skill rating of a mech or a pilot, which is provisional until enough battles have been rated
+type Rating struct {
+	Rating       decimal.Decimal `json:"rating"`
+	BattlesRated int             `json:"battles_rated"`
+	Provisional  bool            `json:"provisional"`
+}
+
 // Mech is the struct that rpc expects for mechs
 type Mech struct {
 	*CollectionItem
@@ -76,6 +83,7 @@ type Mech struct {
 	IdleDrain                   decimal.Decimal `json:"idle_drain"`
 	WalkDrain                   decimal.Decimal `json:"walk_drain"`
 	RunDrain                    decimal.Decimal `json:"run_drain"`
+	Rating                      *Rating         `json:"rating,omitempty"`
 
 	// state
 	QueuePosition null.Int    `json:"queue_position"`
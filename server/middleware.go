@@ -28,24 +28,24 @@ func MustSecure(fn SecureCommandFunc) ws.CommandFunc {
 	}
 }
 
-func MustSecureAdmin(fn SecureCommandFunc) ws.CommandFunc {
+// MustHavePermission only runs the command if the user's role has been granted the given permission
+func MustHavePermission(perm Perm, fn SecureCommandFunc) ws.CommandFunc {
 	return func(ctx context.Context, key string, payload []byte, reply ws.ReplyFunc) error {
 		user, err := RetrieveUser(ctx)
 		if err != nil {
 			return err
 		}
 
-		err = user.L.LoadRole(gamedb.StdConn, true, user, nil)
+		granted, err := boiler.RolePermissions(
+			boiler.RolePermissionWhere.RoleID.EQ(user.RoleID),
+			boiler.RolePermissionWhere.Permission.EQ(perm.String()),
+		).Exists(gamedb.StdConn)
 		if err != nil {
-			return terror.Error(err, "Failed to update player's marketing preferences.")
+			return terror.Error(err, "Failed to check user's permission.")
 		}
 
-		if user.R != nil && user.R.Role != nil {
-			if user.R.Role.RoleType == boiler.RoleNamePLAYER {
-				return fmt.Errorf("user has no admin privillege")
-			}
-		} else {
-			return fmt.Errorf("failed to get users role")
+		if !granted {
+			return terror.Error(fmt.Errorf("user %s does not have permission %s", user.ID, perm), "You do not have permission to perform this action.")
 		}
 
 		return fn(ctx, user, key, payload, reply)
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"server/db/boiler"
+	"sort"
+)
+
+// Mystery crate contents are rolled with a commit-reveal scheme:
+// the hash of the server seed and crate id is published when the crate is purchased,
+// and the seed is revealed when the crate is opened. The player can pick a client seed when opening the crate,
+// so neither side alone decides the rolls. Anyone can then re-derive the rolls from the seeds and the drop table
+// which was published at purchase time.
+
+// NewMysteryCrateServerSeed returns a random hex encoded server seed
+func NewMysteryCrateServerSeed() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// MysteryCrateSeedHash returns the commitment published at purchase, sha256(server seed + ":" + crate id)
+func MysteryCrateSeedHash(serverSeed string, crateID string) string {
+	h := sha256.Sum256([]byte(fmt.Sprintf("%s:%s", serverSeed, crateID)))
+	return hex.EncodeToString(h[:])
+}
+
+// MysteryCrateRoll returns the roll of the slot, the first 8 bytes of HMAC-SHA256(server seed, crate id + ":" + client seed + ":" + slot).
+// The client seed is left out of the message when the player did not pick one.
+func MysteryCrateRoll(serverSeed string, clientSeed string, crateID string, slot string) uint64 {
+	msg := fmt.Sprintf("%s:%s", crateID, slot)
+	if clientSeed != "" {
+		msg = fmt.Sprintf("%s:%s:%s", crateID, clientSeed, slot)
+	}
+
+	mac := hmac.New(sha256.New, []byte(serverSeed))
+	mac.Write([]byte(msg))
+	return binary.BigEndian.Uint64(mac.Sum(nil)[:8])
+}
+
+type MysteryCrateSlotRoll struct {
+	Slot        string                       `json:"slot"`
+	Roll        uint64                       `json:"roll,string"`
+	TotalWeight int                          `json:"total_weight"`
+	Target      int                          `json:"target"` // roll modulo the total weight
+	DropRate    *boiler.MysteryCrateDropRate `json:"drop_rate"`
+}
+
+// MysteryCrateRollDropTable rolls every slot of the drop table.
+// Slots and their entries are sorted, so the result only depends on the seeds, the crate id and the drop table.
+func MysteryCrateRollDropTable(serverSeed string, clientSeed string, crateID string, dropRates boiler.MysteryCrateDropRateSlice) []*MysteryCrateSlotRoll {
+	slotDropRates := make(map[string]boiler.MysteryCrateDropRateSlice)
+	slots := []string{}
+	for _, dr := range dropRates {
+		if _, ok := slotDropRates[dr.Slot]; !ok {
+			slots = append(slots, dr.Slot)
+		}
+		slotDropRates[dr.Slot] = append(slotDropRates[dr.Slot], dr)
+	}
+	sort.Strings(slots)
+
+	result := []*MysteryCrateSlotRoll{}
+	for _, slot := range slots {
+		drs := slotDropRates[slot]
+		sort.Slice(drs, func(i, j int) bool {
+			if drs[i].BlueprintType != drs[j].BlueprintType {
+				return drs[i].BlueprintType < drs[j].BlueprintType
+			}
+			if drs[i].BlueprintID != drs[j].BlueprintID {
+				return drs[i].BlueprintID < drs[j].BlueprintID
+			}
+			return drs[i].ID < drs[j].ID
+		})
+
+		totalWeight := 0
+		for _, dr := range drs {
+			totalWeight += dr.Weight
+		}
+		if totalWeight <= 0 {
+			continue
+		}
+
+		roll := MysteryCrateRoll(serverSeed, clientSeed, crateID, slot)
+		target := int(roll % uint64(totalWeight))
+
+		sr := &MysteryCrateSlotRoll{
+			Slot:        slot,
+			Roll:        roll,
+			TotalWeight: totalWeight,
+			Target:      target,
+		}
+		for _, dr := range drs {
+			if target < dr.Weight {
+				sr.DropRate = dr
+				break
+			}
+			target -= dr.Weight
+		}
+
+		result = append(result, sr)
+	}
+
+	return result
+}
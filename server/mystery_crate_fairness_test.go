@@ -0,0 +1,99 @@
+package server
+
+import (
+	"server/db/boiler"
+	"testing"
+)
+
+const (
+	testCrateServerSeed = "5e1f0c6a1e2b4b0f9a7d3c2e8f6a4b1d0c9e8f7a6b5c4d3e2f1a0b9c8d7e6f5a"
+	testCrateID         = "0b6a0f7e-3c1d-4b5e-9f2a-7d8c6e5b4a31"
+)
+
+// testCrateDropTable is listed out of order, the rolls must not depend on the order of the drop table
+func testCrateDropTable() boiler.MysteryCrateDropRateSlice {
+	return boiler.MysteryCrateDropRateSlice{
+		{ID: "dr-5", Slot: "WEAPON", BlueprintType: boiler.TemplateItemTypeWEAPON, BlueprintID: "w-2", Weight: 6},
+		{ID: "dr-3", Slot: "MECH", BlueprintType: boiler.TemplateItemTypeMECH, BlueprintID: "c-mech", Weight: 60},
+		{ID: "dr-1", Slot: "MECH", BlueprintType: boiler.TemplateItemTypeMECH, BlueprintID: "a-mech", Weight: 30},
+		{ID: "dr-4", Slot: "WEAPON", BlueprintType: boiler.TemplateItemTypeWEAPON, BlueprintID: "w-1", Weight: 4},
+		{ID: "dr-2", Slot: "MECH", BlueprintType: boiler.TemplateItemTypeMECH, BlueprintID: "b-mech", Weight: 10},
+	}
+}
+
+func TestMysteryCrateSeedHash(t *testing.T) {
+	// expected value is sha256(server seed + ":" + crate id), generated outside of go
+	hash := MysteryCrateSeedHash(testCrateServerSeed, testCrateID)
+	if hash != "f43997e191d580d491e584f1b59a1e95170760d462d074e1c9474d65eb20918b" {
+		t.Fatalf("unexpected seed hash: %s", hash)
+	}
+}
+
+func TestMysteryCrateRollDropTable(t *testing.T) {
+	type slotRoll struct {
+		slot        string
+		roll        uint64
+		totalWeight int
+		target      int
+		dropRateID  string
+	}
+
+	// expected rolls are HMAC-SHA256 of the seeds, generated outside of go
+	tests := []struct {
+		name       string
+		clientSeed string
+		expected   []slotRoll
+	}{
+		{
+			name:       "without client seed",
+			clientSeed: "",
+			expected: []slotRoll{
+				{"MECH", 14803723208547151628, 100, 28, "dr-1"},
+				{"WEAPON", 10871819450032189833, 10, 3, "dr-4"},
+			},
+		},
+		{
+			name:       "with client seed",
+			clientSeed: "lucky",
+			expected: []slotRoll{
+				{"MECH", 11617782903230248343, 100, 43, "dr-3"},
+				{"WEAPON", 14153116507478343955, 10, 5, "dr-5"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rolls := MysteryCrateRollDropTable(testCrateServerSeed, tt.clientSeed, testCrateID, testCrateDropTable())
+			if len(rolls) != len(tt.expected) {
+				t.Fatalf("unexpected roll count: %d, expected %d", len(rolls), len(tt.expected))
+			}
+
+			for i, expected := range tt.expected {
+				sr := rolls[i]
+				if sr.Slot != expected.slot {
+					t.Fatalf("unexpected slot: %s, expected %s", sr.Slot, expected.slot)
+				}
+				if sr.Roll != expected.roll {
+					t.Fatalf("%s: unexpected roll: %d, expected %d", sr.Slot, sr.Roll, expected.roll)
+				}
+				if sr.TotalWeight != expected.totalWeight {
+					t.Fatalf("%s: unexpected total weight: %d, expected %d", sr.Slot, sr.TotalWeight, expected.totalWeight)
+				}
+				if sr.Target != expected.target {
+					t.Fatalf("%s: unexpected target: %d, expected %d", sr.Slot, sr.Target, expected.target)
+				}
+				if sr.DropRate == nil || sr.DropRate.ID != expected.dropRateID {
+					t.Fatalf("%s: unexpected drop rate: %v, expected %s", sr.Slot, sr.DropRate, expected.dropRateID)
+				}
+			}
+		})
+	}
+}
+
+func TestMysteryCrateRollDropTable_EmptyDropTable(t *testing.T) {
+	rolls := MysteryCrateRollDropTable(testCrateServerSeed, "", testCrateID, boiler.MysteryCrateDropRateSlice{})
+	if len(rolls) != 0 {
+		t.Fatalf("unexpected roll count: %d", len(rolls))
+	}
+}
@@ -23,6 +23,9 @@ type MysteryCrate struct {
 	Description string      `json:"description"`
 	ItemSaleID  null.String `json:"item_sale_id"`
 
+	// hash of the server seed which the contents are rolled from, only set on purchase
+	ServerSeedHash null.String `json:"server_seed_hash,omitempty"`
+
 	DeletedAt null.Time `json:"deleted_at,omitempty"`
 	UpdatedAt time.Time `json:"updated_at"`
 	CreatedAt time.Time `json:"created_at"`
@@ -140,6 +140,9 @@ type QuestStat struct {
 	Description   string    `db:"description" json:"description"`
 	RequestAmount int       `db:"request_amount" json:"request_amount"`
 	Obtained      bool      `db:"obtained" json:"obtained"`
+	// PrerequisiteQuestID is the quest which needs to be completed first, Locked is set until it is
+	PrerequisiteQuestID null.String `db:"prerequisite_quest_id" json:"prerequisite_quest_id,omitempty"`
+	Locked              bool        `db:"locked" json:"locked"`
 }
 
 type PlayerQueueStatus struct {
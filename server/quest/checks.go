@@ -0,0 +1,142 @@
+package quest
+
+import (
+	"server/db"
+	"server/db/boiler"
+	"server/gamedb"
+
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/null/v8"
+)
+
+// questChecks are the checks of the legacy quest keys, which count the progress of a player from the db.
+var questChecks = map[string]questCheckFunc{
+	boiler.QuestKeyAbilityKill:                  abilityKillQuestCheck,
+	boiler.QuestKeyMechKill:                     mechKillQuestCheck,
+	boiler.QuestKeyTotalBattleUsedMechCommander: mechCommanderQuestCheck,
+	boiler.QuestKeyRepairForOther:               repairQuestCheck,
+	boiler.QuestKeyChatSent:                     chatMessageQuestCheck,
+	boiler.QuestKeyMechJoinBattle:               mechJoinBattleQuestCheck,
+}
+
+// enqueueCheck queues a check of the quests of a legacy quest key for the player.
+func (q *System) enqueueCheck(questKey string, playerID string) {
+	q.enqueue(&boiler.QuestJob{
+		JobType:  db.QuestJobTypeCheck,
+		PlayerID: playerID,
+		QuestKey: null.StringFrom(questKey),
+	})
+}
+
+// AbilityKillQuestCheck gain players ability kill quest if they are eligible.
+func (q *System) AbilityKillQuestCheck(playerID string) {
+	q.enqueueCheck(boiler.QuestKeyAbilityKill, playerID)
+}
+
+func (q *System) MechKillQuestCheck(playerID string) {
+	q.enqueueCheck(boiler.QuestKeyMechKill, playerID)
+}
+
+func (q *System) MechCommanderQuestCheck(playerID string) {
+	q.enqueueCheck(boiler.QuestKeyTotalBattleUsedMechCommander, playerID)
+}
+
+func (q *System) RepairQuestCheck(playerID string) {
+	q.enqueueCheck(boiler.QuestKeyRepairForOther, playerID)
+}
+
+func (q *System) ChatMessageQuestCheck(playerID string) {
+	q.enqueueCheck(boiler.QuestKeyChatSent, playerID)
+}
+
+func (q *System) MechJoinBattleQuestCheck(playerID string) {
+	q.enqueueCheck(boiler.QuestKeyMechJoinBattle, playerID)
+}
+
+func abilityKillQuestCheck(playerID string, pq *boiler.Quest, bq *boiler.BlueprintQuest) (bool, error) {
+	// check player ability kill match the amount
+	playerKillLogs, err := boiler.PlayerKillLogs(
+		boiler.PlayerKillLogWhere.PlayerID.EQ(playerID),
+		boiler.PlayerKillLogWhere.CreatedAt.GT(pq.CreatedAt), // involve the logs after the quest issue time
+	).All(gamedb.StdConn)
+	if err != nil {
+		return false, terror.Error(err, "Failed to get player kill logs")
+	}
+
+	totalKill := 0
+	for _, pkl := range playerKillLogs {
+		if pkl.IsTeamKill {
+			totalKill -= 1
+			continue
+		}
+		totalKill += 1
+	}
+
+	if totalKill < 0 {
+		totalKill = 0
+	}
+
+	broadcastProgression(playerID, pq.ID, bq.ID, totalKill, bq.RequestAmount)
+
+	return totalKill >= bq.RequestAmount, nil
+}
+
+func mechKillQuestCheck(playerID string, pq *boiler.Quest, bq *boiler.BlueprintQuest) (bool, error) {
+	// check player eligible to claim
+	mechKillCount, err := db.PlayerMechKillCount(playerID, pq.CreatedAt)
+	if err != nil {
+		return false, terror.Error(err, "Failed to get player mech kill count")
+	}
+
+	broadcastProgression(playerID, pq.ID, bq.ID, mechKillCount, bq.RequestAmount)
+
+	return mechKillCount >= bq.RequestAmount, nil
+}
+
+func mechCommanderQuestCheck(playerID string, pq *boiler.Quest, bq *boiler.BlueprintQuest) (bool, error) {
+	// check player eligible to claim
+	battleCount, err := db.PlayerTotalBattleMechCommanderUsed(playerID, pq.CreatedAt)
+	if err != nil {
+		return false, terror.Error(err, "Failed to count total battles.")
+	}
+
+	broadcastProgression(playerID, pq.ID, bq.ID, battleCount, bq.RequestAmount)
+
+	return battleCount >= bq.RequestAmount, nil
+}
+
+func repairQuestCheck(playerID string, pq *boiler.Quest, bq *boiler.BlueprintQuest) (bool, error) {
+	// check player eligible to claim
+	blockCount, err := db.PlayerRepairForOthersCount(playerID, pq.CreatedAt)
+	if err != nil {
+		return false, terror.Error(err, "Failed to get total repair block")
+	}
+
+	broadcastProgression(playerID, pq.ID, bq.ID, blockCount, bq.RequestAmount)
+
+	return blockCount >= bq.RequestAmount, nil
+}
+
+func chatMessageQuestCheck(playerID string, pq *boiler.Quest, bq *boiler.BlueprintQuest) (bool, error) {
+	// check player eligible to claim
+	chatCount, err := db.PlayerChatSendCount(playerID, pq.CreatedAt)
+	if err != nil {
+		return false, terror.Error(err, "Failed to get total chat messages")
+	}
+
+	broadcastProgression(playerID, pq.ID, bq.ID, chatCount, bq.RequestAmount)
+
+	return chatCount >= bq.RequestAmount, nil
+}
+
+func mechJoinBattleQuestCheck(playerID string, pq *boiler.Quest, bq *boiler.BlueprintQuest) (bool, error) {
+	// check player eligible to claim
+	mechCount, err := db.PlayerMechJoinBattleCount(playerID, pq.CreatedAt)
+	if err != nil {
+		return false, terror.Error(err, "Failed to get total mechs joined battle")
+	}
+
+	broadcastProgression(playerID, pq.ID, bq.ID, mechCount, bq.RequestAmount)
+
+	return mechCount >= bq.RequestAmount, nil
+}
@@ -0,0 +1,195 @@
+package quest
+
+import (
+	"encoding/json"
+	"fmt"
+	"server/db"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+	"strings"
+
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Event is something a player did, which progresses their objective quests by the amount. The type is one of the
+// db.QuestObjectiveEvent values, and the fields are the ones documented on it.
+type Event struct {
+	Type     string
+	PlayerID string
+	Amount   int
+	Fields   map[string]string
+}
+
+// Publish queues an event for the objective quests of the player. The event is stored in the quest job queue, so it is
+// still counted when the server restarts before a worker gets to it.
+func (q *System) Publish(e *Event) {
+	if e.PlayerID == "" || e.Amount <= 0 {
+		return
+	}
+
+	fields, err := json.Marshal(e.Fields)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("event", e.Type).Str("player id", e.PlayerID).Msg("Failed to marshal quest event fields.")
+		return
+	}
+
+	q.enqueue(&boiler.QuestJob{
+		JobType:   db.QuestJobTypeEvent,
+		PlayerID:  e.PlayerID,
+		EventType: null.StringFrom(e.Type),
+		Amount:    e.Amount,
+		Fields:    fields,
+	})
+}
+
+// processEventJob progresses the unlocked objective quests of the player which target the event of the job. The progress
+// is added in the same transaction which completes the job, and the quests which reach their goal are queued to be granted.
+func (q *System) processEventJob(job *boiler.QuestJob) error {
+	e := &Event{
+		Type:     job.EventType.String,
+		PlayerID: job.PlayerID,
+		Amount:   job.Amount,
+		Fields:   map[string]string{},
+	}
+	err := json.Unmarshal(job.Fields, &e.Fields)
+	if err != nil {
+		return terror.Error(err, "Invalid quest event fields.")
+	}
+
+	// AI players do not do quests
+	player, err := boiler.FindPlayer(gamedb.StdConn, e.PlayerID, boiler.PlayerColumns.ID, boiler.PlayerColumns.IsAi)
+	if err != nil {
+		return terror.Error(err, "Failed to find player")
+	}
+
+	pqs := boiler.QuestSlice{}
+	if !player.IsAi {
+		pqs, err = boiler.Quests(
+			boiler.QuestWhere.ExpiredAt.IsNull(),
+			boiler.QuestWhere.DeletedAt.IsNull(),
+			qm.InnerJoin(
+				fmt.Sprintf(
+					"%s ON %s = %s AND %s = ? AND %s = ?",
+					boiler.TableNames.BlueprintQuests,
+					qm.Rels(boiler.TableNames.BlueprintQuests, boiler.BlueprintQuestColumns.ID),
+					qm.Rels(boiler.TableNames.Quests, boiler.QuestColumns.BlueprintID),
+					qm.Rels(boiler.TableNames.BlueprintQuests, boiler.BlueprintQuestColumns.Key),
+					qm.Rels(boiler.TableNames.BlueprintQuests, boiler.BlueprintQuestColumns.ObjectiveEvent),
+				),
+				boiler.QuestKeyObjective,
+				e.Type,
+			),
+			qm.Load(
+				boiler.QuestRels.ObtainedQuestPlayersObtainedQuests,
+				boiler.PlayersObtainedQuestWhere.PlayerID.EQ(e.PlayerID),
+			),
+			qm.Load(
+				boiler.QuestRels.Blueprint,
+			),
+		).All(gamedb.StdConn)
+		if err != nil {
+			return terror.Error(err, "Failed to get objective quests")
+		}
+	}
+
+	tx, err := gamedb.StdConn.Begin()
+	if err != nil {
+		return terror.Error(err, "Failed to start db transaction")
+	}
+	defer tx.Rollback()
+
+	type progression struct {
+		quest    *boiler.Quest
+		progress int
+	}
+	progressions := []*progression{}
+	for _, pq := range pqs {
+		// skip, if player has already done the quest
+		if pq.R != nil && pq.R.ObtainedQuestPlayersObtainedQuests != nil && len(pq.R.ObtainedQuestPlayersObtainedQuests) > 0 {
+			continue
+		}
+
+		bq := pq.R.Blueprint
+		if !objectiveFilterMatch(bq, e) {
+			continue
+		}
+
+		// skip, if the quest is still locked behind its prerequisite
+		met, err := db.QuestPrerequisiteMet(tx, e.PlayerID, pq, bq)
+		if err != nil {
+			return err
+		}
+		if !met {
+			continue
+		}
+
+		progress, err := db.PlayerQuestProgressAdd(tx, e.PlayerID, pq.ID, e.Amount)
+		if err != nil {
+			return err
+		}
+		progressions = append(progressions, &progression{pq, progress})
+
+		if progress < bq.RequestAmount {
+			continue
+		}
+
+		grant := &boiler.QuestJob{
+			JobType:  db.QuestJobTypeGrant,
+			PlayerID: e.PlayerID,
+			QuestID:  null.StringFrom(pq.ID),
+		}
+		err = grant.Insert(tx, boil.Infer())
+		if err != nil {
+			return terror.Error(err, "Failed to queue quest grant.")
+		}
+	}
+
+	completed, err := db.QuestJobComplete(tx, job)
+	if err != nil {
+		return err
+	}
+	if !completed {
+		// another worker has taken the job since its lease ran out, so leave the progress to it
+		return nil
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return terror.Error(err, "Failed to commit db transaction")
+	}
+
+	for _, p := range progressions {
+		broadcastProgression(e.PlayerID, p.quest.ID, p.quest.BlueprintID, p.progress, p.quest.R.Blueprint.RequestAmount)
+	}
+
+	if len(progressions) > 0 {
+		select {
+		case q.wake <- struct{}{}:
+		default:
+		}
+	}
+
+	return nil
+}
+
+// objectiveFilterMatch returns whether every field of the objective filter matches the field of the event.
+func objectiveFilterMatch(bq *boiler.BlueprintQuest, e *Event) bool {
+	filter := map[string]string{}
+	err := json.Unmarshal(bq.ObjectiveFilter, &filter)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("blueprint quest id", bq.ID).Msg("Invalid quest objective filter.")
+		return false
+	}
+
+	for key, value := range filter {
+		if !strings.EqualFold(e.Fields[key], value) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -4,37 +4,48 @@ import (
 	"database/sql"
 	"fmt"
 	"github.com/friendsofgo/errors"
+	"github.com/gofrs/uuid"
 	"github.com/ninja-software/terror/v2"
 	"github.com/ninja-syndicate/ws"
+	"github.com/shopspring/decimal"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"golang.org/x/exp/slices"
+	"math/rand"
 	"server"
+	"server/asset"
 	"server/db"
 	"server/db/boiler"
 	"server/gamedb"
 	"server/gamelog"
+	"server/rpctypes"
+	"server/xsyn_rpcclient"
 	"sync"
 	"time"
 )
 
 type System struct {
-	playerQuestChan chan *playerQuestCheck
+	// wake tells an idle worker a job has been queued, so it does not wait for the next poll
+	wake     chan struct{}
+	passport *xsyn_rpcclient.XsynXrpcClient
 }
 
-type playerQuestCheck struct {
-	questKey  string
-	playerID  string
-	checkFunc func(playerID string, quest *boiler.Quest, blueprintQuest *boiler.BlueprintQuest) bool
-}
+// questCheckFunc returns whether the player has reached the goal of a quest of a legacy quest key, counted from the db
+type questCheckFunc func(playerID string, quest *boiler.Quest, blueprintQuest *boiler.BlueprintQuest) (bool, error)
 
 const QuestEventNameProvingGround = "Proving Grounds"
 const QuestEventNameDaily = "Daily Challenge"
+const QuestEventNameWeekly = "Weekly Challenge"
+const QuestEventNameSeasonal = "Seasonal Challenge"
+
+// seasonDays is the length of a seasonal quest event
+const seasonDays = 90
 
-func New() (*System, error) {
+func New(passport *xsyn_rpcclient.XsynXrpcClient) (*System, error) {
 	q := &System{
-		playerQuestChan: make(chan *playerQuestCheck, 50),
+		wake:     make(chan struct{}, 1),
+		passport: passport,
 	}
 
 	if !server.IsProductionEnv() {
@@ -90,6 +101,42 @@ func New() (*System, error) {
 		}
 	}
 
+	// check weekly and seasonal quests exist
+	for _, qe := range []*boiler.QuestEvent{
+		{
+			Type:         boiler.QuestEventTypeWeeklyQuest,
+			Name:         QuestEventNameWeekly,
+			EndAt:        time.Now().AddDate(0, 0, 7),
+			DurationType: boiler.QuestEventDurationTypeWeekly,
+		},
+		{
+			Type:               boiler.QuestEventTypeSeasonalQuest,
+			Name:               QuestEventNameSeasonal,
+			EndAt:              time.Now().AddDate(0, 0, seasonDays),
+			DurationType:       boiler.QuestEventDurationTypeCustom,
+			CustomDurationDays: null.IntFrom(seasonDays),
+		},
+	} {
+		exists, err := boiler.QuestEvents(
+			boiler.QuestEventWhere.Type.EQ(qe.Type),
+		).Exists(gamedb.StdConn)
+		if err != nil {
+			return nil, terror.Error(err, "Failed to load quest event")
+		}
+
+		if exists {
+			continue
+		}
+
+		qe.StartedAt = time.Now()
+		qe.Repeatable = true
+		qe.QuestEventNumber = 1
+		err = qe.Insert(gamedb.StdConn, boil.Infer())
+		if err != nil {
+			return nil, terror.Error(err, "Failed to insert quest event.")
+		}
+	}
+
 	err = syncQuests()
 	if err != nil {
 		return nil, err
@@ -97,61 +144,20 @@ func New() (*System, error) {
 
 	go q.Run()
 
+	for i := 0; i < db.GetIntWithDefault(db.KeyQuestWorkerCount, 4); i++ {
+		go q.worker()
+	}
+
 	return q, nil
 }
 
 func (q *System) Run() {
 	regenerateTicker := time.NewTicker(5 * time.Second)
 
-	for {
-		select {
-		case <-regenerateTicker.C:
-			err := syncQuests()
-			if err != nil {
-				gamelog.L.Error().Err(err).Msg("Failed to regen new quest")
-			}
-		case pqc := <-q.playerQuestChan:
-			l := gamelog.L.With().Str("quest key", pqc.questKey).Str("player id", pqc.playerID).Logger()
-			// get all the ability related quests
-			pqs, err := boiler.Quests(
-				boiler.QuestWhere.ExpiredAt.IsNull(),
-				qm.InnerJoin(
-					fmt.Sprintf(
-						"%s ON %s = %s AND %s = ?",
-						boiler.TableNames.BlueprintQuests,
-						qm.Rels(boiler.TableNames.BlueprintQuests, boiler.BlueprintQuestColumns.ID),
-						qm.Rels(boiler.TableNames.Quests, boiler.QuestColumns.BlueprintID),
-						qm.Rels(boiler.TableNames.BlueprintQuests, boiler.BlueprintQuestColumns.Key),
-					),
-					pqc.questKey,
-				),
-				qm.Load(
-					boiler.QuestRels.ObtainedQuestPlayersObtainedQuests,
-					boiler.PlayersObtainedQuestWhere.PlayerID.EQ(pqc.playerID),
-				),
-				qm.Load(
-					boiler.QuestRels.Blueprint,
-				),
-			).All(gamedb.StdConn)
-			if err != nil {
-				l.Error().Err(err).Msg("Failed to get quest")
-				return
-			}
-
-			for _, pq := range pqs {
-
-				// skip, if player has already done the quest
-				if pq.R != nil && pq.R.ObtainedQuestPlayersObtainedQuests != nil && len(pq.R.ObtainedQuestPlayersObtainedQuests) > 0 {
-					continue
-				}
-
-				if pqc.checkFunc(pqc.playerID, pq, pq.R.Blueprint) {
-					err = playerQuestGrant(pqc.playerID, pq.ID)
-					if err != nil {
-						l.Error().Err(err).Str("quest id", pq.ID).Msg("Failed to grant player quest.")
-					}
-				}
-			}
+	for range regenerateTicker.C {
+		err := syncQuests()
+		if err != nil {
+			gamelog.L.Error().Err(err).Msg("Failed to regen new quest")
 		}
 	}
 }
@@ -412,255 +418,347 @@ func syncQuests() error {
 	return nil
 }
 
-func playerQuestGrant(playerID string, questID string) error {
-	poq := boiler.PlayersObtainedQuest{
-		PlayerID:        playerID,
-		ObtainedQuestID: questID,
-	}
+// questGrantAssets are the assets given by a quest grant, which are stored on the grant job in the transaction of the
+// grant and registered on xsyn after it commits. A retried grant job registers them again until it succeeds.
+type questGrantAssets struct {
+	XsynAssets []*rpctypes.XsynAsset `json:"xsyn_assets,omitempty"`
+	// Crates are registered on their own, the same as crates bought from the storefront
+	Crates []*rpctypes.XsynAsset `json:"crates,omitempty"`
+	// SupsTXIDs are the sups paid by an attempt of the grant which has not committed, they are stored outside the
+	// transaction of the grant so they can still be refunded when the attempt stops before refunding them itself
+	SupsTXIDs []string `json:"sups_tx_ids,omitempty"`
+}
 
-	tx, err := gamedb.StdConn.Begin()
+// playerQuestGrant gives the player of a grant job the quest and its rewards, then registers the assets of the rewards on xsyn.
+func (q *System) playerQuestGrant(job *boiler.QuestJob, quest *boiler.Quest, bq *boiler.BlueprintQuest) error {
+	playerID := job.PlayerID
+	l := gamelog.L.With().Str("func name", "playerQuestGrant").Str("player id", playerID).Str("quest id", quest.ID).Logger()
+
+	player, err := boiler.FindPlayer(gamedb.StdConn, playerID)
 	if err != nil {
-		return terror.Error(err, "Failed complete quest")
+		return terror.Error(err, "Failed to find player")
 	}
-	defer tx.Rollback()
 
-	err = poq.Upsert(tx, false, nil, boil.Columns{}, boil.Infer())
+	bqrs, err := db.BlueprintQuestRewards(gamedb.StdConn, bq.ID)
 	if err != nil {
-		return terror.Error(err, "Failed to upsert player")
+		return err
 	}
 
-	// reward mini mech for quest completion
-	miniMechBlueprint, err := boiler.BlueprintPlayerAbilities(
-		boiler.BlueprintPlayerAbilityWhere.GameClientAbilityID.EQ(18), // TODO: when player abilities are in static data, use ID instead
-	).One(tx)
+	// sups paid by an earlier attempt which never committed are refunded before they are paid again
+	pending := &questGrantAssets{}
+	err = job.Fields.Unmarshal(pending)
 	if err != nil {
-		return terror.Error(err, "Failed to get mini mech reward player")
-	}
-	// Update player ability count
-	pa, err := boiler.PlayerAbilities(
-		boiler.PlayerAbilityWhere.BlueprintID.EQ(miniMechBlueprint.ID),
-		boiler.PlayerAbilityWhere.OwnerID.EQ(playerID),
-	).One(tx)
-	if errors.Is(err, sql.ErrNoRows) {
-		pa = &boiler.PlayerAbility{
-			OwnerID:         playerID,
-			BlueprintID:     miniMechBlueprint.ID,
-			LastPurchasedAt: time.Now(),
-		}
-
-		err = pa.Insert(tx, boil.Infer())
-		if err != nil {
-			return terror.Error(err, "Failed to get mini mech reward player")
-		}
-	} else if err != nil {
-		return terror.Error(err, "Failed to get mini mech reward player")
+		return terror.Error(err, "Failed to read quest reward transactions")
 	}
-
-	pa.Count = pa.Count + 1
-
-	_, err = pa.Update(tx, boil.Infer())
+	err = q.questGrantSupsRefund(job, pending.SupsTXIDs)
 	if err != nil {
-		return terror.Error(err, "Failed to get mini mech reward player")
+		return err
 	}
 
-	err = tx.Commit()
+	tx, err := gamedb.StdConn.Begin()
 	if err != nil {
 		return terror.Error(err, "Failed complete quest")
 	}
+	defer tx.Rollback()
 
-	// Tell client to update their player abilities list
-	pas, err := db.PlayerAbilitiesList(playerID)
+	// the player may already have the quest when a job is retried, in which case the rewards have been given and only
+	// the assets stored on the job are left to register
+	inserted, err := db.PlayerObtainedQuestInsert(tx, playerID, quest.ID)
 	if err != nil {
-		return terror.Error(err, "Unable to retrieve abilities, try again or contact support.")
+		return err
 	}
-
-	ws.PublishMessage(fmt.Sprintf("/secure/user/%s/player_abilities", playerID), server.HubKeyPlayerAbilitiesList, pas)
-
-	playerQuestStat, err := db.PlayerQuestStatGet(playerID)
-	if err != nil {
-		return terror.Error(err, "Failed to get player quest stat")
+	if !inserted {
+		return q.questGrantAssetsRegister(job)
 	}
 
-	// broadcast player quest stat
-	ws.PublishMessage(fmt.Sprintf("/secure/user/%s/quest_stat", playerID), server.HubKeyPlayerQuestStats, playerQuestStat)
+	// sups are paid on xsyn before the transaction is committed, so they are refunded when it fails
+	committed := false
+	supsTXIDs := []string{}
+	defer func() {
+		if committed {
+			return
+		}
+		err := q.questGrantSupsRefund(job, supsTXIDs)
+		if err != nil {
+			l.Error().Err(err).Msg("Failed to refund quest reward, the next attempt will retry the refund")
+		}
+	}()
+
+	assets := &questGrantAssets{}
+	playerAbilitiesChanged := false
+	factionPassChanged := false
+	for _, bqr := range questRewardRoll(bqrs[bq.ID]) {
+		pqr := &boiler.PlayerQuestReward{
+			PlayerID:   playerID,
+			QuestID:    quest.ID,
+			RewardType: bqr.RewardType,
+			ItemID:     bqr.ItemID,
+			Amount:     bqr.Amount,
+		}
 
-	return nil
-}
+		switch bqr.RewardType {
+		case db.QuestRewardTypeSups:
+			txID, err := q.passport.SpendSupMessage(xsyn_rpcclient.SpendSupsReq{
+				FromUserID:           uuid.UUID(server.XsynTreasuryUserID),
+				ToUserID:             uuid.FromStringOrNil(playerID),
+				Amount:               bqr.Amount.Mul(decimal.New(1, 18)).StringFixed(0),
+				TransactionReference: server.TransactionReference(fmt.Sprintf("quest_reward|%s|%s|%d|%d", quest.ID, playerID, bqr.Position, job.Attempts)),
+				Group:                string(server.TransactionGroupSupremacy),
+				SubGroup:             "Quest Reward",
+				Description:          fmt.Sprintf("reward for completing quest %s.", bq.Name),
+			})
+			if err != nil {
+				return terror.Error(err, "Failed to pay quest reward")
+			}
+			supsTXIDs = append(supsTXIDs, txID)
+			err = q.questGrantSupsSet(job, supsTXIDs)
+			if err != nil {
+				l.Error().Err(err).Str("transaction id", txID).Msg("Failed to store quest reward transaction")
+				return err
+			}
+			pqr.TransactionID = null.StringFrom(txID)
 
-func broadcastProgression(playerID string, questID string, currentProgress int, goal int) {
-	if currentProgress > goal {
-		currentProgress = goal
-	}
+		case db.QuestRewardTypePlayerAbility, db.QuestRewardTypeMechSkin, db.QuestRewardTypeWeaponSkin:
+			blueprintType := asset.BlueprintTypePlayerAbility
+			switch bqr.RewardType {
+			case db.QuestRewardTypeMechSkin:
+				blueprintType = asset.BlueprintTypeMechSkin
+			case db.QuestRewardTypeWeaponSkin:
+				blueprintType = asset.BlueprintTypeWeaponSkin
+			}
 
-	// broadcast changes
-	ws.PublishMessage(
-		fmt.Sprintf("/secure/user/%s/quest_progression", playerID),
-		server.HubKeyPlayerQuestProgressions,
-		[]*db.PlayerQuestProgression{{questID, currentProgress, goal}},
-	)
-}
+			reward, err := asset.GiveBlueprint(tx, playerID, blueprintType, bqr.ItemID.String, int(bqr.Amount.IntPart()))
+			if err != nil {
+				return err
+			}
+			assets.XsynAssets = append(assets.XsynAssets, reward.XsynAssets...)
+			if blueprintType == asset.BlueprintTypePlayerAbility {
+				playerAbilitiesChanged = true
+			}
 
-// AbilityKillQuestCheck gain players ability kill quest if they are eligible.
-func (q *System) AbilityKillQuestCheck(playerID string) {
-	l := gamelog.L.With().Str("quest key", boiler.QuestKeyAbilityKill).Str("player id", playerID).Logger()
-
-	q.playerQuestChan <- &playerQuestCheck{
-		questKey: boiler.QuestKeyAbilityKill,
-		playerID: playerID,
-		checkFunc: func(playerID string, pq *boiler.Quest, bq *boiler.BlueprintQuest) bool {
-			// check player ability kill match the amount
-			playerKillLogs, err := boiler.PlayerKillLogs(
-				boiler.PlayerKillLogWhere.PlayerID.EQ(playerID),
-				boiler.PlayerKillLogWhere.CreatedAt.GT(pq.CreatedAt), // involve the logs after the quest issue time
-			).All(gamedb.StdConn)
+		case db.QuestRewardTypeMysteryCrate:
+			// crates are given from the storefront of the player's faction
+			sc, err := boiler.FindStorefrontMysteryCrate(tx, bqr.ItemID.String)
 			if err != nil {
-				l.Error().Err(err).Msg("Failed to get player kill logs")
-				return false
+				return terror.Error(err, "Failed to find mystery crate")
+			}
+			if sc.FactionID != player.FactionID.String {
+				sc, err = boiler.StorefrontMysteryCrates(
+					boiler.StorefrontMysteryCrateWhere.FactionID.EQ(player.FactionID.String),
+					boiler.StorefrontMysteryCrateWhere.MysteryCrateType.EQ(sc.MysteryCrateType),
+				).One(tx)
+				if err != nil {
+					return terror.Error(err, "Failed to find faction mystery crate")
+				}
 			}
 
-			totalKill := 0
-			for _, pkl := range playerKillLogs {
-				if pkl.IsTeamKill {
-					totalKill -= 1
-					continue
+			for i := 0; i < int(bqr.Amount.IntPart()); i++ {
+				_, xa, err := asset.GiveMysteryCrate(tx, playerID, sc)
+				if err != nil {
+					return err
 				}
-				totalKill += 1
+				assets.Crates = append(assets.Crates, xa)
 			}
+			pqr.ItemID = null.StringFrom(sc.ID)
 
-			if totalKill < 0 {
-				totalKill = 0
+		case db.QuestRewardTypeFactionPassDays:
+			startFrom := time.Now()
+			if player.FactionPassExpiresAt.Valid && player.FactionPassExpiresAt.Time.After(startFrom) {
+				startFrom = player.FactionPassExpiresAt.Time
+			}
+			player.FactionPassExpiresAt = null.TimeFrom(startFrom.Add(time.Duration(bqr.Amount.IntPart()) * 24 * time.Hour))
+			_, err = player.Update(tx, boil.Whitelist(boiler.PlayerColumns.FactionPassExpiresAt))
+			if err != nil {
+				return terror.Error(err, "Failed to extend faction pass")
 			}
+			factionPassChanged = true
 
-			broadcastProgression(playerID, pq.ID, totalKill, bq.RequestAmount)
+		default:
+			return terror.Error(fmt.Errorf("invalid quest reward type: %s", bqr.RewardType), "Invalid quest reward")
+		}
 
-			// return if not eligible
-			if totalKill < bq.RequestAmount {
-				return false
-			}
+		err = pqr.Insert(tx, boil.Infer())
+		if err != nil {
+			return terror.Error(err, "Failed to record quest reward")
+		}
+	}
 
-			return true
-		},
+	// storing the assets clears the sups paid by this attempt, which are kept once the transaction commits
+	err = job.Fields.Marshal(assets)
+	if err != nil {
+		return terror.Error(err, "Failed to store quest reward assets")
 	}
-}
 
-func (q *System) MechKillQuestCheck(playerID string) {
-	l := gamelog.L.With().Str("quest key", boiler.QuestKeyMechKill).Str("player id", playerID).Logger()
+	// the job holds the assets to register, so it must still be leased to this worker
+	leased, err := db.QuestJobFieldsSet(tx, job)
+	if err != nil {
+		return err
+	}
+	if !leased {
+		return fmt.Errorf("quest grant job lease has been taken by another worker")
+	}
 
-	q.playerQuestChan <- &playerQuestCheck{
-		questKey: boiler.QuestKeyMechKill,
-		playerID: playerID,
-		checkFunc: func(playerID string, pq *boiler.Quest, bq *boiler.BlueprintQuest) bool {
-			// check player eligible to claim
-			mechKillCount, err := db.PlayerMechKillCount(playerID, pq.CreatedAt)
-			if err != nil {
-				l.Error().Err(err).Msg("Failed to get player mech kill count")
-				return false
-			}
+	err = tx.Commit()
+	if err != nil {
+		return terror.Error(err, "Failed complete quest")
+	}
+	committed = true
 
-			broadcastProgression(playerID, pq.ID, mechKillCount, bq.RequestAmount)
+	err = q.questGrantAssetsRegister(job)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to register quest reward to XSYN")
+		return err
+	}
 
-			if mechKillCount < bq.RequestAmount {
-				return false
-			}
+	if playerAbilitiesChanged {
+		// Tell client to update their player abilities list
+		pas, err := db.PlayerAbilitiesList(playerID)
+		if err != nil {
+			return terror.Error(err, "Unable to retrieve abilities, try again or contact support.")
+		}
 
-			return true
-		},
+		ws.PublishMessage(fmt.Sprintf("/secure/user/%s/player_abilities", playerID), server.HubKeyPlayerAbilitiesList, pas)
 	}
-}
 
-func (q *System) MechCommanderQuestCheck(playerID string) {
-	l := gamelog.L.With().Str("quest key", boiler.QuestKeyTotalBattleUsedMechCommander).Str("player id", playerID).Logger()
+	if factionPassChanged {
+		ws.PublishMessage(fmt.Sprintf("/secure/user/%s/faction_pass_expiry_date", playerID), server.HubKeyPlayerFactionPassExpiryDate, player.FactionPassExpiresAt)
+	}
 
-	q.playerQuestChan <- &playerQuestCheck{
-		questKey: boiler.QuestKeyTotalBattleUsedMechCommander,
-		playerID: playerID,
-		checkFunc: func(playerID string, pq *boiler.Quest, bq *boiler.BlueprintQuest) bool {
-			// check player eligible to claim
-			battleCount, err := db.PlayerTotalBattleMechCommanderUsed(playerID, pq.CreatedAt)
-			if err != nil {
-				l.Error().Err(err).Str("quest id", pq.ID).Msg("Failed to count total battles.")
-				return false
-			}
+	playerQuestStat, err := db.PlayerQuestStatGet(playerID)
+	if err != nil {
+		return terror.Error(err, "Failed to get player quest stat")
+	}
 
-			broadcastProgression(playerID, pq.ID, battleCount, bq.RequestAmount)
+	// broadcast player quest stat
+	ws.PublishMessage(fmt.Sprintf("/secure/user/%s/quest_stat", playerID), server.HubKeyPlayerQuestStats, playerQuestStat)
 
-			if battleCount < bq.RequestAmount {
-				return false
-			}
+	return nil
+}
 
-			return true
-		},
+// questGrantSupsSet stores the sups paid by the current attempt of a grant job outside the transaction of the grant.
+func (q *System) questGrantSupsSet(job *boiler.QuestJob, txIDs []string) error {
+	err := job.Fields.Marshal(&questGrantAssets{SupsTXIDs: txIDs})
+	if err != nil {
+		return terror.Error(err, "Failed to store quest reward transaction")
 	}
-}
 
-func (q *System) RepairQuestCheck(playerID string) {
-	l := gamelog.L.With().Str("quest key", boiler.QuestKeyRepairForOther).Str("player id", playerID).Logger()
-	q.playerQuestChan <- &playerQuestCheck{
-		questKey: boiler.QuestKeyRepairForOther,
-		playerID: playerID,
-		checkFunc: func(playerID string, pq *boiler.Quest, bq *boiler.BlueprintQuest) bool {
-			// check player eligible to claim
-			blockCount, err := db.PlayerRepairForOthersCount(playerID, pq.CreatedAt)
-			if err != nil {
-				l.Error().Err(err).Msg("Failed to get total repair block")
-				return false
-			}
+	leased, err := db.QuestJobFieldsSet(gamedb.StdConn, job)
+	if err != nil {
+		return err
+	}
+	if !leased {
+		return fmt.Errorf("quest grant job lease has been taken by another worker")
+	}
 
-			broadcastProgression(playerID, pq.ID, blockCount, bq.RequestAmount)
+	return nil
+}
 
-			if blockCount < bq.RequestAmount {
-				return false
-			}
+// questGrantSupsRefund refunds the sups paid by an attempt of a grant job which did not commit. The sups which fail to
+// refund are stored on the job, so the next attempt retries them before paying the rewards again.
+func (q *System) questGrantSupsRefund(job *boiler.QuestJob, txIDs []string) error {
+	if len(txIDs) == 0 {
+		return nil
+	}
 
-			return true
-		},
+	remaining := []string{}
+	for _, txID := range txIDs {
+		_, err := q.passport.RefundSupsMessage(txID)
+		if err != nil {
+			gamelog.L.Error().Err(err).Str("transaction id", txID).Msg("Failed to refund quest reward")
+			remaining = append(remaining, txID)
+		}
 	}
-}
 
-func (q *System) ChatMessageQuestCheck(playerID string) {
-	l := gamelog.L.With().Str("quest key", boiler.QuestKeyChatSent).Str("player id", playerID).Logger()
-	q.playerQuestChan <- &playerQuestCheck{
-		questKey: boiler.QuestKeyChatSent,
-		playerID: playerID,
-		checkFunc: func(playerID string, pq *boiler.Quest, bq *boiler.BlueprintQuest) bool {
-			// check player eligible to claim
-			chatCount, err := db.PlayerChatSendCount(playerID, pq.CreatedAt)
-			if err != nil {
-				l.Error().Err(err).Msg("Failed to get total repair block")
-				return false
-			}
+	err := q.questGrantSupsSet(job, remaining)
+	if err != nil {
+		return err
+	}
+	if len(remaining) > 0 {
+		return fmt.Errorf("failed to refund %d quest reward transactions", len(remaining))
+	}
 
-			broadcastProgression(playerID, pq.ID, chatCount, bq.RequestAmount)
+	return nil
+}
 
-			if chatCount < bq.RequestAmount {
-				return false
-			}
+// questGrantAssetsRegister registers the assets stored on a grant job on xsyn.
+func (q *System) questGrantAssetsRegister(job *boiler.QuestJob) error {
+	assets := &questGrantAssets{}
+	err := job.Fields.Unmarshal(assets)
+	if err != nil {
+		return terror.Error(err, "Failed to read quest reward assets")
+	}
 
-			return true
-		},
+	if len(assets.XsynAssets) > 0 {
+		err = q.passport.AssetsRegister(assets.XsynAssets)
+		if err != nil {
+			return terror.Error(err, "Failed to register quest reward")
+		}
+	}
+	if len(assets.Crates) > 0 {
+		err = q.passport.AssetRegister(assets.Crates...)
+		if err != nil {
+			return terror.Error(err, "Failed to register quest reward")
+		}
 	}
+
+	return nil
 }
 
-func (q *System) MechJoinBattleQuestCheck(playerID string) {
-	l := gamelog.L.With().Str("quest key", boiler.QuestKeyMechJoinBattle).Str("player id", playerID).Logger()
-	q.playerQuestChan <- &playerQuestCheck{
-		questKey: boiler.QuestKeyMechJoinBattle,
-		playerID: playerID,
-		checkFunc: func(playerID string, pq *boiler.Quest, bq *boiler.BlueprintQuest) bool {
-			// check player eligible to claim
-			mechCount, err := db.PlayerMechJoinBattleCount(playerID, pq.CreatedAt)
-			if err != nil {
-				l.Error().Err(err).Msg("Failed to get total repair block")
-				return false
-			}
+// questRewardRoll returns the rewards a player is given for a quest, which are the rewards outside a pool and one reward of each pool picked by weight.
+func questRewardRoll(bqrs []*boiler.BlueprintQuestReward) []*boiler.BlueprintQuestReward {
+	result := []*boiler.BlueprintQuestReward{}
+	pools := make(map[string][]*boiler.BlueprintQuestReward)
+	poolNames := []string{}
+	for _, bqr := range bqrs {
+		if !bqr.Pool.Valid {
+			result = append(result, bqr)
+			continue
+		}
+		if _, ok := pools[bqr.Pool.String]; !ok {
+			poolNames = append(poolNames, bqr.Pool.String)
+		}
+		pools[bqr.Pool.String] = append(pools[bqr.Pool.String], bqr)
+	}
 
-			broadcastProgression(playerID, pq.ID, mechCount, bq.RequestAmount)
+	for _, poolName := range poolNames {
+		totalWeight := 0
+		for _, bqr := range pools[poolName] {
+			totalWeight += bqr.Weight
+		}
 
-			if mechCount < bq.RequestAmount {
-				return false
+		roll := rand.Intn(totalWeight)
+		for _, bqr := range pools[poolName] {
+			if roll < bqr.Weight {
+				result = append(result, bqr)
+				break
 			}
+			roll -= bqr.Weight
+		}
+	}
 
-			return true
-		},
+	return result
+}
+
+func broadcastProgression(playerID string, questID string, blueprintQuestID string, currentProgress int, goal int) {
+	if currentProgress > goal {
+		currentProgress = goal
 	}
+
+	rewards, err := db.QuestRewards(gamedb.StdConn, blueprintQuestID)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("quest id", questID).Msg("Failed to load quest rewards")
+		return
+	}
+
+	// broadcast changes
+	ws.PublishMessage(
+		fmt.Sprintf("/secure/user/%s/quest_progression", playerID),
+		server.HubKeyPlayerQuestProgressions,
+		[]*db.PlayerQuestProgression{{
+			QuestID: questID,
+			Current: currentProgress,
+			Goal:    goal,
+			Rewards: rewards[blueprintQuestID],
+		}},
+	)
 }
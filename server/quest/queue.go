@@ -0,0 +1,190 @@
+package quest
+
+import (
+	"fmt"
+	"server/db"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+	"time"
+
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// jobPollInterval is how often an idle worker checks the queue for jobs it was not woken for, such as retries and
+// jobs queued by another server
+const jobPollInterval = time.Second
+
+// enqueue stores a quest job in the durable queue and wakes a worker for it.
+func (q *System) enqueue(job *boiler.QuestJob) {
+	err := job.Insert(gamedb.StdConn, boil.Infer())
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("job type", job.JobType).Str("player id", job.PlayerID).Msg("Failed to queue quest job.")
+		return
+	}
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+// worker processes quest jobs until the queue is empty, then waits to be woken or for the next poll.
+func (q *System) worker() {
+	poll := time.NewTicker(jobPollInterval)
+	defer poll.Stop()
+
+	for {
+		for q.processNextJob() {
+		}
+
+		select {
+		case <-q.wake:
+		case <-poll.C:
+		}
+	}
+}
+
+// processNextJob claims and processes a single job, and returns whether there was one. A failed job is retried once
+// its lease runs out, so an error in one job never stops the worker.
+func (q *System) processNextJob() (claimed bool) {
+	maxAttempts := db.GetIntWithDefault(db.KeyQuestJobMaxAttempts, 8)
+
+	job, err := db.QuestJobClaim(gamedb.StdConn, maxAttempts)
+	if err != nil {
+		gamelog.L.Error().Err(err).Msg("Failed to claim quest job.")
+		return false
+	}
+	if job == nil {
+		return false
+	}
+
+	l := gamelog.L.With().Str("func name", "processNextJob").Str("job id", job.ID).Str("job type", job.JobType).Str("player id", job.PlayerID).Int("attempt", job.Attempts).Logger()
+
+	defer func() {
+		if r := recover(); r != nil {
+			gamelog.LogPanicRecovery("panic! panic! panic! Panic at the quest worker!", r)
+		}
+	}()
+
+	switch job.JobType {
+	case db.QuestJobTypeCheck:
+		err = q.processCheckJob(job)
+	case db.QuestJobTypeGrant:
+		err = q.processGrantJob(job)
+	case db.QuestJobTypeEvent:
+		// event jobs complete themselves in the transaction which adds the progress, so an event is only ever counted once
+		err = q.processEventJob(job)
+	default:
+		err = fmt.Errorf("invalid quest job type: %s", job.JobType)
+	}
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to process quest job.")
+
+		err = db.QuestJobFail(gamedb.StdConn, job, err, maxAttempts)
+		if err != nil {
+			l.Error().Err(err).Msg("Failed to record quest job failure.")
+		}
+		return true
+	}
+
+	if job.JobType == db.QuestJobTypeEvent {
+		return true
+	}
+
+	_, err = db.QuestJobComplete(gamedb.StdConn, job)
+	if err != nil {
+		l.Error().Err(err).Msg("Failed to complete quest job.")
+	}
+
+	return true
+}
+
+// processCheckJob checks every quest of the legacy quest key of the job, and queues grants of the ones the player has reached
+// the goal of. Checks count from the db and grants are only given once, so a retried check job has the same result.
+func (q *System) processCheckJob(job *boiler.QuestJob) error {
+	check, ok := questChecks[job.QuestKey.String]
+	if !ok {
+		return fmt.Errorf("invalid quest key: %s", job.QuestKey.String)
+	}
+
+	pqs, err := boiler.Quests(
+		boiler.QuestWhere.ExpiredAt.IsNull(),
+		qm.InnerJoin(
+			fmt.Sprintf(
+				"%s ON %s = %s AND %s = ?",
+				boiler.TableNames.BlueprintQuests,
+				qm.Rels(boiler.TableNames.BlueprintQuests, boiler.BlueprintQuestColumns.ID),
+				qm.Rels(boiler.TableNames.Quests, boiler.QuestColumns.BlueprintID),
+				qm.Rels(boiler.TableNames.BlueprintQuests, boiler.BlueprintQuestColumns.Key),
+			),
+			job.QuestKey.String,
+		),
+		qm.Load(
+			boiler.QuestRels.ObtainedQuestPlayersObtainedQuests,
+			boiler.PlayersObtainedQuestWhere.PlayerID.EQ(job.PlayerID),
+		),
+		qm.Load(
+			boiler.QuestRels.Blueprint,
+		),
+	).All(gamedb.StdConn)
+	if err != nil {
+		return terror.Error(err, "Failed to get quest")
+	}
+
+	var lastErr error
+	for _, pq := range pqs {
+		// skip, if player has already done the quest
+		if pq.R != nil && pq.R.ObtainedQuestPlayersObtainedQuests != nil && len(pq.R.ObtainedQuestPlayersObtainedQuests) > 0 {
+			continue
+		}
+
+		// skip, if the quest is still locked behind its prerequisite
+		met, err := db.QuestPrerequisiteMet(gamedb.StdConn, job.PlayerID, pq, pq.R.Blueprint)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if !met {
+			continue
+		}
+
+		reached, err := check(job.PlayerID, pq, pq.R.Blueprint)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if !reached {
+			continue
+		}
+
+		// grant in a job of its own, so the grant can register its rewards after it commits
+		grant := &boiler.QuestJob{
+			JobType:  db.QuestJobTypeGrant,
+			PlayerID: job.PlayerID,
+			QuestID:  null.StringFrom(pq.ID),
+		}
+		err = grant.Insert(gamedb.StdConn, boil.Infer())
+		if err != nil {
+			lastErr = terror.Error(err, "Failed to queue quest grant.")
+		}
+	}
+
+	return lastErr
+}
+
+// processGrantJob grants the quest of the job, which the player reached the goal of.
+func (q *System) processGrantJob(job *boiler.QuestJob) error {
+	pq, err := boiler.Quests(
+		boiler.QuestWhere.ID.EQ(job.QuestID.String),
+		qm.Load(boiler.QuestRels.Blueprint),
+	).One(gamedb.StdConn)
+	if err != nil {
+		return terror.Error(err, "Failed to get quest")
+	}
+
+	return q.playerQuestGrant(job, pq, pq.R.Blueprint)
+}
@@ -37,6 +37,7 @@ const (
 	PermRoleUpdate    Perm = "RoleUpdate"
 	PermRoleArchive   Perm = "RoleArchive"
 	PermRoleUnarchive Perm = "RoleUnarchive"
+	PermRoleAssign    Perm = "RoleAssign"
 
 	PermUserList            Perm = "UserList"
 	PermUserCreate          Perm = "UserCreate"
@@ -45,6 +46,9 @@ const (
 	PermUserArchive         Perm = "UserArchive"
 	PermUserUnarchive       Perm = "UserUnarchive"
 	PermUserForceDisconnect Perm = "UserForceDisconnect"
+	PermUserBan             Perm = "UserBan"
+	PermUserUnban           Perm = "UserUnban"
+	PermUserAssetRead       Perm = "UserAssetRead"
 
 	PermOrganisationList      Perm = "OrganisationList"
 	PermOrganisationCreate    Perm = "OrganisationCreate"
@@ -60,6 +64,19 @@ const (
 	PermProductArchive   Perm = "ProductArchive"
 	PermProductUnarchive Perm = "ProductUnarchive"
 
+	PermMechRename    Perm = "MechRename"
+	PermServerRestart Perm = "ServerRestart"
+
+	PermCrateDropRateRead   Perm = "CrateDropRateRead"
+	PermCrateDropRateUpdate Perm = "CrateDropRateUpdate"
+
+	PermCouponList   Perm = "CouponList"
+	PermCouponCreate Perm = "CouponCreate"
+	PermCouponUpdate Perm = "CouponUpdate"
+
+	PermTournamentCreate Perm = "TournamentCreate"
+	PermTournamentUpdate Perm = "TournamentUpdate"
+
 	PermAdminPortal      Perm = "AdminPortal"
 	PermImpersonateUser  Perm = "ImpersonateUser"
 	PermUserActivityList Perm = "UserActivityList"
@@ -73,6 +90,7 @@ var AllPerm = []Perm{
 	PermRoleUpdate,
 	PermRoleArchive,
 	PermRoleUnarchive,
+	PermRoleAssign,
 
 	PermUserList,
 	PermUserCreate,
@@ -81,6 +99,9 @@ var AllPerm = []Perm{
 	PermUserArchive,
 	PermUserUnarchive,
 	PermUserForceDisconnect,
+	PermUserBan,
+	PermUserUnban,
+	PermUserAssetRead,
 
 	PermOrganisationList,
 	PermOrganisationCreate,
@@ -96,6 +117,19 @@ var AllPerm = []Perm{
 	PermProductArchive,
 	PermProductUnarchive,
 
+	PermMechRename,
+	PermServerRestart,
+
+	PermCrateDropRateRead,
+	PermCrateDropRateUpdate,
+
+	PermCouponList,
+	PermCouponCreate,
+	PermCouponUpdate,
+
+	PermTournamentCreate,
+	PermTournamentUpdate,
+
 	PermAdminPortal,
 	PermImpersonateUser,
 	PermUserActivityList,
@@ -104,3 +138,13 @@ var AllPerm = []Perm{
 func (e Perm) String() string {
 	return string(e)
 }
+
+// IsValid returns true if the permission is one of the known permissions
+func (e Perm) IsValid() bool {
+	for _, p := range AllPerm {
+		if p == e {
+			return true
+		}
+	}
+	return false
+}
@@ -1,15 +1,16 @@
 package synctool
 
 import (
-	"bufio"
+	"bytes"
 	"context"
 	"database/sql"
 	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"os"
+	"server/db"
 	"server/db/boiler"
 	"server/synctool/types"
 	"strconv"
@@ -26,184 +27,38 @@ import (
 type StaticSyncTool struct {
 	DB       *sql.DB
 	FilePath string
+	// DryRun rolls the sync back once the report is made
+	DryRun bool
+	// ReportPath is where the json report of the sync is written, when set
+	ReportPath string
 }
 
+// SyncTool syncs the static data files in FilePath in a single transaction and prints what changed in each table.
 func SyncTool(dt *StaticSyncTool) error {
-
-	f, err := readFile(fmt.Sprintf("%sbattle_arena.csv", dt.FilePath))
-	if err != nil {
-		return err
-	}
-	err = SyncBattleArenas(f, dt.DB)
-	if err != nil {
-		return err
-	}
-	f.Close()
-
-	f, err = readFile(fmt.Sprintf("%sfactions.csv", dt.FilePath))
-	if err != nil {
-		return err
-	}
-	err = SyncFactions(f, dt.DB)
-	if err != nil {
-		return err
-	}
-	f.Close()
-
-	f, err = readFile(fmt.Sprintf("%sfaction_palettes.csv", dt.FilePath))
-	if err != nil {
-		return err
-	}
-	err = SyncFactionPalettes(f, dt.DB)
-	if err != nil {
-		return err
-	}
-	f.Close()
-
-	f, err = readFile(fmt.Sprintf("%sfaction_passes.csv", dt.FilePath))
-	if err != nil {
-		return err
-	}
-	err = SyncFactionPasses(f, dt.DB)
-	if err != nil {
-		return err
-	}
-	f.Close()
-
-	f, err = readFile(fmt.Sprintf("%sbrands.csv", dt.FilePath))
-	if err != nil {
-		return err
-	}
-	err = SyncBrands(f, dt.DB)
-	if err != nil {
-		return err
-	}
-	f.Close()
-
-	f, err = readFile(fmt.Sprintf("%sshield_types.csv", dt.FilePath))
-	if err != nil {
-		return err
-	}
-	err = SyncShieldTypes(f, dt.DB)
-	if err != nil {
-		return err
-	}
-	f.Close()
-
-	f, err = readFile(fmt.Sprintf("%sweapon_skins.csv", dt.FilePath))
-	if err != nil {
-		return err
-	}
-	err = SyncWeaponSkins(f, dt.DB)
-	if err != nil {
-		return err
-	}
-	f.Close()
-
-	f, err = readFile(fmt.Sprintf("%smech_skins.csv", dt.FilePath))
-	if err != nil {
-		return err
-	}
-	err = SyncMechSkins(f, dt.DB)
-	if err != nil {
-		return err
-	}
-	f.Close()
-
-	f, err = readFile(fmt.Sprintf("%smechs.csv", dt.FilePath))
-	if err != nil {
-		return err
-	}
-	err = SyncMechModels(f, dt.DB)
-	if err != nil {
-		return err
-	}
-	f.Close()
-
-	f, err = readFile(fmt.Sprintf("%smech_model_skin_compatibilities.csv", dt.FilePath))
+	sd, err := LoadStaticFiles(func(name string) ([]byte, error) {
+		return os.ReadFile(dt.FilePath + name)
+	})
 	if err != nil {
 		return err
 	}
-	err = SyncMechModelSkinCompatibilities(f, dt.DB)
-	if err != nil {
-		return err
-	}
-	f.Close()
-
-	//err = SyncMysteryCrates(f, dt.DB)
-	//if err != nil {
-	//	return err
-	//}
 
-	f, err = readFile(fmt.Sprintf("%sweapons.csv", dt.FilePath))
-	if err != nil {
-		return err
-	}
-	err = SyncWeaponModel(f, dt.DB)
+	report, err := SyncStaticFiles(dt.DB, sd, dt.DryRun)
 	if err != nil {
 		return err
 	}
-	f.Close()
 
-	f, err = readFile(fmt.Sprintf("%sweapon_model_skin_compatibilities.csv", dt.FilePath))
-	if err != nil {
-		return err
-	}
-	err = SyncWeaponModelSkinCompatibilities(f, dt.DB)
-	if err != nil {
-		return err
-	}
-	f.Close()
+	fmt.Println(report.String())
 
-	f, err = readFile(fmt.Sprintf("%sbattle_abilities.csv", dt.FilePath))
-	if err != nil {
-		return err
-	}
-	err = SyncBattleAbilities(f, dt.DB)
-	if err != nil {
-		return err
-	}
-	f.Close()
-
-	f, err = readFile(fmt.Sprintf("%sgame_abilities.csv", dt.FilePath))
-	if err != nil {
-		return err
-	}
-	err = SyncGameAbilities(f, dt.DB)
-	if err != nil {
-		return err
-	}
-	f.Close()
-
-	f, err = readFile(fmt.Sprintf("%splayer_abilities.csv", dt.FilePath))
-	if err != nil {
-		return err
-	}
-	err = SyncPlayerAbilities(f, dt.DB)
-	if err != nil {
-		return err
-	}
-	f.Close()
-
-	f, err = readFile(fmt.Sprintf("%spower_cores.csv", dt.FilePath))
-	if err != nil {
-		return err
-	}
-	err = SyncPowerCores(f, dt.DB)
-	if err != nil {
-		return err
-	}
-	f.Close()
-
-	f, err = readFile(fmt.Sprintf("%squests.csv", dt.FilePath))
-	if err != nil {
-		return err
-	}
-	err = SyncStaticQuest(f, dt.DB)
-	if err != nil {
-		return err
+	if dt.ReportPath != "" {
+		b, err := json.MarshalIndent(report, "", "\t")
+		if err != nil {
+			return err
+		}
+		err = os.WriteFile(dt.ReportPath, b, 0644)
+		if err != nil {
+			return err
+		}
 	}
-	f.Close()
 
 	return nil
 }
@@ -222,24 +77,30 @@ func DownloadFile(ctx context.Context, url string, timout time.Duration) (io.Rea
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
-	// Writer the body to file
-	readr := bufio.NewReader(resp.Body)
-
-	return readr, nil
-}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download %s: %s", url, resp.Status)
+	}
 
-func readFile(fileName string) (*os.File, error) {
-	f, err := os.OpenFile(fileName, os.O_RDONLY, os.ModeAppend)
+	b, err := io.ReadAll(resp.Body)
 	if err != nil {
-		log.Fatalf("CANT OPEN FILE: %s", fileName)
 		return nil, err
 	}
-	return f, nil
+
+	return bytes.NewReader(b), nil
 }
 
+// RemoveFKContraints recreates the foreign keys of the static tables with ON UPDATE CASCADE. It runs in a transaction,
+// so a failing statement leaves every constraint as it was.
 func RemoveFKContraints(dt StaticSyncTool) error {
-	_, err := dt.DB.Exec(
+	tx, err := dt.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(
 		`
 			ALTER TABLE mech_models DROP CONSTRAINT mech_model_default_chassis_skin_id_fkey;
 			ALTER TABLE mech_models ADD CONSTRAINT mech_model_default_chassis_skin_id_fkey FOREIGN KEY (default_chassis_skin_id) REFERENCES blueprint_mech_skin(id) ON UPDATE CASCADE;
@@ -287,7 +148,12 @@ func RemoveFKContraints(dt StaticSyncTool) error {
 			`,
 	)
 	if err != nil {
-		fmt.Println(err.Error())
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
 	}
 
 	fmt.Println("Finished removing constraints")
@@ -295,7 +161,7 @@ func RemoveFKContraints(dt StaticSyncTool) error {
 	return nil
 }
 
-func SyncBattleArenas(f io.Reader, db *sql.DB) error {
+func SyncBattleArenas(f io.Reader, db boil.Executor) error {
 
 	r := csv.NewReader(f)
 	if _, err := r.Read(); err != nil {
@@ -330,7 +196,7 @@ func SyncBattleArenas(f io.Reader, db *sql.DB) error {
 	return nil
 }
 
-func SyncMechModels(f io.Reader, db *sql.DB) error {
+func SyncMechModels(f io.Reader, db boil.Executor) error {
 
 	r := csv.NewReader(f)
 
@@ -472,7 +338,7 @@ func SyncMechModels(f io.Reader, db *sql.DB) error {
 	return nil
 }
 
-func SyncMechModelSkinCompatibilities(f io.Reader, db *sql.DB) error {
+func SyncMechModelSkinCompatibilities(f io.Reader, db boil.Executor) error {
 
 	r := csv.NewReader(f)
 
@@ -553,7 +419,7 @@ func SyncMechModelSkinCompatibilities(f io.Reader, db *sql.DB) error {
 	return nil
 }
 
-func SyncShieldTypes(f io.Reader, db *sql.DB) error {
+func SyncShieldTypes(f io.Reader, db boil.Executor) error {
 	r := csv.NewReader(f)
 
 	if _, err := r.Read(); err != nil {
@@ -599,7 +465,7 @@ func SyncShieldTypes(f io.Reader, db *sql.DB) error {
 
 }
 
-func SyncMechSkins(f io.Reader, db *sql.DB) error {
+func SyncMechSkins(f io.Reader, db boil.Executor) error {
 	r := csv.NewReader(f)
 
 	if _, err := r.Read(); err != nil {
@@ -696,7 +562,7 @@ func SyncMechSkins(f io.Reader, db *sql.DB) error {
 
 }
 
-func SyncFactions(f io.Reader, db *sql.DB) error {
+func SyncFactions(f io.Reader, db boil.Executor) error {
 	r := csv.NewReader(f)
 
 	if _, err := r.Read(); err != nil {
@@ -751,7 +617,7 @@ func SyncFactions(f io.Reader, db *sql.DB) error {
 	return nil
 }
 
-func SyncFactionPalettes(f io.Reader, db *sql.DB) error {
+func SyncFactionPalettes(f io.Reader, db boil.Executor) error {
 	r := csv.NewReader(f)
 
 	if _, err := r.Read(); err != nil {
@@ -836,7 +702,7 @@ func SyncFactionPalettes(f io.Reader, db *sql.DB) error {
 	return nil
 }
 
-func SyncFactionPasses(f io.Reader, db *sql.DB) error {
+func SyncFactionPasses(f io.Reader, db boil.Executor) error {
 	r := csv.NewReader(f)
 
 	if _, err := r.Read(); err != nil {
@@ -905,7 +771,7 @@ func SyncFactionPasses(f io.Reader, db *sql.DB) error {
 	return nil
 }
 
-func SyncBrands(f io.Reader, db *sql.DB) error {
+func SyncBrands(f io.Reader, db boil.Executor) error {
 	r := csv.NewReader(f)
 
 	if _, err := r.Read(); err != nil {
@@ -949,7 +815,7 @@ func SyncBrands(f io.Reader, db *sql.DB) error {
 	return nil
 }
 
-func SyncMysteryCrates(f io.Reader, db *sql.DB) error {
+func SyncMysteryCrates(f io.Reader, db boil.Executor) error {
 	r := csv.NewReader(f)
 
 	if _, err := r.Read(); err != nil {
@@ -1031,7 +897,7 @@ func SyncMysteryCrates(f io.Reader, db *sql.DB) error {
 	return nil
 }
 
-func SyncWeaponModel(f io.Reader, db *sql.DB) error {
+func SyncWeaponModel(f io.Reader, db boil.Executor) error {
 	r := csv.NewReader(f)
 
 	if _, err := r.Read(); err != nil {
@@ -1200,7 +1066,7 @@ func SyncWeaponModel(f io.Reader, db *sql.DB) error {
 	return nil
 }
 
-func SyncWeaponSkins(f io.Reader, db *sql.DB) error {
+func SyncWeaponSkins(f io.Reader, db boil.Executor) error {
 	r := csv.NewReader(f)
 
 	if _, err := r.Read(); err != nil {
@@ -1290,7 +1156,7 @@ func SyncWeaponSkins(f io.Reader, db *sql.DB) error {
 	return nil
 }
 
-func SyncWeaponModelSkinCompatibilities(f io.Reader, db *sql.DB) error {
+func SyncWeaponModelSkinCompatibilities(f io.Reader, db boil.Executor) error {
 
 	r := csv.NewReader(f)
 
@@ -1370,7 +1236,7 @@ func SyncWeaponModelSkinCompatibilities(f io.Reader, db *sql.DB) error {
 	return nil
 }
 
-func SyncBattleAbilities(f io.Reader, db *sql.DB) error {
+func SyncBattleAbilities(f io.Reader, db boil.Executor) error {
 	r := csv.NewReader(f)
 
 	if _, err := r.Read(); err != nil {
@@ -1394,12 +1260,12 @@ func SyncBattleAbilities(f io.Reader, db *sql.DB) error {
 		battleAbility.CooldownDurationSecond, err = strconv.Atoi(record[2])
 		if err != nil {
 			fmt.Println(err.Error()+battleAbility.ID, battleAbility.Label, battleAbility.Description)
-			continue
+			return err
 		}
 		battleAbility.MaximumCommanderCount, err = strconv.Atoi(record[5])
 		if err != nil {
 			fmt.Println(err.Error()+battleAbility.ID, battleAbility.Label, battleAbility.Description)
-			continue
+			return err
 		}
 
 		// upsert blueprint quest
@@ -1432,9 +1298,11 @@ func SyncBattleAbilities(f io.Reader, db *sql.DB) error {
 	// soft delete any row that is not on the list
 	_, err = boiler.BattleAbilities(
 		boiler.BattleAbilityWhere.ID.NIN(ids),
+		boiler.BattleAbilityWhere.DeletedAt.IsNull(),
 	).UpdateAll(db, boiler.M{boiler.BattleAbilityColumns.DeletedAt: null.TimeFrom(time.Now())})
 	if err != nil {
 		fmt.Println(err.Error(), "Failed to archive rows that are not in the static battle abilities data.")
+		return err
 	}
 
 	fmt.Println("Finish syncing battle abilities")
@@ -1442,7 +1310,7 @@ func SyncBattleAbilities(f io.Reader, db *sql.DB) error {
 	return nil
 }
 
-func SyncGameAbilities(f io.Reader, db *sql.DB) error {
+func SyncGameAbilities(f io.Reader, db boil.Executor) error {
 	r := csv.NewReader(f)
 
 	if _, err := r.Read(); err != nil {
@@ -1477,7 +1345,7 @@ func SyncGameAbilities(f io.Reader, db *sql.DB) error {
 		gameAbility.GameClientAbilityID, err = strconv.Atoi(record[1])
 		if err != nil {
 			fmt.Println(err.Error()+gameAbility.ID, gameAbility.Label, gameAbility.Description)
-			continue
+			return err
 		}
 
 		if record[11] != "" {
@@ -1487,25 +1355,25 @@ func SyncGameAbilities(f io.Reader, db *sql.DB) error {
 		gameAbility.LaunchingDelaySeconds, err = strconv.Atoi(record[12])
 		if err != nil {
 			fmt.Println(err.Error()+gameAbility.ID, gameAbility.Label, gameAbility.Description)
-			continue
+			return err
 		}
 
 		gameAbility.AnimationDurationSeconds, err = strconv.Atoi(record[16])
 		if err != nil {
 			fmt.Println(err.Error()+gameAbility.ID, gameAbility.Label, gameAbility.Description)
-			continue
+			return err
 		}
 
 		gameAbility.MaximumTeamKillTolerantCount, err = strconv.Atoi(record[18])
 		if err != nil {
 			fmt.Println(err.Error()+gameAbility.ID, gameAbility.Label, gameAbility.Description)
-			continue
+			return err
 		}
 
 		gameAbility.CountPerBattle, err = strconv.Atoi(record[20])
 		if err != nil {
 			fmt.Println(err.Error()+gameAbility.ID, gameAbility.Label, gameAbility.Description)
-			continue
+			return err
 		}
 
 		// upsert game ability
@@ -1553,9 +1421,11 @@ func SyncGameAbilities(f io.Reader, db *sql.DB) error {
 	// soft delete any row that is not on the list
 	_, err = boiler.GameAbilities(
 		boiler.GameAbilityWhere.ID.NIN(ids),
+		boiler.GameAbilityWhere.DeletedAt.IsNull(),
 	).UpdateAll(db, boiler.M{boiler.GameAbilityColumns.DeletedAt: null.TimeFrom(time.Now())})
 	if err != nil {
 		fmt.Println(err.Error(), "Failed to archive rows that are not in the static game abilities data.")
+		return err
 	}
 
 	fmt.Println("Finish syncing game abilities")
@@ -1563,7 +1433,7 @@ func SyncGameAbilities(f io.Reader, db *sql.DB) error {
 	return nil
 }
 
-func SyncPlayerAbilities(f io.Reader, db *sql.DB) error {
+func SyncPlayerAbilities(f io.Reader, db boil.Executor) error {
 	r := csv.NewReader(f)
 
 	if _, err := r.Read(); err != nil {
@@ -1592,37 +1462,37 @@ func SyncPlayerAbilities(f io.Reader, db *sql.DB) error {
 		playerAbility.GameClientAbilityID, err = strconv.Atoi(record[1])
 		if err != nil {
 			fmt.Println(err.Error()+playerAbility.ID, playerAbility.Label, playerAbility.Description)
-			continue
+			return err
 		}
 
 		playerAbility.RarityWeight, err = strconv.Atoi(record[9])
 		if err != nil {
 			fmt.Println(err.Error()+playerAbility.ID, playerAbility.Label, playerAbility.Description)
-			continue
+			return err
 		}
 
 		playerAbility.InventoryLimit, err = strconv.Atoi(record[10])
 		if err != nil {
 			fmt.Println(err.Error()+playerAbility.ID, playerAbility.Label, playerAbility.Description)
-			continue
+			return err
 		}
 
 		playerAbility.CooldownSeconds, err = strconv.Atoi(record[11])
 		if err != nil {
 			fmt.Println(err.Error()+playerAbility.ID, playerAbility.Label, playerAbility.Description)
-			continue
+			return err
 		}
 
 		playerAbility.LaunchingDelaySeconds, err = strconv.Atoi(record[13])
 		if err != nil {
 			fmt.Println(err.Error()+playerAbility.ID, playerAbility.Label, playerAbility.Description)
-			continue
+			return err
 		}
 
 		playerAbility.AnimationDurationSeconds, err = strconv.Atoi(record[16])
 		if err != nil {
 			fmt.Println(err.Error()+playerAbility.ID, playerAbility.Label, playerAbility.Description)
-			continue
+			return err
 		}
 
 		// upsert game ability
@@ -1701,7 +1571,7 @@ func SyncPlayerAbilities(f io.Reader, db *sql.DB) error {
 	return nil
 }
 
-func SyncPowerCores(f io.Reader, db *sql.DB) error {
+func SyncPowerCores(f io.Reader, db boil.Executor) error {
 	r := csv.NewReader(f)
 
 	if _, err := r.Read(); err != nil {
@@ -1797,7 +1667,7 @@ func SyncPowerCores(f io.Reader, db *sql.DB) error {
 	return nil
 }
 
-func SyncStaticQuest(f io.Reader, db *sql.DB) error {
+func SyncStaticQuest(f io.Reader, db boil.Executor) error {
 	r := csv.NewReader(f)
 
 	if _, err := r.Read(); err != nil {
@@ -1809,13 +1679,17 @@ func SyncStaticQuest(f io.Reader, db *sql.DB) error {
 		return err
 	}
 
+	// prerequisites are set after every quest is synced, as a quest can come before its prerequisite in the file
+	prerequisites := make(map[string]null.String)
+
 	for _, record := range records {
 		blueprintQuest := &boiler.BlueprintQuest{
-			ID:             record[0],
-			QuestEventType: record[1],
-			Key:            record[2],
-			Name:           record[3],
-			Description:    record[4],
+			ID:              record[0],
+			QuestEventType:  record[1],
+			Key:             record[2],
+			Name:            record[3],
+			Description:     record[4],
+			ObjectiveFilter: []byte("{}"),
 		}
 
 		// convert request amount
@@ -1825,6 +1699,26 @@ func SyncStaticQuest(f io.Reader, db *sql.DB) error {
 			return err
 		}
 
+		// read the prerequisite and objective, when the static data has them
+		if len(record) > 7 && record[7] != "" {
+			prerequisites[blueprintQuest.ID] = null.StringFrom(record[7])
+		} else {
+			prerequisites[blueprintQuest.ID] = null.String{}
+		}
+
+		if len(record) > 8 {
+			err = parseQuestObjective(blueprintQuest, record[8:])
+			if err != nil {
+				fmt.Println(err.Error(), blueprintQuest.ID, blueprintQuest.Name)
+				return err
+			}
+		}
+		if blueprintQuest.Key == boiler.QuestKeyObjective && !blueprintQuest.ObjectiveEvent.Valid {
+			err = fmt.Errorf("objective quest has no objective event")
+			fmt.Println(err.Error(), blueprintQuest.ID, blueprintQuest.Name)
+			return err
+		}
+
 		// upsert blueprint quest
 		err = blueprintQuest.Upsert(
 			db,
@@ -1838,6 +1732,8 @@ func SyncStaticQuest(f io.Reader, db *sql.DB) error {
 				boiler.BlueprintQuestColumns.Name,
 				boiler.BlueprintQuestColumns.Description,
 				boiler.BlueprintQuestColumns.RequestAmount,
+				boiler.BlueprintQuestColumns.ObjectiveEvent,
+				boiler.BlueprintQuestColumns.ObjectiveFilter,
 			),
 			boil.Infer(),
 		)
@@ -1846,11 +1742,152 @@ func SyncStaticQuest(f io.Reader, db *sql.DB) error {
 			return err
 		}
 
+		// sync the quest rewards, when the static data has them
+		if len(record) > 6 {
+			err = syncQuestRewards(db, blueprintQuest.ID, record[6])
+			if err != nil {
+				fmt.Println(err.Error(), blueprintQuest.ID, blueprintQuest.Name)
+				return err
+			}
+		}
+
 		fmt.Println("UPDATED: "+blueprintQuest.ID, blueprintQuest.Name)
 
 	}
 
+	err = checkQuestPrerequisites(prerequisites)
+	if err != nil {
+		return err
+	}
+
+	for id, prerequisiteID := range prerequisites {
+		_, err = boiler.BlueprintQuests(
+			boiler.BlueprintQuestWhere.ID.EQ(id),
+		).UpdateAll(db, boiler.M{boiler.BlueprintQuestColumns.PrerequisiteID: prerequisiteID})
+		if err != nil {
+			fmt.Println(err.Error(), id, prerequisiteID.String)
+			return err
+		}
+	}
+
 	fmt.Println("Finish syncing static quest")
 
 	return nil
 }
+
+// parseQuestObjective reads the objective event and the json object of fields the event must match.
+func parseQuestObjective(blueprintQuest *boiler.BlueprintQuest, record []string) error {
+	if record[0] == "" {
+		return nil
+	}
+
+	if !db.QuestObjectiveEventIsValid(record[0]) {
+		return fmt.Errorf("invalid quest objective event: %s", record[0])
+	}
+	blueprintQuest.ObjectiveEvent = null.StringFrom(record[0])
+
+	if len(record) > 1 && strings.TrimSpace(record[1]) != "" {
+		filter := map[string]string{}
+		err := json.Unmarshal([]byte(record[1]), &filter)
+		if err != nil {
+			return fmt.Errorf("invalid quest objective filter: %w", err)
+		}
+		blueprintQuest.ObjectiveFilter = []byte(record[1])
+	}
+
+	return nil
+}
+
+// checkQuestPrerequisites returns an error when following the prerequisites of a quest leads back to the quest,
+// which would leave every quest of the chain locked.
+func checkQuestPrerequisites(prerequisites map[string]null.String) error {
+	for id := range prerequisites {
+		visited := map[string]bool{id: true}
+		for prerequisiteID := prerequisites[id]; prerequisiteID.Valid; prerequisiteID = prerequisites[prerequisiteID.String] {
+			if visited[prerequisiteID.String] {
+				return fmt.Errorf("quest %s has a prerequisite cycle through quest %s", id, prerequisiteID.String)
+			}
+			visited[prerequisiteID.String] = true
+		}
+	}
+	return nil
+}
+
+// syncQuestRewards replaces the rewards of a quest with the json list of rewards in the static data.
+func syncQuestRewards(conn boil.Executor, blueprintQuestID string, rewardsJSON string) error {
+	rewards := []*types.QuestReward{}
+	if strings.TrimSpace(rewardsJSON) != "" {
+		d := json.NewDecoder(strings.NewReader(rewardsJSON))
+		d.DisallowUnknownFields()
+		err := d.Decode(&rewards)
+		if err != nil {
+			return fmt.Errorf("invalid quest rewards: %w", err)
+		}
+	}
+
+	for i, reward := range rewards {
+		valid, needsItem := db.QuestRewardTypeIsValid(reward.Type)
+		if !valid {
+			return fmt.Errorf("invalid quest reward type: %s", reward.Type)
+		}
+		if needsItem != (reward.ItemID != "") {
+			return fmt.Errorf("quest reward %d of type %s has the wrong item: %q", i, reward.Type, reward.ItemID)
+		}
+		exists, err := db.QuestRewardItemExists(conn, reward.Type, reward.ItemID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("quest reward %d of type %s has an item that does not exist: %s", i, reward.Type, reward.ItemID)
+		}
+		if !reward.Amount.IsPositive() || !reward.Amount.IsInteger() {
+			return fmt.Errorf("quest reward %d has an invalid amount: %s", i, reward.Amount)
+		}
+		if reward.Weight < 0 {
+			return fmt.Errorf("quest reward %d has a negative weight", i)
+		}
+		if reward.Weight == 0 {
+			reward.Weight = 1
+		}
+
+		bqr := &boiler.BlueprintQuestReward{
+			BlueprintQuestID: blueprintQuestID,
+			Position:         i,
+			RewardType:       reward.Type,
+			ItemID:           null.NewString(reward.ItemID, reward.ItemID != ""),
+			Amount:           reward.Amount,
+			Pool:             null.NewString(reward.Pool, reward.Pool != ""),
+			Weight:           reward.Weight,
+		}
+		err = bqr.Upsert(
+			conn,
+			true,
+			[]string{
+				boiler.BlueprintQuestRewardColumns.BlueprintQuestID,
+				boiler.BlueprintQuestRewardColumns.Position,
+			},
+			boil.Whitelist(
+				boiler.BlueprintQuestRewardColumns.RewardType,
+				boiler.BlueprintQuestRewardColumns.ItemID,
+				boiler.BlueprintQuestRewardColumns.Amount,
+				boiler.BlueprintQuestRewardColumns.Pool,
+				boiler.BlueprintQuestRewardColumns.Weight,
+			),
+			boil.Infer(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	// remove the rewards past the end of the list
+	_, err := boiler.BlueprintQuestRewards(
+		boiler.BlueprintQuestRewardWhere.BlueprintQuestID.EQ(blueprintQuestID),
+		boiler.BlueprintQuestRewardWhere.Position.GTE(len(rewards)),
+	).DeleteAll(conn)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
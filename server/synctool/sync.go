@@ -0,0 +1,392 @@
+package synctool
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"server/db/boiler"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// StaticFile is a csv file of the static data and the tables it is synced into.
+type StaticFile struct {
+	Name string
+	// Columns is the least number of columns the sync reads from each record
+	Columns int
+	// Types is the type of each column the sync parses into a number, by column index
+	Types  map[int]ColumnType
+	Tables []string
+	Sync   func(f io.Reader, db boil.Executor) error
+}
+
+// ColumnType is the type a static data column must parse as.
+type ColumnType string
+
+const (
+	ColumnTypeInt     ColumnType = "integer"
+	ColumnTypeDecimal ColumnType = "decimal"
+)
+
+// StaticFiles are the static data files in the order they are synced, which syncs rows before the rows that reference them.
+var StaticFiles = []*StaticFile{
+	{"battle_arena.csv", 2, nil, []string{boiler.TableNames.BattleArena}, SyncBattleArenas},
+	{"factions.csv", 11, nil, []string{boiler.TableNames.Factions}, SyncFactions},
+	{"faction_palettes.csv", 15, nil, []string{boiler.TableNames.FactionPalettes}, SyncFactionPalettes},
+	{"faction_passes.csv", 8, map[int]ColumnType{2: ColumnTypeInt, 3: ColumnTypeDecimal, 4: ColumnTypeDecimal, 5: ColumnTypeDecimal, 6: ColumnTypeDecimal}, []string{boiler.TableNames.FactionPasses}, SyncFactionPasses},
+	{"brands.csv", 7, nil, []string{boiler.TableNames.Brands}, SyncBrands},
+	{"shield_types.csv", 3, nil, []string{boiler.TableNames.BlueprintShieldTypes}, SyncShieldTypes},
+	{"weapon_skins.csv", 13, nil, []string{boiler.TableNames.BlueprintWeaponSkin}, SyncWeaponSkins},
+	{"mech_skins.csv", 14, nil, []string{boiler.TableNames.BlueprintMechSkin}, SyncMechSkins},
+	{"mechs.csv", 25, nil, []string{boiler.TableNames.BlueprintMechs}, SyncMechModels},
+	{"mech_model_skin_compatibilities.csv", 10, nil, []string{boiler.TableNames.MechModelSkinCompatibilities}, SyncMechModelSkinCompatibilities},
+	{"weapons.csv", 30, map[int]ColumnType{26: ColumnTypeInt}, []string{boiler.TableNames.BlueprintWeapons}, SyncWeaponModel},
+	{"weapon_model_skin_compatibilities.csv", 9, nil, []string{boiler.TableNames.WeaponModelSkinCompatibilities}, SyncWeaponModelSkinCompatibilities},
+	{"battle_abilities.csv", 6, map[int]ColumnType{2: ColumnTypeInt, 5: ColumnTypeInt}, []string{boiler.TableNames.BattleAbilities}, SyncBattleAbilities},
+	{"game_abilities.csv", 21, map[int]ColumnType{1: ColumnTypeInt, 12: ColumnTypeInt, 16: ColumnTypeInt, 18: ColumnTypeInt, 20: ColumnTypeInt}, []string{boiler.TableNames.GameAbilities}, SyncGameAbilities},
+	{"player_abilities.csv", 17, map[int]ColumnType{1: ColumnTypeInt, 9: ColumnTypeInt, 10: ColumnTypeInt, 11: ColumnTypeInt, 13: ColumnTypeInt, 16: ColumnTypeInt}, []string{boiler.TableNames.BlueprintPlayerAbilities}, SyncPlayerAbilities},
+	{"power_cores.csv", 21, nil, []string{boiler.TableNames.BlueprintPowerCores}, SyncPowerCores},
+	{"quests.csv", 6, map[int]ColumnType{5: ColumnTypeInt}, []string{boiler.TableNames.BlueprintQuests, boiler.TableNames.BlueprintQuestRewards}, SyncStaticQuest},
+}
+
+// StaticData is the content of each static data file, by file name.
+type StaticData map[string][]byte
+
+// Checksum returns the sha256 of every static data file, which tells an apply it is syncing the files it was shown the report of.
+func (sd StaticData) Checksum() string {
+	h := sha256.New()
+	for _, sf := range StaticFiles {
+		h.Write([]byte(sf.Name))
+		h.Write(sd[sf.Name])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// LoadStaticFiles reads every static data file and checks each one has the columns its sync reads,
+// so a bad file stops the sync before anything is written.
+func LoadStaticFiles(read func(name string) ([]byte, error)) (StaticData, error) {
+	sd := StaticData{}
+	for _, sf := range StaticFiles {
+		b, err := read(sf.Name)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", sf.Name, err)
+		}
+
+		err = validateStaticFile(sf, b)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", sf.Name, err)
+		}
+
+		sd[sf.Name] = b
+	}
+	return sd, nil
+}
+
+// validateStaticFile checks the file parses as csv, has a header, every record has at least the columns the sync reads
+// and the numeric columns parse, so a bad value fails before the sync transaction is opened.
+func validateStaticFile(sf *StaticFile, b []byte) error {
+	r := csv.NewReader(bytes.NewReader(b))
+	header, err := r.Read()
+	if err == io.EOF {
+		return fmt.Errorf("missing header")
+	}
+	if err != nil {
+		return err
+	}
+	if len(header) < sf.Columns {
+		return fmt.Errorf("header has %d columns, expected at least %d", len(header), sf.Columns)
+	}
+
+	// the csv reader errors on any record which has a different number of columns to the header
+	records, err := r.ReadAll()
+	if err != nil {
+		return err
+	}
+
+	for i, record := range records {
+		for column, columnType := range sf.Types {
+			switch columnType {
+			case ColumnTypeInt:
+				_, err = strconv.Atoi(record[column])
+			case ColumnTypeDecimal:
+				_, err = decimal.NewFromString(record[column])
+			}
+			if err != nil {
+				// record line numbers start after the header
+				return fmt.Errorf("line %d column %s is not %s: %q", i+2, header[column], columnType, record[column])
+			}
+		}
+	}
+
+	return nil
+}
+
+// SyncStaticFiles syncs the static data in a single transaction and returns what changed in each table.
+// Nothing is written when dryRun is set or any of the syncs fail.
+func SyncStaticFiles(conn *sql.DB, sd StaticData, dryRun bool) (*SyncReport, error) {
+	tx, err := conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	tables := []string{}
+	for _, sf := range StaticFiles {
+		tables = append(tables, sf.Tables...)
+	}
+
+	before := make(map[string]*tableSnapshot)
+	for _, table := range tables {
+		before[table], err = snapshotTable(tx, table)
+		if err != nil {
+			return nil, fmt.Errorf("snapshot %s: %w", table, err)
+		}
+	}
+
+	for _, sf := range StaticFiles {
+		err = sf.Sync(bytes.NewReader(sd[sf.Name]), tx)
+		if err != nil {
+			return nil, fmt.Errorf("sync %s: %w", sf.Name, err)
+		}
+	}
+
+	report := &SyncReport{
+		DryRun:   dryRun,
+		Checksum: sd.Checksum(),
+		Tables:   []*TableDiff{},
+	}
+	for _, table := range tables {
+		after, err := snapshotTable(tx, table)
+		if err != nil {
+			return nil, fmt.Errorf("snapshot %s: %w", table, err)
+		}
+		report.Tables = append(report.Tables, diffTable(table, before[table], after))
+	}
+
+	if dryRun {
+		return report, nil
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// SyncReport is what a static data sync changed, or would change on a dry run.
+type SyncReport struct {
+	DryRun   bool         `json:"dry_run"`
+	Checksum string       `json:"checksum"`
+	Tables   []*TableDiff `json:"tables"`
+}
+
+// TableDiff is the rows of a table a sync changed, keyed by their primary key.
+type TableDiff struct {
+	Table    string     `json:"table"`
+	Inserted []string   `json:"inserted"`
+	Updated  []*RowDiff `json:"updated"`
+	Deleted  []string   `json:"deleted"`
+}
+
+type RowDiff struct {
+	Key     string          `json:"key"`
+	Columns []*ColumnChange `json:"columns"`
+}
+
+type ColumnChange struct {
+	Column string      `json:"column"`
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// HasChanges returns whether the sync changed any row.
+func (sr *SyncReport) HasChanges() bool {
+	for _, td := range sr.Tables {
+		if len(td.Inserted)+len(td.Updated)+len(td.Deleted) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the report as text, listing only the tables which changed.
+func (sr *SyncReport) String() string {
+	sb := &strings.Builder{}
+	if sr.DryRun {
+		sb.WriteString("DRY RUN, nothing has been written\n")
+	}
+	sb.WriteString(fmt.Sprintf("checksum: %s\n", sr.Checksum))
+
+	if !sr.HasChanges() {
+		sb.WriteString("no changes\n")
+		return sb.String()
+	}
+
+	for _, td := range sr.Tables {
+		if len(td.Inserted)+len(td.Updated)+len(td.Deleted) == 0 {
+			continue
+		}
+
+		sb.WriteString(fmt.Sprintf("\n%s: %d inserted, %d updated, %d deleted\n", td.Table, len(td.Inserted), len(td.Updated), len(td.Deleted)))
+		for _, key := range td.Inserted {
+			sb.WriteString(fmt.Sprintf("  + %s\n", key))
+		}
+		for _, rd := range td.Updated {
+			sb.WriteString(fmt.Sprintf("  ~ %s\n", rd.Key))
+			for _, cc := range rd.Columns {
+				sb.WriteString(fmt.Sprintf("      %s: %s -> %s\n", cc.Column, reportValue(cc.Before), reportValue(cc.After)))
+			}
+		}
+		for _, key := range td.Deleted {
+			sb.WriteString(fmt.Sprintf("  - %s\n", key))
+		}
+	}
+
+	return sb.String()
+}
+
+func reportValue(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+// tableSnapshot is every row of a table as json, keyed by primary key.
+type tableSnapshot struct {
+	keys []string
+	rows map[string]map[string]interface{}
+}
+
+func snapshotTable(conn boil.Executor, table string) (*tableSnapshot, error) {
+	ts := &tableSnapshot{
+		keys: []string{},
+		rows: make(map[string]map[string]interface{}),
+	}
+
+	q := `
+		SELECT a.attname
+		FROM pg_index i
+		INNER JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = ANY(i.indkey)
+		WHERE i.indrelid = $1::REGCLASS AND i.indisprimary
+		ORDER BY ARRAY_POSITION(i.indkey, a.attnum)
+	`
+	rows, err := conn.Query(q, table)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		key := ""
+		err = rows.Scan(&key)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		ts.keys = append(ts.keys, key)
+	}
+	rows.Close()
+
+	rows, err = conn.Query(fmt.Sprintf(`SELECT TO_JSONB(t)::TEXT FROM %s t`, pq.QuoteIdentifier(table)))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		raw := ""
+		err = rows.Scan(&raw)
+		if err != nil {
+			return nil, err
+		}
+
+		row := make(map[string]interface{})
+		d := json.NewDecoder(strings.NewReader(raw))
+		d.UseNumber()
+		err = d.Decode(&row)
+		if err != nil {
+			return nil, err
+		}
+
+		ts.rows[ts.rowKey(raw, row)] = row
+	}
+
+	return ts, rows.Err()
+}
+
+// rowKey joins the primary key columns of a row, or uses the whole row when the table has no primary key.
+func (ts *tableSnapshot) rowKey(raw string, row map[string]interface{}) string {
+	if len(ts.keys) == 0 {
+		return raw
+	}
+	values := []string{}
+	for _, key := range ts.keys {
+		values = append(values, fmt.Sprint(row[key]))
+	}
+	return strings.Join(values, "/")
+}
+
+// diffTable compares the rows of a table before and after the sync. The syncs stamp updated_at and deleted_at with the
+// time of the sync, so updated_at is ignored and deleted_at only counts as changed when a row is deleted or restored.
+func diffTable(table string, before *tableSnapshot, after *tableSnapshot) *TableDiff {
+	td := &TableDiff{
+		Table:    table,
+		Inserted: []string{},
+		Updated:  []*RowDiff{},
+		Deleted:  []string{},
+	}
+
+	for key, row := range after.rows {
+		old, ok := before.rows[key]
+		if !ok {
+			td.Inserted = append(td.Inserted, key)
+			continue
+		}
+
+		rd := &RowDiff{Key: key}
+		for column, value := range row {
+			switch column {
+			case "updated_at":
+				continue
+			case "deleted_at":
+				if (old[column] == nil) == (value == nil) {
+					continue
+				}
+			default:
+				if reflect.DeepEqual(old[column], value) {
+					continue
+				}
+			}
+			rd.Columns = append(rd.Columns, &ColumnChange{column, old[column], value})
+		}
+		if len(rd.Columns) > 0 {
+			sort.Slice(rd.Columns, func(i, j int) bool { return rd.Columns[i].Column < rd.Columns[j].Column })
+			td.Updated = append(td.Updated, rd)
+		}
+	}
+
+	for key := range before.rows {
+		if _, ok := after.rows[key]; !ok {
+			td.Deleted = append(td.Deleted, key)
+		}
+	}
+
+	sort.Strings(td.Inserted)
+	sort.Slice(td.Updated, func(i, j int) bool { return td.Updated[i].Key < td.Updated[j].Key })
+	sort.Strings(td.Deleted)
+
+	return td
+}
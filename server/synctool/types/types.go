@@ -256,3 +256,13 @@ type BlueprintWeapons struct {
 	EnergyCost          string `json:"energy_cost"`
 	WeaponModelID       string `json:"weapon_model_id"`
 }
+
+// QuestReward is a reward in the json list of rewards of a static quest. Rewards which share a pool are a weighted pool,
+// of which one reward is given.
+type QuestReward struct {
+	Type   string          `json:"type"`
+	ItemID string          `json:"item_id"`
+	Amount decimal.Decimal `json:"amount"`
+	Pool   string          `json:"pool"`
+	Weight int             `json:"weight"`
+}
@@ -0,0 +1,93 @@
+package syndicate
+
+import (
+	"fmt"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+	"strings"
+)
+
+type ChatEventType string
+
+const (
+	ChatEventTypeCreated ChatEventType = "CREATED"
+	ChatEventTypeRemoved ChatEventType = "REMOVED"
+	ChatEventTypeNotice  ChatEventType = "NOTICE"
+)
+
+// ChatEvent tells the chat controller to create or remove a syndicate chatroom, or to post a notice in it
+type ChatEvent struct {
+	Type        ChatEventType
+	SyndicateID string
+	FactionID   string
+	Notice      string
+}
+
+// sendChatEvent waits for the chat controller when the channel is full, so no chatroom or notice is ever lost
+func (ss *System) sendChatEvent(event *ChatEvent) {
+	ss.ChatEventChan <- event
+}
+
+// RemoveSyndicateChat removes the chatroom of a liquidated syndicate.
+// It is called once the liquidation is committed, so the chatroom stays if the liquidation is rolled back.
+func (ss *System) RemoveSyndicateChat(syndicateID string, factionID string) {
+	ss.sendChatEvent(&ChatEvent{
+		Type:        ChatEventTypeRemoved,
+		SyndicateID: syndicateID,
+		FactionID:   factionID,
+	})
+}
+
+// SyndicateIDs returns the ids of all the active syndicates
+func (ss *System) SyndicateIDs() []string {
+	ss.RLock()
+	defer ss.RUnlock()
+
+	ids := []string{}
+	for id, s := range ss.syndicateMap {
+		if s.isLiquidated.Load() {
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+// postResultNotice posts the final result of the motion in the syndicate chat
+func (sm *Motion) postResultNotice() {
+	if !sm.Result.Valid {
+		return
+	}
+
+	result := strings.ToLower(strings.ReplaceAll(sm.Result.String, "_", " "))
+
+	notice := fmt.Sprintf("Motion %s: %s", result, sm.Reason)
+	if sm.Note.Valid && sm.Note.String != "" {
+		notice = fmt.Sprintf("%s (%s)", notice, sm.Note.String)
+	}
+
+	sm.syndicate.system.sendChatEvent(&ChatEvent{
+		Type:        ChatEventTypeNotice,
+		SyndicateID: sm.SyndicateID,
+		FactionID:   sm.syndicate.FactionID,
+		Notice:      notice,
+	})
+}
+
+// postWinnerNotice posts the winner of the election in the syndicate chat
+func (es *ElectionSystem) postWinnerNotice(se *boiler.SyndicateElection, candidateID string) {
+	candidate, err := boiler.FindPlayer(gamedb.StdConn, candidateID)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("candidate id", candidateID).Msg("Failed to load election winner.")
+		return
+	}
+
+	es.syndicate.system.sendChatEvent(&ChatEvent{
+		Type:        ChatEventTypeNotice,
+		SyndicateID: es.syndicateID,
+		FactionID:   es.factionID,
+		Notice:      fmt.Sprintf("%s #%d has won the %s election.", candidate.Username.String, candidate.Gid, strings.ToLower(se.Type)),
+	})
+}
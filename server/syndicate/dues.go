@@ -0,0 +1,513 @@
+package syndicate
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/friendsofgo/errors"
+	"github.com/ninja-syndicate/ws"
+	"github.com/sasha-s/go-deadlock"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"go.uber.org/atomic"
+	"server"
+	"server/db"
+	"server/db/boiler"
+	"server/gamedb"
+	"server/gamelog"
+	"server/system_messages"
+	"time"
+)
+
+// DuesSystem charges the syndicate members their monthly dues at the start of every month.
+// Members who fail to pay before the grace period ends are removed from the syndicate.
+type DuesSystem struct {
+	syndicate *Syndicate
+	isClosed  atomic.Bool
+	deadlock.Mutex
+}
+
+func newDuesSystem(s *Syndicate) *DuesSystem {
+	ds := &DuesSystem{
+		syndicate: s,
+	}
+
+	ds.isClosed.Store(false)
+
+	go ds.start()
+
+	return ds
+}
+
+// billingPeriod returns the start of the month the given time belongs to
+func billingPeriod(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (ds *DuesSystem) start() {
+	for {
+		// check every minute
+		time.Sleep(1 * time.Minute)
+
+		if ds.isClosed.Load() {
+			return
+		}
+
+		func() {
+			ds.Lock()
+			defer ds.Unlock()
+
+			ds.process(time.Now())
+		}()
+	}
+}
+
+// terminate stops the billing cycle and cancels all the outstanding dues
+func (ds *DuesSystem) terminate() {
+	ds.Lock()
+	defer ds.Unlock()
+
+	ds.isClosed.Store(true)
+
+	_, err := boiler.SyndicateMemberDues(
+		boiler.SyndicateMemberDueWhere.SyndicateID.EQ(ds.syndicate.ID),
+		boiler.SyndicateMemberDueWhere.Status.IN([]string{boiler.SyndicateMemberDuesStatusPENDING, boiler.SyndicateMemberDuesStatusOVERDUE}),
+	).UpdateAll(gamedb.StdConn, boiler.M{
+		boiler.SyndicateMemberDueColumns.Status: boiler.SyndicateMemberDuesStatusCANCELLED,
+		boiler.SyndicateMemberDueColumns.Note:   null.StringFrom("Syndicate is liquidated."),
+	})
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("syndicate id", ds.syndicate.ID).Msg("Failed to cancel outstanding syndicate dues.")
+	}
+}
+
+func (ds *DuesSystem) process(now time.Time) {
+	currentPeriod := billingPeriod(now)
+
+	// cycles are created a month ahead, so a new syndicate is not charged until the next month
+	cycle, err := boiler.SyndicateDuesCycles(
+		boiler.SyndicateDuesCycleWhere.SyndicateID.EQ(ds.syndicate.ID),
+		boiler.SyndicateDuesCycleWhere.BillingPeriod.EQ(currentPeriod),
+		boiler.SyndicateDuesCycleWhere.ChargedAt.IsNull(),
+	).One(gamedb.StdConn)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		gamelog.L.Error().Err(err).Str("syndicate id", ds.syndicate.ID).Msg("Failed to load syndicate dues cycle.")
+		return
+	}
+
+	if cycle != nil && !now.Before(cycle.ChargeAt) {
+		ds.chargeCycle(cycle, now)
+	}
+
+	upcoming, err := ds.upcomingCycle(currentPeriod.AddDate(0, 1, 0))
+	if err != nil {
+		return
+	}
+
+	reminderWindow := time.Duration(db.GetIntWithDefault(db.KeySyndicateDuesReminderHours, 72)) * time.Hour
+	if !upcoming.RemindedAt.Valid && now.After(upcoming.ChargeAt.Add(-reminderWindow)) {
+		ds.remind(upcoming, now)
+	}
+
+	ds.chargeOutstandingDues(now)
+}
+
+// upcomingCycle returns the cycle of the given billing period, the cycle is created if it does not exist
+func (ds *DuesSystem) upcomingCycle(period time.Time) (*boiler.SyndicateDuesCycle, error) {
+	cycle, err := boiler.SyndicateDuesCycles(
+		boiler.SyndicateDuesCycleWhere.SyndicateID.EQ(ds.syndicate.ID),
+		boiler.SyndicateDuesCycleWhere.BillingPeriod.EQ(period),
+	).One(gamedb.StdConn)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		gamelog.L.Error().Err(err).Str("syndicate id", ds.syndicate.ID).Msg("Failed to load upcoming syndicate dues cycle.")
+		return nil, err
+	}
+
+	if cycle != nil {
+		return cycle, nil
+	}
+
+	cycle = &boiler.SyndicateDuesCycle{
+		SyndicateID:   ds.syndicate.ID,
+		BillingPeriod: period,
+		ChargeAt:      period,
+	}
+	err = cycle.Insert(gamedb.StdConn, boil.Infer())
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("syndicate id", ds.syndicate.ID).Msg("Failed to insert syndicate dues cycle.")
+		return nil, err
+	}
+
+	return cycle, nil
+}
+
+// remind notifies members and leaders about the upcoming charge
+func (ds *DuesSystem) remind(cycle *boiler.SyndicateDuesCycle, now time.Time) {
+	syndicate, err := boiler.FindSyndicate(gamedb.StdConn, ds.syndicate.ID)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("syndicate id", ds.syndicate.ID).Msg("Failed to load syndicate.")
+		return
+	}
+
+	if syndicate.MemberMonthlyDues.GreaterThan(decimal.Zero) {
+		members, err := boiler.Players(
+			boiler.PlayerWhere.SyndicateID.EQ(null.StringFrom(ds.syndicate.ID)),
+		).All(gamedb.StdConn)
+		if err != nil {
+			gamelog.L.Error().Err(err).Str("syndicate id", ds.syndicate.ID).Msg("Failed to load syndicate members.")
+			return
+		}
+
+		var memberIDs []string
+		for _, m := range members {
+			memberIDs = append(memberIDs, m.ID)
+		}
+
+		sendDuesMessage(
+			memberIDs,
+			"Upcoming Syndicate Dues",
+			fmt.Sprintf("Monthly dues of %s SUPS for syndicate %s will be charged on %s. Keep enough SUPS in your account to stay in the syndicate.", formatSups(syndicate.MemberMonthlyDues), syndicate.Name, cycle.ChargeAt.Format("2 Jan 2006")),
+			cycle,
+		)
+
+		sendDuesMessage(
+			ds.leaderIDs(syndicate),
+			"Upcoming Syndicate Dues",
+			fmt.Sprintf("%d members of syndicate %s will be charged monthly dues of %s SUPS on %s.", len(members), syndicate.Name, formatSups(syndicate.MemberMonthlyDues), cycle.ChargeAt.Format("2 Jan 2006")),
+			cycle,
+		)
+	}
+
+	cycle.RemindedAt = null.TimeFrom(now)
+	_, err = cycle.Update(gamedb.StdConn, boil.Whitelist(boiler.SyndicateDuesCycleColumns.RemindedAt))
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("cycle id", cycle.ID).Msg("Failed to update syndicate dues cycle.")
+	}
+}
+
+// chargeCycle creates the dues of every member for the cycle and charges them
+func (ds *DuesSystem) chargeCycle(cycle *boiler.SyndicateDuesCycle, now time.Time) {
+	syndicate, err := boiler.FindSyndicate(gamedb.StdConn, ds.syndicate.ID)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("syndicate id", ds.syndicate.ID).Msg("Failed to load syndicate.")
+		return
+	}
+
+	// the amount is locked in when the cycle is charged, so changing the dues during the grace period does not affect it
+	cycle.Amount = decimal.NewNullDecimal(syndicate.MemberMonthlyDues)
+
+	if syndicate.MemberMonthlyDues.GreaterThan(decimal.Zero) {
+		members, err := boiler.Players(
+			boiler.PlayerWhere.SyndicateID.EQ(null.StringFrom(ds.syndicate.ID)),
+		).All(gamedb.StdConn)
+		if err != nil {
+			gamelog.L.Error().Err(err).Str("syndicate id", ds.syndicate.ID).Msg("Failed to load syndicate members.")
+			return
+		}
+
+		// skip members who already have dues in this cycle, in case the previous run was interrupted
+		existingDues, err := boiler.SyndicateMemberDues(
+			boiler.SyndicateMemberDueWhere.CycleID.EQ(cycle.ID),
+		).All(gamedb.StdConn)
+		if err != nil {
+			gamelog.L.Error().Err(err).Str("cycle id", cycle.ID).Msg("Failed to load syndicate member dues.")
+			return
+		}
+
+		paidCount := 0
+		overdueCount := 0
+		for _, m := range members {
+			exists := false
+			for _, d := range existingDues {
+				if d.PlayerID == m.ID {
+					exists = true
+					break
+				}
+			}
+			if exists {
+				continue
+			}
+
+			md := &boiler.SyndicateMemberDue{
+				CycleID:     cycle.ID,
+				SyndicateID: ds.syndicate.ID,
+				PlayerID:    m.ID,
+				Amount:      syndicate.MemberMonthlyDues,
+				Status:      boiler.SyndicateMemberDuesStatusPENDING,
+			}
+			err = md.Insert(gamedb.StdConn, boil.Infer())
+			if err != nil {
+				gamelog.L.Error().Err(err).Str("player id", m.ID).Str("cycle id", cycle.ID).Msg("Failed to insert syndicate member dues.")
+				continue
+			}
+
+			if ds.chargeMemberDues(md, m, syndicate, now) {
+				paidCount++
+			} else {
+				overdueCount++
+			}
+		}
+
+		sendDuesMessage(
+			ds.leaderIDs(syndicate),
+			"Syndicate Dues Charged",
+			fmt.Sprintf("Monthly dues of %s SUPS have been charged. %d members paid, %d members are in arrears.", formatSups(syndicate.MemberMonthlyDues), paidCount, overdueCount),
+			cycle,
+		)
+	}
+
+	cycle.ChargedAt = null.TimeFrom(now)
+	_, err = cycle.Update(gamedb.StdConn, boil.Whitelist(
+		boiler.SyndicateDuesCycleColumns.Amount,
+		boiler.SyndicateDuesCycleColumns.ChargedAt,
+	))
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("cycle id", cycle.ID).Msg("Failed to update syndicate dues cycle.")
+	}
+}
+
+// chargeOutstandingDues retries the unpaid dues, and removes the members whose grace period has ended
+func (ds *DuesSystem) chargeOutstandingDues(now time.Time) {
+	dues, err := boiler.SyndicateMemberDues(
+		boiler.SyndicateMemberDueWhere.SyndicateID.EQ(ds.syndicate.ID),
+		boiler.SyndicateMemberDueWhere.Status.IN([]string{boiler.SyndicateMemberDuesStatusPENDING, boiler.SyndicateMemberDuesStatusOVERDUE}),
+	).All(gamedb.StdConn)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("syndicate id", ds.syndicate.ID).Msg("Failed to load outstanding syndicate dues.")
+		return
+	}
+
+	if len(dues) == 0 {
+		return
+	}
+
+	syndicate, err := boiler.FindSyndicate(gamedb.StdConn, ds.syndicate.ID)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("syndicate id", ds.syndicate.ID).Msg("Failed to load syndicate.")
+		return
+	}
+
+	retryInterval := time.Duration(db.GetIntWithDefault(db.KeySyndicateDuesRetryIntervalHours, 24)) * time.Hour
+
+	for _, md := range dues {
+		player, err := boiler.FindPlayer(gamedb.StdConn, md.PlayerID)
+		if err != nil {
+			gamelog.L.Error().Err(err).Str("player id", md.PlayerID).Msg("Failed to load player.")
+			continue
+		}
+
+		// cancel the dues of the players who already left the syndicate
+		if player.SyndicateID.String != ds.syndicate.ID {
+			md.Status = boiler.SyndicateMemberDuesStatusCANCELLED
+			md.Note = null.StringFrom("Player is no longer a member of the syndicate.")
+			_, err = md.Update(gamedb.StdConn, boil.Whitelist(
+				boiler.SyndicateMemberDueColumns.Status,
+				boiler.SyndicateMemberDueColumns.Note,
+				boiler.SyndicateMemberDueColumns.UpdatedAt,
+			))
+			if err != nil {
+				gamelog.L.Error().Err(err).Str("member dues id", md.ID).Msg("Failed to cancel syndicate member dues.")
+			}
+			continue
+		}
+
+		graceEnded := md.GraceEndsAt.Valid && !now.Before(md.GraceEndsAt.Time)
+		retryDue := md.Status == boiler.SyndicateMemberDuesStatusPENDING || !md.LastAttemptedAt.Valid || now.Sub(md.LastAttemptedAt.Time) >= retryInterval
+
+		// dues of the members who could not be removed stay overdue, and are retried with the other overdue dues
+		removalAttempted := graceEnded && md.Note.Valid
+
+		if (!graceEnded || removalAttempted) && !retryDue {
+			continue
+		}
+
+		if ds.chargeMemberDues(md, player, syndicate, now) || !graceEnded {
+			continue
+		}
+
+		ds.defaultMemberDues(md, player, syndicate)
+	}
+}
+
+// chargeMemberDues charges the member through the syndicate account, returns true if the dues are paid
+func (ds *DuesSystem) chargeMemberDues(md *boiler.SyndicateMemberDue, player *boiler.Player, syndicate *boiler.Syndicate, now time.Time) bool {
+	md.AttemptCount += 1
+	md.LastAttemptedAt = null.TimeFrom(now)
+
+	// the reference is the same on every attempt, so the dues can not be charged twice
+	reference := server.TransactionReference(fmt.Sprintf("syndicate_member_monthly_dues|%s", md.ID))
+	err := ds.syndicate.accountSystem.receiveFund(
+		player.ID,
+		md.Amount,
+		reference,
+		fmt.Sprintf("Player '%s' #%d monthly dues.", player.Username.String, player.Gid),
+	)
+	if err == nil {
+		md.Status = boiler.SyndicateMemberDuesStatusPAID
+		md.PaidAt = null.TimeFrom(now)
+		md.TransactionReference = null.StringFrom(string(reference))
+
+		sendDuesMessage(
+			[]string{player.ID},
+			"Syndicate Dues Paid",
+			fmt.Sprintf("Monthly dues of %s SUPS have been paid to syndicate %s.", formatSups(md.Amount), syndicate.Name),
+			md,
+		)
+	} else if md.Status == boiler.SyndicateMemberDuesStatusPENDING {
+		gracePeriod := time.Duration(db.GetIntWithDefault(db.KeySyndicateDuesGracePeriodHours, 168)) * time.Hour
+
+		md.Status = boiler.SyndicateMemberDuesStatusOVERDUE
+		md.GraceEndsAt = null.TimeFrom(now.Add(gracePeriod))
+
+		arrears, err := db.SyndicateMemberArrears(md.SyndicateID, md.PlayerID)
+		if err != nil {
+			arrears = md.Amount
+		}
+
+		sendDuesMessage(
+			[]string{player.ID},
+			"Syndicate Dues Overdue",
+			fmt.Sprintf("Failed to charge monthly dues of %s SUPS for syndicate %s, you are %s SUPS in arrears. Top up your account before %s, or you will be removed from the syndicate.", formatSups(md.Amount), syndicate.Name, formatSups(arrears.Add(md.Amount)), md.GraceEndsAt.Time.Format("2 Jan 2006 15:04 MST")),
+			md,
+		)
+	}
+
+	_, err = md.Update(gamedb.StdConn, boil.Whitelist(
+		boiler.SyndicateMemberDueColumns.Status,
+		boiler.SyndicateMemberDueColumns.AttemptCount,
+		boiler.SyndicateMemberDueColumns.LastAttemptedAt,
+		boiler.SyndicateMemberDueColumns.GraceEndsAt,
+		boiler.SyndicateMemberDueColumns.PaidAt,
+		boiler.SyndicateMemberDueColumns.TransactionReference,
+		boiler.SyndicateMemberDueColumns.UpdatedAt,
+	))
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("member dues id", md.ID).Msg("Failed to update syndicate member dues.")
+	}
+
+	return md.Status == boiler.SyndicateMemberDuesStatusPAID
+}
+
+// defaultMemberDues removes the member who failed to pay within the grace period.
+// Members who can not be removed keep their dues overdue, so they are still charged once they top up.
+func (ds *DuesSystem) defaultMemberDues(md *boiler.SyndicateMemberDue, player *boiler.Player, syndicate *boiler.Syndicate) {
+	reason, err := ds.syndicate.removeMember(player.ID)
+	if err != nil {
+		return
+	}
+
+	// leaders are not removed, so let the syndicate deal with it
+	if reason != "" {
+		// only notify the leaders when the reason changes, the removal is attempted again on every retry
+		if md.Note.Valid && md.Note.String == reason {
+			return
+		}
+
+		md.Note = null.StringFrom(reason)
+		_, err = md.Update(gamedb.StdConn, boil.Whitelist(
+			boiler.SyndicateMemberDueColumns.Note,
+			boiler.SyndicateMemberDueColumns.UpdatedAt,
+		))
+		if err != nil {
+			gamelog.L.Error().Err(err).Str("member dues id", md.ID).Msg("Failed to update syndicate member dues.")
+		}
+
+		sendDuesMessage(
+			ds.leaderIDs(syndicate),
+			"Syndicate Dues Defaulted",
+			fmt.Sprintf("Player %s #%d has not paid the monthly dues of %s SUPS, but is not removed. %s", player.Username.String, player.Gid, formatSups(md.Amount), reason),
+			md,
+		)
+		return
+	}
+
+	md.Status = boiler.SyndicateMemberDuesStatusDEFAULTED
+	md.Note = null.StringFrom("Removed from the syndicate.")
+	_, err = md.Update(gamedb.StdConn, boil.Whitelist(
+		boiler.SyndicateMemberDueColumns.Status,
+		boiler.SyndicateMemberDueColumns.Note,
+		boiler.SyndicateMemberDueColumns.UpdatedAt,
+	))
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("member dues id", md.ID).Msg("Failed to update syndicate member dues.")
+	}
+
+	sendDuesMessage(
+		[]string{player.ID},
+		"Removed From Syndicate",
+		fmt.Sprintf("You have been removed from syndicate %s for not paying the monthly dues of %s SUPS.", syndicate.Name, formatSups(md.Amount)),
+		md,
+	)
+
+	sendDuesMessage(
+		ds.leaderIDs(syndicate),
+		"Syndicate Dues Defaulted",
+		fmt.Sprintf("Player %s #%d has been removed from the syndicate for not paying the monthly dues of %s SUPS.", player.Username.String, player.Gid, formatSups(md.Amount)),
+		md,
+	)
+}
+
+// leaderIDs returns the directors, ceo and admin of the syndicate
+func (ds *DuesSystem) leaderIDs(syndicate *boiler.Syndicate) []string {
+	var ids []string
+	add := func(id string) {
+		for _, existing := range ids {
+			if existing == id {
+				return
+			}
+		}
+		ids = append(ids, id)
+	}
+
+	if syndicate.CeoPlayerID.Valid {
+		add(syndicate.CeoPlayerID.String)
+	}
+	if syndicate.AdminID.Valid {
+		add(syndicate.AdminID.String)
+	}
+
+	directors, err := boiler.SyndicateDirectors(
+		boiler.SyndicateDirectorWhere.SyndicateID.EQ(syndicate.ID),
+	).All(gamedb.StdConn)
+	if err != nil {
+		gamelog.L.Error().Err(err).Str("syndicate id", syndicate.ID).Msg("Failed to load syndicate directors.")
+	}
+	for _, d := range directors {
+		add(d.PlayerID)
+	}
+
+	return ids
+}
+
+func sendDuesMessage(playerIDs []string, title string, message string, data interface{}) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		gamelog.L.Error().Err(err).Interface("data", data).Msg("Failed to marshal syndicate dues data.")
+		return
+	}
+
+	for _, playerID := range playerIDs {
+		msg := &boiler.SystemMessage{
+			PlayerID: playerID,
+			SenderID: server.SupremacySystemAdminUserID,
+			DataType: null.StringFrom(string(system_messages.SystemMessageDataTypeSyndicateDues)),
+			Title:    title,
+			Message:  message,
+			Data:     null.JSONFrom(b),
+		}
+
+		err = msg.Insert(gamedb.StdConn, boil.Infer())
+		if err != nil {
+			gamelog.L.Error().Err(err).Interface("newSystemMessage", msg).Msg("failed to insert new system message into db")
+			continue
+		}
+
+		ws.PublishMessage(fmt.Sprintf("/secure/user/%s/system_messages", playerID), server.HubKeySystemMessageListUpdatedSubscribe, true)
+	}
+}
+
+func formatSups(amount decimal.Decimal) string {
+	return amount.Shift(-18).StringFixed(2)
+}
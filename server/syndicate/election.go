@@ -19,6 +19,7 @@ import (
 )
 
 type ElectionSystem struct {
+	syndicate   *Syndicate
 	syndicateID string
 	factionID   string
 	isClosed    atomic.Bool
@@ -27,6 +28,7 @@ type ElectionSystem struct {
 
 func newElectionSystem(s *Syndicate) (*ElectionSystem, error) {
 	es := &ElectionSystem{
+		syndicate:   s,
 		syndicateID: s.ID,
 		factionID:   s.FactionID,
 	}
@@ -258,7 +260,8 @@ func (es *ElectionSystem) setElectionWinner(se *boiler.SyndicateElection, candid
 	// remove ongoing election in the frontend
 	ws.PublishMessage(fmt.Sprintf("/faction/%s/syndicate/%s/ongoing_election", es.factionID, es.syndicateID), server.HubKeySyndicateOngoingElectionSubscribe, nil)
 
-	// TODO: broadcast election result
+	// broadcast election result
+	es.postWinnerNotice(se, candidateID)
 }
 
 func (es *ElectionSystem) handleTieElection(se *boiler.SyndicateElection, result []*ElectionResult) {
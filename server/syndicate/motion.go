@@ -794,6 +794,9 @@ func (sm *Motion) start() {
 		// execute result
 		sm.action()
 
+		// post the final result in the syndicate chat
+		sm.postResultNotice()
+
 		return
 	}
 }
@@ -1379,60 +1382,14 @@ func (sm *Motion) changeRule() {
 }
 
 func (sm *Motion) removeMember() {
-	// get target player
-	player, err := boiler.FindPlayer(gamedb.StdConn, sm.MemberID.String)
+	reason, err := sm.syndicate.removeMember(sm.MemberID.String)
 	if err != nil {
-		gamelog.L.Error().Err(err).Msg("Failed to get member from db")
 		return
 	}
 
-	// check the player is still a member of the syndicate
-	if !player.SyndicateID.Valid || player.SyndicateID.String != sm.SyndicateID {
-		sm.broadcastEndResult(boiler.SyndicateMotionResultFAILED, fmt.Sprintf("Player %s #%d is no longer a member of our syndicate.", player.Username.String, player.Gid))
-		return
-	}
-
-	// check the player is a committee
-	isCommittee, err := boiler.SyndicateCommittees(
-		boiler.SyndicateCommitteeWhere.SyndicateID.EQ(sm.SyndicateID),
-		boiler.SyndicateCommitteeWhere.PlayerID.EQ(player.ID),
-	).Exists(gamedb.StdConn)
-	if err != nil {
-		gamelog.L.Error().Str("syndicate id", sm.SyndicateID).Str("player id", player.ID).Err(err).Msg("Failed to check syndicate committee list")
-	}
-
-	if isCommittee {
-		sm.broadcastEndResult(boiler.SyndicateMotionResultFAILED, fmt.Sprintf("Player %s #%d is a committee of our syndicate now.", player.Username.String, player.Gid))
-		return
+	if reason != "" {
+		sm.broadcastEndResult(boiler.SyndicateMotionResultFAILED, reason)
 	}
-
-	// check the player is a director
-	isDirector, err := boiler.SyndicateDirectors(
-		boiler.SyndicateDirectorWhere.SyndicateID.EQ(sm.SyndicateID),
-		boiler.SyndicateDirectorWhere.PlayerID.EQ(player.ID),
-	).Exists(gamedb.StdConn)
-	if err != nil {
-		gamelog.L.Error().Str("syndicate id", sm.SyndicateID).Str("player id", player.ID).Err(err).Msg("Failed to check syndicate director list")
-	}
-
-	if isDirector {
-		sm.broadcastEndResult(boiler.SyndicateMotionResultFAILED, fmt.Sprintf("Player %s #%d is a director of our syndicate now.", player.Username.String, player.Gid))
-		return
-	}
-
-	// vote pass
-	player.SyndicateID = null.StringFromPtr(nil)
-	_, err = player.Update(gamedb.StdConn, boil.Whitelist(boiler.PlayerColumns.SyndicateID))
-	if err != nil {
-		gamelog.L.Error().Str("player id", player.ID).Err(err).Msg("Failed to update player syndicate id in db")
-	}
-
-	err = player.L.LoadRole(gamedb.StdConn, true, player, nil)
-	if err != nil {
-		gamelog.L.Error().Str("player id", player.ID).Err(err).Msg("Failed to load role_id")
-	}
-
-	ws.PublishMessage(fmt.Sprintf("/secure/user/%s", player.ID), server.HubKeyUserSubscribe, server.PlayerFromBoiler(player))
 }
 
 func (sm *Motion) appointCommittee() {
@@ -27,6 +27,7 @@ type Syndicate struct {
 	accountSystem  *AccountSystem
 	recruitSystem  *RecruitSystem
 	electionSystem *ElectionSystem
+	duesSystem     *DuesSystem
 }
 
 func newSyndicate(ss *System, syndicate *boiler.Syndicate) (*Syndicate, error) {
@@ -55,6 +56,8 @@ func newSyndicate(ss *System, syndicate *boiler.Syndicate) (*Syndicate, error) {
 
 	s.accountSystem = newAccountSystem(s)
 
+	s.duesSystem = newDuesSystem(s)
+
 	return s, nil
 }
 
@@ -74,6 +77,9 @@ func (s *Syndicate) liquidate(tx *sql.Tx) error {
 	// stop election system
 	s.electionSystem.isClosed.Store(true)
 
+	// stop charging monthly dues
+	s.duesSystem.terminate()
+
 	// liquidate fund
 	err := s.accountSystem.liquidate()
 	if err != nil {
@@ -130,3 +136,61 @@ func (s *Syndicate) liquidate(tx *sql.Tx) error {
 
 	return nil
 }
+
+// removeMember kicks the player out of the syndicate.
+// A reason is returned instead, if the player is no longer a member or holds a position in the syndicate.
+func (s *Syndicate) removeMember(playerID string) (string, error) {
+	// get target player
+	player, err := boiler.FindPlayer(gamedb.StdConn, playerID)
+	if err != nil {
+		gamelog.L.Error().Err(err).Msg("Failed to get member from db")
+		return "", terror.Error(err, "Failed to get member.")
+	}
+
+	// check the player is still a member of the syndicate
+	if !player.SyndicateID.Valid || player.SyndicateID.String != s.ID {
+		return fmt.Sprintf("Player %s #%d is no longer a member of our syndicate.", player.Username.String, player.Gid), nil
+	}
+
+	// check the player is a committee
+	isCommittee, err := boiler.SyndicateCommittees(
+		boiler.SyndicateCommitteeWhere.SyndicateID.EQ(s.ID),
+		boiler.SyndicateCommitteeWhere.PlayerID.EQ(player.ID),
+	).Exists(gamedb.StdConn)
+	if err != nil {
+		gamelog.L.Error().Str("syndicate id", s.ID).Str("player id", player.ID).Err(err).Msg("Failed to check syndicate committee list")
+	}
+
+	if isCommittee {
+		return fmt.Sprintf("Player %s #%d is a committee of our syndicate now.", player.Username.String, player.Gid), nil
+	}
+
+	// check the player is a director
+	isDirector, err := boiler.SyndicateDirectors(
+		boiler.SyndicateDirectorWhere.SyndicateID.EQ(s.ID),
+		boiler.SyndicateDirectorWhere.PlayerID.EQ(player.ID),
+	).Exists(gamedb.StdConn)
+	if err != nil {
+		gamelog.L.Error().Str("syndicate id", s.ID).Str("player id", player.ID).Err(err).Msg("Failed to check syndicate director list")
+	}
+
+	if isDirector {
+		return fmt.Sprintf("Player %s #%d is a director of our syndicate now.", player.Username.String, player.Gid), nil
+	}
+
+	player.SyndicateID = null.StringFromPtr(nil)
+	_, err = player.Update(gamedb.StdConn, boil.Whitelist(boiler.PlayerColumns.SyndicateID))
+	if err != nil {
+		gamelog.L.Error().Str("player id", player.ID).Err(err).Msg("Failed to update player syndicate id in db")
+		return "", terror.Error(err, "Failed to remove member.")
+	}
+
+	err = player.L.LoadRole(gamedb.StdConn, true, player, nil)
+	if err != nil {
+		gamelog.L.Error().Str("player id", player.ID).Err(err).Msg("Failed to load role_id")
+	}
+
+	ws.PublishMessage(fmt.Sprintf("/secure/user/%s", player.ID), server.HubKeyUserSubscribe, server.PlayerFromBoiler(player))
+
+	return "", nil
+}
@@ -21,6 +21,8 @@ type System struct {
 	Passport         *xsyn_rpcclient.XsynXrpcClient
 	syndicateMap     map[string]*Syndicate
 	deadlock.RWMutex
+
+	ChatEventChan chan *ChatEvent
 }
 
 func (ss *System) getSyndicate(id string) (*Syndicate, error) {
@@ -67,6 +69,7 @@ func NewSystem(Passport *xsyn_rpcclient.XsynXrpcClient, profanityManager *profan
 		profanityManager: profanityManager,
 		Passport:         Passport,
 		syndicateMap:     make(map[string]*Syndicate),
+		ChatEventChan:    make(chan *ChatEvent, 100),
 	}
 
 	for _, syndicate := range syndicates {
@@ -98,6 +101,12 @@ func (ss *System) RegisterSyndicate(syndicateID string) error {
 
 	ss.addSyndicate(s)
 
+	ss.sendChatEvent(&ChatEvent{
+		Type:        ChatEventTypeCreated,
+		SyndicateID: s.ID,
+		FactionID:   s.FactionID,
+	})
+
 	return nil
 }
 
@@ -204,7 +213,7 @@ func (ss *System) GetOngoingMotions(user *boiler.Player) ([]*boiler.SyndicateMot
 	return oms, nil
 }
 
-// LiquidateSyndicate remove syndicate from the system
+// LiquidateSyndicate remove syndicate from the system, call RemoveSyndicateChat once the transaction is committed
 func (ss *System) LiquidateSyndicate(tx *sql.Tx, id string) error {
 	s, err := ss.getSyndicate(id)
 	if err != nil {
@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"html"
 	"server"
+	"server/db"
 	"server/db/boiler"
 	"server/gamedb"
 	"server/gamelog"
+	"time"
 
 	"github.com/microcosm-cc/bluemonday"
+	"github.com/ninja-software/terror/v2"
 	"github.com/ninja-syndicate/ws"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
@@ -18,6 +21,38 @@ import (
 type SystemMessagingManager struct {
 }
 
+// New starts the system messaging manager, which sends the scheduled broadcast system messages when they are due.
+func New() *SystemMessagingManager {
+	smm := &SystemMessagingManager{}
+	go smm.Run()
+	return smm
+}
+
+func (smm *SystemMessagingManager) Run() {
+	defer func() {
+		if r := recover(); r != nil {
+			gamelog.LogPanicRecovery("panic! panic! panic! Panic at the SystemMessagingManager!", r)
+		}
+	}()
+
+	ticker := time.NewTicker(10 * time.Second)
+	for range ticker.C {
+		due, err := boiler.BroadcastSystemMessages(
+			boiler.BroadcastSystemMessageWhere.NotifiedAt.IsNull(),
+			boiler.BroadcastSystemMessageWhere.DeletedAt.IsNull(),
+			boiler.BroadcastSystemMessageWhere.SendAt.LTE(time.Now()),
+		).All(gamedb.StdConn)
+		if err != nil {
+			gamelog.L.Error().Err(err).Msg("failed to load scheduled broadcast system messages")
+			continue
+		}
+
+		for _, b := range due {
+			notifyBroadcast(b)
+		}
+	}
+}
+
 type SystemMessageDataType string
 
 const (
@@ -29,85 +64,173 @@ const (
 	SystemMessageDataTypeFaction               SystemMessageDataType = "FACTION"
 	SystemMessageDataTypeExpiredBattleLobby    SystemMessageDataType = "EXPIRED_BATTLE_LOBBY"
 	SystemMessageDataTypeBattleLobbyInvitation SystemMessageDataType = "BATTLE_LOBBY_INVITATION"
+	SystemMessageDataTypeSyndicateDues         SystemMessageDataType = "SYNDICATE_DUES"
+	SystemMessageDataTypeFollowedMechDeployed  SystemMessageDataType = "FOLLOWED_MECH_DEPLOYED"
+	SystemMessageDataTypeFollowedMechWon       SystemMessageDataType = "FOLLOWED_MECH_WON"
+	SystemMessageDataTypeMarketplaceAlert      SystemMessageDataType = "MARKETPLACE_ALERT"
+	SystemMessageDataTypeFiatGift              SystemMessageDataType = "FIAT_GIFT"
 )
 
 var bm = bluemonday.StrictPolicy()
 
-func BroadcastGlobalSystemMessage(title string, message string, dataType SystemMessageDataType, data *interface{}) error {
-	l := gamelog.L.With().Str("func", "BroadcastGlobalSystemMessage").Logger()
+// BroadcastSystemMessage is a system message stored once and shown to every player in its audience.
+type BroadcastSystemMessage struct {
+	SenderID string
+	// Audience is one of the db.SystemMessageAudience values, and AudienceID the faction, syndicate, role or feature of it
+	Audience   string
+	AudienceID string
+	Title      string
+	Message    string
+	DataType   SystemMessageDataType
+	Data       *interface{}
+	// SendAt schedules the message, it is sent straight away when it is zero. The message is hidden once it expires
+	SendAt    time.Time
+	ExpiresAt null.Time
+}
 
-	players, err := boiler.Players().All(gamedb.StdConn)
-	if err != nil {
-		l.Error().Err(err).Msg("failed to get players from db")
-		return err
+// Broadcast stores a broadcast system message, and tells the online players of its audience about it, unless it is scheduled.
+func Broadcast(bsm *BroadcastSystemMessage) (*boiler.BroadcastSystemMessage, error) {
+	l := gamelog.L.With().Str("func", "Broadcast").Str("audience", bsm.Audience).Str("audience id", bsm.AudienceID).Logger()
+
+	valid, needsID := db.SystemMessageAudienceIsValid(bsm.Audience)
+	if !valid {
+		return nil, terror.Error(fmt.Errorf("invalid audience: %s", bsm.Audience), "Invalid system message audience.")
+	}
+	if needsID != (bsm.AudienceID != "") {
+		return nil, terror.Error(fmt.Errorf("invalid audience id: %q", bsm.AudienceID), "Invalid system message audience.")
+	}
+	if bsm.ExpiresAt.Valid && !bsm.ExpiresAt.Time.After(time.Now()) {
+		return nil, terror.Error(fmt.Errorf("system message has expired"), "System message expiry must be in the future.")
 	}
-	l = l.With().Interface("players", players).Logger()
 
-	sanitisedTitle := html.UnescapeString(bm.Sanitize(title))
-	sanitisedMsg := html.UnescapeString(bm.Sanitize(message))
-	template := &boiler.SystemMessage{
-		SenderID: server.SupremacySystemAdminUserID,
-		Title:    sanitisedTitle,
-		Message:  sanitisedMsg,
+	b := &boiler.BroadcastSystemMessage{
+		SenderID:  bsm.SenderID,
+		Audience:  bsm.Audience,
+		Title:     html.UnescapeString(bm.Sanitize(bsm.Title)),
+		Message:   html.UnescapeString(bm.Sanitize(bsm.Message)),
+		SendAt:    bsm.SendAt,
+		ExpiresAt: bsm.ExpiresAt,
 	}
 
-	if dataType != "" {
-		template.DataType = null.StringFrom(string(dataType))
+	if b.SendAt.IsZero() {
+		b.SendAt = time.Now()
 	}
 
-	if data != nil {
-		marshalled, err := json.Marshal(data)
-		if err != nil {
-			l.Error().Err(err).Interface("objectToMarshal", data).Msg("failed to marshal global system message data")
-			return err
-		}
-		template.Data = null.JSONFrom(marshalled)
+	if bsm.AudienceID != "" {
+		b.AudienceID = null.StringFrom(bsm.AudienceID)
 	}
-	l = l.With().Interface("templateMsg", template).Logger()
 
-	for _, p := range players {
-		msg := &boiler.SystemMessage{
-			PlayerID: p.ID,
-			SenderID: template.SenderID,
-			Title:    template.Title,
-			Message:  template.Message,
-			Data:     template.Data,
-			DataType: template.DataType,
-		}
-		err := msg.Insert(gamedb.StdConn, boil.Infer())
+	if bsm.DataType != "" {
+		b.DataType = null.StringFrom(string(bsm.DataType))
+	}
+
+	if bsm.Data != nil {
+		marshalled, err := json.Marshal(bsm.Data)
 		if err != nil {
-			l.Error().Err(err).Interface("newSystemMessage", msg).Msg("failed to insert new global system message into db")
-			return err
+			l.Error().Err(err).Interface("objectToMarshal", bsm.Data).Msg("failed to marshal broadcast system message data")
+			return nil, err
 		}
+		b.Data = null.JSONFrom(marshalled)
+	}
 
-		ws.PublishMessage(fmt.Sprintf("/secure/user/%s/system_messages", p.ID), server.HubKeySystemMessageListUpdatedSubscribe, true)
+	err := b.Insert(gamedb.StdConn, boil.Infer())
+	if err != nil {
+		l.Error().Err(err).Interface("newSystemMessage", b).Msg("failed to insert new broadcast system message into db")
+		return nil, err
 	}
-	return nil
+
+	if !b.SendAt.After(time.Now()) {
+		notifyBroadcast(b)
+	}
+
+	return b, nil
 }
 
-func BroadcastFactionSystemMessage(factionID string, title string, message string, dataType SystemMessageDataType, data *interface{}) error {
-	l := gamelog.L.With().Str("func", "BroadcastGlobalSystemMessage").Logger()
+// notifyBroadcast tells the online players in the audience of a broadcast system message to refresh their system messages.
+func notifyBroadcast(b *boiler.BroadcastSystemMessage) {
+	l := gamelog.L.With().Str("func", "notifyBroadcast").Str("broadcast system message id", b.ID).Logger()
 
-	players, err := boiler.Players(boiler.PlayerWhere.FactionID.EQ(null.StringFrom(factionID))).All(gamedb.StdConn)
+	b.NotifiedAt = null.TimeFrom(time.Now())
+	_, err := b.Update(gamedb.StdConn, boil.Whitelist(boiler.BroadcastSystemMessageColumns.NotifiedAt))
 	if err != nil {
-		l.Error().Err(err).Msg("failed to get players from db")
-		return err
+		l.Error().Err(err).Msg("failed to update broadcast system message notified at")
+		return
+	}
+
+	playerIDs, err := db.BroadcastSystemMessageAudienceFilter(gamedb.StdConn, b.ID, ws.TrackedIdents())
+	if err != nil {
+		l.Error().Err(err).Msg("failed to load broadcast system message audience")
+		return
+	}
+
+	for _, playerID := range playerIDs {
+		ws.PublishMessage(fmt.Sprintf("/secure/user/%s/system_messages", playerID), server.HubKeySystemMessageListUpdatedSubscribe, true)
 	}
-	l = l.With().Interface("players", players).Logger()
+}
 
+func BroadcastGlobalSystemMessage(title string, message string, dataType SystemMessageDataType, data *interface{}) error {
+	_, err := Broadcast(&BroadcastSystemMessage{
+		SenderID: server.SupremacySystemAdminUserID,
+		Audience: db.SystemMessageAudienceGlobal,
+		Title:    title,
+		Message:  message,
+		DataType: dataType,
+		Data:     data,
+	})
+	return err
+}
+
+// FactionSender returns the faction user which faction system messages are sent from.
+func FactionSender(factionID string) (*boiler.Player, error) {
 	sender, err := boiler.Players(
 		boiler.PlayerWhere.FactionID.EQ(null.StringFrom(factionID)),
 		boiler.PlayerWhere.ID.IN([]string{server.RedMountainPlayerID, server.BostonCyberneticsPlayerID, server.ZaibatsuPlayerID}),
 	).One(gamedb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to find faction user.")
+	}
+	return sender, nil
+}
+
+func BroadcastFactionSystemMessage(factionID string, title string, message string, dataType SystemMessageDataType, data *interface{}) error {
+	l := gamelog.L.With().Str("func", "BroadcastFactionSystemMessage").Logger()
+
+	sender, err := FactionSender(factionID)
 	if err != nil {
 		l.Error().Err(err).Str("factionID", factionID).Msg("failed to get faction user from faction ID")
 		return err
 	}
 
+	_, err = Broadcast(&BroadcastSystemMessage{
+		SenderID:   sender.ID,
+		Audience:   db.SystemMessageAudienceFaction,
+		AudienceID: factionID,
+		Title:      title,
+		Message:    message,
+		DataType:   dataType,
+		Data:       data,
+	})
+	return err
+}
+
+// BroadcastFollowerSystemMessage sends the system message to the followers of the player
+func BroadcastFollowerSystemMessage(playerID string, title string, message string, dataType SystemMessageDataType, data *interface{}) error {
+	l := gamelog.L.With().Str("func", "BroadcastFollowerSystemMessage").Str("player id", playerID).Logger()
+
+	follows, err := boiler.PlayerFollows(boiler.PlayerFollowWhere.FollowedID.EQ(playerID)).All(gamedb.StdConn)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to get followers from db")
+		return err
+	}
+
+	if len(follows) == 0 {
+		return nil
+	}
+
 	sanitisedTitle := html.UnescapeString(bm.Sanitize(title))
 	sanitisedMsg := html.UnescapeString(bm.Sanitize(message))
 	template := &boiler.SystemMessage{
-		SenderID: sender.ID,
+		SenderID: server.SupremacySystemAdminUserID,
 		Title:    sanitisedTitle,
 		Message:  sanitisedMsg,
 	}
@@ -119,20 +242,16 @@ func BroadcastFactionSystemMessage(factionID string, title string, message strin
 	if data != nil {
 		marshalled, err := json.Marshal(data)
 		if err != nil {
-			l.Error().Err(err).Interface("objectToMarshal", data).Msg("failed to marshal faction system message data")
+			l.Error().Err(err).Interface("objectToMarshal", data).Msg("failed to marshal follower system message data")
 			return err
 		}
 		template.Data = null.JSONFrom(marshalled)
 	}
 	l = l.With().Interface("templateMsg", template).Logger()
 
-	for _, p := range players {
-		if p.FactionID.String != factionID {
-			continue
-		}
-
+	for _, f := range follows {
 		msg := &boiler.SystemMessage{
-			PlayerID: p.ID,
+			PlayerID: f.FollowerID,
 			SenderID: template.SenderID,
 			Title:    template.Title,
 			Message:  template.Message,
@@ -141,11 +260,11 @@ func BroadcastFactionSystemMessage(factionID string, title string, message strin
 		}
 		err := msg.Insert(gamedb.StdConn, boil.Infer())
 		if err != nil {
-			l.Error().Err(err).Interface("newSystemMessage", msg).Msg("failed to insert new global system message into db")
+			l.Error().Err(err).Interface("newSystemMessage", msg).Msg("failed to insert new follower system message into db")
 			return err
 		}
 
-		ws.PublishMessage(fmt.Sprintf("/secure/user/%s/system_messages", p.ID), server.HubKeySystemMessageListUpdatedSubscribe, true)
+		ws.PublishMessage(fmt.Sprintf("/secure/user/%s/system_messages", f.FollowerID), server.HubKeySystemMessageListUpdatedSubscribe, true)
 	}
 
 	return nil